@@ -3,6 +3,7 @@ package adapters
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store"
@@ -11,8 +12,20 @@ import (
 )
 
 var (
+	// TaskTypeBase64Decode is the identifier for the Base64Decode adapter.
+	TaskTypeBase64Decode = models.MustNewTaskType("base64decode")
+	// TaskTypeBase64Encode is the identifier for the Base64Encode adapter.
+	TaskTypeBase64Encode = models.MustNewTaskType("base64encode")
+	// TaskTypeCondition is the identifier for the Condition adapter.
+	TaskTypeCondition = models.MustNewTaskType("condition")
 	// TaskTypeCopy is the identifier for the Copy adapter.
 	TaskTypeCopy = models.MustNewTaskType("copy")
+	// TaskTypeDivide is the identifier for the Divide adapter.
+	TaskTypeDivide = models.MustNewTaskType("divide")
+	// TaskTypeEthABIDecode is the identifier for the EthABIDecode adapter.
+	TaskTypeEthABIDecode = models.MustNewTaskType("ethabidecode")
+	// TaskTypeEthABIEncode is the identifier for the EthABIEncode adapter.
+	TaskTypeEthABIEncode = models.MustNewTaskType("ethabiencode")
 	// TaskTypeEthBool is the identifier for the EthBool adapter.
 	TaskTypeEthBool = models.MustNewTaskType("ethbool")
 	// TaskTypeEthBytes32 is the identifier for the EthBytes32 adapter.
@@ -21,8 +34,14 @@ var (
 	TaskTypeEthInt256 = models.MustNewTaskType("ethint256")
 	// TaskTypeEthUint256 is the identifier for the EthUint256 adapter.
 	TaskTypeEthUint256 = models.MustNewTaskType("ethuint256")
+	// TaskTypeEthCall is the identifier for the EthCall adapter.
+	TaskTypeEthCall = models.MustNewTaskType("ethcall")
+	// TaskTypeEthSign is the identifier for the EthSign adapter.
+	TaskTypeEthSign = models.MustNewTaskType("ethsign")
 	// TaskTypeEthTx is the identifier for the EthTx adapter.
 	TaskTypeEthTx = models.MustNewTaskType("ethtx")
+	// TaskTypeGraphQL is the identifier for the GraphQL adapter.
+	TaskTypeGraphQL = models.MustNewTaskType("graphql")
 	// TaskTypeHTTPGetWithUnrestrictedNetworkAccess is the identifier for the HTTPGet adapter, with local/private IP access enabled.
 	TaskTypeHTTPGetWithUnrestrictedNetworkAccess = models.MustNewTaskType("httpgetwithunrestrictednetworkaccess")
 	// TaskTypeHTTPPostWithUnrestrictedNetworkAccess is the identifier for the HTTPPost adapter, with local/private IP access enabled.
@@ -31,14 +50,30 @@ var (
 	TaskTypeHTTPGet = models.MustNewTaskType("httpget")
 	// TaskTypeHTTPPost is the identifier for the HTTPPost adapter.
 	TaskTypeHTTPPost = models.MustNewTaskType("httppost")
+	// TaskTypeHexDecode is the identifier for the HexDecode adapter.
+	TaskTypeHexDecode = models.MustNewTaskType("hexdecode")
+	// TaskTypeHexEncode is the identifier for the HexEncode adapter.
+	TaskTypeHexEncode = models.MustNewTaskType("hexencode")
 	// TaskTypeJSONParse is the identifier for the JSONParse adapter.
 	TaskTypeJSONParse = models.MustNewTaskType("jsonparse")
+	// TaskTypeJSONTransform is the identifier for the JSONTransform adapter.
+	TaskTypeJSONTransform = models.MustNewTaskType("jsontransform")
+	// TaskTypeMean is the identifier for the Mean adapter.
+	TaskTypeMean = models.MustNewTaskType("mean")
+	// TaskTypeMedian is the identifier for the Median adapter.
+	TaskTypeMedian = models.MustNewTaskType("median")
+	// TaskTypeMode is the identifier for the Mode adapter.
+	TaskTypeMode = models.MustNewTaskType("mode")
 	// TaskTypeMultiply is the identifier for the Multiply adapter.
 	TaskTypeMultiply = models.MustNewTaskType("multiply")
 	// TaskTypeNoOp is the identifier for the NoOp adapter.
 	TaskTypeNoOp = models.MustNewTaskType("noop")
 	// TaskTypeNoOpPendOutgoing is the identifier for the NoOpPendOutgoing adapter.
 	TaskTypeNoOpPendOutgoing = models.MustNewTaskType("nooppendoutgoing")
+	// TaskTypeResultCollect is the identifier for the ResultCollect adapter.
+	TaskTypeResultCollect = models.MustNewTaskType("resultcollect")
+	// TaskTypeSignedWebhook is the identifier for the SignedWebhook adapter.
+	TaskTypeSignedWebhook = models.MustNewTaskType("signedwebhook")
 	// TaskTypeSleep is the identifier for the Sleep adapter.
 	TaskTypeSleep = models.MustNewTaskType("sleep")
 	// TaskTypeRandom is the identifier for the Random adapter.
@@ -56,6 +91,12 @@ type BaseAdapter interface {
 	Perform(models.RunInput, *store.Store) models.RunOutput
 }
 
+// taskTimeoutSetter is implemented by adapters whose requests time out after
+// DEFAULT_HTTP_TIMEOUT, unless the task has a timeout of its own.
+type taskTimeoutSetter interface {
+	setTaskTimeout(time.Duration)
+}
+
 // PipelineAdapter wraps a BaseAdapter with requirements for execution in the pipeline.
 type PipelineAdapter struct {
 	BaseAdapter
@@ -88,6 +129,9 @@ func For(task models.TaskSpec, config orm.ConfigReader, orm *orm.ORM) (*Pipeline
 			return nil, fmt.Errorf("%s is not a supported adapter type", task.Type)
 		}
 		b := Bridge{BridgeType: bt, Params: task.Params}
+		if err := b.takeResponseSchema(); err != nil {
+			return nil, err
+		}
 		ba = &b
 		mp = bt.MinimumContractPayment
 		mic = b.Confirmations
@@ -96,6 +140,9 @@ func For(task models.TaskSpec, config orm.ConfigReader, orm *orm.ORM) (*Pipeline
 	if ba == nil {
 		return nil, fmt.Errorf("%s is not a supported adapter type", task.Type)
 	}
+	if t, ok := ba.(taskTimeoutSetter); ok && task.Timeout != nil {
+		t.setTaskTimeout(task.Timeout.Duration())
+	}
 
 	pa := &PipelineAdapter{
 		BaseAdapter: ba,
@@ -109,8 +156,20 @@ func For(task models.TaskSpec, config orm.ConfigReader, orm *orm.ORM) (*Pipeline
 // FindNativeAdapterFor find the native adapter for a given task
 func FindNativeAdapterFor(task models.TaskSpec) BaseAdapter {
 	switch task.Type {
+	case TaskTypeBase64Decode:
+		return &Base64Decode{}
+	case TaskTypeBase64Encode:
+		return &Base64Encode{}
+	case TaskTypeCondition:
+		return &Condition{}
 	case TaskTypeCopy:
 		return &Copy{}
+	case TaskTypeDivide:
+		return &Divide{}
+	case TaskTypeEthABIDecode:
+		return &EthABIDecode{}
+	case TaskTypeEthABIEncode:
+		return &EthABIEncode{}
 	case TaskTypeEthBool:
 		return &EthBool{}
 	case TaskTypeEthBytes32:
@@ -119,8 +178,14 @@ func FindNativeAdapterFor(task models.TaskSpec) BaseAdapter {
 		return &EthInt256{}
 	case TaskTypeEthUint256:
 		return &EthUint256{}
+	case TaskTypeEthCall:
+		return &EthCall{}
+	case TaskTypeEthSign:
+		return &EthSign{}
 	case TaskTypeEthTx:
 		return &EthTx{}
+	case TaskTypeGraphQL:
+		return &GraphQL{}
 	case TaskTypeHTTPGetWithUnrestrictedNetworkAccess:
 		return &HTTPGet{AllowUnrestrictedNetworkAccess: true}
 	case TaskTypeHTTPPostWithUnrestrictedNetworkAccess:
@@ -129,14 +194,30 @@ func FindNativeAdapterFor(task models.TaskSpec) BaseAdapter {
 		return &HTTPGet{}
 	case TaskTypeHTTPPost:
 		return &HTTPPost{}
+	case TaskTypeHexDecode:
+		return &HexDecode{}
+	case TaskTypeHexEncode:
+		return &HexEncode{}
 	case TaskTypeJSONParse:
 		return &JSONParse{}
+	case TaskTypeJSONTransform:
+		return &JSONTransform{}
+	case TaskTypeMean:
+		return &Mean{}
+	case TaskTypeMedian:
+		return &Median{}
+	case TaskTypeMode:
+		return &Mode{}
 	case TaskTypeMultiply:
 		return &Multiply{}
 	case TaskTypeNoOp:
 		return &NoOp{}
 	case TaskTypeNoOpPendOutgoing:
 		return &NoOpPendOutgoing{}
+	case TaskTypeResultCollect:
+		return &ResultCollect{}
+	case TaskTypeSignedWebhook:
+		return &SignedWebhook{}
 	case TaskTypeSleep:
 		return &Sleep{}
 	case TaskTypeRandom:
@@ -150,6 +231,19 @@ func FindNativeAdapterFor(task models.TaskSpec) BaseAdapter {
 	}
 }
 
+// ReplaySafe returns false for adapters whose requests may have side effects
+// outside the node, which must not be repeated if the node stops while they
+// are being performed. EthTx is safe, as its transactions are idempotent for
+// a task run.
+func ReplaySafe(adapter BaseAdapter) bool {
+	switch adapter.(type) {
+	case *HTTPPost, *SignedWebhook, *Bridge:
+		return false
+	default:
+		return true
+	}
+}
+
 func unmarshalParams(params models.JSON, dst interface{}) error {
 	bytes, err := params.MarshalJSON()
 	if err != nil {
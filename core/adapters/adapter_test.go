@@ -58,3 +58,13 @@ func TestAdapterFor(t *testing.T) {
 		})
 	}
 }
+
+func TestReplaySafe(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, adapters.ReplaySafe(&adapters.HTTPGet{}))
+	assert.True(t, adapters.ReplaySafe(&adapters.EthTx{}))
+	assert.False(t, adapters.ReplaySafe(&adapters.HTTPPost{}))
+	assert.False(t, adapters.ReplaySafe(&adapters.SignedWebhook{}))
+	assert.False(t, adapters.ReplaySafe(&adapters.Bridge{}))
+}
@@ -0,0 +1,157 @@
+package adapters
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	simplejson "github.com/bitly/go-simplejson"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/tidwall/gjson"
+)
+
+// Aggregate holds the parameters shared by the Median, Mean and Mode
+// adapters, which reduce an array of numbers to one.
+type Aggregate struct {
+	// Path leads to the array within the result, which is the array itself
+	// if Path is empty
+	Path JSONPath `json:"path,omitempty"`
+	// Trim is the share of the values dropped from each end of the sorted
+	// array before it is reduced, e.g. 0.1 drops the lowest and the highest
+	// tenth
+	Trim *decimal.Decimal `json:"trim,omitempty"`
+}
+
+// values returns the sorted and trimmed numbers of the array in the result.
+// The numbers may also be given as strings.
+func (a Aggregate) values(input models.RunInput) ([]decimal.Decimal, error) {
+	trim := decimal.Zero
+	if a.Trim != nil {
+		trim = *a.Trim
+	}
+	if trim.IsNegative() || trim.GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+		return nil, fmt.Errorf("trim must be at least 0 and less than 0.5, got %s", trim)
+	}
+
+	raw := input.Result().String()
+	if input.Result().Type == gjson.JSON {
+		raw = input.Result().Raw
+	}
+	js, err := simplejson.NewJson([]byte(raw))
+	if err != nil {
+		return nil, errors.Wrap(err, "result is not JSON")
+	}
+	js, err = dig(js, a.Path)
+	if err != nil {
+		return nil, err
+	}
+	array, err := js.Array()
+	if err != nil {
+		return nil, errors.New("value to aggregate is not an array")
+	}
+
+	values := make([]decimal.Decimal, len(array))
+	for i, elem := range array {
+		values[i], err = decimal.NewFromString(fmt.Sprint(elem))
+		if err != nil {
+			return nil, fmt.Errorf("element %v of %v is not a number", i, elem)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].LessThan(values[j]) })
+
+	trimmed := trim.Mul(decimal.NewFromInt(int64(len(values)))).IntPart()
+	values = values[trimmed : int64(len(values))-trimmed]
+	if len(values) == 0 {
+		return nil, errors.New("no values to aggregate")
+	}
+	return values, nil
+}
+
+// Median returns the median of an array of numbers.
+type Median struct {
+	Aggregate
+}
+
+// TaskType returns the type of Adapter.
+func (m *Median) TaskType() models.TaskType {
+	return TaskTypeMedian
+}
+
+// Perform returns the median of the numbers in the array, which for an even
+// number of values is the mean of the two in the middle.
+//
+// For example, the median of [1, "3", 2, 10] is "2.5".
+func (m *Median) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	values, err := m.values(input)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	middle := len(values) / 2
+	median := values[middle]
+	if len(values)%2 == 0 {
+		median = median.Add(values[middle-1]).Div(decimal.NewFromInt(2))
+	}
+	return models.NewRunOutputCompleteWithResult(median.String())
+}
+
+// Mean returns the mean of an array of numbers.
+type Mean struct {
+	Aggregate
+}
+
+// TaskType returns the type of Adapter.
+func (m *Mean) TaskType() models.TaskType {
+	return TaskTypeMean
+}
+
+// Perform returns the mean of the numbers in the array.
+//
+// For example, the mean of [1, 2, 3, 10] is "4", or "2.5" when trimming 0.25.
+func (m *Mean) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	values, err := m.values(input)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	sum := decimal.Zero
+	for _, value := range values {
+		sum = sum.Add(value)
+	}
+	return models.NewRunOutputCompleteWithResult(sum.Div(decimal.NewFromInt(int64(len(values)))).String())
+}
+
+// Mode returns the most common value of an array of numbers.
+type Mode struct {
+	Aggregate
+}
+
+// TaskType returns the type of Adapter.
+func (m *Mode) TaskType() models.TaskType {
+	return TaskTypeMode
+}
+
+// Perform returns the value which occurs most often in the array, or the
+// lowest of them if several occur as often.
+//
+// For example, the mode of [3, 1, 3, 1, 2] is "1".
+func (m *Mode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	values, err := m.values(input)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	// The values are sorted, so equal ones are next to each other
+	mode, modeCount := values[0], 0
+	for i := 0; i < len(values); {
+		j := i
+		for j < len(values) && values[j].Equal(values[i]) {
+			j++
+		}
+		if j-i > modeCount {
+			mode, modeCount = values[i], j-i
+		}
+		i = j
+	}
+	return models.NewRunOutputCompleteWithResult(mode.String())
+}
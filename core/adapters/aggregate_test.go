@@ -0,0 +1,68 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregate_Perform(t *testing.T) {
+	tests := []struct {
+		name    string
+		adapter adapters.BaseAdapter
+		json    string
+		want    string
+	}{
+		{"median odd", &adapters.Median{}, `{"result":[3, 1, 2]}`, "2"},
+		{"median even", &adapters.Median{}, `{"result":[1, "3", 2, 10]}`, "2.5"},
+		{"median single", &adapters.Median{}, `{"result":["7.25"]}`, "7.25"},
+		{"median trimmed", &adapters.Median{Aggregate: adapters.Aggregate{Trim: mustDecimal(t, "0.25")}}, `{"result":[1, 2, 3, 100]}`, "2.5"},
+		{"median at path", &adapters.Median{Aggregate: adapters.Aggregate{Path: []string{"data", "answers"}}}, `{"result":{"data":{"answers":[5, 1, 4]}}}`, "4"},
+		{"mean", &adapters.Mean{}, `{"result":[1, 2, 3, 10]}`, "4"},
+		{"mean trimmed", &adapters.Mean{Aggregate: adapters.Aggregate{Trim: mustDecimal(t, "0.25")}}, `{"result":[1, 2, 3, 10]}`, "2.5"},
+		{"mean large values", &adapters.Mean{}, `{"result":["100000000000000000000.1", "100000000000000000000.3"]}`, "100000000000000000000.2"},
+		{"mode", &adapters.Mode{}, `{"result":[2, 3, 3, 1]}`, "3"},
+		{"mode ties go to the lowest", &adapters.Mode{}, `{"result":[3, 1, 3, 1, 2]}`, "1"},
+		{"mode compares values", &adapters.Mode{}, `{"result":["1.50", 1.5, 2]}`, "1.5"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := test.adapter.Perform(input, nil)
+
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.want, result.Result().String())
+		})
+	}
+}
+
+func TestAggregate_Perform_Failure(t *testing.T) {
+	tests := []struct {
+		name    string
+		adapter adapters.BaseAdapter
+		json    string
+	}{
+		{"not an array", &adapters.Median{}, `{"result":"1"}`},
+		{"empty array", &adapters.Mean{}, `{"result":[]}`},
+		{"not a number", &adapters.Mode{}, `{"result":[1, "one"]}`},
+		{"missing path", &adapters.Median{Aggregate: adapters.Aggregate{Path: []string{"answers"}}}, `{"result":{"data":[1]}}`},
+		{"trim too large", &adapters.Median{Aggregate: adapters.Aggregate{Trim: mustDecimal(t, "0.5")}}, `{"result":[1, 2]}`},
+		{"negative trim", &adapters.Mean{Aggregate: adapters.Aggregate{Trim: mustDecimal(t, "-0.1")}}, `{"result":[1, 2]}`},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := test.adapter.Perform(input, nil)
+
+			require.Error(t, result.Error())
+		})
+	}
+}
@@ -7,15 +7,25 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// DefaultCircuitBreakerCooldown is how long a bridge with a circuit breaker
+// threshold but no cooldown is skipped for.
+const DefaultCircuitBreakerCooldown = time.Minute
+
 // Bridge adapter is responsible for connecting the task pipeline to external
 // adapters, allowing for custom computations to be executed and included in runs.
 type Bridge struct {
@@ -80,14 +90,32 @@ func (ba *Bridge) handleNewRun(input models.RunInput, meta *models.JSON, store *
 	// URL is "safe" because it comes from the node's own database
 	// Some node operators may run external adapters on their own hardware
 	httpConfig.AllowUnrestrictedNetworkAccess = true
+	httpConfig.TLSConfig, err = clientTLSConfig(store, ba.ClientCertificate)
+	if err != nil {
+		return models.NewRunOutputError(baRunResultError("loading client certificate", err))
+	}
+	httpConfig.Proxy, err = utils.ParseProxyURL(ba.Proxy)
+	if err != nil {
+		return models.NewRunOutputError(baRunResultError("parsing proxy", err))
+	}
+	if !ba.Timeout.IsInstant() {
+		httpConfig.Timeout = ba.Timeout.Duration()
+	}
+	if ba.Retries.Valid {
+		httpConfig.MaxAttempts = uint(ba.Retries.Uint32) + 1
+	}
 
-	body, err := ba.postToExternalAdapter(input, meta, responseURL, httpConfig)
+	if err = store.BridgeCircuitBreakers.Allow(ba.Name.String(), store.Clock.Now()); err != nil {
+		return models.NewRunOutputError(baRunResultError("skipped", err))
+	}
+	body, sent, err := ba.postToExternalAdapter(input, meta, responseURL, httpConfig)
+	store.BridgeCircuitBreakers.Record(ba.Name.String(), err != nil, ba.CircuitBreakerThreshold, ba.circuitBreakerCooldown(), store.Clock.Now())
 	if err != nil {
 		return models.NewRunOutputError(baRunResultError("post to external adapter", err))
 	}
 
 	input = input.CloneWithData(data)
-	return ba.responseToRunResult(body, input)
+	return ba.responseToRunResult(body, input).WithBytesTransferred(int64(sent + len(body)))
 }
 
 func (ba *Bridge) responseToRunResult(body []byte, input models.RunInput) models.RunOutput {
@@ -105,6 +133,10 @@ func (ba *Bridge) responseToRunResult(body []byte, input models.RunInput) models
 		return models.NewRunOutputPendingBridge()
 	}
 
+	if err := ba.ValidateResponse(brr.Data); err != nil {
+		return models.NewRunOutputError(err)
+	}
+
 	if brr.Data.IsObject() {
 		data, err := models.Merge(ba.Params, brr.Data)
 		if err != nil {
@@ -122,10 +154,10 @@ func (ba *Bridge) postToExternalAdapter(
 	meta *models.JSON,
 	bridgeResponseURL *url.URL,
 	config utils.HTTPRequestConfig,
-) ([]byte, error) {
+) ([]byte, int, error) {
 	data, err := models.Merge(input.Data(), ba.Params)
 	if err != nil {
-		return nil, errors.Wrap(err, "error merging bridge params with input params")
+		return nil, 0, errors.Wrap(err, "error merging bridge params with input params")
 	}
 
 	outgoing := bridgeOutgoing{JobRunID: input.JobRunID().String(), Data: data, Meta: meta}
@@ -134,33 +166,92 @@ func (ba *Bridge) postToExternalAdapter(
 	}
 	in, err := json.Marshal(&outgoing)
 	if err != nil {
-		return nil, fmt.Errorf("marshaling request body: %v", err)
+		return nil, 0, fmt.Errorf("marshaling request body: %v", err)
 	}
 
 	request, err := http.NewRequest("POST", ba.URL.String(), bytes.NewBuffer(in))
 	if err != nil {
-		return nil, fmt.Errorf("building outgoing bridge http post: %v", err)
+		return nil, 0, fmt.Errorf("building outgoing bridge http post: %v", err)
 	}
 	request.Header.Set("Authorization", "Bearer "+ba.BridgeType.OutgoingToken)
 	request.Header.Set("Content-Type", "application/json")
 
+	taskRunID := input.TaskRunID()
+	ctx, span := tracing.Tracer().Start(
+		tracing.TaskContext(context.Background(), input.JobRunID(), &taskRunID),
+		"bridge.request",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("bridge.name", ba.Name.String())),
+	)
+	defer span.End()
+	// A trace which is being recorded is passed on to the adapter, which can
+	// add its own spans to it
+	if span.SpanContext().IsSampled() {
+		propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(request.Header))
+	}
+
 	httpRequest := utils.HTTPRequest{
 		Request: request,
 		Config:  config,
 	}
 
 	bytes, statusCode, err := httpRequest.SendRequest(context.TODO())
+	span.SetAttributes(attribute.Int("http.status_code", statusCode))
 
 	if err != nil {
-		return nil, err
+		span.SetStatus(codes.Error, err.Error())
+		return nil, len(in), err
 	}
 
 	if statusCode >= 400 {
 		err = fmt.Errorf("%v %v", statusCode, string(bytes))
-		return nil, fmt.Errorf("POST request: %v", err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, len(in), fmt.Errorf("POST request: %v", err)
+	}
+
+	return bytes, len(in), nil
+}
+
+// circuitBreakerCooldown returns how long the bridge is skipped for once its
+// circuit breaker opens.
+func (ba *Bridge) circuitBreakerCooldown() time.Duration {
+	if ba.CircuitBreakerCooldown.IsInstant() {
+		return DefaultCircuitBreakerCooldown
+	}
+	return ba.CircuitBreakerCooldown.Duration()
+}
+
+// ValidateResponse checks the data returned by the external adapter against
+// the response schema, if there is one.
+func (ba *Bridge) ValidateResponse(data models.JSON) error {
+	if ba.ResponseSchema == nil {
+		return nil
 	}
+	if err := ba.ResponseSchema.ValidateNamed("response", data); err != nil {
+		return baRunResultError("invalid response", err)
+	}
+	return nil
+}
 
-	return bytes, nil
+// takeResponseSchema moves a response schema given in the task's params,
+// which takes the place of the bridge's, out of the params sent to the
+// external adapter.
+func (ba *Bridge) takeResponseSchema() error {
+	schema := ba.Params.Get("responseSchema")
+	if !schema.Exists() {
+		return nil
+	}
+	var responseSchema models.InputSchema
+	if err := json.Unmarshal([]byte(schema.Raw), &responseSchema); err != nil {
+		return err
+	}
+	params, err := ba.Params.Delete("responseSchema")
+	if err != nil {
+		return err
+	}
+	ba.ResponseSchema = &responseSchema
+	ba.Params = params
+	return nil
 }
 
 func baRunResultError(str string, err error) error {
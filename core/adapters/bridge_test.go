@@ -2,17 +2,23 @@ package adapters_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestBridge_PerformEmbedsParamsInData(t *testing.T) {
@@ -109,6 +115,110 @@ func TestBridge_PerformAcceptsNonJsonObjectResponses(t *testing.T) {
 	assert.Equal(t, "251990120", result.Result().String())
 }
 
+func TestBridge_Perform_TraceParent(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	jobRunID := models.NewID()
+
+	var traceParents []string
+	mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"data": {"result": 1}}`,
+		func(h http.Header, b string) { traceParents = append(traceParents, h.Get("traceparent")) },
+	)
+	defer cleanup()
+
+	_, bt := cltest.NewBridgeType(t, "auctionBidding", mock.URL)
+	ba := &adapters.Bridge{BridgeType: *bt}
+	input := *models.NewRunInput(jobRunID, *models.NewID(), models.JSON{}, models.RunStatusUnstarted)
+
+	require.NoError(t, ba.Perform(input, store).Error())
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample())))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	require.NoError(t, ba.Perform(input, store).Error())
+
+	require.Len(t, traceParents, 2)
+	assert.Empty(t, traceParents[0])
+	assert.Regexp(t, "^00-"+hex.EncodeToString(jobRunID.Bytes())+"-[0-9a-f]{16}-01$", traceParents[1])
+}
+
+func TestBridge_Perform_ResponseSchema(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	tests := []struct {
+		name     string
+		schema   string
+		params   string
+		response string
+		wantErr  string
+	}{
+		{"conforming", `{"type": "object", "required": ["price"]}`, `{}`, `{"data": {"price": 100}}`, ""},
+		{"missing field", `{"type": "object", "required": ["price"]}`, `{}`, `{"data": {"cost": 100}}`,
+			"ExternalBridge invalid response: response is missing the required field price"},
+		{"wrong type", `{"type": "object", "properties": {"price": {"type": "number"}}}`, `{}`, `{"data": {"price": "100"}}`,
+			"ExternalBridge invalid response: response.price must be of type number, got string"},
+		{"task schema", `{"type": "object", "required": ["price"]}`, `{"responseSchema": {"type": "object", "required": ["cost"]}}`, `{"data": {"cost": 100}}`, ""},
+		{"pending", `{"type": "object", "required": ["price"]}`, `{}`, `{"pending": true}`, ""},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sent := ""
+			mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", test.response,
+				func(h http.Header, b string) { sent = b },
+			)
+			defer cleanup()
+
+			_, bt := cltest.NewBridgeType(t, fmt.Sprintf("validated%d", i), mock.URL)
+			var schema models.InputSchema
+			require.NoError(t, json.Unmarshal([]byte(test.schema), &schema))
+			bt.ResponseSchema = &schema
+
+			task := models.TaskSpec{Type: bt.Name, Params: cltest.JSONFromString(t, test.params)}
+			require.NoError(t, store.CreateBridgeType(bt))
+			adapter, err := adapters.For(task, store.Config, store.ORM)
+			require.NoError(t, err)
+
+			result := adapter.Perform(cltest.NewRunInputWithResult("100"), store)
+			if test.wantErr == "" {
+				require.NoError(t, result.Error())
+			} else {
+				assert.EqualError(t, result.Error(), test.wantErr)
+			}
+			assert.False(t, cltest.JSONFromString(t, sent).Get("data.responseSchema").Exists())
+		})
+	}
+}
+
+func TestBridge_Perform_CircuitBreaker(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	requests := 0
+	mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusInternalServerError, "POST", `{}`,
+		func(h http.Header, b string) { requests++ },
+	)
+	defer cleanup()
+
+	_, bt := cltest.NewBridgeType(t, "flakybridge", mock.URL)
+	bt.Retries = null.Uint32From(1)
+	bt.CircuitBreakerThreshold = 2
+	bt.CircuitBreakerCooldown = models.MustMakeDuration(time.Hour)
+	ba := &adapters.Bridge{BridgeType: *bt}
+
+	for i := 0; i < 2; i++ {
+		result := ba.Perform(cltest.NewRunInputWithResult("100"), store)
+		require.Error(t, result.Error())
+		assert.Contains(t, result.Error().Error(), "ExternalBridge post to external adapter")
+	}
+	assert.Equal(t, 4, requests)
+
+	result := ba.Perform(cltest.NewRunInputWithResult("100"), store)
+	require.Error(t, result.Error())
+	assert.Contains(t, result.Error().Error(), "ExternalBridge skipped: circuit open after 2 consecutive failures")
+	assert.Equal(t, 4, requests)
+}
+
 func TestBridge_Perform_transitionsTo(t *testing.T) {
 	t.Parallel()
 	cases := []struct {
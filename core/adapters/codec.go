@@ -0,0 +1,178 @@
+package adapters
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// CodecFormatHex holds bytes as a 0x prefixed hex string, the form
+	// contracts and the Eth adapters take them in.
+	CodecFormatHex = "hex"
+	// CodecFormatText holds bytes as a UTF-8 string.
+	CodecFormatText = "text"
+)
+
+// Base64Encode encodes the result as base64.
+type Base64Encode struct {
+	// Format is how the bytes encoded are held in the result, either "hex"
+	// or "text". If unset, results with a 0x prefix are taken as hex, and
+	// others as text.
+	Format string `json:"format,omitempty"`
+	// URLSafe encodes with the URL and filename safe alphabet
+	URLSafe bool `json:"urlSafe,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (b64e *Base64Encode) TaskType() models.TaskType {
+	return TaskTypeBase64Encode
+}
+
+// Perform sets the result to its base64 encoding.
+func (b64e *Base64Encode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	val, err := input.ResultString()
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	format := b64e.Format
+	if format == "" {
+		format = CodecFormatText
+		if utils.HasHexPrefix(val) {
+			format = CodecFormatHex
+		}
+	}
+	b, err := bytesFrom(val, format)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(base64Encoding(b64e.URLSafe).EncodeToString(b))
+}
+
+// Base64Decode decodes the result from base64, such as a binary payload
+// returned by an API.
+type Base64Decode struct {
+	// Format is how the decoded bytes are held in the result, either "hex",
+	// the default, or "text".
+	Format string `json:"format,omitempty"`
+	// URLSafe decodes with the URL and filename safe alphabet
+	URLSafe bool `json:"urlSafe,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (b64d *Base64Decode) TaskType() models.TaskType {
+	return TaskTypeBase64Decode
+}
+
+// Perform sets the result to the bytes it encodes. The padding is optional.
+func (b64d *Base64Decode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	val, err := input.ResultString()
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	encoding := base64Encoding(b64d.URLSafe).WithPadding(base64.NoPadding)
+	b, err := encoding.DecodeString(strings.TrimRight(val, "="))
+	if err != nil {
+		return models.NewRunOutputError(fmt.Errorf("invalid base64: %v", err))
+	}
+	format := b64d.Format
+	if format == "" {
+		format = CodecFormatHex
+	}
+	out, err := bytesTo(b, format)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(out)
+}
+
+// HexEncode encodes the UTF-8 bytes of the result as 0x prefixed hex.
+type HexEncode struct{}
+
+// TaskType returns the type of Adapter.
+func (he *HexEncode) TaskType() models.TaskType {
+	return TaskTypeHexEncode
+}
+
+// Perform sets the result to the hex encoding of its text.
+func (he *HexEncode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	val, err := input.ResultString()
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(utils.StringToHex(val))
+}
+
+// HexDecode decodes hex, with or without a 0x prefix, into the UTF-8 text it
+// encodes.
+type HexDecode struct{}
+
+// TaskType returns the type of Adapter.
+func (hd *HexDecode) TaskType() models.TaskType {
+	return TaskTypeHexDecode
+}
+
+// Perform sets the result to the text its hex encodes.
+func (hd *HexDecode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	val, err := input.ResultString()
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	b, err := bytesFrom(utils.AddHexPrefix(val), CodecFormatHex)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	out, err := bytesTo(b, CodecFormatText)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(out)
+}
+
+func base64Encoding(urlSafe bool) *base64.Encoding {
+	if urlSafe {
+		return base64.URLEncoding
+	}
+	return base64.StdEncoding
+}
+
+// bytesFrom returns the bytes held by val in the given format.
+func bytesFrom(val, format string) ([]byte, error) {
+	switch format {
+	case CodecFormatHex:
+		if !utils.HasHexPrefix(val) {
+			return nil, fmt.Errorf("%q is not 0x prefixed hex", val)
+		}
+		b, err := hex.DecodeString(utils.RemoveHexPrefix(val))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex %q: %v", val, err)
+		}
+		return b, nil
+	case CodecFormatText:
+		return []byte(val), nil
+	default:
+		return nil, fmt.Errorf(`format must be "%s" or "%s", got "%s"`, CodecFormatHex, CodecFormatText, format)
+	}
+}
+
+// bytesTo holds b in the given format.
+func bytesTo(b []byte, format string) (string, error) {
+	switch format {
+	case CodecFormatHex:
+		return hexutil.Encode(b), nil
+	case CodecFormatText:
+		if !utf8.Valid(b) {
+			return "", fmt.Errorf("%s is not UTF-8 text", hexutil.Encode(b))
+		}
+		return string(b), nil
+	default:
+		return "", fmt.Errorf(`format must be "%s" or "%s", got "%s"`, CodecFormatHex, CodecFormatText, format)
+	}
+}
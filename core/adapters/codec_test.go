@@ -0,0 +1,70 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs_Perform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		adapter adapters.BaseAdapter
+		input   string
+		want    string
+	}{
+		{"base64 encode text", &adapters.Base64Encode{}, "hello, world", "aGVsbG8sIHdvcmxk"},
+		{"base64 encode hex", &adapters.Base64Encode{}, "0xfbff", "+/8="},
+		{"base64 encode hex as text", &adapters.Base64Encode{Format: adapters.CodecFormatText}, "0xfbff", "MHhmYmZm"},
+		{"base64 encode url safe", &adapters.Base64Encode{Format: adapters.CodecFormatHex, URLSafe: true}, "0xfbff", "-_8="},
+		{"base64 decode to hex", &adapters.Base64Decode{}, "+/8=", "0xfbff"},
+		{"base64 decode without padding", &adapters.Base64Decode{}, "+/8", "0xfbff"},
+		{"base64 decode url safe", &adapters.Base64Decode{URLSafe: true}, "-_8=", "0xfbff"},
+		{"base64 decode to text", &adapters.Base64Decode{Format: adapters.CodecFormatText}, "aGVsbG8sIHdvcmxk", "hello, world"},
+		{"hex encode", &adapters.HexEncode{}, "hello", "0x68656c6c6f"},
+		{"hex decode", &adapters.HexDecode{}, "0x68656c6c6f", "hello"},
+		{"hex decode without prefix", &adapters.HexDecode{}, "68656c6c6f", "hello"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result := test.adapter.Perform(cltest.NewRunInputWithResult(test.input), nil)
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.want, result.Result().String())
+		})
+	}
+}
+
+func TestCodecs_Perform_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		adapter adapters.BaseAdapter
+		input   interface{}
+	}{
+		{"base64 encode number", &adapters.Base64Encode{}, 1},
+		{"base64 encode invalid hex", &adapters.Base64Encode{Format: adapters.CodecFormatHex}, "0xzz"},
+		{"base64 encode unprefixed hex", &adapters.Base64Encode{Format: adapters.CodecFormatHex}, "fbff"},
+		{"base64 encode unknown format", &adapters.Base64Encode{Format: "binary"}, "hello"},
+		{"base64 decode invalid", &adapters.Base64Decode{}, "not base64!"},
+		{"base64 decode binary to text", &adapters.Base64Decode{Format: adapters.CodecFormatText}, "+/8="},
+		{"hex decode invalid", &adapters.HexDecode{}, "0x123"},
+		{"hex decode binary", &adapters.HexDecode{}, "0xfbff"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			result := test.adapter.Perform(cltest.NewRunInputWithResult(test.input), nil)
+			require.Error(t, result.Error())
+		})
+	}
+}
@@ -2,17 +2,35 @@ package adapters
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
+	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
+const (
+	// CompareOnFalseError errors the run when the comparison is false.
+	CompareOnFalseError = "error"
+	// CompareOnFalseSkip ends the run successfully, skipping the remaining
+	// tasks, when the comparison is false.
+	CompareOnFalseSkip = "skip"
+)
+
 // Compare adapter type takes an Operator and a Value field to
 // compare to the previous task's Result.
 type Compare struct {
 	Operator string `json:"operator"`
 	Value    string `json:"value"`
+	// Percent is how far from Value the result may be, relative to Value,
+	// with the "within" operator
+	Percent *decimal.Decimal `json:"percent,omitempty"`
+	// OnFalse turns the comparison into an assertion of sanity bounds.
+	// Rather than setting the result to the outcome, the run data is passed
+	// on unchanged if it is true, and otherwise the run either errors or
+	// ends, with "error" or "skip".
+	OnFalse string `json:"onFalse,omitempty"`
 }
 
 var (
@@ -20,6 +38,7 @@ var (
 	ErrValueNotNumber       = errors.New("the value was not a number")
 	ErrOperatorNotSpecified = errors.New("operator not specified")
 	ErrValueNotSpecified    = errors.New("value not specified")
+	ErrPercentNotSpecified  = errors.New("percent not specified")
 )
 
 // TaskType returns the type of Adapter.
@@ -29,44 +48,89 @@ func (c *Compare) TaskType() models.TaskType {
 
 // Perform uses the Operator to check the run's result against the
 // specified Value.
+//
+// For example, {"operator": "within", "value": "100", "percent": "5",
+// "onFalse": "error"} errors the run unless the result is between 95 and 105.
 func (c *Compare) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
-	prevResult := input.Result().String()
+	result := input.Result().String()
+	var matches bool
+	var err error
+	if c.Operator == "within" {
+		matches, err = within(result, c.Value, c.Percent)
+	} else {
+		matches, err = compare(c.Operator, result, c.Value)
+	}
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	switch c.OnFalse {
+	case "":
+		return models.NewRunOutputCompleteWithResult(matches)
+	case CompareOnFalseError:
+		if !matches {
+			return models.NewRunOutputError(fmt.Errorf("result %s is out of bounds: not %s %s", result, c.Operator, c.Value))
+		}
+	case CompareOnFalseSkip:
+		if !matches {
+			return models.NewRunOutputCompleteSkippingTasks(input.Data(), -1)
+		}
+	default:
+		return models.NewRunOutputError(fmt.Errorf(`onFalse must be "%s" or "%s", got "%s"`, CompareOnFalseError, CompareOnFalseSkip, c.OnFalse))
+	}
+	return models.NewRunOutputComplete(input.Data())
+}
+
+// within checks that result differs from desired by at most percent of
+// desired.
+func within(result, desired string, percent *decimal.Decimal) (bool, error) {
+	if desired == "" {
+		return false, ErrValueNotSpecified
+	}
+	if percent == nil {
+		return false, ErrPercentNotSpecified
+	}
+	value, err := decimal.NewFromString(result)
+	if err != nil {
+		return false, ErrResultNotNumber
+	}
+	desiredValue, err := decimal.NewFromString(desired)
+	if err != nil {
+		return false, ErrValueNotNumber
+	}
+	allowed := desiredValue.Abs().Mul(percent.Abs()).Div(decimal.NewFromInt(100))
+	return value.Sub(desiredValue).Abs().LessThanOrEqual(allowed), nil
+}
 
-	if c.Value == "" {
-		return models.NewRunOutputError(ErrValueNotSpecified)
+// compare checks result against the desired value with the given operator.
+// eq and neq compare them as strings, and the others as numbers.
+func compare(operator, result, desired string) (bool, error) {
+	if desired == "" {
+		return false, ErrValueNotSpecified
 	}
 
-	switch c.Operator {
+	switch operator {
 	case "eq":
-		return models.NewRunOutputCompleteWithResult(c.Value == prevResult)
+		return desired == result, nil
 	case "neq":
-		return models.NewRunOutputCompleteWithResult(c.Value != prevResult)
-	case "gt":
-		value, desired, err := getValues(prevResult, c.Value)
-		if err != nil {
-			return models.NewRunOutputError(err)
-		}
-		return models.NewRunOutputCompleteWithResult(desired < value)
-	case "gte":
-		value, desired, err := getValues(prevResult, c.Value)
+		return desired != result, nil
+	case "gt", "gte", "lt", "lte":
+		value, desiredValue, err := getValues(result, desired)
 		if err != nil {
-			return models.NewRunOutputError(err)
+			return false, err
 		}
-		return models.NewRunOutputCompleteWithResult(desired <= value)
-	case "lt":
-		value, desired, err := getValues(prevResult, c.Value)
-		if err != nil {
-			return models.NewRunOutputError(err)
-		}
-		return models.NewRunOutputCompleteWithResult(desired > value)
-	case "lte":
-		value, desired, err := getValues(prevResult, c.Value)
-		if err != nil {
-			return models.NewRunOutputError(err)
+		switch operator {
+		case "gt":
+			return desiredValue < value, nil
+		case "gte":
+			return desiredValue <= value, nil
+		case "lt":
+			return desiredValue > value, nil
+		default:
+			return desiredValue >= value, nil
 		}
-		return models.NewRunOutputCompleteWithResult(desired >= value)
 	default:
-		return models.NewRunOutputError(ErrOperatorNotSpecified)
+		return false, ErrOperatorNotSpecified
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCompare_Perform(t *testing.T) {
@@ -874,6 +875,25 @@ func TestCompareError_Perform(t *testing.T) {
 			},
 			adapters.ErrValueNotSpecified,
 		},
+		{
+			"within not number in result",
+			"a",
+			adapters.Compare{
+				Operator: "within",
+				Value:    "2",
+				Percent:  mustDecimal(t, "5"),
+			},
+			adapters.ErrResultNotNumber,
+		},
+		{
+			"within missing percent",
+			"2",
+			adapters.Compare{
+				Operator: "within",
+				Value:    "2",
+			},
+			adapters.ErrPercentNotSpecified,
+		},
 	}
 
 	for _, test := range tests {
@@ -885,3 +905,68 @@ func TestCompareError_Perform(t *testing.T) {
 		})
 	}
 }
+
+func TestCompare_Perform_Within(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      interface{}
+		percent    string
+		wantResult bool
+	}{
+		{"equal", "100", "5", true},
+		{"at upper bound", "105", "5", true},
+		{"at lower bound", 95, "5", true},
+		{"above upper bound", "105.01", "5", false},
+		{"below lower bound", "94.99", "5", false},
+		{"no tolerance", "100.1", "0", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithResult(test.input)
+			adapter := adapters.Compare{
+				Operator: "within",
+				Value:    "100",
+				Percent:  mustDecimal(t, test.percent),
+			}
+			result := adapter.Perform(input, nil)
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.wantResult, result.Result().Bool())
+		})
+	}
+}
+
+func TestCompare_Perform_OnFalse(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		onFalse   string
+		wantError bool
+		wantSkips int
+	}{
+		{"error within bounds", "150", adapters.CompareOnFalseError, false, 0},
+		{"error out of bounds", "250", adapters.CompareOnFalseError, true, 0},
+		{"skip within bounds", "150", adapters.CompareOnFalseSkip, false, 0},
+		{"skip out of bounds", "250", adapters.CompareOnFalseSkip, false, -1},
+		{"invalid", "150", "ignore", true, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithResult(test.input)
+			adapter := adapters.Compare{
+				Operator: "lte",
+				Value:    "200",
+				OnFalse:  test.onFalse,
+			}
+			result := adapter.Perform(input, nil)
+			if test.wantError {
+				require.Error(t, result.Error())
+				return
+			}
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.input, result.Result().String(), "expected the result to be passed on")
+			assert.Equal(t, test.wantSkips, result.SkipTasks())
+		})
+	}
+}
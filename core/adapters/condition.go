@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+const (
+	// ConditionElseEnd ends the run successfully when the condition does
+	// not hold.
+	ConditionElseEnd = "end"
+	// ConditionElseSkip skips the tasks following the condition when it
+	// does not hold.
+	ConditionElseSkip = "skip"
+)
+
+// Condition gates the tasks following it on a comparison against the run
+// data, such as only writing an answer on chain once it crosses a threshold.
+type Condition struct {
+	// Field is the path of the run data compared, which is the result if
+	// empty
+	Field    string `json:"field,omitempty"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+	// Else is what happens when the comparison is false, either "end" for
+	// the run to complete successfully, which is the default, or "skip"
+	Else string `json:"else,omitempty"`
+	// Skip is the number of tasks skipped with the "skip" else, which is 1
+	// if unset
+	Skip uint `json:"skip,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (c *Condition) TaskType() models.TaskType {
+	return TaskTypeCondition
+}
+
+// Perform compares the field of the run data against Value with Operator,
+// like Compare does with the result. The run data is passed on unchanged,
+// to the next task if the comparison is true, and otherwise to the first
+// task which is not skipped.
+//
+// For example, with {"operator": "gt", "value": "100"} the tasks after the
+// condition are only performed when the result is greater than 100.
+func (c *Condition) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	field := c.Field
+	if field == "" {
+		field = "result"
+	}
+	value := input.Data().Get(field)
+	if !value.Exists() {
+		return models.NewRunOutputError(fmt.Errorf("no value for field %s", field))
+	}
+
+	holds, err := compare(c.Operator, value.String(), c.Value)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	if holds {
+		return models.NewRunOutputComplete(input.Data())
+	}
+
+	switch c.Else {
+	case "", ConditionElseEnd:
+		return models.NewRunOutputCompleteSkippingTasks(input.Data(), -1)
+	case ConditionElseSkip:
+		skip := int(c.Skip)
+		if skip == 0 {
+			skip = 1
+		}
+		return models.NewRunOutputCompleteSkippingTasks(input.Data(), skip)
+	default:
+		return models.NewRunOutputError(fmt.Errorf(`else must be "%s" or "%s", got "%s"`, ConditionElseEnd, ConditionElseSkip, c.Else))
+	}
+}
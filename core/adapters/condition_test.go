@@ -0,0 +1,63 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition_Perform(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		adapter   adapters.Condition
+		wantSkips int
+	}{
+		{"holds", `{"result":"150"}`, adapters.Condition{Operator: "gt", Value: "100"}, 0},
+		{"ends the run", `{"result":"50"}`, adapters.Condition{Operator: "gt", Value: "100"}, -1},
+		{"ends the run explicitly", `{"result":"50"}`, adapters.Condition{Operator: "gt", Value: "100", Else: "end"}, -1},
+		{"skips the next task", `{"result":"50"}`, adapters.Condition{Operator: "gt", Value: "100", Else: "skip"}, 1},
+		{"skips several tasks", `{"result":"50"}`, adapters.Condition{Operator: "gt", Value: "100", Else: "skip", Skip: 3}, 3},
+		{"compares a field", `{"result":"150","details":{"deviation":"0.2"}}`, adapters.Condition{Field: "details.deviation", Operator: "gte", Value: "0.5"}, -1},
+		{"compares strings", `{"result":"open"}`, adapters.Condition{Operator: "eq", Value: "open"}, 0},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := test.adapter.Perform(input, nil)
+
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.wantSkips, result.SkipTasks())
+			assert.JSONEq(t, test.json, result.Data().String())
+		})
+	}
+}
+
+func TestCondition_Perform_Failure(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		adapter adapters.Condition
+	}{
+		{"missing field", `{"result":"1"}`, adapters.Condition{Field: "price", Operator: "gt", Value: "0"}},
+		{"unknown operator", `{"result":"1"}`, adapters.Condition{Operator: "between", Value: "0"}},
+		{"not a number", `{"result":"one"}`, adapters.Condition{Operator: "gt", Value: "0"}},
+		{"unknown else", `{"result":"1"}`, adapters.Condition{Operator: "gt", Value: "2", Else: "retry"}},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := test.adapter.Perform(input, nil)
+
+			require.Error(t, result.Error())
+		})
+	}
+}
@@ -1,14 +1,52 @@
 package adapters
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // Copy obj keys refers to which value to copy inside `data`,
 // each obj value refers to where to copy the value to inside `data`
 type Copy struct {
 	CopyPath JSONPath `json:"copyPath"`
+	// CopyPaths are copied into an object, each under the last key of its
+	// path unless it is renamed, which the result is set to
+	CopyPaths []CopyPath `json:"copyPaths,omitempty"`
+	// Merge adds the values of CopyPaths to the run data, rather than setting
+	// the result to an object of them
+	Merge bool `json:"merge,omitempty"`
+}
+
+// CopyPath is a path copied by the Copy adapter, given either as a JSONPath
+// or as an object with the path and the key to copy it to.
+type CopyPath struct {
+	Path JSONPath `json:"path"`
+	As   string   `json:"as,omitempty"`
+}
+
+// UnmarshalJSON implements the Unmarshaler interface
+func (cp *CopyPath) UnmarshalJSON(input []byte) error {
+	if utils.IsQuoted(input) || (len(input) > 0 && input[0] == '[') {
+		*cp = CopyPath{}
+		return json.Unmarshal(input, &cp.Path)
+	}
+	type plain CopyPath
+	return json.Unmarshal(input, (*plain)(cp))
+}
+
+func (cp CopyPath) key() string {
+	if cp.As != "" {
+		return cp.As
+	}
+	if len(cp.Path) == 0 {
+		return ""
+	}
+	return cp.Path[len(cp.Path)-1]
 }
 
 // TaskType returns the type of Adapter.
@@ -18,12 +56,54 @@ func (c *Copy) TaskType() models.TaskType {
 
 // Perform returns the copied values from the desired mapping within the `data` JSON object
 func (c *Copy) Perform(input models.RunInput, store *store.Store) models.RunOutput {
+	if len(c.CopyPaths) > 0 {
+		return c.performPaths(input, store)
+	}
+	return c.copy(input, c.CopyPath, store)
+}
+
+func (c *Copy) copy(input models.RunInput, path JSONPath, store *store.Store) models.RunOutput {
 	data, err := models.JSON{}.Add("result", input.Data().String())
 	if err != nil {
 		return models.NewRunOutputError(err)
 	}
 
-	jp := JSONParse{Path: c.CopyPath}
+	jp := JSONParse{Path: path}
 	input = input.CloneWithData(data)
 	return jp.Perform(input, store)
 }
+
+// performPaths copies each of the paths, so that the fields of a response
+// which a contract takes together can be passed on by a single task. For
+// example, the copyPaths ["data.price", {"path": "data.ts", "as": "time"}]
+// set the result to {"price": ..., "time": ...}.
+func (c *Copy) performPaths(input models.RunInput, store *store.Store) models.RunOutput {
+	kv := models.KV{}
+	for _, cp := range c.CopyPaths {
+		key := cp.key()
+		if key == "" {
+			return models.NewRunOutputError(errors.New("copyPaths must not be empty"))
+		}
+		if _, ok := kv[key]; ok {
+			return models.NewRunOutputError(fmt.Errorf("copyPaths copy more than one value to %s", key))
+		}
+		output := c.copy(input, cp.Path, store)
+		if output.HasError() {
+			return output
+		}
+		var value interface{}
+		if raw := output.Result().Raw; raw != "" {
+			value = json.RawMessage(raw)
+		}
+		kv[key] = value
+	}
+
+	if c.Merge {
+		data, err := input.Data().MultiAdd(kv)
+		if err != nil {
+			return models.NewRunOutputError(err)
+		}
+		return models.NewRunOutputComplete(data)
+	}
+	return models.NewRunOutputCompleteWithResult(kv)
+}
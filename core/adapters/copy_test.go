@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCopy_Perform(t *testing.T) {
@@ -119,3 +120,43 @@ func TestCopy_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestCopy_Perform_CopyPaths(t *testing.T) {
+	t.Parallel()
+
+	input := `{"data":{"price":"11779.99","ts":1606000000,"volume":[1,2]},"result":"old"}`
+	tests := []struct {
+		name      string
+		params    string
+		wantData  string
+		wantError string
+	}{
+		{"object of paths", `{"copyPaths":["data.price","data.ts"]}`,
+			`{"result":{"price":"11779.99","ts":1606000000}}`, ""},
+		{"renamed", `{"copyPaths":[["data","price"],{"path":"data.ts","as":"time"},{"path":"data.volume.1","as":"volume"}]}`,
+			`{"result":{"price":"11779.99","time":1606000000,"volume":2}}`, ""},
+		{"nonexistent last key", `{"copyPaths":["data.price","data.open"]}`,
+			`{"result":{"open":null,"price":"11779.99"}}`, ""},
+		{"merged", `{"copyPaths":[{"path":"data.price","as":"price"}],"merge":true}`,
+			`{"data":{"price":"11779.99","ts":1606000000,"volume":[1,2]},"price":"11779.99","result":"old"}`, ""},
+		{"nonexistent path", `{"copyPaths":["data.price","no.really"]}`,
+			``, "No value could be found for the key 'no'"},
+		{"duplicate keys", `{"copyPaths":["data.price",{"path":"data.ts","as":"price"}]}`,
+			``, "copyPaths copy more than one value to price"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adapter := adapters.Copy{}
+			require.NoError(t, json.Unmarshal([]byte(test.params), &adapter))
+			result := adapter.Perform(cltest.NewRunInputWithString(t, input), nil)
+			if test.wantError != "" {
+				require.Error(t, result.Error())
+				assert.Equal(t, test.wantError, result.Error().Error())
+				return
+			}
+			require.NoError(t, result.Error())
+			assert.JSONEq(t, test.wantData, result.Data().String())
+		})
+	}
+}
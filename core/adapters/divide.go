@@ -0,0 +1,45 @@
+package adapters
+
+import (
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// Divide holds the number to divide the given value by.
+type Divide struct {
+	By *decimal.Decimal `json:"by,omitempty"`
+	Rounding
+}
+
+// TaskType returns the type of Adapter.
+func (d *Divide) TaskType() models.TaskType {
+	return TaskTypeDivide
+}
+
+// Perform returns the input's "result" field divided by the adapter's "by"
+// field, rounded to the given precision, or to 18 decimal places if none is
+// given.
+//
+// For example, if input value is "9999.4" and the adapter's "by" is set to
+// "100", the result's value will be "99.994".
+func (d *Divide) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	dec, err := resultDecimal(input)
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrapf(err, "cannot parse into big.Float: %v", input.Result().String()))
+	}
+	by := decimal.NewFromInt(1)
+	if d.By != nil {
+		by = *d.By
+	}
+	precision := int32(defaultDividePrecision)
+	if d.Precision != nil {
+		precision = *d.Precision
+	}
+	dec, err = d.quo(dec, by, precision)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(dec.String())
+}
@@ -0,0 +1,78 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDivide_Unmarshal(t *testing.T) {
+	var d adapters.Divide
+	err := json.Unmarshal([]byte(`{"by": 1000000000000000000, "precision": 4, "roundingMode": "floor"}`), &d)
+	require.NoError(t, err)
+	assert.Equal(t, mustDecimal(t, "1000000000000000000").String(), d.By.String())
+	require.NotNil(t, d.Precision)
+	assert.Equal(t, int32(4), *d.Precision)
+	assert.Equal(t, adapters.RoundFloor, d.RoundingMode)
+}
+
+func TestDivide_Perform(t *testing.T) {
+	precision := func(p int32) *int32 { return &p }
+
+	tests := []struct {
+		name    string
+		adapter adapters.Divide
+		json    string
+		want    string
+	}{
+		{"exact", adapters.Divide{By: mustDecimal(t, "100")}, `{"result":"9999.4"}`, "99.994"},
+		{"wei to ether", adapters.Divide{By: mustDecimal(t, "1000000000000000000")}, `{"result":"1234567890123456789012"}`, "1234.567890123456789012"},
+		{"default precision", adapters.Divide{By: mustDecimal(t, "3")}, `{"result":2}`, "0.666666666666666667"},
+		{"no by parameter", adapters.Divide{}, `{"result":"3.14"}`, "3.14"},
+		{"half up", adapters.Divide{By: mustDecimal(t, "8"), Rounding: adapters.Rounding{Precision: precision(2)}}, `{"result":"1"}`, "0.13"},
+		{"half down", adapters.Divide{By: mustDecimal(t, "8"), Rounding: adapters.Rounding{Precision: precision(2), RoundingMode: "halfDown"}}, `{"result":"1"}`, "0.12"},
+		{"half even", adapters.Divide{By: mustDecimal(t, "8"), Rounding: adapters.Rounding{Precision: precision(2), RoundingMode: "halfEven"}}, `{"result":"1"}`, "0.12"},
+		{"half even odd", adapters.Divide{By: mustDecimal(t, "8"), Rounding: adapters.Rounding{Precision: precision(2), RoundingMode: "halfEven"}}, `{"result":"3"}`, "0.38"},
+		{"up", adapters.Divide{By: mustDecimal(t, "3"), Rounding: adapters.Rounding{Precision: precision(0), RoundingMode: "up"}}, `{"result":"-4"}`, "-2"},
+		{"down", adapters.Divide{By: mustDecimal(t, "3"), Rounding: adapters.Rounding{Precision: precision(0), RoundingMode: "down"}}, `{"result":"-5"}`, "-1"},
+		{"ceiling", adapters.Divide{By: mustDecimal(t, "3"), Rounding: adapters.Rounding{Precision: precision(0), RoundingMode: "ceiling"}}, `{"result":"-5"}`, "-1"},
+		{"floor", adapters.Divide{By: mustDecimal(t, "-3"), Rounding: adapters.Rounding{Precision: precision(0), RoundingMode: "floor"}}, `{"result":"4"}`, "-2"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := test.adapter.Perform(input, nil)
+
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.want, result.Result().String())
+		})
+	}
+}
+
+func TestDivide_Perform_Failure(t *testing.T) {
+	tests := []struct {
+		name    string
+		adapter adapters.Divide
+		json    string
+	}{
+		{"by zero", adapters.Divide{By: mustDecimal(t, "0")}, `{"result":"1"}`},
+		{"object", adapters.Divide{By: mustDecimal(t, "2")}, `{"result":{"foo":"bar"}}`},
+		{"unknown rounding mode", adapters.Divide{By: mustDecimal(t, "3"), Rounding: adapters.Rounding{RoundingMode: "sideways"}}, `{"result":"1"}`},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := test.adapter.Perform(input, nil)
+			require.Error(t, result.Error())
+		})
+	}
+}
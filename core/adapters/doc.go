@@ -8,6 +8,31 @@
 // For example:
 //  {"id": "b8004e2989e24e1d8e4449afad2eb480", "data": {}}
 //
+// A bridge created with a clientCertificate presents that certificate to the
+// external adapter, for adapters requiring mutual TLS. One created with a
+// proxy sends its requests through that HTTP or SOCKS5 proxy.
+//
+// A bridge's timeout and retries take the place of the node's HTTP defaults
+// for its requests. With a circuitBreakerThreshold, a bridge whose requests
+// fail that many times in a row is skipped for its circuitBreakerCooldown,
+// its tasks erroring straight away, before a request is tried again.
+//
+// The data a bridge responds with, synchronously or later on, must conform
+// to the bridge's responseSchema when it has one, or else the task errors.
+// A task can give its own responseSchema in params in place of the bridge's.
+//  { "type": "priceBridge", "params": {"responseSchema": {"type": "object",
+//    "required": ["price"] }}}
+//
+// Base64Encode, Base64Decode, HexEncode and HexDecode
+//
+// The codec adapters convert binary payloads between the formats APIs return
+// and those contracts take. Base64Decode decodes the result into 0x prefixed
+// hex, or UTF-8 text with a format of "text", and Base64Encode takes either,
+// telling hex apart by its prefix unless a format is given. Both take urlSafe
+// for the URL safe alphabet. HexEncode and HexDecode convert between text and
+// hex.
+//  { "type": "Base64Decode", "params": {"format": "hex" }}
+//
 // Compare
 //
 // The Compare adapter is used to compare the previous task's result
@@ -15,6 +40,34 @@
 // adapter will save `true` or `false` in the task run's result.
 //  { "type": "Compare", "params": {"operator": "eq", "value": "Hello" }}
 //
+// The "within" operator checks that the result is no more than percent away
+// from the value. With an onFalse of "error" or "skip", Compare asserts sanity
+// bounds instead, passing the result on when the comparison is true, and
+// otherwise erroring or ending the run before a wild outlier is submitted.
+//  { "type": "Compare", "params": {"operator": "within", "value": "2000",
+//    "percent": "10", "onFalse": "error" }}
+//
+// Condition
+//
+// The Condition adapter compares the result, or the run data at field,
+// against a value with the same operators as Compare. The tasks following it
+// are only performed when the comparison is true. Otherwise the run completes
+// successfully, or with an else of "skip", the next skip tasks are passed
+// over. The run data is left unchanged either way.
+//  { "type": "Condition", "params": {"operator": "gt", "value": "100" }}
+//
+// GraphQL
+//
+// The GraphQL adapter POSTs a query to a GraphQL endpoint, taking each of the
+// variables the query defines from variables, or else from the run data, and
+// sets the result to the value at path in the data of the response. Errors in
+// the response error the task, and only queries are allowed, not mutations.
+// It takes headers and the retry settings of HTTPPost, and is restricted to
+// the same IPs.
+//  { "type": "GraphQL", "params": {"url": "https://api.example.com/graphql",
+//    "query": "query ($symbol: String!) { ticker(symbol: $symbol) { price } }",
+//    "path": ["ticker", "price"] }}
+//
 // HTTPGet
 //
 // The HTTPGet adapter is used to grab the JSON data from the given URL.
@@ -34,6 +87,36 @@
 // restrictions on which IPs may be fetched. Local network and multicast IPs
 // are disallowed by default and attempting to connect will result in an error.
 //
+// Both retry requests failing with a transport error or a 5xx status, up to
+// DEFAULT_MAX_HTTP_ATTEMPTS times. A task may set its own number of attempts,
+// the backoff before the first retry, which doubles on each retry, and the
+// status codes to retry instead of any 5xx.
+//  { "type": "HTTPGet", "params": {"get": "https://some-api-example.net/api",
+//    "attempts": 5, "backoff": "500ms", "retryOn": [429, 502, 503] }}
+//
+// With a cacheTTL, a successful response is reused by identical requests for
+// that long, in memory or in the database depending on HTTP_CACHE_BACKEND.
+//  { "type": "HTTPGet", "params": {"get": "https://some-api-example.net/api",
+//    "cacheTTL": "30s" }}
+//
+// A clientCertificate names the certificate to present to servers requiring
+// mutual TLS, kept as <name>.crt and <name>.key in $ROOT/client_certs.
+//  { "type": "HTTPGet", "params": {"get": "https://some-api-example.net/api",
+//    "clientCertificate": "provider" }}
+//
+// A proxy routes the request through an HTTP, HTTPS or SOCKS5 proxy rather
+// than sending it directly. The IP restrictions apply to the proxy's address.
+//  { "type": "HTTPGet", "params": {"get": "https://some-api-example.net/api",
+//    "proxy": "socks5://egress.internal:1080" }}
+//
+// Responses larger than DEFAULT_HTTP_LIMIT are rejected. A task may raise its
+// own maxResponseSize, in bytes, up to HTTP_MAX_RESPONSE_SIZE. A resultPath
+// decodes the JSON response as it is read and keeps only the value at that
+// path, which the result is set to, so large documents are never buffered
+// whole.
+//  { "type": "HTTPGet", "params": {"get": "https://some-api-example.net/api",
+//    "resultPath": ["data", "price"], "maxResponseSize": 104857600 }}
+//
 // HTTPGetWithUnrestrictedNetworkAccess
 //
 // Identical to HTTPGet except there are no IP restrictions. Use with caution.
@@ -47,6 +130,39 @@
 // The JSONParse adapter will obtain the value(s) for the given field(s).
 //  { "type": "JSONParse", "params": {"path": ["someField"] }}
 //
+// JSONTransform
+//
+// The JSONTransform adapter sets the result to the output of a jq expression
+// evaluated against it. It supports a subset of jq: paths, iteration with
+// [], pipes, object and array construction, arithmetic, comparisons, and, or,
+// the // alternative, if-then-else, and the length, keys, has, map, select,
+// add, min, max, sort, tostring, tonumber, not, floor, ceil and round
+// builtins. Numbers keep their precision rather than being rounded to floats.
+//  { "type": "JSONTransform", "params": {"expression":
+//    ".data | {price: (.bid + .ask) / 2, time: .timestamp}" }}
+//
+// EthABIEncode
+//
+// The EthABIEncode adapter encodes a call to the function described by abi,
+// taking each argument from args or else from the input data field of the
+// same name. The result is the hex encoded calldata, which EthTx sends as is
+// with the "calldata" format. With argumentsOnly the function selector is
+// left out.
+//   {
+//     "type": "EthABIEncode", "params": {
+//       "abi": {"type": "function", "name": "transfer", "outputs": [],
+//               "inputs": [{"name": "to", "type": "address"},
+//                          {"name": "value", "type": "uint256"}]},
+//       "args": {"to": "0x0000000000000000000000000000000000000000"}
+//     }
+//   }
+//
+// EthABIDecode
+//
+// The EthABIDecode adapter decodes the hex encoded return value of the
+// function described by abi into JSON, or its calldata if inputs is true.
+//   { "type": "EthABIDecode", "params": {"abi": [...], "method": "latestRoundData" }}
+//
 // EthBool
 //
 // The EthBool adapter will take the given values and format them for
@@ -71,6 +187,30 @@
 // in hex for the Ethereum blockchain.
 //  { "type": "EthUint256" }
 //
+// EthCall
+//
+// The EthCall adapter reads from a contract with eth_call, calling the
+// function given by functionSelector with dataPrefix as its ABI encoded
+// arguments. The result is the hex encoded return data, unless the function
+// is described by an abi, in which case it is the decoded return value.
+//   {
+//     "type": "EthCall", "params": {
+//       "address": "0x0000000000000000000000000000000000000000",
+//       "abi": {"type": "function", "name": "latestAnswer", "inputs": [],
+//               "outputs": [{"name": "", "type": "int256"}]}
+//     }
+//   }
+//
+// EthSign
+//
+// The EthSign adapter signs the result, or the run data at field, with the
+// node's account at address, or its first account. The keccak256 hash of the
+// payload is signed as an Ethereum message, like eth_sign, and the signature
+// and signer are added to the run data. Hex strings are signed as bytes, other
+// strings as text, and anything else as compact JSON. With append, the result
+// becomes the payload followed by the signature.
+//  { "type": "EthSign", "params": {"field": "report", "append": true }}
+//
 // EthTx
 //
 // The EthTx adapter will write the data to the given address and functionSelector.
@@ -87,6 +227,25 @@
 // value.
 //   { "type": "Multiply", "params": {"times": 100 }}
 //
+// The product is exact. With precision, it is rounded to that many decimal
+// places with roundingMode, one of halfUp (the default), halfDown, halfEven,
+// up, down, ceiling or floor.
+//
+// Divide
+//
+// The Divide adapter divides the given input value by another specified
+// value, rounding the quotient like Multiply, or to 18 decimal places without
+// a precision.
+//   { "type": "Divide", "params": {"by": "1000000000000000000", "precision": 8 }}
+//
+// Median, Mean and Mode
+//
+// The Median, Mean and Mode adapters reduce an array of numbers, such as the
+// answers of several sources collected earlier in the job, to a single value.
+// The array is the result, or the value at path within it. With trim, that
+// share of the values is dropped from each end of the sorted array first.
+//   { "type": "Median", "params": {"path": ["answers"], "trim": 0.1 }}
+//
 // Quotient
 //
 // The Quotient adapter gives the result of x / y where x is a specified value (dividend)
@@ -98,6 +257,22 @@
 // value.
 //   { "type": "Quotient", "params": {"dividend": 1 }}
 //
+// ResultCollect
+//
+// The ResultCollect adapter sets the result to an array or object of
+// results, usually task variables referring to earlier named tasks, so that
+// values fetched from several sources can be aggregated by the next task.
+//   { "type": "ResultCollect", "params": {"results": ["$(fetchA)", "$(fetchB)"] }}
+//
+// SignedWebhook
+//
+// The SignedWebhook adapter POSTs the run data, with the IDs of the run and
+// the task and a Unix timestamp, to url. The X-Chainlink-Signature header
+// holds the HMAC-SHA256 of the body keyed with secret, or with a signature of
+// "eth", the node's Ethereum signature of the keccak256 hash of the body,
+// along with its address in X-Chainlink-Signer.
+//   { "type": "SignedWebhook", "params": {"url": "https://example.com/hook", "signature": "eth" }}
+//
 // Random
 //
 // Random adapter generates proofs of randomness verifiable against a public key
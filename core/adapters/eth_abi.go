@@ -0,0 +1,227 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+// parseABI reads an ABI given either as the usual JSON array, or as a single
+// fragment object describing one function.
+func parseABI(raw json.RawMessage) (abi.ABI, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		trimmed = append(append([]byte{'['}, trimmed...), ']')
+	}
+	contractABI, err := abi.JSON(bytes.NewReader(trimmed))
+	return contractABI, errors.Wrap(err, "invalid abi")
+}
+
+// findABIMethod returns the method called name, or the only method of the ABI
+// when name is empty.
+func findABIMethod(contractABI abi.ABI, name string) (abi.Method, error) {
+	if name == "" {
+		if len(contractABI.Methods) != 1 {
+			return abi.Method{}, errors.New("method must be given when the abi has more than one function")
+		}
+		for _, method := range contractABI.Methods {
+			return method, nil
+		}
+	}
+	method, ok := contractABI.Methods[name]
+	if !ok {
+		return abi.Method{}, fmt.Errorf("method %s is not in the abi", name)
+	}
+	return method, nil
+}
+
+// decodeABIValues unpacks ABI encoded data. A single value is returned on its
+// own, and several are returned as an object keyed by their names, or by
+// their positions when unnamed.
+func decodeABIValues(args abi.Arguments, data []byte) (interface{}, error) {
+	values, err := args.UnpackValues(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode abi values")
+	}
+	if len(values) == 1 {
+		return abiValueToJSON(values[0]), nil
+	}
+	decoded := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		name := args[i].Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		decoded[name] = abiValueToJSON(value)
+	}
+	return decoded, nil
+}
+
+// abiValueToJSON converts a value unpacked by the abi package into one which
+// marshals to readable JSON. Integers become decimal strings, so that none
+// lose precision, and bytes become hex strings.
+func abiValueToJSON(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Array, reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = abiValueToJSON(rv.Index(i).Interface())
+		}
+		return list
+	case reflect.Struct:
+		// Tuples are unpacked into structs tagged with the component names
+		fields := make(map[string]interface{}, rv.NumField())
+		for i := 0; i < rv.NumField(); i++ {
+			field := rv.Type().Field(i)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			fields[name] = abiValueToJSON(rv.Field(i).Interface())
+		}
+		return fields
+	default:
+		return v
+	}
+}
+
+// abiValueFromJSON converts a JSON value into the Go type the abi package
+// packs for t. Integers may be given as numbers or as decimal or hex strings,
+// bytes as hex strings, arrays as JSON arrays and tuples as objects keyed by
+// component name.
+func abiValueFromJSON(t abi.Type, value gjson.Result) (interface{}, error) {
+	if !value.Exists() {
+		return nil, errors.New("missing value")
+	}
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, ok := new(big.Int).SetString(strings.TrimSpace(value.String()), 0)
+		if !ok {
+			return nil, fmt.Errorf("%s is not an integer", value.Raw)
+		}
+		return abiIntValue(t, n)
+	case abi.BoolTy:
+		if value.Type != gjson.True && value.Type != gjson.False {
+			return nil, fmt.Errorf("%s is not a bool", value.Raw)
+		}
+		return value.Bool(), nil
+	case abi.StringTy:
+		return value.String(), nil
+	case abi.AddressTy:
+		if !common.IsHexAddress(value.String()) {
+			return nil, fmt.Errorf("%s is not an address", value.Raw)
+		}
+		return common.HexToAddress(value.String()), nil
+	case abi.BytesTy:
+		return hexutil.Decode(value.String())
+	case abi.FixedBytesTy, abi.HashTy:
+		b, err := hexutil.Decode(value.String())
+		if err != nil {
+			return nil, err
+		}
+		array := reflect.New(t.GetType()).Elem()
+		if len(b) > array.Len() {
+			return nil, fmt.Errorf("%s is longer than %v bytes", value.Raw, array.Len())
+		}
+		reflect.Copy(array, reflect.ValueOf(b))
+		return array.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		if !value.IsArray() {
+			return nil, fmt.Errorf("%s is not an array", value.Raw)
+		}
+		elems := value.Array()
+		var list reflect.Value
+		if t.T == abi.ArrayTy {
+			if len(elems) != t.Size {
+				return nil, fmt.Errorf("expected %v elements, got %v", t.Size, len(elems))
+			}
+			list = reflect.New(t.GetType()).Elem()
+		} else {
+			list = reflect.MakeSlice(t.GetType(), len(elems), len(elems))
+		}
+		for i, elem := range elems {
+			v, err := abiValueFromJSON(*t.Elem, elem)
+			if err != nil {
+				return nil, errors.Wrapf(err, "element %v", i)
+			}
+			list.Index(i).Set(reflect.ValueOf(v))
+		}
+		return list.Interface(), nil
+	case abi.TupleTy:
+		if !value.IsObject() {
+			return nil, fmt.Errorf("%s is not an object", value.Raw)
+		}
+		tuple := reflect.New(t.GetType()).Elem()
+		for i, name := range t.TupleRawNames {
+			v, err := abiValueFromJSON(*t.TupleElems[i], value.Get(name))
+			if err != nil {
+				return nil, errors.Wrapf(err, "field %s", name)
+			}
+			tuple.Field(i).Set(reflect.ValueOf(v))
+		}
+		return tuple.Interface(), nil
+	default:
+		return nil, fmt.Errorf("abi type %s is not supported", t.String())
+	}
+}
+
+// abiIntValue returns n as the Go type the abi package uses for t, which is
+// a sized int or uint up to 64 bits, and a *big.Int beyond.
+func abiIntValue(t abi.Type, n *big.Int) (interface{}, error) {
+	if t.T == abi.UintTy && n.Sign() < 0 {
+		return nil, fmt.Errorf("%s is negative", n)
+	}
+	bits := n.BitLen()
+	if t.T == abi.IntTy {
+		// Leave room for the sign, which lets the lowest negative value fit
+		// one bit more
+		if n.Sign() < 0 {
+			bits = new(big.Int).Not(n).BitLen()
+		}
+		bits++
+	}
+	if bits > t.Size {
+		return nil, fmt.Errorf("%s overflows %s", n, t.String())
+	}
+
+	goType := t.GetType()
+	if goType == reflect.TypeOf(&big.Int{}) {
+		return n, nil
+	}
+	v := reflect.New(goType).Elem()
+	if t.T == abi.IntTy {
+		v.SetInt(n.Int64())
+	} else {
+		v.SetUint(n.Uint64())
+	}
+	return v.Interface(), nil
+}
@@ -0,0 +1,63 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// EthABIDecode decodes the ABI encoded return value, or the calldata, of a
+// contract function.
+type EthABIDecode struct {
+	// ABI describes the function, either as a contract ABI or as the fragment
+	// of the function alone
+	ABI    json.RawMessage `json:"abi"`
+	Method string          `json:"method,omitempty"`
+	// Inputs decodes the input as calldata of the function, which must start
+	// with its selector, rather than as its return value
+	Inputs bool `json:"inputs,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (e *EthABIDecode) TaskType() models.TaskType {
+	return TaskTypeEthABIDecode
+}
+
+// Perform decodes the hex encoded input result. A single value becomes the
+// result on its own, and several become an object keyed by name, or by
+// position when unnamed. Integers are returned as decimal strings and bytes as
+// hex strings.
+func (e *EthABIDecode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	contractABI, err := parseABI(e.ABI)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	method, err := findABIMethod(contractABI, e.Method)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	data, err := hexutil.Decode(input.Result().String())
+	if err != nil {
+		return models.NewRunOutputError(fmt.Errorf("result is not hex encoded bytes: %v", err))
+	}
+
+	args := method.Outputs
+	if e.Inputs {
+		if !bytes.HasPrefix(data, method.ID) {
+			return models.NewRunOutputError(fmt.Errorf("calldata does not start with the selector %s of %s", hexutil.Encode(method.ID), method.Sig))
+		}
+		data = data[len(method.ID):]
+		args = method.Inputs
+	}
+
+	result, err := decodeABIValues(args, data)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(result)
+}
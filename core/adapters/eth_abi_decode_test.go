@@ -0,0 +1,84 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthABIDecode_Perform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		adapter adapters.EthABIDecode
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			"single return value",
+			adapters.EthABIDecode{ABI: json.RawMessage(transferABI)},
+			"0x0000000000000000000000000000000000000000000000000000000000000001",
+			`true`,
+			false,
+		},
+		{
+			"several return values",
+			adapters.EthABIDecode{ABI: json.RawMessage(latestRoundDataABI)},
+			"0x0000000000000000000000000000000000000000000000000000000000000003" +
+				"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff" +
+				"0000000000000000000000000000000000000000000000000000000000000064" +
+				"00000000000000000000000000000000000000000000000000000000000000c8" +
+				"0000000000000000000000000000000000000000000000000000000000000003",
+			`{"roundId": "3", "answer": "-1", "startedAt": "100", "updatedAt": "200", "answeredInRound": "3"}`,
+			false,
+		},
+		{
+			"calldata",
+			adapters.EthABIDecode{ABI: json.RawMessage(transferABI), Inputs: true},
+			"0xa9059cbb" + transferTo[2:] + transferValue100,
+			`{"to": "0x0000000000000000000000000000000012345678", "value": "100"}`,
+			false,
+		},
+		{
+			"calldata of another function",
+			adapters.EthABIDecode{ABI: json.RawMessage(transferABI), Inputs: true},
+			"0x23b872dd" + transferTo[2:] + transferValue100,
+			"",
+			true,
+		},
+		{
+			"too short",
+			adapters.EthABIDecode{ABI: json.RawMessage(latestRoundDataABI)},
+			"0x0000000000000000000000000000000000000000000000000000000000000003",
+			"",
+			true,
+		},
+		{
+			"not hex",
+			adapters.EthABIDecode{ABI: json.RawMessage(transferABI)},
+			"true",
+			"",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			result := test.adapter.Perform(cltest.NewRunInputWithResult(test.input), nil)
+			if test.wantErr {
+				assert.Error(t, result.Error())
+				return
+			}
+			require.NoError(t, result.Error())
+			assert.JSONEq(t, test.want, result.Result().Raw)
+		})
+	}
+}
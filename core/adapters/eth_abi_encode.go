@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// EthABIEncode encodes the arguments of a contract function.
+type EthABIEncode struct {
+	// ABI describes the function, either as a contract ABI or as the fragment
+	// of the function alone
+	ABI    json.RawMessage `json:"abi"`
+	Method string          `json:"method,omitempty"`
+	// Args holds the arguments by name. Those left out are taken from the
+	// input data.
+	Args models.JSON `json:"args,omitempty"`
+	// ArgumentsOnly leaves the function selector out of the result
+	ArgumentsOnly bool `json:"argumentsOnly,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (e *EthABIEncode) TaskType() models.TaskType {
+	return TaskTypeEthABIEncode
+}
+
+// Perform returns the calldata of the function as a hex string, with each
+// argument taken from args or else from the input data field of the same
+// name. Unnamed arguments are looked up by position.
+//
+// For example, with the fragment of transfer(address to, uint256 value), and
+// args {"to": "0x...", "value": "100"}, the result is the selector 0xa9059cbb
+// followed by the two encoded arguments. EthTx sends it as is with the
+// "calldata" format.
+func (e *EthABIEncode) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	contractABI, err := parseABI(e.ABI)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	method, err := findABIMethod(contractABI, e.Method)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	values := make([]interface{}, len(method.Inputs))
+	for i, arg := range method.Inputs {
+		name := arg.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		value := e.Args.Get(name)
+		if !value.Exists() {
+			value = input.Data().Get(name)
+		}
+		values[i], err = abiValueFromJSON(arg.Type, value)
+		if err != nil {
+			return models.NewRunOutputError(errors.Wrapf(err, "argument %s", name))
+		}
+	}
+
+	encoded, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrap(err, "could not encode arguments"))
+	}
+	if !e.ArgumentsOnly {
+		encoded = utils.ConcatBytes(method.ID, encoded)
+	}
+	return models.NewRunOutputCompleteWithResult(hexutil.Encode(encoded))
+}
@@ -0,0 +1,172 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transferABI = `{
+	"type": "function",
+	"name": "transfer",
+	"inputs": [
+		{"name": "to", "type": "address"},
+		{"name": "value", "type": "uint256"}
+	],
+	"outputs": [{"name": "", "type": "bool"}]
+}`
+
+const (
+	transferTo       = "0x0000000000000000000000000000000000000000000000000000000012345678"
+	transferValue100 = "0000000000000000000000000000000000000000000000000000000000000064"
+)
+
+func TestEthABIEncode_Perform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		adapter adapters.EthABIEncode
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			"args",
+			adapters.EthABIEncode{
+				ABI:  json.RawMessage(transferABI),
+				Args: cltest.JSONFromString(t, `{"to": "0x0000000000000000000000000000000012345678", "value": 100}`),
+			},
+			`{}`,
+			"0xa9059cbb" + transferTo[2:] + transferValue100,
+			false,
+		},
+		{
+			"args from the input data",
+			adapters.EthABIEncode{
+				ABI:  json.RawMessage(transferABI),
+				Args: cltest.JSONFromString(t, `{"to": "0x0000000000000000000000000000000012345678"}`),
+			},
+			`{"value": "0x64"}`,
+			"0xa9059cbb" + transferTo[2:] + transferValue100,
+			false,
+		},
+		{
+			"arguments only",
+			adapters.EthABIEncode{
+				ABI:           json.RawMessage(transferABI),
+				Args:          cltest.JSONFromString(t, `{"to": "0x0000000000000000000000000000000012345678", "value": "100"}`),
+				ArgumentsOnly: true,
+			},
+			`{}`,
+			transferTo + transferValue100,
+			false,
+		},
+		{
+			"missing argument",
+			adapters.EthABIEncode{
+				ABI:  json.RawMessage(transferABI),
+				Args: cltest.JSONFromString(t, `{"to": "0x0000000000000000000000000000000012345678"}`),
+			},
+			`{}`,
+			"",
+			true,
+		},
+		{
+			"negative uint",
+			adapters.EthABIEncode{
+				ABI:  json.RawMessage(transferABI),
+				Args: cltest.JSONFromString(t, `{"to": "0x0000000000000000000000000000000012345678", "value": -1}`),
+			},
+			`{}`,
+			"",
+			true,
+		},
+		{
+			"overflow",
+			adapters.EthABIEncode{
+				ABI:  json.RawMessage(`{"type":"function","name":"f","inputs":[{"name":"n","type":"int8"}],"outputs":[]}`),
+				Args: cltest.JSONFromString(t, `{"n": 128}`),
+			},
+			`{}`,
+			"",
+			true,
+		},
+		{
+			"lowest int8",
+			adapters.EthABIEncode{
+				ABI:           json.RawMessage(`{"type":"function","name":"f","inputs":[{"name":"n","type":"int8"}],"outputs":[]}`),
+				Args:          cltest.JSONFromString(t, `{"n": -128}`),
+				ArgumentsOnly: true,
+			},
+			`{}`,
+			"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80",
+			false,
+		},
+		{
+			"invalid address",
+			adapters.EthABIEncode{
+				ABI:  json.RawMessage(transferABI),
+				Args: cltest.JSONFromString(t, `{"to": "0xdeadbeef", "value": 1}`),
+			},
+			`{}`,
+			"",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInput(cltest.JSONFromString(t, test.input))
+			result := test.adapter.Perform(input, nil)
+			if test.wantErr {
+				assert.Error(t, result.Error())
+				return
+			}
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.want, result.Result().String())
+		})
+	}
+}
+
+func TestEthABIEncode_RoundTripsThroughEthABIDecode(t *testing.T) {
+	t.Parallel()
+
+	fragment := json.RawMessage(`{
+		"type": "function",
+		"name": "submit",
+		"inputs": [
+			{"name": "items", "type": "tuple[]", "components": [
+				{"name": "id", "type": "uint64"},
+				{"name": "key", "type": "bytes32"},
+				{"name": "note", "type": "string"}
+			]},
+			{"name": "flags", "type": "bool[2]"},
+			{"name": "data", "type": "bytes"}
+		],
+		"outputs": []
+	}`)
+	args := `{
+		"items": [
+			{"id": "1", "key": "0x0100000000000000000000000000000000000000000000000000000000000000", "note": "one"},
+			{"id": "2", "key": "0x0200000000000000000000000000000000000000000000000000000000000000", "note": "two"}
+		],
+		"flags": [true, false],
+		"data": "0xdeadbeef"
+	}`
+
+	encoder := adapters.EthABIEncode{ABI: fragment, Args: cltest.JSONFromString(t, args)}
+	encoded := encoder.Perform(cltest.NewRunInputWithResult(""), nil)
+	require.NoError(t, encoded.Error())
+
+	decoder := adapters.EthABIDecode{ABI: fragment, Inputs: true}
+	decoded := decoder.Perform(cltest.NewRunInputWithResult(encoded.Result().String()), nil)
+	require.NoError(t, decoded.Error())
+	assert.JSONEq(t, args, decoded.Result().Raw)
+}
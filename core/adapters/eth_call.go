@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// ethCallTimeout is how long the eth node may take to answer an eth_call
+const ethCallTimeout = 15 * time.Second
+
+// EthCall reads from a contract with eth_call, without sending a transaction.
+type EthCall struct {
+	Address     common.Address `json:"address"`
+	FromAddress common.Address `json:"fromAddress,omitempty"`
+	// FunctionSelector may be left out when the function is described by ABI
+	FunctionSelector models.FunctionSelector `json:"functionSelector"`
+	// DataPrefix holds the ABI encoded arguments of the function, if any
+	DataPrefix hexutil.Bytes `json:"dataPrefix"`
+	// ABI describes the function, either as a contract ABI or as the fragment
+	// of the function alone, so that its return value can be decoded
+	ABI    json.RawMessage `json:"abi,omitempty"`
+	Method string          `json:"method,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (e *EthCall) TaskType() models.TaskType {
+	return TaskTypeEthCall
+}
+
+// Perform calls the function of the contract at address with the data
+// prefix as its arguments, at the latest block.
+//
+// Without an ABI the result is the returned bytes as a hex string. With an
+// ABI it is the decoded return value, or an object keyed by output name if
+// the function returns several values.
+func (e *EthCall) Perform(_ models.RunInput, store *strpkg.Store) models.RunOutput {
+	var outputs abi.Arguments
+	selector := e.FunctionSelector
+	if len(e.ABI) > 0 {
+		contractABI, err := parseABI(e.ABI)
+		if err != nil {
+			return models.NewRunOutputError(err)
+		}
+		method, err := findABIMethod(contractABI, e.Method)
+		if err != nil {
+			return models.NewRunOutputError(err)
+		}
+		if selector == (models.FunctionSelector{}) {
+			selector = models.BytesToFunctionSelector(method.ID)
+		}
+		outputs = method.Outputs
+	}
+	if selector == (models.FunctionSelector{}) {
+		return models.NewRunOutputError(errors.New("ethcall needs a functionSelector or an abi"))
+	}
+
+	msg := ethereum.CallMsg{
+		From: e.FromAddress,
+		To:   &e.Address,
+		Data: append(selector[:], e.DataPrefix...),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ethCallTimeout)
+	defer cancel()
+	returned, err := store.EthClient.CallContract(ctx, msg, nil)
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrapf(err, "eth_call to %s failed", e.Address.Hex()))
+	}
+
+	if len(e.ABI) == 0 {
+		return models.NewRunOutputCompleteWithResult(hexutil.Encode(returned))
+	}
+	if len(returned) == 0 && len(outputs) > 0 {
+		return models.NewRunOutputError(errors.Errorf("eth_call to %s returned no data, is it a contract?", e.Address.Hex()))
+	}
+	result, err := decodeABIValues(outputs, returned)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(result)
+}
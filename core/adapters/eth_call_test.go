@@ -0,0 +1,143 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const latestRoundDataABI = `{
+	"type": "function",
+	"name": "latestRoundData",
+	"inputs": [],
+	"outputs": [
+		{"name": "roundId", "type": "uint80"},
+		{"name": "answer", "type": "int256"},
+		{"name": "startedAt", "type": "uint256"},
+		{"name": "updatedAt", "type": "uint256"},
+		{"name": "answeredInRound", "type": "uint80"}
+	]
+}`
+
+func TestEthCall_Perform(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	address := cltest.NewAddress()
+	word := func(n int64) []byte {
+		return math.U256Bytes(big.NewInt(n))
+	}
+	isCallTo := func(data string) interface{} {
+		return mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return *msg.To == address && hexutil.Encode(msg.Data) == data
+		})
+	}
+
+	t.Run("returns the raw data without an abi", func(t *testing.T) {
+		ethClient.On("CallContract", mock.Anything, isCallTo("0x50d25bcd"), (*big.Int)(nil)).
+			Return(word(42), nil).Once()
+
+		adapter := adapters.EthCall{
+			Address:          address,
+			FunctionSelector: models.HexToFunctionSelector("0x50d25bcd"),
+		}
+		result := adapter.Perform(cltest.NewRunInputWithResult(""), store)
+		require.NoError(t, result.Error())
+		assert.Equal(t, hexutil.Encode(word(42)), result.Result().String())
+	})
+
+	t.Run("appends the data prefix to the selector", func(t *testing.T) {
+		ethClient.On("CallContract", mock.Anything, isCallTo("0x9a6fc8f5"+hexutil.Encode(word(7))[2:]), (*big.Int)(nil)).
+			Return(word(1), nil).Once()
+
+		adapter := adapters.EthCall{
+			Address:          address,
+			FunctionSelector: models.HexToFunctionSelector("0x9a6fc8f5"),
+			DataPrefix:       word(7),
+		}
+		result := adapter.Perform(cltest.NewRunInputWithResult(""), store)
+		require.NoError(t, result.Error())
+	})
+
+	t.Run("decodes the return values with an abi", func(t *testing.T) {
+		var returned []byte
+		for _, n := range []int64{3, -1500, 100, 200, 3} {
+			returned = append(returned, word(n)...)
+		}
+		ethClient.On("CallContract", mock.Anything, isCallTo("0xfeaf968c"), (*big.Int)(nil)).
+			Return(returned, nil).Once()
+
+		adapter := adapters.EthCall{
+			Address: address,
+			ABI:     json.RawMessage(latestRoundDataABI),
+		}
+		result := adapter.Perform(cltest.NewRunInputWithResult(""), store)
+		require.NoError(t, result.Error())
+		assert.Equal(t, "3", result.Result().Get("roundId").String())
+		assert.Equal(t, "-1500", result.Result().Get("answer").String())
+		assert.Equal(t, "200", result.Result().Get("updatedAt").String())
+	})
+
+	t.Run("decodes a single return value on its own", func(t *testing.T) {
+		ethClient.On("CallContract", mock.Anything, isCallTo("0x50d25bcd"), (*big.Int)(nil)).
+			Return(word(42), nil).Once()
+
+		adapter := adapters.EthCall{
+			Address: address,
+			ABI:     json.RawMessage(`[{"type":"function","name":"latestAnswer","inputs":[],"outputs":[{"name":"","type":"int256"}]}]`),
+		}
+		result := adapter.Perform(cltest.NewRunInputWithResult(""), store)
+		require.NoError(t, result.Error())
+		assert.Equal(t, "42", result.Result().String())
+	})
+
+	t.Run("errors when the call fails", func(t *testing.T) {
+		ethClient.On("CallContract", mock.Anything, isCallTo("0x50d25bcd"), (*big.Int)(nil)).
+			Return(nil, errors.New("execution reverted")).Once()
+
+		adapter := adapters.EthCall{
+			Address:          address,
+			FunctionSelector: models.HexToFunctionSelector("0x50d25bcd"),
+		}
+		result := adapter.Perform(cltest.NewRunInputWithResult(""), store)
+		require.Error(t, result.Error())
+		assert.Contains(t, result.Error().Error(), "execution reverted")
+	})
+
+	t.Run("errors without a selector or an abi", func(t *testing.T) {
+		adapter := adapters.EthCall{Address: address}
+		result := adapter.Perform(cltest.NewRunInputWithResult(""), store)
+		require.Error(t, result.Error())
+	})
+
+	t.Run("errors when the abi has several functions and no method is given", func(t *testing.T) {
+		adapter := adapters.EthCall{
+			Address: address,
+			ABI: json.RawMessage(`[
+				{"type":"function","name":"a","inputs":[],"outputs":[]},
+				{"type":"function","name":"b","inputs":[],"outputs":[]}
+			]`),
+		}
+		result := adapter.Perform(cltest.NewRunInputWithResult(""), store)
+		require.Error(t, result.Error())
+	})
+
+	ethClient.AssertExpectations(t)
+}
@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+// EthSign signs the result, or the run data at a field, with one of the
+// node's Ethereum keys, for attestations checked off chain or payloads
+// submitted to contracts by someone else.
+type EthSign struct {
+	// Address is the node's account which signs, or the first if empty
+	Address *common.Address `json:"address,omitempty"`
+	// Field is the path of the run data signed, which is the result if empty
+	Field string `json:"field,omitempty"`
+	// Append sets the result to the payload followed by the signature
+	Append bool `json:"append,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (e *EthSign) TaskType() models.TaskType {
+	return TaskTypeEthSign
+}
+
+// Perform signs the keccak256 hash of the payload as an Ethereum message, as
+// eth_sign does, so that a transaction can never be signed in its place. It
+// adds the signature and the address of the account which signed to the run
+// data as "signature" and "signer".
+//
+// The payload is the bytes of a 0x prefixed hex string, the UTF-8 bytes of
+// any other string, or else the compact JSON of the value.
+func (e *EthSign) Perform(input models.RunInput, store *store.Store) models.RunOutput {
+	field := e.Field
+	if field == "" {
+		field = "result"
+	}
+	payload, err := signPayload(input.Data().Get(field))
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrapf(err, "field %s", field))
+	}
+	account, err := e.account(store)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	hash, err := utils.Keccak256(payload)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	signature, err := store.KeyStore.SignHashWithAccount(account, common.BytesToHash(hash))
+	if err != nil {
+		return models.NewRunOutputError(errors.Wrap(err, "could not sign payload"))
+	}
+
+	kv := models.KV{
+		"signature": signature.Hex(),
+		"signer":    account.Address.Hex(),
+	}
+	if e.Append {
+		kv["result"] = hexutil.Encode(utils.ConcatBytes(payload, signature[:]))
+	}
+	data, err := input.Data().MultiAdd(kv)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputComplete(data)
+}
+
+func (e *EthSign) account(store *store.Store) (accounts.Account, error) {
+	if e.Address == nil {
+		return store.KeyStore.GetFirstAccount()
+	}
+	return store.KeyStore.GetAccountByAddress(*e.Address)
+}
+
+func signPayload(value gjson.Result) ([]byte, error) {
+	switch {
+	case !value.Exists():
+		return nil, errors.New("no value to sign")
+	case value.Type == gjson.String && utils.HasHexPrefix(value.Str):
+		payload, err := hexutil.Decode(value.Str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex %s: %v", value.Str, err)
+		}
+		return payload, nil
+	case value.Type == gjson.String:
+		return []byte(value.Str), nil
+	default:
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, []byte(value.Raw)); err != nil {
+			return nil, err
+		}
+		return compact.Bytes(), nil
+	}
+}
@@ -0,0 +1,78 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthSign_Perform(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, store.KeyStore.Unlock(cltest.Password))
+	account, err := store.KeyStore.GetFirstAccount()
+	require.NoError(t, err)
+
+	recoverSigner := func(t *testing.T, payload []byte, signature string) common.Address {
+		hash, err := utils.Keccak256(payload)
+		require.NoError(t, err)
+		prefixed, err := utils.Keccak256(append([]byte(strpkg.EthereumMessageHashPrefix), hash...))
+		require.NoError(t, err)
+		sig, err := hexutil.Decode(signature)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(prefixed, sig)
+		require.NoError(t, err)
+		return crypto.PubkeyToAddress(*pub)
+	}
+
+	tests := []struct {
+		name    string
+		adapter adapters.EthSign
+		input   string
+		payload []byte
+	}{
+		{"hex result", adapters.EthSign{}, `{"result": "0xdeadbeef"}`, []byte{0xde, 0xad, 0xbe, 0xef}},
+		{"text result", adapters.EthSign{}, `{"result": "hello"}`, []byte("hello")},
+		{"object field", adapters.EthSign{Field: "report"}, `{"result": "1", "report": {"price": 100, "round": 2}}`, []byte(`{"price":100,"round":2}`)},
+		{"given address", adapters.EthSign{Address: &account.Address}, `{"result": 42}`, []byte("42")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.input)
+			result := test.adapter.Perform(input, store)
+			require.NoError(t, result.Error())
+
+			assert.Equal(t, account.Address.Hex(), result.Data().Get("signer").String())
+			signature := result.Data().Get("signature").String()
+			assert.Equal(t, account.Address, recoverSigner(t, test.payload, signature))
+			assert.Equal(t, input.Result().Raw, result.Result().Raw)
+		})
+	}
+
+	t.Run("appends the signature", func(t *testing.T) {
+		adapter := adapters.EthSign{Append: true}
+		result := adapter.Perform(cltest.NewRunInputWithResult("0xdeadbeef"), store)
+		require.NoError(t, result.Error())
+
+		signature := result.Data().Get("signature").String()
+		assert.Equal(t, "0xdeadbeef"+signature[2:], result.Result().String())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		unknown := common.HexToAddress("0x0000000000000000000000000000000000000001")
+		for _, adapter := range []adapters.EthSign{{Field: "missing"}, {Address: &unknown}} {
+			result := adapter.Perform(cltest.NewRunInputWithResult("0xdeadbeef"), store)
+			assert.Error(t, result.Error())
+		}
+	})
+}
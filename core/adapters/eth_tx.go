@@ -1,20 +1,28 @@
 package adapters
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v3"
 )
 
@@ -22,6 +30,10 @@ const (
 	// DataFormatBytes instructs the EthTx Adapter to treat the input value as a
 	// bytes string, rather than a hexadecimal encoded bytes32
 	DataFormatBytes = "bytes"
+	// DataFormatCalldata instructs the EthTx Adapter to send the input value,
+	// such as the output of EthABIEncode, as the whole transaction data,
+	// without a function selector or data prefix
+	DataFormatCalldata = "calldata"
 )
 
 // EthTx holds the Address to send the result to and the FunctionSelector
@@ -36,11 +48,22 @@ type EthTx struct {
 	DataFormat       string                  `json:"format"`
 	GasLimit         uint64                  `json:"gasLimit,omitempty"`
 
-	// GasPrice only needed for legacy tx manager
+	// GasPrice overrides ETH_GAS_PRICE_DEFAULT for transactions sent by this task
 	GasPrice *utils.Big `json:"gasPrice" gorm:"type:numeric"`
 
+	// MaxGasPrice caps the gas price the transaction may be bumped to below
+	// ETH_MAX_GAS_PRICE_WEI, and only works with bulletprooftxmanager
+	MaxGasPrice *utils.Big `json:"maxGasPrice,omitempty"`
+
 	// MinRequiredOutgoingConfirmations only works with bulletprooftxmanager
 	MinRequiredOutgoingConfirmations uint64 `json:"minRequiredOutgoingConfirmations,omitempty"`
+
+	// Simulate overrides ETH_SIMULATE_TRANSACTIONS, and only works with bulletprooftxmanager
+	Simulate *bool `json:"simulate,omitempty"`
+
+	// Priority orders the transaction ahead of pending transactions with a
+	// lower priority, and only works with bulletprooftxmanager
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -74,9 +97,12 @@ func (e *EthTx) perform(input models.RunInput, store *strpkg.Store) models.RunOu
 
 func (e *EthTx) checkForConfirmation(trtx models.EthTaskRunTx,
 	input models.RunInput, store *strpkg.Store) models.RunOutput {
+	if trtx.EthTx.Abandoned {
+		return models.NewRunOutputError(errors.Errorf("eth_tx %v was abandoned", trtx.EthTx.ID))
+	}
 	switch trtx.EthTx.State {
 	case models.EthTxConfirmed:
-		return e.checkEthTxForReceipt(trtx.EthTx.ID, input, store)
+		return e.checkEthTxForReceipt(trtx.EthTx, input, store)
 	case models.EthTxFatalError:
 		return models.NewRunOutputError(trtx.EthTx.GetError())
 	default:
@@ -91,10 +117,13 @@ func (e *EthTx) pickFromAddress(input models.RunInput, store *strpkg.Store) (com
 				" fromAddress is deprecated, it will be ignored and fromAddresses used instead. "+
 				"Specifying both of these keys in a job spec may result in an error in future versions of Chainlink", input.TaskRunID())
 		}
-		return store.GetRoundRobinAddress(e.FromAddresses...)
+		return nextSendingAddress(store, e.FromAddresses...)
 	}
 	if e.FromAddress == utils.ZeroAddress {
-		return store.GetRoundRobinAddress()
+		return nextSendingAddress(store)
+	}
+	if !store.Config.SendsFrom(e.FromAddress) {
+		return common.Address{}, fmt.Errorf("fromAddress %s does not send transactions on chain %s", e.FromAddress.Hex(), store.Config.ChainID())
 	}
 	logger.Warnf(`DEPRECATION WARNING: task spec for task run %s specified a fromAddress of %s. fromAddress has been deprecated and will be removed in a future version of Chainlink. Please use fromAddresses instead. You can pin a job to one address simply by using only one element, like so:
 {
@@ -105,6 +134,58 @@ func (e *EthTx) pickFromAddress(input models.RunInput, store *strpkg.Store) (com
 	return e.FromAddress, nil
 }
 
+// nextSendingAddress picks one of the given keys, or of all sending keys if
+// none are given, according to ETH_KEY_SELECTION
+func nextSendingAddress(store *strpkg.Store, addresses ...common.Address) (common.Address, error) {
+	if store.Config.EthKeySelection() == orm.KeySelectionLeastPending {
+		return store.GetLeastPendingAddress(addresses...)
+	}
+	return store.GetRoundRobinAddress(addresses...)
+}
+
+// forward wraps the payload in a call to the configured forwarder contract, if
+// any, and returns the address the transaction should be sent to along with
+// its payload
+func forward(store *strpkg.Store, to common.Address, payload []byte) (common.Address, []byte, error) {
+	forwarder := store.Config.EthForwarderAddress()
+	if forwarder == "" {
+		return to, payload, nil
+	}
+	forwardedPayload, err := contracts.EncodeForwardCall(to, payload)
+	if err != nil {
+		return to, payload, errors.Wrap(err, "could not encode forwarder call")
+	}
+	return common.HexToAddress(forwarder), forwardedPayload, nil
+}
+
+func (e *EthTx) simulate(store *strpkg.Store) bool {
+	if e.Simulate != nil {
+		return *e.Simulate
+	}
+	return store.Config.EthSimulateTransactions()
+}
+
+// simulateTx runs the transaction as an eth_call against the latest block, and
+// returns an error with the revert reason if the call reverts. Errors which do
+// not come from the call reverting are only logged, so that a node which
+// cannot run the call does not stop transactions from being sent.
+func simulateTx(store *strpkg.Store, from, to common.Address, payload []byte, gasLimit uint64) error {
+	_, err := store.EthClient.CallContract(context.TODO(), ethereum.CallMsg{
+		From: from,
+		To:   &to,
+		Gas:  gasLimit,
+		Data: payload,
+	}, nil)
+	if err == nil {
+		return nil
+	}
+	if reason, reverted := eth.ExtractRevertReason(err); reverted {
+		return errors.Errorf("transaction simulation reverted: %s", reason)
+	}
+	logger.Warnw("EthTx: unable to simulate transaction, sending it anyway", "error", err)
+	return nil
+}
+
 func (e *EthTx) insertEthTx(input models.RunInput, store *strpkg.Store) models.RunOutput {
 	txData, err := getTxData(e, input)
 	if err != nil {
@@ -113,14 +194,17 @@ func (e *EthTx) insertEthTx(input models.RunInput, store *strpkg.Store) models.R
 	}
 
 	taskRunID := input.TaskRunID()
-	toAddress := e.ToAddress
 	fromAddress, err := e.pickFromAddress(input, store)
 	if err != nil {
 		err = errors.Wrap(err, "insertEthTx failed to pickFromAddress")
 		logger.Error(err)
 		return models.NewRunOutputError(err)
 	}
-	encodedPayload := utils.ConcatBytes(e.FunctionSelector.Bytes(), e.DataPrefix, txData)
+	toAddress, encodedPayload, err := forward(store, e.ToAddress, e.payload(txData))
+	if err != nil {
+		err = errors.Wrap(err, "insertEthTx failed")
+		return models.NewRunOutputError(err)
+	}
 
 	var gasLimit uint64
 	if e.GasLimit == 0 {
@@ -129,7 +213,22 @@ func (e *EthTx) insertEthTx(input models.RunInput, store *strpkg.Store) models.R
 		gasLimit = e.GasLimit
 	}
 
-	if err := store.IdempotentInsertEthTaskRunTx(taskRunID, fromAddress, toAddress, encodedPayload, gasLimit); err != nil {
+	var gasPrice, maxGasPrice *big.Int
+	if e.GasPrice != nil {
+		gasPrice = e.GasPrice.ToInt()
+	}
+	if e.MaxGasPrice != nil {
+		maxGasPrice = e.MaxGasPrice.ToInt()
+	}
+
+	if e.simulate(store) {
+		if err := simulateTx(store, fromAddress, toAddress, encodedPayload, gasLimit); err != nil {
+			logger.Warnw("EthTx: not sending transaction which would revert", "taskRunID", taskRunID, "error", err)
+			return models.NewRunOutputError(err)
+		}
+	}
+
+	if err := store.IdempotentInsertEthTaskRunTx(taskRunID, fromAddress, toAddress, encodedPayload, gasLimit, gasPrice, maxGasPrice, e.Priority); err != nil {
 		err = errors.Wrap(err, "insertEthTx failed")
 		logger.Error(err)
 		return models.NewRunOutputError(err)
@@ -138,7 +237,7 @@ func (e *EthTx) insertEthTx(input models.RunInput, store *strpkg.Store) models.R
 	return models.NewRunOutputPendingOutgoingConfirmationsWithData(input.Data())
 }
 
-func (e *EthTx) checkEthTxForReceipt(ethTxID int64, input models.RunInput, s *strpkg.Store) models.RunOutput {
+func (e *EthTx) checkEthTxForReceipt(ethTx models.EthTx, input models.RunInput, s *strpkg.Store) models.RunOutput {
 	var minRequiredOutgoingConfirmations uint64
 	if e.MinRequiredOutgoingConfirmations == 0 {
 		minRequiredOutgoingConfirmations = s.Config.MinRequiredOutgoingConfirmations()
@@ -146,18 +245,34 @@ func (e *EthTx) checkEthTxForReceipt(ethTxID int64, input models.RunInput, s *st
 		minRequiredOutgoingConfirmations = e.MinRequiredOutgoingConfirmations
 	}
 
-	hash, err := getConfirmedTxHash(ethTxID, s.DB, minRequiredOutgoingConfirmations)
+	receipt, err := getConfirmedReceipt(ethTx.ID, s.ORM, minRequiredOutgoingConfirmations)
 
 	if err != nil {
 		logger.Error(err)
 		return models.NewRunOutputError(err)
 	}
 
-	if hash == nil {
+	if receipt == nil {
 		return models.NewRunOutputPendingOutgoingConfirmationsWithData(input.Data())
 	}
 
-	hexHash := (*hash).Hex()
+	// A forwarded call which reverts makes the forwarder revert in turn, so a
+	// failed receipt means the call to the task's contract failed
+	if forwarder := s.Config.EthForwarderAddress(); forwarder != "" && ethTx.ToAddress == common.HexToAddress(forwarder) {
+		var gethReceipt types.Receipt
+		if err = json.Unmarshal(receipt.Receipt, &gethReceipt); err != nil {
+			err = errors.Wrap(err, "checkEthTxForReceipt failed to decode receipt")
+			logger.Error(err)
+			return models.NewRunOutputError(err)
+		}
+		if gethReceipt.Status == types.ReceiptStatusFailed {
+			return models.NewRunOutputError(errors.Errorf("forwarded transaction %s reverted", receipt.TxHash.Hex()))
+		}
+	}
+
+	recordGasSpent(s.DB, input.JobRunID(), *receipt)
+	traceEthTx(input, ethTx, *receipt, minRequiredOutgoingConfirmations)
+	hexHash := receipt.TxHash.Hex()
 
 	output := input.Data()
 	output, err = output.MultiAdd(models.KV{
@@ -173,25 +288,79 @@ func (e *EthTx) checkEthTxForReceipt(ethTxID int64, input models.RunInput, s *st
 	return models.NewRunOutputComplete(output)
 }
 
-func getConfirmedTxHash(ethTxID int64, db *gorm.DB, minRequiredOutgoingConfirmations uint64) (*common.Hash, error) {
+func getConfirmedReceipt(ethTxID int64, orm *orm.ORM, minRequiredOutgoingConfirmations uint64) (*models.EthReceipt, error) {
 	receipt := models.EthReceipt{}
-	err := db.
+	err := orm.DB.
 		Joins("INNER JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash AND eth_tx_attempts.eth_tx_id = ?", ethTxID).
 		Joins("INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state = 'confirmed'").
-		Where("eth_receipts.block_number <= (SELECT max(number) - ? FROM heads)", minRequiredOutgoingConfirmations).
+		Where("eth_receipts.block_number <= (SELECT max(number) - ? FROM heads WHERE "+orm.ChainCondition("heads")+")", minRequiredOutgoingConfirmations).
 		First(&receipt).
 		Error
 
 	if err == nil {
-		return &receipt.TxHash, nil
+		return &receipt, nil
 	}
 
 	if gorm.IsRecordNotFoundError(err) {
 		return nil, nil
 	}
 
-	return nil, errors.Wrap(err, "getConfirmedTxHash failed")
+	return nil, errors.Wrap(err, "getConfirmedReceipt failed")
+
+}
+
+// recordGasSpent adds the cost of a confirmed transaction to the gas spent by
+// the job whose run sent it. It is only used for metrics, so failures are
+// logged rather than failing the task.
+func recordGasSpent(db *gorm.DB, jobRunID *models.ID, receipt models.EthReceipt) {
+	var gethReceipt types.Receipt
+	if err := json.Unmarshal(receipt.Receipt, &gethReceipt); err != nil {
+		logger.Warnw("Unable to record gas spent: could not decode receipt", "error", err, "txHash", receipt.TxHash.Hex())
+		return
+	}
+	var attempt models.EthTxAttempt
+	if err := db.Where("hash = ?", receipt.TxHash).First(&attempt).Error; err != nil {
+		logger.Warnw("Unable to record gas spent: could not load transaction attempt", "error", err, "txHash", receipt.TxHash.Hex())
+		return
+	}
+	jobSpecID := new(models.ID)
+	if err := db.Table("job_runs").Select("job_spec_id").Where("id = ?", jobRunID).Row().Scan(jobSpecID); err != nil {
+		logger.Warnw("Unable to record gas spent: could not load job run", "error", err, "jobRunID", jobRunID.String())
+		return
+	}
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gethReceipt.GasUsed), attempt.GasPrice.ToInt())
+	strpkg.PromAddGasSpent(jobSpecID, cost)
+}
 
+// traceEthTx records spans for the time the transaction spent waiting to be
+// broadcast, and then waiting to be mined and confirmed.
+func traceEthTx(input models.RunInput, ethTx models.EthTx, receipt models.EthReceipt, confirmations uint64) {
+	if ethTx.BroadcastAt == nil {
+		return
+	}
+	taskRunID := input.TaskRunID()
+	ctx := tracing.TaskContext(context.Background(), input.JobRunID(), &taskRunID)
+
+	_, broadcast := tracing.Tracer().Start(ctx, "eth_tx.broadcast",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(ethTx.CreatedAt),
+		trace.WithAttributes(
+			attribute.Int64("eth_tx.id", ethTx.ID),
+			attribute.String("eth_tx.from", ethTx.FromAddress.Hex()),
+		),
+	)
+	broadcast.End(trace.WithTimestamp(*ethTx.BroadcastAt))
+
+	_, confirm := tracing.Tracer().Start(ctx, "eth_tx.confirm",
+		trace.WithTimestamp(*ethTx.BroadcastAt),
+		trace.WithAttributes(
+			attribute.Int64("eth_tx.id", ethTx.ID),
+			attribute.String("eth_tx.hash", receipt.TxHash.Hex()),
+			attribute.Int64("eth_tx.block_number", receipt.BlockNumber),
+			attribute.Int64("eth_tx.confirmations", int64(confirmations)),
+		),
+	)
+	confirm.End()
 }
 
 func (e *EthTx) legacyPerform(input models.RunInput, store *strpkg.Store) models.RunOutput {
@@ -209,8 +378,11 @@ func (e *EthTx) legacyPerform(input models.RunInput, store *strpkg.Store) models
 		return models.NewRunOutputError(err)
 	}
 
-	data := utils.ConcatBytes(e.FunctionSelector.Bytes(), e.DataPrefix, value)
-	return createTxRunResult(e.ToAddress, e.GasPrice, e.GasLimit, data, input, store)
+	toAddress, data, err := forward(store, e.ToAddress, e.payload(value))
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return createTxRunResult(toAddress, e.GasPrice, e.GasLimit, data, input, store)
 }
 
 // getTxData returns the data to save against the callback encoded according to
@@ -220,6 +392,9 @@ func getTxData(e *EthTx, input models.RunInput) ([]byte, error) {
 	if e.DataFormat == "" {
 		return common.HexToHash(result.Str).Bytes(), nil
 	}
+	if e.DataFormat == DataFormatCalldata {
+		return hexutil.Decode(result.String())
+	}
 
 	output, err := utils.EVMTranscodeJSONWithFormat(result, e.DataFormat)
 	if err != nil {
@@ -236,6 +411,15 @@ func getTxData(e *EthTx, input models.RunInput) ([]byte, error) {
 	return utils.ConcatBytes(output), nil
 }
 
+// payload prefixes the transaction data with the function selector and data
+// prefix, unless it is already whole calldata
+func (e *EthTx) payload(data []byte) []byte {
+	if e.DataFormat == DataFormatCalldata {
+		return data
+	}
+	return utils.ConcatBytes(e.FunctionSelector.Bytes(), e.DataPrefix, data)
+}
+
 func createTxRunResult(
 	address common.Address,
 	gasPrice *utils.Big,
@@ -12,10 +12,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -174,6 +176,34 @@ func TestEthTxAdapter_Perform_Preformatted(t *testing.T) {
 	txManager.AssertExpectations(t)
 }
 
+func TestEthTxAdapter_Perform_Calldata(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := newStoreWithLegacyTXManager(t)
+	defer cleanup()
+
+	calldata := "0xa9059cbb00000000000000000000000000000000000000000000000000000000123456780000000000000000000000000000000000000000000000000000000000000064"
+
+	txManager := new(mocks.TxManager)
+	tx := &models.Tx{Attempts: []*models.TxAttempt{&models.TxAttempt{}}}
+	txManager.On("Connected").Maybe().Return(true)
+	txManager.On("CreateTxWithGas", mock.Anything, mock.Anything, hexutil.MustDecode(calldata), mock.Anything, mock.Anything).Return(tx, nil)
+	txManager.On("CheckAttempt", mock.Anything, mock.Anything).Return(&types.Receipt{}, strpkg.Unconfirmed, nil)
+	store.TxManager = txManager
+
+	adapter := adapters.EthTx{
+		FunctionSelector: models.HexToFunctionSelector("0xdeadcafe"),
+		DataFormat:       adapters.DataFormatCalldata,
+	}
+	input := cltest.NewRunInputWithResult(calldata)
+	result := adapter.Perform(input, store)
+
+	assert.NoError(t, result.Error())
+	assert.Equal(t, models.RunStatusPendingOutgoingConfirmations, result.Status())
+
+	txManager.AssertExpectations(t)
+}
+
 func TestEthTxAdapter_Perform_FromPendingOutgoingConfirmations_StillPending(t *testing.T) {
 	t.Parallel()
 
@@ -807,3 +837,133 @@ func TestEthTxAdapter_Perform_BPTXM(t *testing.T) {
 		assert.Equal(t, "", runOutput.Result().String())
 	})
 }
+
+func TestEthTxAdapter_Perform_BPTXM_Forwarder(t *testing.T) {
+	t.Parallel()
+
+	config, cfCleanup := cltest.NewConfig(t)
+	defer cfCleanup()
+	forwarderAddress := cltest.NewAddress()
+	config.Config.Set("ETH_FORWARDER_ADDRESS", forwarderAddress.Hex())
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	toAddress := cltest.NewAddress()
+	functionSelector := models.HexToFunctionSelector("0x70a08231") // balanceOf(address)
+	adapter := adapters.EthTx{
+		ToAddress:        toAddress,
+		FunctionSelector: functionSelector,
+	}
+
+	t.Run("wraps the payload in a call to the forwarder", func(t *testing.T) {
+		taskRunID := cltest.MustInsertTaskRun(t, store)
+		input := models.NewRunInputWithResult(models.NewID(), taskRunID, "0x9786856756", models.RunStatusUnstarted)
+		runOutput := adapter.Perform(*input, store)
+		require.NoError(t, runOutput.Error())
+
+		etrt, err := store.FindEthTaskRunTxByTaskRunID(taskRunID.UUID())
+		require.NoError(t, err)
+
+		payload := hexutil.MustDecode("0x70a082310000000000000000000000000000000000000000000000000000009786856756")
+		expected, err := contracts.EncodeForwardCall(toAddress, payload)
+		require.NoError(t, err)
+		assert.Equal(t, forwarderAddress, etrt.EthTx.ToAddress)
+		assert.Equal(t, expected, etrt.EthTx.EncodedPayload)
+	})
+
+	t.Run("errors if the forwarded call reverted", func(t *testing.T) {
+		taskRunID := cltest.MustInsertTaskRun(t, store)
+		etx := cltest.MustInsertConfirmedEthTxWithAttempt(t, store, 1, 1)
+		require.NoError(t, store.DB.Exec(`UPDATE eth_txes SET to_address = ? WHERE id = ?`, forwarderAddress, etx.ID).Error)
+		require.NoError(t, store.DB.Exec(`INSERT INTO eth_task_run_txes (task_run_id, eth_tx_id) VALUES ($1, $2)`, taskRunID.UUID(), etx.ID).Error)
+		receipt := cltest.MustInsertEthReceipt(t, store, 1, cltest.NewHash(), etx.EthTxAttempts[0].Hash)
+		gethReceipt, err := json.Marshal(types.Receipt{TxHash: receipt.TxHash, Status: types.ReceiptStatusFailed, Logs: []*types.Log{}})
+		require.NoError(t, err)
+		require.NoError(t, store.DB.Exec(`UPDATE eth_receipts SET receipt = ? WHERE id = ?`, gethReceipt, receipt.ID).Error)
+		require.NoError(t, store.IdempotentInsertHead(models.Head{
+			Hash:   cltest.NewHash(),
+			Number: 100,
+		}))
+
+		input := models.NewRunInputWithResult(models.NewID(), taskRunID, "0x9786856756", models.RunStatusUnstarted)
+		runOutput := adapter.Perform(*input, store)
+		require.Error(t, runOutput.Error())
+		assert.Contains(t, runOutput.Error().Error(), "reverted")
+	})
+}
+
+func TestEthTxAdapter_Perform_BPTXM_Simulate(t *testing.T) {
+	t.Parallel()
+
+	config, cfCleanup := cltest.NewConfig(t)
+	defer cfCleanup()
+	config.Config.Set("ETH_SIMULATE_TRANSACTIONS", true)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	toAddress := cltest.NewAddress()
+	functionSelector := models.HexToFunctionSelector("0x70a08231") // balanceOf(address)
+	payload := hexutil.MustDecode("0x70a082310000000000000000000000000000000000000000000000000000009786856756")
+
+	t.Run("does not insert a transaction which would revert", func(t *testing.T) {
+		adapter := adapters.EthTx{
+			ToAddress:        toAddress,
+			FunctionSelector: functionSelector,
+		}
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return *msg.To == toAddress && bytes.Equal(msg.Data, payload)
+		}), (*big.Int)(nil)).Return(nil, errors.New("execution reverted")).Once()
+
+		taskRunID := cltest.MustInsertTaskRun(t, store)
+		input := models.NewRunInputWithResult(models.NewID(), taskRunID, "0x9786856756", models.RunStatusUnstarted)
+		runOutput := adapter.Perform(*input, store)
+		require.Error(t, runOutput.Error())
+		assert.Contains(t, runOutput.Error().Error(), "transaction simulation reverted: execution reverted")
+
+		etrt, err := store.FindEthTaskRunTxByTaskRunID(taskRunID.UUID())
+		require.NoError(t, err)
+		assert.Nil(t, etrt)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("inserts the transaction if the simulation succeeds", func(t *testing.T) {
+		adapter := adapters.EthTx{
+			ToAddress:        toAddress,
+			FunctionSelector: functionSelector,
+		}
+		ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return([]byte{}, nil).Once()
+
+		taskRunID := cltest.MustInsertTaskRun(t, store)
+		input := models.NewRunInputWithResult(models.NewID(), taskRunID, "0x9786856756", models.RunStatusUnstarted)
+		runOutput := adapter.Perform(*input, store)
+		require.NoError(t, runOutput.Error())
+		assert.Equal(t, models.RunStatusPendingOutgoingConfirmations, runOutput.Status())
+
+		etrt, err := store.FindEthTaskRunTxByTaskRunID(taskRunID.UUID())
+		require.NoError(t, err)
+		require.NotNil(t, etrt)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("skips the simulation if the task disables it", func(t *testing.T) {
+		simulate := false
+		adapter := adapters.EthTx{
+			ToAddress:        toAddress,
+			FunctionSelector: functionSelector,
+			Simulate:         &simulate,
+		}
+
+		taskRunID := cltest.MustInsertTaskRun(t, store)
+		input := models.NewRunInputWithResult(models.NewID(), taskRunID, "0x9786856756", models.RunStatusUnstarted)
+		runOutput := adapter.Perform(*input, store)
+		require.NoError(t, runOutput.Error())
+
+		etrt, err := store.FindEthTaskRunTxByTaskRunID(taskRunID.UUID())
+		require.NoError(t, err)
+		require.NotNil(t, etrt)
+		ethClient.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,202 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	simplejson "github.com/bitly/go-simplejson"
+	gjson "github.com/tidwall/gjson"
+)
+
+// GraphQL sends a query to a GraphQL endpoint and sets the result to the
+// value at Path in the data it responds with.
+type GraphQL struct {
+	URL   models.WebURL `json:"url"`
+	Query string        `json:"query"`
+	// Variables holds the variables of the query by name. Those left out are
+	// taken from the input data.
+	Variables models.JSON `json:"variables,omitempty"`
+	// Path is the path of the result within the data of the response
+	Path    JSONPath    `json:"path"`
+	Headers http.Header `json:"headers"`
+	HTTPRetry
+	// ClientCertificate names the certificate presented to servers which
+	// require mutual TLS
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	// Proxy is the URL of the HTTP or SOCKS5 proxy the request is sent
+	// through, instead of directly
+	Proxy string `json:"proxy,omitempty"`
+}
+
+// TaskType returns the type of Adapter.
+func (g *GraphQL) TaskType() models.TaskType {
+	return TaskTypeGraphQL
+}
+
+// Validate checks that there is a query, and that it only reads data, as a
+// mutation could have side effects which must not be repeated when a run is
+// resumed.
+func (g *GraphQL) Validate() error {
+	_, err := g.variableNames()
+	return err
+}
+
+// Perform POSTs the query to the URL, with each variable it declares taken
+// from variables or else from the input data field of the same name.
+//
+// For example, the query
+//
+//	query ($symbol: String!) { ticker(symbol: $symbol) { price } }
+//
+// with the input data {"symbol": "ETH"} and path ["ticker", "price"] sets the
+// result to the price of ETH. Errors in the response error the task.
+func (g *GraphQL) Perform(input models.RunInput, store *store.Store) models.RunOutput {
+	body, err := g.requestBody(input.Data())
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	request, err := http.NewRequest("POST", g.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	setHeaders(request, g.Headers, "application/json")
+
+	httpConfig := defaultHTTPConfig(store.Config)
+	httpConfig.RateLimiter = store.HTTPRateLimiter
+	httpConfig.TLSConfig, err = clientTLSConfig(store, g.ClientCertificate)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	httpConfig.Proxy, err = utils.ParseProxyURL(g.Proxy)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	g.HTTPRetry.apply(&httpConfig)
+
+	output := sendRequest(input, request, httpConfig)
+	if output.HasError() {
+		return output
+	}
+	result, err := g.extract(output.Result().String())
+	if err != nil {
+		return models.NewRunOutputError(err).WithBytesTransferred(output.BytesTransferred())
+	}
+	return models.NewRunOutputCompleteWithResult(result).WithBytesTransferred(output.BytesTransferred())
+}
+
+func (g *GraphQL) requestBody(data models.JSON) ([]byte, error) {
+	names, err := g.variableNames()
+	if err != nil {
+		return nil, err
+	}
+	variables := make(map[string]interface{})
+	for _, name := range names {
+		value := g.Variables.Get(name)
+		if !value.Exists() {
+			value = data.Get(name)
+		}
+		if value.Exists() {
+			variables[name] = json.RawMessage(value.Raw)
+		}
+	}
+	return json.Marshal(map[string]interface{}{
+		"query":     g.Query,
+		"variables": variables,
+	})
+}
+
+// extract returns the value at the path in the data of the response, or the
+// errors the response lists.
+func (g *GraphQL) extract(body string) (interface{}, error) {
+	if !gjson.Valid(body) {
+		return nil, fmt.Errorf("invalid GraphQL response: %s", body)
+	}
+	response := gjson.Parse(body)
+	if errs := response.Get("errors").Array(); len(errs) > 0 {
+		messages := make([]string, len(errs))
+		for i, e := range errs {
+			messages[i] = e.Get("message").String()
+		}
+		return nil, fmt.Errorf("GraphQL errors: %s", strings.Join(messages, "; "))
+	}
+	data := response.Get("data")
+	if !data.Exists() || data.Type == gjson.Null {
+		return nil, errors.New("GraphQL response has no data")
+	}
+
+	js, err := simplejson.NewJson([]byte(data.Raw))
+	if err != nil {
+		return nil, err
+	}
+	value, err := dig(js, g.Path)
+	if err != nil {
+		return nil, err
+	}
+	return value.Interface(), nil
+}
+
+// variableNames scans the query for its operation type and the variables it
+// defines, skipping over strings, comments and selection sets.
+func (g *GraphQL) variableNames() ([]string, error) {
+	if strings.TrimSpace(g.Query) == "" {
+		return nil, errors.New("query not specified")
+	}
+	var names []string
+	query := []rune(g.Query)
+	depth := 0
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			for i++; i < len(query) && query[i] != '"'; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+		case depth > 0:
+		case c == '$':
+			start := i + 1
+			for i+1 < len(query) && isGraphQLNameRune(query[i+1]) {
+				i++
+			}
+			name := string(query[start : i+1])
+			j := i + 1
+			for j < len(query) && unicode.IsSpace(query[j]) {
+				j++
+			}
+			if j < len(query) && query[j] == ':' {
+				names = append(names, name)
+			}
+		case isGraphQLNameRune(c):
+			start := i
+			for i+1 < len(query) && isGraphQLNameRune(query[i+1]) {
+				i++
+			}
+			switch word := string(query[start : i+1]); word {
+			case "mutation", "subscription":
+				return nil, fmt.Errorf("GraphQL %s operations are not supported, only queries", word)
+			}
+		}
+	}
+	return names, nil
+}
+
+func isGraphQLNameRune(c rune) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
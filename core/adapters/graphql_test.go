@@ -0,0 +1,120 @@
+package adapters_test
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQL_Perform(t *testing.T) {
+	t.Parallel()
+
+	query := `query ($symbol: String!, $currency: String = "USD") {
+		ticker(symbol: $symbol, currency: $currency) { price }
+	}`
+	tests := []struct {
+		name          string
+		params        string
+		input         string
+		response      string
+		wantVariables string
+		want          string
+		wantErr       string
+	}{
+		{"variable from run data", `{"path": ["ticker", "price"]}`, `{"symbol": "ETH"}`,
+			`{"data": {"ticker": {"price": 600.25}}}`, `{"symbol":"ETH"}`, "600.25", ""},
+		{"variables from params", `{"path": "ticker.price", "variables": {"symbol": "BTC", "currency": "EUR"}}`, `{"symbol": "ETH"}`,
+			`{"data": {"ticker": {"price": 15000}}}`, `{"currency":"EUR","symbol":"BTC"}`, "15000", ""},
+		{"whole data", `{}`, `{"symbol": "ETH"}`,
+			`{"data": {"ticker": {"price": 600}}}`, `{"symbol":"ETH"}`, `{"ticker":{"price":600}}`, ""},
+		{"errors", `{"path": ["ticker", "price"]}`, `{"symbol": "XYZ"}`,
+			`{"data": null, "errors": [{"message": "unknown symbol"}, {"message": "try again"}]}`, `{"symbol":"XYZ"}`, "", "GraphQL errors: unknown symbol; try again"},
+		{"missing path", `{"path": ["ticker", "volume"]}`, `{"symbol": "ETH"}`,
+			`{"data": {"ticker": {"price": 600}}}`, `{"symbol":"ETH"}`, "", "No value could be found for the key 'volume'"},
+		{"not JSON", `{"path": ["ticker", "price"]}`, `{"symbol": "ETH"}`,
+			`<html></html>`, `{"symbol":"ETH"}`, "", "invalid GraphQL response: <html></html>"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received struct {
+				Query     string          `json:"query"`
+				Variables json.RawMessage `json:"variables"`
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.NoError(t, json.Unmarshal(body, &received))
+				io.WriteString(w, test.response)
+			}))
+			defer server.Close()
+
+			adapter := adapters.GraphQL{}
+			require.NoError(t, json.Unmarshal([]byte(test.params), &adapter))
+			adapter.URL = cltest.WebURL(t, server.URL)
+			adapter.Query = query
+
+			store := leanStore()
+			store.Config.Set("DEFAULT_HTTP_ALLOW_UNRESTRICTED_NETWORK_ACCESS", true)
+			result := adapter.Perform(cltest.NewRunInputWithString(t, test.input), store)
+
+			assert.Equal(t, query, received.Query)
+			assert.JSONEq(t, test.wantVariables, string(received.Variables))
+			if test.wantErr != "" {
+				require.Error(t, result.Error())
+				assert.Equal(t, test.wantErr, result.Error().Error())
+				return
+			}
+			require.NoError(t, result.Error())
+			if result.Result().IsObject() {
+				assert.JSONEq(t, test.want, result.Result().Raw)
+			} else {
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
+}
+
+func TestGraphQL_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		query   string
+		wantErr string
+	}{
+		{"shorthand", `{ ticker(symbol: "ETH") { price } }`, ""},
+		{"named query", `query Price($s: String) { ticker(symbol: $s) { price } }`, ""},
+		{"field named mutation", `{ mutation { id } }`, ""},
+		{"keyword in a string", `# mutation
+			query { search(text: "mutation") { id } }`, ""},
+		{"empty", ` `, "query not specified"},
+		{"mutation", `mutation { setPrice(price: 1) { id } }`, "GraphQL mutation operations are not supported, only queries"},
+		{"subscription", `subscription { ticker { price } }`, "GraphQL subscription operations are not supported, only queries"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := (&adapters.GraphQL{Query: test.query}).Validate()
+			if test.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
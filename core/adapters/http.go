@@ -3,14 +3,21 @@ package adapters
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -25,6 +32,16 @@ type HTTPGet struct {
 	QueryParams                    QueryParameters `json:"queryParams"`
 	ExtendedPath                   ExtendedPath    `json:"extPath"`
 	AllowUnrestrictedNetworkAccess bool            `json:"-"`
+	HTTPRetry
+	HTTPResponseOptions
+	// CacheTTL is how long the response is reused for, if it is cached
+	CacheTTL models.Duration `json:"cacheTTL,omitempty"`
+	// ClientCertificate names the certificate presented to servers which
+	// require mutual TLS
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	// Proxy is the URL of the HTTP or SOCKS5 proxy the request is sent
+	// through, instead of directly
+	Proxy string `json:"proxy,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -41,7 +58,20 @@ func (hga *HTTPGet) Perform(input models.RunInput, store *store.Store) models.Ru
 	}
 	httpConfig := defaultHTTPConfig(store.Config)
 	httpConfig.AllowUnrestrictedNetworkAccess = hga.AllowUnrestrictedNetworkAccess
-	return sendRequest(input, request, httpConfig)
+	httpConfig.RateLimiter = store.HTTPRateLimiter
+	httpConfig.TLSConfig, err = clientTLSConfig(store, hga.ClientCertificate)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	httpConfig.Proxy, err = utils.ParseProxyURL(hga.Proxy)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	hga.HTTPRetry.apply(&httpConfig)
+	if err = hga.HTTPResponseOptions.apply(&httpConfig, store.Config); err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return sendCachedRequest(input, request, httpConfig, store.HTTPCache, hga.CacheTTL.Duration())
 }
 
 // GetURL retrieves the GET field if set otherwise returns the URL field
@@ -73,6 +103,16 @@ type HTTPPost struct {
 	Body                           *string         `json:"body,omitempty"`
 	ExtendedPath                   ExtendedPath    `json:"extPath"`
 	AllowUnrestrictedNetworkAccess bool            `json:"-"`
+	HTTPRetry
+	HTTPResponseOptions
+	// CacheTTL is how long the response is reused for, if it is cached
+	CacheTTL models.Duration `json:"cacheTTL,omitempty"`
+	// ClientCertificate names the certificate presented to servers which
+	// require mutual TLS
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	// Proxy is the URL of the HTTP or SOCKS5 proxy the request is sent
+	// through, instead of directly
+	Proxy string `json:"proxy,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -89,7 +129,20 @@ func (hpa *HTTPPost) Perform(input models.RunInput, store *store.Store) models.R
 	}
 	httpConfig := defaultHTTPConfig(store.Config)
 	httpConfig.AllowUnrestrictedNetworkAccess = hpa.AllowUnrestrictedNetworkAccess
-	return sendRequest(input, request, httpConfig)
+	httpConfig.RateLimiter = store.HTTPRateLimiter
+	httpConfig.TLSConfig, err = clientTLSConfig(store, hpa.ClientCertificate)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	httpConfig.Proxy, err = utils.ParseProxyURL(hpa.Proxy)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	hpa.HTTPRetry.apply(&httpConfig)
+	if err = hpa.HTTPResponseOptions.apply(&httpConfig, store.Config); err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return sendCachedRequest(input, request, httpConfig, store.HTTPCache, hpa.CacheTTL.Duration())
 }
 
 // GetURL retrieves the POST field if set otherwise returns the URL field
@@ -120,6 +173,63 @@ func (hpa *HTTPPost) GetRequest(body string) (*http.Request, error) {
 	return request, nil
 }
 
+// HTTPRetry holds the retry settings of the HTTP adapters. Requests failing
+// with a transport error or a retried status code are sent again after a
+// backoff which doubles each time, until they have been attempted Attempts
+// times.
+type HTTPRetry struct {
+	// Attempts overrides DEFAULT_MAX_HTTP_ATTEMPTS
+	Attempts uint `json:"attempts,omitempty"`
+	// Backoff is the delay before the first retry
+	Backoff models.Duration `json:"backoff,omitempty"`
+	// RetryOn lists the status codes to retry, instead of any 5xx
+	RetryOn []int `json:"retryOn,omitempty"`
+	// taskTimeout is the timeout of the task, which overrides
+	// DEFAULT_HTTP_TIMEOUT for each attempt
+	taskTimeout time.Duration
+}
+
+func (r *HTTPRetry) setTaskTimeout(timeout time.Duration) {
+	r.taskTimeout = timeout
+}
+
+func (r HTTPRetry) apply(config *utils.HTTPRequestConfig) {
+	if r.Attempts > 0 {
+		config.MaxAttempts = r.Attempts
+	}
+	if r.taskTimeout > 0 {
+		config.Timeout = r.taskTimeout
+	}
+	config.Backoff = r.Backoff.Duration()
+	config.RetryStatusCodes = r.RetryOn
+}
+
+// HTTPResponseOptions hold the settings of the HTTP adapters for reading the
+// response.
+type HTTPResponseOptions struct {
+	// ResultPath, if set, is the path of the value in the JSON response which
+	// the result is set to. The response is decoded as it is read, so that
+	// only that value is held in memory.
+	ResultPath JSONPath `json:"resultPath,omitempty"`
+	// MaxResponseSize overrides DEFAULT_HTTP_LIMIT, up to
+	// HTTP_MAX_RESPONSE_SIZE
+	MaxResponseSize int64 `json:"maxResponseSize,omitempty"`
+}
+
+func (o HTTPResponseOptions) apply(config *utils.HTTPRequestConfig, nodeConfig orm.ConfigReader) error {
+	if o.MaxResponseSize < 0 {
+		return fmt.Errorf("maxResponseSize must not be negative, got %d", o.MaxResponseSize)
+	}
+	if o.MaxResponseSize > 0 {
+		if max := nodeConfig.HTTPMaxResponseSize(); o.MaxResponseSize > max {
+			return fmt.Errorf("maxResponseSize of %d bytes exceeds HTTP_MAX_RESPONSE_SIZE of %d bytes", o.MaxResponseSize, max)
+		}
+		config.SizeLimit = o.MaxResponseSize
+	}
+	config.ResponsePath = o.ResultPath
+	return nil
+}
+
 func appendExtendedPath(request *http.Request, extPath ExtendedPath) {
 	request.URL.Path = path.Join(append([]string{request.URL.Path}, []string(extPath)...)...)
 }
@@ -148,10 +258,64 @@ func setHeaders(request *http.Request, headers http.Header, contentType string)
 	}
 }
 
+// sendCachedRequest answers with the cached response to an identical request
+// if there is one, and caches successful responses for ttl. Nothing is cached
+// when ttl is 0.
+func sendCachedRequest(input models.RunInput, request *http.Request, config utils.HTTPRequestConfig, cache store.HTTPCache, ttl time.Duration) models.RunOutput {
+	if ttl <= 0 || cache == nil {
+		return sendRequest(input, request, config)
+	}
+	key, err := httpCacheKey(request, config.ResponsePath)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	if body, ok := cache.Get(key); ok {
+		return responseOutput(body, config)
+	}
+	output := sendRequest(input, request, config)
+	if output.Error() == nil {
+		if len(config.ResponsePath) > 0 {
+			cache.Set(key, []byte(output.Result().Raw), ttl)
+		} else {
+			cache.Set(key, []byte(output.Result().String()), ttl)
+		}
+	}
+	return output
+}
+
+// httpCacheKey identifies a request by its method, URL, headers and body, and
+// the path of the value taken from the response
+func httpCacheKey(request *http.Request, responsePath []string) (string, error) {
+	hash := sha256.New()
+	fmt.Fprintln(hash, request.Method, request.URL.String())
+	if len(responsePath) > 0 {
+		fmt.Fprintln(hash, responsePath)
+	}
+	names := make([]string, 0, len(request.Header))
+	for name := range request.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(hash, name, request.Header[name])
+	}
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer logger.ErrorIfCalling(body.Close)
+		if _, err := io.Copy(hash, body); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func sendRequest(input models.RunInput, request *http.Request, config utils.HTTPRequestConfig) models.RunOutput {
 	httpRequest := utils.HTTPRequest{
 		Request: request,
-		Config:  config,
+		Config:  limitResponseSize(config, input),
 	}
 
 	bytes, statusCode, err := httpRequest.SendRequest(context.TODO())
@@ -160,14 +324,42 @@ func sendRequest(input models.RunInput, request *http.Request, config utils.HTTP
 	}
 
 	responseBody := string(bytes)
+	transferred := int64(len(bytes))
+	if request.ContentLength > 0 {
+		transferred += request.ContentLength
+	}
 
 	// This is either a client error caused on our end or a server error that persists even after retrying.
 	// Either way, there is no way for us to complete the run with a result.
 	if statusCode >= 400 {
-		return models.NewRunOutputError(errors.New(responseBody))
+		return models.NewRunOutputError(errors.New(responseBody)).WithBytesTransferred(transferred)
+	}
+
+	return responseOutput(bytes, config).WithBytesTransferred(transferred)
+}
+
+// limitResponseSize lowers the size limit of the response to the data the
+// task's output may still hold within its run's budget, so that reading the
+// response stops as soon as the output is sure to be too large. With a result
+// path only the value extracted becomes the output, so the response is read
+// up to its usual limit and the budget is left to the run executor.
+func limitResponseSize(config utils.HTTPRequestConfig, input models.RunInput) utils.HTTPRequestConfig {
+	if len(config.ResponsePath) > 0 {
+		return config
+	}
+	if limit := input.DataLimit(); limit > 0 && (config.SizeLimit <= 0 || limit < config.SizeLimit) {
+		config.SizeLimit = limit
 	}
+	return config
+}
 
-	return models.NewRunOutputCompleteWithResult(responseBody)
+// responseOutput sets the result to the response body, or to the JSON value
+// taken from it if there is a response path.
+func responseOutput(body []byte, config utils.HTTPRequestConfig) models.RunOutput {
+	if len(config.ResponsePath) > 0 {
+		return models.NewRunOutputCompleteWithResult(json.RawMessage(body))
+	}
+	return models.NewRunOutputCompleteWithResult(string(body))
 }
 
 // QueryParameters are the keys and values to append to the URL
@@ -238,6 +430,15 @@ func (ep *ExtendedPath) UnmarshalJSON(input []byte) error {
 	return err
 }
 
+// clientTLSConfig returns the TLS configuration presenting the named client
+// certificate, or nil if none is named.
+func clientTLSConfig(store *store.Store, name string) (*tls.Config, error) {
+	if name == "" {
+		return nil, nil
+	}
+	return store.ClientCertificates.TLSConfig(name)
+}
+
 func defaultHTTPConfig(config orm.ConfigReader) utils.HTTPRequestConfig {
 	return utils.HTTPRequestConfig{
 		Timeout:                        config.DefaultHTTPTimeout().Duration(),
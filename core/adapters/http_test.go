@@ -2,11 +2,15 @@ package adapters_test
 
 import (
 	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -101,6 +105,7 @@ func TestHTTPGet_Perform(t *testing.T) {
 				require.NoError(t, result.Error())
 				assert.Equal(t, test.want, result.Result().String())
 			}
+			assert.Equal(t, int64(len(test.response)), result.BytesTransferred())
 			assert.Equal(t, false, result.Status().PendingBridge())
 		})
 	}
@@ -141,6 +146,40 @@ func TestHTTP_TooLarge(t *testing.T) {
 	}
 }
 
+func TestHTTP_TooLargeForRunDataLimit(t *testing.T) {
+	cfg := orm.NewConfig()
+	cfg.Set("DEFAULT_HTTP_LIMIT", "1024")
+	store := &store.Store{Config: cfg}
+
+	mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "GET", "0123456789")
+	defer cleanup()
+	hga := &adapters.HTTPGet{URL: cltest.WebURL(t, mock.URL), AllowUnrestrictedNetworkAccess: true}
+
+	result := hga.Perform(cltest.NewRunInputWithResult("inputValue").WithDataLimit(5), store)
+	require.Error(t, result.Error())
+	assert.Contains(t, result.Error().Error(), "HTTP response too large, must be less than 5 bytes")
+
+	result = hga.Perform(cltest.NewRunInputWithResult("inputValue").WithDataLimit(64), store)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "0123456789", result.Result().String())
+}
+
+func TestHTTP_RunDataLimitWithResultPath(t *testing.T) {
+	cfg := orm.NewConfig()
+	cfg.Set("DEFAULT_HTTP_LIMIT", "1024")
+	store := &store.Store{Config: cfg}
+
+	body := `{"padding":"` + strings.Repeat("x", 100) + `","price":"1.5"}`
+	mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "GET", body)
+	defer cleanup()
+	hga := &adapters.HTTPGet{URL: cltest.WebURL(t, mock.URL), AllowUnrestrictedNetworkAccess: true}
+	hga.ResultPath = adapters.JSONPath{"price"}
+
+	result := hga.Perform(cltest.NewRunInputWithResult("inputValue").WithDataLimit(16), store)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "1.5", result.Result().String())
+}
+
 func TestHTTP_PerformWithRestrictedIP(t *testing.T) {
 	cfg := orm.NewConfig()
 	store := &store.Store{Config: cfg}
@@ -827,10 +866,228 @@ func TestHTTP_RetryPolicy(t *testing.T) {
 			t.Fatalf("expected adapter to try %d times but got %d when the server is broken", expected, counter)
 		}
 	})
+	t.Run("retry the attempts of the task then give up", func(t *testing.T) {
+		t.Parallel()
+		var counter uint32 = 0
+		srv := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddUint32(&counter, 1)
+				w.WriteHeader(503)
+			}))
+		defer srv.Close()
+		hga := makeHTTPGetAdapter(t, srv)
+		hga.Attempts = 2
+		hga.Backoff = models.MustMakeDuration(time.Millisecond)
+		result := hga.Perform(input, str)
+		assert.Error(t, result.Error())
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&counter))
+	})
+	t.Run("retry only the status codes of the task", func(t *testing.T) {
+		t.Parallel()
+		var counter uint32 = 0
+		srv := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				switch atomic.AddUint32(&counter, 1) {
+				case 1:
+					w.WriteHeader(429)
+				case 2:
+					w.WriteHeader(500)
+				default:
+					w.WriteHeader(200)
+				}
+			}))
+		defer srv.Close()
+		hga := makeHTTPGetAdapter(t, srv)
+		hga.RetryOn = []int{429}
+		result := hga.Perform(input, str)
+		assert.Error(t, result.Error())
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&counter))
+	})
+	t.Run("send the body again when retrying a post", func(t *testing.T) {
+		t.Parallel()
+		var counter uint32 = 0
+		srv := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, `{"a":1}`, string(body))
+				if atomic.AddUint32(&counter, 1) == 1 {
+					w.WriteHeader(502)
+					return
+				}
+				w.WriteHeader(200)
+			}))
+		defer srv.Close()
+		body := `{"a":1}`
+		hpa := &adapters.HTTPPost{
+			URL:                            cltest.WebURL(t, srv.URL),
+			Body:                           &body,
+			AllowUnrestrictedNetworkAccess: true,
+		}
+		result := hpa.Perform(input, str)
+		assert.NoError(t, result.Error())
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&counter))
+	})
+}
+
+func TestHTTP_CacheTTL(t *testing.T) {
+	t.Parallel()
+	str := leanStore()
+	str.HTTPCache = store.NewMemoryHTTPCache()
+	input := cltest.NewRunInputWithResult("testCacheTTL")
+
+	var counter uint32
+	status := uint32(http.StatusOK)
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddUint32(&counter, 1)
+			w.WriteHeader(int(atomic.LoadUint32(&status)))
+			_, _ = w.Write([]byte(strconv.Itoa(int(n))))
+		}))
+	defer srv.Close()
+
+	hga := makeHTTPGetAdapter(t, srv)
+	hga.Attempts = 1
+	hga.CacheTTL = models.MustMakeDuration(time.Hour)
+
+	result := hga.Perform(input, str)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "1", result.Result().String())
+
+	result = hga.Perform(input, str)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "1", result.Result().String(), "expected the cached response")
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&counter))
+
+	hga.QueryParams = adapters.QueryParameters{"a": []string{"b"}}
+	result = hga.Perform(input, str)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "2", result.Result().String(), "expected another request for other params")
+
+	hga.QueryParams = adapters.QueryParameters{"c": []string{"d"}}
+	atomic.StoreUint32(&status, http.StatusTooManyRequests)
+	result = hga.Perform(input, str)
+	require.Error(t, result.Error())
+	atomic.StoreUint32(&status, http.StatusOK)
+	result = hga.Perform(input, str)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "4", result.Result().String(), "expected errors not to be cached")
+
+	hga.CacheTTL = models.Duration{}
+	hga.QueryParams = nil
+	result = hga.Perform(input, str)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "5", result.Result().String(), "expected no caching without a cacheTTL")
+}
+
+func TestHTTP_Proxy(t *testing.T) {
+	t.Parallel()
+
+	var requested string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.String()
+		_, _ = w.Write([]byte("proxied"))
+	}))
+	defer proxy.Close()
+
+	input := cltest.NewRunInputWithResult("inputValue")
+	hga := adapters.HTTPGet{
+		URL:                            cltest.WebURL(t, "http://api.example.com/price"),
+		Proxy:                          proxy.URL,
+		AllowUnrestrictedNetworkAccess: true,
+	}
+	result := hga.Perform(input, leanStore())
+	require.NoError(t, result.Error())
+	assert.Equal(t, "proxied", result.Result().String())
+	assert.Equal(t, "http://api.example.com/price", requested)
+
+	hga.Proxy = "ftp://" + proxy.Listener.Addr().String()
+	result = hga.Perform(input, leanStore())
+	require.Error(t, result.Error())
+}
+
+func TestHTTP_ResponseOptions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"padding": "`)
+		w.Write(fillBlob(2048))
+		io.WriteString(w, `", "data": {"prices": [1.5, {"last": 600.25}]}}`)
+	}))
+	defer server.Close()
+
+	store := leanStore()
+	store.Config.Set("DEFAULT_HTTP_LIMIT", 1024)
+	store.Config.Set("HTTP_MAX_RESPONSE_SIZE", 4096)
+	input := cltest.NewRunInputWithResult("inputValue")
+
+	tests := []struct {
+		name            string
+		resultPath      adapters.JSONPath
+		maxResponseSize int64
+		want            string
+		wantErr         bool
+	}{
+		{"over the default limit", nil, 0, "", true},
+		{"raised limit", nil, 4096, "", false},
+		{"above the node's maximum", nil, 8192, "", true},
+		{"result path", adapters.JSONPath{"data", "prices", "1", "last"}, 4096, "600.25", false},
+		{"object at result path", adapters.JSONPath{"data", "prices"}, 4096, `[1.5,{"last":600.25}]`, false},
+		{"missing last key", adapters.JSONPath{"data", "volume"}, 4096, "", false},
+		{"missing key", adapters.JSONPath{"meta", "volume"}, 4096, "", true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			hga := makeHTTPGetAdapter(t, server)
+			hga.ResultPath = test.resultPath
+			hga.MaxResponseSize = test.maxResponseSize
+
+			result := hga.Perform(input, store)
+			if test.wantErr {
+				require.Error(t, result.Error())
+				return
+			}
+			require.NoError(t, result.Error())
+			if result.Result().IsArray() {
+				assert.JSONEq(t, test.want, result.Result().Raw)
+			} else if test.resultPath != nil {
+				assert.Equal(t, test.want, result.Result().String())
+			}
+		})
+	}
 }
 
 // Helpers
 
+func TestHTTP_TaskTimeout(t *testing.T) {
+	t.Parallel()
+	str := leanStore()
+	input := cltest.NewRunInputWithResult("testTaskTimeout")
+
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(500 * time.Millisecond)
+			w.WriteHeader(200)
+		}))
+	defer srv.Close()
+
+	timeout := models.MustMakeDuration(50 * time.Millisecond)
+	task := models.TaskSpec{
+		Type:    adapters.TaskTypeHTTPGet,
+		Params:  cltest.JSONFromString(t, `{"get": "`+srv.URL+`", "attempts": 1}`),
+		Timeout: &timeout,
+	}
+	adapter, err := adapters.For(task, str.Config, nil)
+	require.NoError(t, err)
+	hga := adapter.BaseAdapter.(*adapters.HTTPGet)
+	hga.AllowUnrestrictedNetworkAccess = true
+
+	result := hga.Perform(input, str)
+	require.True(t, result.HasError())
+	assert.True(t, models.ErrorIsOfClass(result.Error(), models.RetryOnTimeout), result.Error().Error())
+}
+
 func makeHTTPGetAdapter(t *testing.T, server *httptest.Server) *adapters.HTTPGet {
 	return &adapters.HTTPGet{
 		URL:                            cltest.WebURL(t, server.URL),
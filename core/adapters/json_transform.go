@@ -0,0 +1,141 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/itchyny/gojq"
+	gjson "github.com/tidwall/gjson"
+)
+
+// jsonTransformTimeout is how long an expression may run before it is
+// abandoned, so that one which never ends, such as repeat(.), cannot hold a
+// run worker.
+const jsonTransformTimeout = 5 * time.Second
+
+// JSONTransform reshapes the result with a jq expression, such as to rename
+// the fields of a provider's response, build an object from several of them
+// or do arithmetic on them, without an external adapter.
+//
+// Expressions are evaluated by gojq, which implements the jq language, except
+// that input and inputs and importing modules are not allowed, debug and
+// stderr print nothing, and env and $ENV are empty so that the node's
+// environment is not exposed. As in jq, integers are exact however large,
+// while other numbers are 64 bit floats.
+type JSONTransform struct {
+	Expression string `json:"expression"`
+}
+
+// TaskType returns the type of Adapter.
+func (jta *JSONTransform) TaskType() models.TaskType {
+	return TaskTypeJSONTransform
+}
+
+// Validate checks that the expression is valid jq.
+func (jta *JSONTransform) Validate() error {
+	_, err := jta.compile()
+	return err
+}
+
+func (jta *JSONTransform) compile() (*gojq.Code, error) {
+	if jta.Expression == "" {
+		return nil, errors.New("expression not specified")
+	}
+	query, err := gojq.Parse(jta.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %s: %v", jta.Expression, err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %s: %v", jta.Expression, err)
+	}
+	return code, nil
+}
+
+// Perform evaluates the expression against the result, which may be a JSON
+// document held as a string, such as an HTTPGet response, and sets the
+// result to the single value it outputs.
+//
+// For example, with the result
+//
+//	{"data": {"bid": "100.5", "ask": "101.5", "ts": 1606000000}}
+//
+// the expression
+//
+//	.data | {price: ((.bid | tonumber) + (.ask | tonumber)) / 2, time: .ts}
+//
+// sets the result to {"price": 101, "time": 1606000000}.
+func (jta *JSONTransform) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	code, err := jta.compile()
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	result := input.Result()
+	if !result.Exists() {
+		return models.NewRunOutputError(errors.New("no result to transform"))
+	}
+	document := []byte(result.Raw)
+	if result.Type == gjson.String && json.Valid([]byte(result.Str)) {
+		document = []byte(result.Str)
+	}
+
+	output, err := jta.run(code, document)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	return models.NewRunOutputCompleteWithResult(output)
+}
+
+// run evaluates code against the document, returning its one output. It
+// stops at a second output, as the expression may output any number of
+// them.
+func (jta *JSONTransform) run(code *gojq.Code, document []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jsonTransformTimeout)
+	defer cancel()
+
+	var outputs []interface{}
+	iter := code.RunWithContext(ctx, value)
+	for len(outputs) < 2 {
+		output, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if _, ok := output.([2]interface{}); ok {
+			// The message of debug or stderr
+			continue
+		}
+		if err, ok := output.(error); ok {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, fmt.Errorf("evaluating %s: did not finish within %s", jta.Expression, jsonTransformTimeout)
+			}
+			return nil, fmt.Errorf("evaluating %s: %v", jta.Expression, err)
+		}
+		outputs = append(outputs, output)
+	}
+	if len(outputs) != 1 {
+		return nil, fmt.Errorf("expression %s must output 1 value, not %s, several can be collected into an array with [...]", jta.Expression, outputCount(len(outputs)))
+	}
+	return outputs[0], nil
+}
+
+func outputCount(n int) string {
+	if n == 0 {
+		return "none"
+	}
+	return "several"
+}
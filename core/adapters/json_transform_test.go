@@ -0,0 +1,117 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONTransform_Perform(t *testing.T) {
+	t.Parallel()
+
+	response := `{"data": {"bid": "100.5", "ask": "101.5", "ts": 1606000000,` +
+		` "trades": [{"price": 1.1, "size": 2}, {"price": 1.3, "size": 1}, {"price": 0.9, "size": 4}]}}`
+
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+	}{
+		{"identity", `.`, `{"data":{"ask":"101.5","bid":"100.5","trades":[{"price":1.1,"size":2},{"price":1.3,"size":1},{"price":0.9,"size":4}],"ts":1606000000}}`},
+		{"path", `.data.ts`, `1606000000`},
+		{"quoted field", `.data."bid"`, `"100.5"`},
+		{"array index", `.data.trades[1].price`, `1.3`},
+		{"negative index", `.data.trades[-1].price`, `0.9`},
+		{"missing field", `.data.missing`, `null`},
+		{"rename keys", `.data | {buy: .bid, sell: .ask}`, `{"buy":"100.5","sell":"101.5"}`},
+		{"shorthand keys", `.data | {bid, ts}`, `{"bid":"100.5","ts":1606000000}`},
+		{"computed keys", `.data | {(.bid): .ask}`, `{"100.5":"101.5"}`},
+		{"math", `.data | ((.bid | tonumber) + (.ask | tonumber)) / 2`, `101`},
+		{"big integers", `.data.ts * 100000000000000`, `160600000000000000000000`},
+		{"precedence", `1 + 2 * 3 - -4`, `11`},
+		{"modulo", `.data.ts % 7`, `3`},
+		{"collect", `[.data.trades[].price]`, `[1.1,1.3,0.9]`},
+		{"map", `.data.trades | map(.price * .size)`, `[2.2,1.3,3.6]`},
+		{"select", `[.data.trades[] | select(.size >= 2) | .price]`, `[1.1,0.9]`},
+		{"add", `.data.trades | map(.size) | add`, `7`},
+		{"min and max", `.data.trades | map(.price) | [min, max]`, `[0.9,1.3]`},
+		{"sort", `.data.trades | map(.price) | sort`, `[0.9,1.1,1.3]`},
+		{"length", `[(.data.trades | length), (.data.bid | length)]`, `[3,5]`},
+		{"keys", `.data | keys`, `["ask","bid","trades","ts"]`},
+		{"has", `.data | [has("bid"), has("volume")]`, `[true,false]`},
+		{"rounding", `[1.5 | floor, ceil, round]`, `[1,2,2]`},
+		{"tostring", `.data.ts | tostring`, `"1606000000"`},
+		{"alternative", `.data.volume // .data.ts`, `1606000000`},
+		{"optional", `[.data.bid.price?]`, `[]`},
+		{"conditional", `if .data.trades[0].price > 1 then "up" elif .data.trades[0].price < 1 then "down" else "flat" end`, `"up"`},
+		{"boolean logic", `[(true and false), (true or false), (null | not)]`, `[false,true,true]`},
+		{"comparison", `[1 < "a", "a" == "a", [1, 2] < [1, 3], null != false]`, `[true,true,true,true]`},
+		{"string concatenation", `.data.bid + "/" + .data.ask`, `"100.5/101.5"`},
+		{"object merge", `{a: 1} + {b: 2}`, `{"a":1,"b":2}`},
+		{"array difference", `[1, 2, 3, 2] - [2]`, `[1,3]`},
+		{"slice", `.data.trades[1:] | map(.size)`, `[1,4]`},
+		{"variable", `.data as $d | $d.trades | map(.size * ($d.ts % 7))`, `[6,3,12]`},
+		{"reduce", `reduce .data.trades[] as $t (0; . + $t.size)`, `7`},
+		{"try", `try (.data.bid | tonumber) catch 0`, `100.5`},
+		{"catch", `try error("unavailable") catch .`, `"unavailable"`},
+		{"recursive descent", `[.. | numbers] | length`, `7`},
+		{"debug", `.data.ts | debug`, `1606000000`},
+		{"no environment", `env`, `{}`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			adapter := adapters.JSONTransform{Expression: test.expression}
+			input := cltest.NewRunInputWithResult(response)
+			result := adapter.Perform(input, nil)
+			require.NoError(t, result.Error())
+			assert.JSONEq(t, test.want, result.Result().Raw)
+		})
+	}
+}
+
+func TestJSONTransform_Perform_JSONResult(t *testing.T) {
+	t.Parallel()
+
+	adapter := adapters.JSONTransform{Expression: `.price * 100`}
+	input := cltest.NewRunInputWithString(t, `{"result": {"price": 1.23}}`)
+	result := adapter.Perform(input, nil)
+	require.NoError(t, result.Error())
+	assert.Equal(t, "123", result.Result().String())
+}
+
+func TestJSONTransform_Perform_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"missing expression", ``},
+		{"syntax error", `.data | {`},
+		{"unknown function", `.data | frobnicate`},
+		{"unterminated string", `.data."bid`},
+		{"multiple outputs", `.data.trades[]`},
+		{"no outputs", `.data.trades[] | select(.size > 10)`},
+		{"indexing a string", `.data.bid.price`},
+		{"division by zero", `.data.ts / 0`},
+		{"adding mismatched types", `.data.ts + "s"`},
+		{"reading inputs", `[inputs]`},
+		{"importing modules", `import "a" as a; .`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			adapter := adapters.JSONTransform{Expression: test.expression}
+			input := cltest.NewRunInputWithResult(`{"data": {"bid": "100.5", "ts": 1606000000, "trades": [{"size": 1}, {"size": 2}]}}`)
+			result := adapter.Perform(input, nil)
+			require.Error(t, result.Error())
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 // Multiply holds the a number to multiply the given value by.
 type Multiply struct {
 	Times *decimal.Decimal `json:"times,omitempty"`
+	Rounding
 }
 
 // TaskType returns the type of Adapter.
@@ -18,18 +19,22 @@ func (m *Multiply) TaskType() models.TaskType {
 }
 
 // Perform returns the input's "result" field, multiplied times the adapter's
-// "times" field.
+// "times" field. The product is exact, unless a precision is given to round
+// it to.
 //
 // For example, if input value is "99.994" and the adapter's "times" is
 // set to "100", the result's value will be "9999.4".
 func (m *Multiply) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
-	val := input.Result()
-	dec, err := decimal.NewFromString(val.String())
+	dec, err := resultDecimal(input)
 	if err != nil {
-		return models.NewRunOutputError(errors.Wrapf(err, "cannot parse into big.Float: %v", val.String()))
+		return models.NewRunOutputError(errors.Wrapf(err, "cannot parse into big.Float: %v", input.Result().String()))
 	}
 	if m.Times != nil {
 		dec = dec.Mul(*m.Times)
 	}
+	dec, err = m.round(dec)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
 	return models.NewRunOutputCompleteWithResult(dec.String())
 }
@@ -145,3 +145,32 @@ func TestMultiply_Perform_Failure(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiply_Perform_Precision(t *testing.T) {
+	precision := func(p int32) *int32 { return &p }
+
+	tests := []struct {
+		name    string
+		adapter adapters.Multiply
+		json    string
+		want    string
+	}{
+		{"keeps long decimals", adapters.Multiply{Times: mustDecimal(t, "1000000000000000000")}, `{"result":1.234567890123456789}`, "1234567890123456789"},
+		{"large times", adapters.Multiply{Times: mustDecimal(t, "100000000000000000000000000000")}, `{"result":"1.5"}`, "150000000000000000000000000000"},
+		{"rounds half up", adapters.Multiply{Times: mustDecimal(t, "1"), Rounding: adapters.Rounding{Precision: precision(2)}}, `{"result":"1.005"}`, "1.01"},
+		{"rounds half even", adapters.Multiply{Times: mustDecimal(t, "1"), Rounding: adapters.Rounding{Precision: precision(2), RoundingMode: "halfEven"}}, `{"result":"1.005"}`, "1"},
+		{"rounds down", adapters.Multiply{Times: mustDecimal(t, "3"), Rounding: adapters.Rounding{Precision: precision(0), RoundingMode: "down"}}, `{"result":"-1.5"}`, "-4"},
+		{"rounds to tens", adapters.Multiply{Times: mustDecimal(t, "10"), Rounding: adapters.Rounding{Precision: precision(-1)}}, `{"result":"1.25"}`, "10"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			input := cltest.NewRunInputWithString(t, test.json)
+			result := test.adapter.Perform(input, nil)
+
+			require.NoError(t, result.Error())
+			assert.Equal(t, test.want, result.Result().String())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// ResultCollect gathers the results of several earlier tasks into one, so
+// that a job can fetch a value from more than one source and aggregate them.
+type ResultCollect struct {
+	// Results is an array or object of values, which are usually task
+	// variables referring to the outputs of named tasks
+	Results models.JSON `json:"results"`
+}
+
+// TaskType returns the type of Adapter.
+func (rc *ResultCollect) TaskType() models.TaskType {
+	return TaskTypeResultCollect
+}
+
+// Perform sets the result to the array or object of results, once their task
+// variables have been replaced with the outputs they refer to.
+//
+// For example, after tasks named fetchA and fetchB, the results
+// ["$(fetchA)", "$(fetchB)"] set the result to an array of theirs, which a
+// Median task can then reduce to one.
+func (rc *ResultCollect) Perform(input models.RunInput, _ *store.Store) models.RunOutput {
+	if !rc.Results.IsArray() && !rc.Results.IsObject() {
+		return models.NewRunOutputError(errors.New("results must be an array or an object"))
+	}
+	return models.NewRunOutputCompleteWithResult(json.RawMessage(rc.Results.Raw))
+}
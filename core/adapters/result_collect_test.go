@@ -0,0 +1,44 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultCollect_Perform(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		results string
+		want    string
+		wantErr bool
+	}{
+		{"array", `["100.5", 101, {"price": 99}]`, `["100.5", 101, {"price": 99}]`, false},
+		{"object", `{"a": "100.5", "b": 101}`, `{"a": "100.5", "b": 101}`, false},
+		{"empty array", `[]`, `[]`, false},
+		{"string", `"100.5"`, ``, true},
+		{"missing", ``, ``, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			adapter := adapters.ResultCollect{}
+			if test.results != "" {
+				adapter.Results = cltest.JSONFromString(t, test.results)
+			}
+			result := adapter.Perform(cltest.NewRunInputWithResult("0"), nil)
+			if test.wantErr {
+				assert.Error(t, result.Error())
+				return
+			}
+			require.NoError(t, result.Error())
+			assert.JSONEq(t, test.want, result.Result().Raw)
+		})
+	}
+}
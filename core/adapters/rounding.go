@@ -0,0 +1,116 @@
+package adapters
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/shopspring/decimal"
+	"github.com/tidwall/gjson"
+)
+
+// The rounding modes of the Multiply and Divide adapters
+const (
+	// RoundHalfUp rounds to the nearest value, and halfway values away from
+	// zero
+	RoundHalfUp = "halfUp"
+	// RoundHalfDown rounds to the nearest value, and halfway values towards
+	// zero
+	RoundHalfDown = "halfDown"
+	// RoundHalfEven rounds to the nearest value, and halfway values to the
+	// even neighbour
+	RoundHalfEven = "halfEven"
+	// RoundUp rounds away from zero
+	RoundUp = "up"
+	// RoundDown rounds towards zero, truncating
+	RoundDown = "down"
+	// RoundCeiling rounds towards positive infinity
+	RoundCeiling = "ceiling"
+	// RoundFloor rounds towards negative infinity
+	RoundFloor = "floor"
+)
+
+// defaultDividePrecision is the number of decimal places Divide keeps when
+// no precision is given, which is enough for amounts of ether in wei.
+const defaultDividePrecision = 18
+
+// Rounding holds the rounding parameters of the Multiply and Divide
+// adapters.
+type Rounding struct {
+	// Precision is the number of decimal places kept in the result, which
+	// may be negative to round to tens, hundreds and so on
+	Precision *int32 `json:"precision,omitempty"`
+	// RoundingMode is how results are rounded to Precision, which is
+	// halfUp if unset
+	RoundingMode string `json:"roundingMode,omitempty"`
+}
+
+// quo returns a / b rounded to places decimal places.
+func (r Rounding) quo(a, b decimal.Decimal, places int32) (decimal.Decimal, error) {
+	if b.IsZero() {
+		return decimal.Decimal{}, fmt.Errorf("cannot divide by zero")
+	}
+	q, rem := a.QuoRem(b, places)
+	if rem.IsZero() {
+		return q, nil
+	}
+
+	// The quotient has been truncated towards zero, so the alternative is the
+	// value one unit further away from it
+	unit := decimal.New(1, -places)
+	negative := a.Sign()*b.Sign() < 0
+	away := q.Add(unit)
+	if negative {
+		away = q.Sub(unit)
+	}
+	// Compare the remainder with half of the divisor, in units of the last
+	// place kept
+	half := rem.Abs().Shift(places).Mul(decimal.NewFromInt(2)).Cmp(b.Abs())
+
+	switch r.RoundingMode {
+	case "", RoundHalfUp:
+		if half >= 0 {
+			return away, nil
+		}
+	case RoundHalfDown:
+		if half > 0 {
+			return away, nil
+		}
+	case RoundHalfEven:
+		if half > 0 || (half == 0 && !q.Shift(places).Mod(decimal.NewFromInt(2)).IsZero()) {
+			return away, nil
+		}
+	case RoundUp:
+		return away, nil
+	case RoundDown:
+	case RoundCeiling:
+		if !negative {
+			return away, nil
+		}
+	case RoundFloor:
+		if negative {
+			return away, nil
+		}
+	default:
+		return decimal.Decimal{}, fmt.Errorf("unknown rounding mode %s", r.RoundingMode)
+	}
+	return q, nil
+}
+
+// round returns d rounded to Precision, or d itself if no precision is given.
+func (r Rounding) round(d decimal.Decimal) (decimal.Decimal, error) {
+	if r.Precision == nil {
+		return d, nil
+	}
+	return r.quo(d, decimal.NewFromInt(1), *r.Precision)
+}
+
+// resultDecimal parses the result as a decimal. Numbers are read from the
+// raw JSON rather than as floats, so that none of their digits are lost.
+func resultDecimal(input models.RunInput) (decimal.Decimal, error) {
+	val := input.Result()
+	if val.Type == gjson.Number {
+		return decimal.NewFromString(val.Raw)
+	}
+	return decimal.NewFromString(val.String())
+}
@@ -0,0 +1,124 @@
+package adapters
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignedWebhookHMAC signs webhooks with an HMAC-SHA256 of the body,
+	// keyed with a secret shared with the receiver.
+	SignedWebhookHMAC = "hmac"
+	// SignedWebhookEth signs webhooks with the node's Ethereum key, which
+	// lets the receiver check them against the node's address alone.
+	SignedWebhookEth = "eth"
+
+	// SignedWebhookSignatureHeader holds the signature of the body
+	SignedWebhookSignatureHeader = "X-Chainlink-Signature"
+	// SignedWebhookSignerHeader holds the address of the key which signed
+	// the body, for Ethereum signatures
+	SignedWebhookSignerHeader = "X-Chainlink-Signer"
+)
+
+// SignedWebhook POSTs the run data to a URL, with a signature of the body in
+// the X-Chainlink-Signature header so that the receiver can verify that it
+// came from this node.
+type SignedWebhook struct {
+	URL     models.WebURL `json:"url"`
+	Headers http.Header   `json:"headers"`
+	// Signature is either "hmac" or "eth"
+	Signature string `json:"signature"`
+	// Secret is the HMAC key, which is required with the "hmac" signature
+	Secret string `json:"secret,omitempty"`
+	HTTPRetry
+}
+
+// signedWebhookBody is the body of a SignedWebhook request. The timestamp
+// lets receivers reject old requests replayed by a third party.
+type signedWebhookBody struct {
+	JobRunID  string      `json:"jobRunId"`
+	TaskRunID string      `json:"taskRunId"`
+	Timestamp int64       `json:"timestamp"`
+	Data      models.JSON `json:"data"`
+}
+
+// TaskType returns the type of Adapter.
+func (sw *SignedWebhook) TaskType() models.TaskType {
+	return TaskTypeSignedWebhook
+}
+
+// Perform POSTs the run data along with the IDs of the run and the task and
+// the current Unix time, and returns the response body as the result.
+//
+// With the "hmac" signature, X-Chainlink-Signature is the hex encoded
+// HMAC-SHA256 of the body keyed with the secret. With the "eth" signature, it
+// is the hex encoded signature of the keccak256 hash of the body, signed as
+// an Ethereum message by the node's account given in X-Chainlink-Signer.
+func (sw *SignedWebhook) Perform(input models.RunInput, store *store.Store) models.RunOutput {
+	taskRunID := input.TaskRunID()
+	body, err := json.Marshal(signedWebhookBody{
+		JobRunID:  input.JobRunID().String(),
+		TaskRunID: taskRunID.String(),
+		Timestamp: store.Clock.Now().Unix(),
+		Data:      input.Data(),
+	})
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	request, err := http.NewRequest("POST", sw.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	setHeaders(request, sw.Headers, "application/json")
+	if err := sw.sign(request, body, store); err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	httpConfig := defaultHTTPConfig(store.Config)
+	httpConfig.RateLimiter = store.HTTPRateLimiter
+	sw.HTTPRetry.apply(&httpConfig)
+	return sendRequest(input, request, httpConfig)
+}
+
+func (sw *SignedWebhook) sign(request *http.Request, body []byte, store *store.Store) error {
+	switch sw.Signature {
+	case SignedWebhookHMAC:
+		if sw.Secret == "" {
+			return errors.New("secret is required to sign with hmac")
+		}
+		mac := hmac.New(sha256.New, []byte(sw.Secret))
+		mac.Write(body)
+		request.Header.Set(SignedWebhookSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	case SignedWebhookEth:
+		account, err := store.KeyStore.GetFirstAccount()
+		if err != nil {
+			return err
+		}
+		hash, err := utils.Keccak256(body)
+		if err != nil {
+			return err
+		}
+		signature, err := store.KeyStore.SignHash(common.BytesToHash(hash))
+		if err != nil {
+			return errors.Wrap(err, "could not sign webhook")
+		}
+		request.Header.Set(SignedWebhookSignatureHeader, signature.Hex())
+		request.Header.Set(SignedWebhookSignerHeader, account.Address.Hex())
+	default:
+		return fmt.Errorf(`signature must be "%s" or "%s", got "%s"`, SignedWebhookHMAC, SignedWebhookEth, sw.Signature)
+	}
+	return nil
+}
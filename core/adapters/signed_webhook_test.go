@@ -0,0 +1,113 @@
+package adapters_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestSignedWebhook_Perform(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	require.NoError(t, store.KeyStore.Unlock(cltest.Password))
+
+	input := cltest.NewRunInputWithResult("100")
+
+	t.Run("signs with hmac", func(t *testing.T) {
+		mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"ok": true}`,
+			func(header http.Header, body string) {
+				mac := hmac.New(sha256.New, []byte("s3cr3t"))
+				mac.Write([]byte(body))
+				assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), header.Get(adapters.SignedWebhookSignatureHeader))
+				assert.Equal(t, "application/json", header.Get("Content-Type"))
+				assert.Equal(t, input.JobRunID().String(), gjson.Get(body, "jobRunId").String())
+				assert.Equal(t, "100", gjson.Get(body, "data.result").String())
+				assert.NotZero(t, gjson.Get(body, "timestamp").Int())
+			})
+		defer cleanup()
+
+		adapter := adapters.SignedWebhook{
+			URL:       cltest.WebURL(t, mock.URL),
+			Signature: "hmac",
+			Secret:    "s3cr3t",
+		}
+		result := adapter.Perform(input, store)
+		require.NoError(t, result.Error())
+		assert.JSONEq(t, `{"ok": true}`, result.Result().String())
+	})
+
+	t.Run("signs with the node's eth key", func(t *testing.T) {
+		account, err := store.KeyStore.GetFirstAccount()
+		require.NoError(t, err)
+
+		mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{}`,
+			func(header http.Header, body string) {
+				assert.Equal(t, account.Address.Hex(), header.Get(adapters.SignedWebhookSignerHeader))
+
+				signature, err := hexutil.Decode(header.Get(adapters.SignedWebhookSignatureHeader))
+				require.NoError(t, err)
+				hash, err := utils.Keccak256([]byte(body))
+				require.NoError(t, err)
+				prefixed, err := utils.Keccak256(append([]byte(strpkg.EthereumMessageHashPrefix), hash...))
+				require.NoError(t, err)
+				pub, err := crypto.SigToPub(prefixed, signature)
+				require.NoError(t, err)
+				assert.Equal(t, account.Address, crypto.PubkeyToAddress(*pub))
+			})
+		defer cleanup()
+
+		adapter := adapters.SignedWebhook{
+			URL:       cltest.WebURL(t, mock.URL),
+			Signature: "eth",
+		}
+		result := adapter.Perform(input, store)
+		require.NoError(t, result.Error())
+	})
+
+	t.Run("errors with an unknown signature", func(t *testing.T) {
+		adapter := adapters.SignedWebhook{
+			URL:       cltest.WebURL(t, "http://example.com"),
+			Signature: "rsa",
+		}
+		result := adapter.Perform(input, store)
+		require.Error(t, result.Error())
+	})
+
+	t.Run("errors without an hmac secret", func(t *testing.T) {
+		adapter := adapters.SignedWebhook{
+			URL:       cltest.WebURL(t, "http://example.com"),
+			Signature: "hmac",
+		}
+		result := adapter.Perform(input, store)
+		require.Error(t, result.Error())
+	})
+
+	t.Run("errors when the receiver rejects the webhook", func(t *testing.T) {
+		mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusUnauthorized, "POST", `bad signature`)
+		defer cleanup()
+
+		adapter := adapters.SignedWebhook{
+			URL:       cltest.WebURL(t, mock.URL),
+			Signature: "hmac",
+			Secret:    "wrong",
+		}
+		result := adapter.Perform(input, store)
+		require.Error(t, result.Error())
+		assert.Contains(t, result.Error().Error(), "bad signature")
+	})
+}
@@ -3,15 +3,16 @@ package adapters
 import (
 	"time"
 
-	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
-	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
-// Sleep adapter allows a job to do nothing for some amount of wall time.
+// Sleep adapter allows a job to do nothing for some amount of wall time,
+// either until an absolute time or for a duration from when it starts.
 type Sleep struct {
 	Until models.AnyTime `json:"until"`
+	// Duration is how long to sleep for if Until is not set
+	Duration models.Duration `json:"duration,omitempty"`
 }
 
 // TaskType returns the type of Adapter.
@@ -19,18 +20,21 @@ func (adapter *Sleep) TaskType() models.TaskType {
 	return TaskTypeSleep
 }
 
-// Perform returns the input RunResult after waiting for the specified Until parameter.
+// Perform returns the input data once the sleep is over. Rather than waiting
+// itself, it returns the time to wake up, which the run executor persists
+// before waiting for it, so that the sleep survives a restart of the node.
 func (adapter *Sleep) Perform(input models.RunInput, str *store.Store) models.RunOutput {
-	duration := adapter.Duration()
-	if duration > 0 {
-		logger.Debugw("Task sleeping...", "duration", duration)
-		<-str.Clock.After(duration)
+	wakeAt := adapter.WakeAt(str.Clock.Now())
+	if wakeAt.After(str.Clock.Now()) {
+		return models.NewRunOutputPendingSleep(input.Data(), wakeAt)
 	}
-
-	return models.NewRunOutputComplete(models.JSON{})
+	return models.NewRunOutputComplete(input.Data())
 }
 
-// Duration returns the amount of sleeping this task should be paused for.
-func (adapter *Sleep) Duration() time.Duration {
-	return utils.DurationFromNow(adapter.Until.Time)
+// WakeAt returns the time a sleep started at now is over.
+func (adapter *Sleep) WakeAt(now time.Time) time.Time {
+	if adapter.Until.Valid {
+		return adapter.Until.Time
+	}
+	return now.Add(adapter.Duration.Duration())
 }
@@ -3,6 +3,7 @@ package adapters_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -15,30 +16,32 @@ import (
 func TestSleep_Perform(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
-	clock := cltest.NewTriggerClock(t)
-	store.Clock = clock
 
 	adapter := adapters.Sleep{}
 	err := json.Unmarshal([]byte(`{"until": 2147483647}`), &adapter)
 	assert.NoError(t, err)
 
-	doneChan := make(chan struct{})
-	go func() {
-		result := adapter.Perform(models.RunInput{}, store)
-		assert.Equal(t, string(models.RunStatusCompleted), string(result.Status()))
-		doneChan <- struct{}{}
-	}()
+	input := cltest.NewRunInputWithResult("inputValue")
+	result := adapter.Perform(input, store)
+	require.NoError(t, result.Error())
+	assert.Equal(t, string(models.RunStatusPendingSleep), string(result.Status()))
+	assert.Equal(t, int64(2147483647), result.SleepUntil().Unix())
+	assert.Equal(t, "inputValue", result.Result().String())
+}
 
-	select {
-	case <-doneChan:
-		t.Error("Sleep adapter did not sleep")
-	default:
-	}
+func TestSleep_Perform_Duration(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
 
-	clock.Trigger()
+	adapter := adapters.Sleep{}
+	err := json.Unmarshal([]byte(`{"duration": "10m"}`), &adapter)
+	require.NoError(t, err)
 
-	_, ok := <-doneChan
-	assert.True(t, ok)
+	start := time.Now()
+	result := adapter.Perform(cltest.NewRunInputWithResult("inputValue"), store)
+	require.NoError(t, result.Error())
+	assert.Equal(t, string(models.RunStatusPendingSleep), string(result.Status()))
+	assert.WithinDuration(t, start.Add(10*time.Minute), result.SleepUntil(), time.Second)
 }
 
 func TestSleep_Perform_AlreadyElapsed(t *testing.T) {
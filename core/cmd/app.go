@@ -162,7 +162,7 @@ func NewApp(client *Client) *cli.App {
 				},
 				{
 					Name:   "create",
-					Usage:  "Create Job from a Job Specification JSON",
+					Usage:  "Create Job from a Job Specification JSON or TOML",
 					Action: client.CreateJobSpec,
 				},
 				{
@@ -181,6 +181,16 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show a specific Job's details",
 					Action: client.ShowJobSpec,
 				},
+				{
+					Name:   "pause",
+					Usage:  "Stop a Job's initiators from starting new Runs, keeping the Job and its Runs",
+					Action: client.PauseJobSpec,
+				},
+				{
+					Name:   "resume",
+					Usage:  "Restart the initiators of a paused Job",
+					Action: client.ResumeJobSpec,
+				},
 				{
 					Name:   "createocr",
 					Usage:  "Create an off-chain reporting job",
@@ -535,6 +545,11 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Cancel a Run with a specified ID",
 					Action: client.CancelJobRun,
 				},
+				{
+					Name:   "retry",
+					Usage:  "Resume an errored Run with a specified ID from the task it failed on",
+					Action: client.RetryJobRun,
+				},
 			},
 		},
 
@@ -563,6 +578,29 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "rebroadcast",
+					Usage:  "Re-sign and re-send the node's unconfirmed transactions at the given gas price, e.g. after transactions were dropped from the mempool",
+					Action: client.RebroadcastPendingTransactions,
+					Flags: []cli.Flag{
+						cli.UintFlag{
+							Name:  "beginning-nonce",
+							Usage: "rebroadcast unconfirmed transactions from this nonce onwards",
+						},
+						cli.StringFlag{
+							Name:  "gas-price",
+							Usage: "gas price (in Wei) to rebroadcast transactions at",
+						},
+						cli.Uint64Flag{
+							Name:  "gas-limit",
+							Usage: "OPTIONAL: gas limit to use for each transaction",
+						},
+						cli.StringFlag{
+							Name:  "address",
+							Usage: "OPTIONAL: only rebroadcast transactions sent from this address",
+						},
+					},
+				},
 			},
 		},
 	}...)
@@ -153,7 +153,7 @@ func createServer(handler *gin.Engine, port uint16) *http.Server {
 // HTTPClient encapsulates all methods used to interact with a chainlink node API.
 type HTTPClient interface {
 	Get(string, ...map[string]string) (*http.Response, error)
-	Post(string, io.Reader) (*http.Response, error)
+	Post(string, io.Reader, ...map[string]string) (*http.Response, error)
 	Put(string, io.Reader) (*http.Response, error)
 	Patch(string, io.Reader, ...map[string]string) (*http.Response, error)
 	Delete(string) (*http.Response, error)
@@ -181,8 +181,8 @@ func (h *authenticatedHTTPClient) Get(path string, headers ...map[string]string)
 }
 
 // Post performs an HTTP Post using the authenticated HTTP client's cookie.
-func (h *authenticatedHTTPClient) Post(path string, body io.Reader) (*http.Response, error) {
-	return h.doRequest("POST", path, body)
+func (h *authenticatedHTTPClient) Post(path string, body io.Reader, headers ...map[string]string) (*http.Response, error) {
+	return h.doRequest("POST", path, body, headers...)
 }
 
 // Put performs an HTTP Put using the authenticated HTTP client's cookie.
@@ -220,7 +220,7 @@ func (h *authenticatedHTTPClient) doRequest(verb, path string, body io.Reader, h
 
 	request.Header.Set("Content-Type", "application/json")
 	for key, value := range headers {
-		request.Header.Add(key, value)
+		request.Header.Set(key, value)
 	}
 	request.AddCookie(cookie)
 	return h.client.Do(request)
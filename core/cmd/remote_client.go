@@ -189,18 +189,18 @@ func (cli *Client) IndexJobSpecs(c *clipkg.Context) error {
 	return cli.getPage("/v2/specs", c.Int("page"), &[]models.JobSpec{})
 }
 
-// CreateJobSpec creates a JobSpec based on JSON input
+// CreateJobSpec creates a JobSpec based on JSON or TOML input
 func (cli *Client) CreateJobSpec(c *clipkg.Context) (err error) {
 	if !c.Args().Present() {
-		return cli.errorOut(errors.New("Must pass in JSON or filepath"))
+		return cli.errorOut(errors.New("Must pass in JSON, TOML or filepath"))
 	}
 
-	buf, err := getBufferFromJSON(c.Args().First())
+	buf, contentType, err := getJobSpecBuffer(c.Args().First())
 	if err != nil {
 		return cli.errorOut(err)
 	}
 
-	resp, err := cli.HTTP.Post("/v2/specs", buf)
+	resp, err := cli.HTTP.Post("/v2/specs", buf, map[string]string{"Content-Type": contentType})
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -284,6 +284,36 @@ func (cli *Client) ArchiveJobSpec(c *clipkg.Context) error {
 	return nil
 }
 
+// PauseJobSpec stops a job's initiators from starting new runs.
+func (cli *Client) PauseJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id to be paused"))
+	}
+	return cli.postJobSpecAction(c.Args().First(), "pause")
+}
+
+// ResumeJobSpec restarts the initiators of a paused job.
+func (cli *Client) ResumeJobSpec(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the job id to be resumed"))
+	}
+	return cli.postJobSpecAction(c.Args().First(), "resume")
+}
+
+func (cli *Client) postJobSpecAction(id, action string) (err error) {
+	resp, err := cli.HTTP.Post("/v2/specs/"+id+"/"+action, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	var job presenters.JobSpec
+	return cli.renderAPIResponse(resp, &job)
+}
+
 func (cli *Client) DeleteJobV2(c *clipkg.Context) error {
 	if !c.Args().Present() {
 		return cli.errorOut(errors.New("Must pass the job id to be archived"))
@@ -570,6 +600,47 @@ func (cli *Client) ShowTransaction(c *clipkg.Context) (err error) {
 	return err
 }
 
+// RebroadcastPendingTransactions asks the node to re-sign its unconfirmed
+// transactions from the given nonce onwards at a new gas price, and to send
+// them again
+func (cli *Client) RebroadcastPendingTransactions(c *clipkg.Context) (err error) {
+	gasPrice, ok := new(big.Int).SetString(c.String("gas-price"), 10)
+	if !ok {
+		return cli.errorOut(fmt.Errorf("invalid gas price: %q", c.String("gas-price")))
+	}
+	request := models.RebroadcastRequest{
+		BeginningNonce: c.Uint("beginning-nonce"),
+		GasPrice:       utils.NewBig(gasPrice),
+		GasLimit:       c.Uint64("gas-limit"),
+	}
+	if c.IsSet("address") {
+		address, err := utils.ParseEthereumAddress(c.String("address"))
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "while parsing address"))
+		}
+		request.Address = &address
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/transactions/rebroadcast", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var txs []presenters.EthTx
+	err = cli.renderAPIResponse(resp, &txs)
+	return err
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *clipkg.Context) error {
@@ -597,6 +668,38 @@ func getBufferFromJSON(s string) (*bytes.Buffer, error) {
 	return buf, nil
 }
 
+// getJobSpecBuffer returns a job spec given either directly or as the path of
+// a file, and the content type of the format it is written in.
+func getJobSpecBuffer(s string) (*bytes.Buffer, string, error) {
+	if gjson.Valid(s) {
+		return bytes.NewBufferString(s), "application/json", nil
+	}
+	if isTOMLTable(s) {
+		return bytes.NewBufferString(s), web.MediaTypeTOML, nil
+	}
+
+	buf, err := fromFile(s)
+	if os.IsNotExist(err) {
+		return nil, "", fmt.Errorf("invalid JSON or TOML, or file not found '%s'", s)
+	} else if err != nil {
+		return nil, "", fmt.Errorf("error reading from file '%s': %v", s, err)
+	}
+	if gjson.Valid(buf.String()) {
+		return buf, "application/json", nil
+	}
+	if isTOMLTable(buf.String()) {
+		return buf, web.MediaTypeTOML, nil
+	}
+	return nil, "", fmt.Errorf("file '%s' is neither valid JSON nor TOML", s)
+}
+
+// isTOMLTable returns true if s is a TOML document with at least one key, as
+// a job spec must have, rather than a file path.
+func isTOMLTable(s string) bool {
+	var val map[string]interface{}
+	return toml.Unmarshal([]byte(s), &val) == nil && len(val) > 0
+}
+
 func getTOMLString(s string) (string, error) {
 	var val interface{}
 	err := toml.Unmarshal([]byte(s), &val)
@@ -787,6 +890,25 @@ func (cli *Client) CancelJobRun(c *clipkg.Context) error {
 	return nil
 }
 
+// RetryJobRun resumes an errored run from the task it failed on.
+func (cli *Client) RetryJobRun(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the run id to be retried"))
+	}
+
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/runs/%s/retry", c.Args().First()), nil)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "HTTP.Post"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	var run presenters.JobRun
+	return cli.renderAPIResponse(resp, &run)
+}
+
 func (cli *Client) CreateP2PKey(c *clipkg.Context) (err error) {
 	resp, err := cli.HTTP.Post("/v2/p2p_keys", nil)
 	if err != nil {
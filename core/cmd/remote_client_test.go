@@ -394,6 +394,9 @@ func TestClient_CreateJobSpec(t *testing.T) {
 		{"web", `{"initiators":[{"type":"web"}],"tasks":[{"type":"NoOp"}]}`, 1, false},
 		{"runAt", `{"initiators":[{"type":"runAt","params":{"time":"3000-01-08T18:12:01.103Z"}}],"tasks":[{"type":"NoOp"}]}`, 2, false},
 		{"file", "../internal/fixtures/web/end_at_job.json", 3, false},
+		{"toml", "[[initiators]]\ntype = \"web\"\n[[tasks]]\ntype = \"NoOp\"", 4, false},
+		{"toml file", "../internal/fixtures/web/web_initiated_noop_job.toml", 5, false},
+		{"bad toml", "[[initiators]]\ntype = web", 5, true},
 	}
 
 	for _, test := range tests {
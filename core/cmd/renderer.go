@@ -273,7 +273,7 @@ func (rt RendererTable) renderJobInitiators(j presenters.JobSpec) error {
 		p := presenters.Initiator{Initiator: i}
 		table.Append([]string{
 			p.Type,
-			p.Schedule.String(),
+			p.FriendlySchedule(),
 			p.FriendlyRunAt(),
 			p.FriendlyAddress(),
 		})
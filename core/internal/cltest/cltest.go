@@ -642,8 +642,8 @@ func (r *HTTPClientCleaner) Get(path string, headers ...map[string]string) (*htt
 	return bodyCleaner(r.t, resp, err)
 }
 
-func (r *HTTPClientCleaner) Post(path string, body io.Reader) (*http.Response, func()) {
-	resp, err := r.HTTPClient.Post(path, body)
+func (r *HTTPClientCleaner) Post(path string, body io.Reader, headers ...map[string]string) (*http.Response, func()) {
+	resp, err := r.HTTPClient.Post(path, body, headers...)
 	return bodyCleaner(r.t, resp, err)
 }
 
@@ -851,14 +851,25 @@ func (*MockCron) Stop() context.Context {
 
 // AddFunc appends a schedule to mockcron entries
 func (mc *MockCron) AddFunc(schd string, fn func()) (cron.EntryID, error) {
+	mc.nextID++
 	mc.Entries = append(mc.Entries, MockCronEntry{
+		ID:       mc.nextID,
 		Schedule: schd,
 		Function: fn,
 	})
-	mc.nextID++
 	return mc.nextID, nil
 }
 
+// Remove removes the mockcron entry with the given ID
+func (mc *MockCron) Remove(id cron.EntryID) {
+	for i, entry := range mc.Entries {
+		if entry.ID == id {
+			mc.Entries = append(mc.Entries[:i], mc.Entries[i+1:]...)
+			return
+		}
+	}
+}
+
 // RunEntries run every function for each mockcron entry
 func (mc *MockCron) RunEntries() {
 	for _, entry := range mc.Entries {
@@ -868,6 +879,7 @@ func (mc *MockCron) RunEntries() {
 
 // MockCronEntry a cron schedule and function
 type MockCronEntry struct {
+	ID       cron.EntryID
 	Schedule string
 	Function func()
 }
@@ -5,9 +5,12 @@ package mocks
 import (
 	context "context"
 	big "math/big"
+	time "time"
 
 	packr "github.com/gobuffalo/packr"
+	fluxmonitor "github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
 	job "github.com/smartcontractkit/chainlink/core/services/job"
+	postgres "github.com/smartcontractkit/chainlink/core/services/postgres"
 	synchronization "github.com/smartcontractkit/chainlink/core/services/synchronization"
 	store "github.com/smartcontractkit/chainlink/core/store"
 	models "github.com/smartcontractkit/chainlink/core/store/models"
@@ -33,6 +36,20 @@ func (_m *Application) AddJob(_a0 models.JobSpec) error {
 	return r0
 }
 
+// AddJobBundle provides a mock function with given fields: job, bridges, externalInitiators
+func (_m *Application) AddJobBundle(job models.JobSpec, bridges []models.BridgeType, externalInitiators []models.ExternalInitiator) error {
+	ret := _m.Called(job, bridges, externalInitiators)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.JobSpec, []models.BridgeType, []models.ExternalInitiator) error); ok {
+		r0 = rf(job, bridges, externalInitiators)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 job.Spec) (int32, error) {
 	ret := _m.Called(ctx, _a1)
@@ -173,6 +190,52 @@ func (_m *Application) DeleteJobV2(ctx context.Context, jobID int32) error {
 	return r0
 }
 
+// ExpireAllPastDeadline provides a mock function with given fields:
+func (_m *Application) ExpireAllPastDeadline() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetEventBroadcaster provides a mock function with given fields:
+func (_m *Application) GetEventBroadcaster() postgres.EventBroadcaster {
+	ret := _m.Called()
+
+	var r0 postgres.EventBroadcaster
+	if rf, ok := ret.Get(0).(func() postgres.EventBroadcaster); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgres.EventBroadcaster)
+		}
+	}
+
+	return r0
+}
+
+// GetFluxMonitor provides a mock function with given fields:
+func (_m *Application) GetFluxMonitor() fluxmonitor.Service {
+	ret := _m.Called()
+
+	var r0 fluxmonitor.Service
+	if rf, ok := ret.Get(0).(func() fluxmonitor.Service); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(fluxmonitor.Service)
+		}
+	}
+
+	return r0
+}
+
 // GetStatsPusher provides a mock function with given fields:
 func (_m *Application) GetStatsPusher() synchronization.StatsPusher {
 	ret := _m.Called()
@@ -205,6 +268,27 @@ func (_m *Application) GetStore() *store.Store {
 	return r0
 }
 
+// MaintenanceSince provides a mock function with given fields:
+func (_m *Application) MaintenanceSince() (time.Time, bool) {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // NewBox provides a mock function with given fields:
 func (_m *Application) NewBox() packr.Box {
 	ret := _m.Called()
@@ -219,6 +303,20 @@ func (_m *Application) NewBox() packr.Box {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: _a0
+func (_m *Application) PauseJob(_a0 *models.ID) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeAllInProgress provides a mock function with given fields:
 func (_m *Application) ResumeAllInProgress() error {
 	ret := _m.Called()
@@ -233,8 +331,8 @@ func (_m *Application) ResumeAllInProgress() error {
 	return r0
 }
 
-// ResumeAllPendingConnection provides a mock function with given fields:
-func (_m *Application) ResumeAllPendingConnection() error {
+// ResumeAllPendingConcurrency provides a mock function with given fields:
+func (_m *Application) ResumeAllPendingConcurrency() error {
 	ret := _m.Called()
 
 	var r0 error
@@ -247,13 +345,27 @@ func (_m *Application) ResumeAllPendingConnection() error {
 	return r0
 }
 
-// ResumeAllPendingNextBlock provides a mock function with given fields: currentBlockHeight
-func (_m *Application) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error {
-	ret := _m.Called(currentBlockHeight)
+// ResumeAllPendingConnection provides a mock function with given fields: chainID
+func (_m *Application) ResumeAllPendingConnection(chainID *big.Int) error {
+	ret := _m.Called(chainID)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(currentBlockHeight)
+		r0 = rf(chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeAllPendingNextBlock provides a mock function with given fields: chainID, currentBlockHeight
+func (_m *Application) ResumeAllPendingNextBlock(chainID *big.Int, currentBlockHeight *big.Int) error {
+	ret := _m.Called(chainID, currentBlockHeight)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int, *big.Int) error); ok {
+		r0 = rf(chainID, currentBlockHeight)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -261,6 +373,11 @@ func (_m *Application) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) er
 	return r0
 }
 
+// ResumeAt provides a mock function with given fields: run, at
+func (_m *Application) ResumeAt(run *models.JobRun, at time.Time) {
+	_m.Called(run, at)
+}
+
 // ResumePendingBridge provides a mock function with given fields: runID, input
 func (_m *Application) ResumePendingBridge(runID *models.ID, input models.BridgeRunResult) error {
 	ret := _m.Called(runID, input)
@@ -275,6 +392,80 @@ func (_m *Application) ResumePendingBridge(runID *models.ID, input models.Bridge
 	return r0
 }
 
+// ResumePendingConcurrency provides a mock function with given fields: jobSpecID
+func (_m *Application) ResumePendingConcurrency(jobSpecID *models.ID) error {
+	ret := _m.Called(jobSpecID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID) error); ok {
+		r0 = rf(jobSpecID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeJob provides a mock function with given fields: _a0
+func (_m *Application) ResumeJob(_a0 *models.ID) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RetryDeadLetters provides a mock function with given fields: runIDs
+func (_m *Application) RetryDeadLetters(runIDs []*models.ID) ([]models.JobRun, error) {
+	ret := _m.Called(runIDs)
+
+	var r0 []models.JobRun
+	if rf, ok := ret.Get(0).(func([]*models.ID) []models.JobRun); ok {
+		r0 = rf(runIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JobRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*models.ID) error); ok {
+		r1 = rf(runIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryRun provides a mock function with given fields: runID
+func (_m *Application) RetryRun(runID *models.ID) (*models.JobRun, error) {
+	ret := _m.Called(runID)
+
+	var r0 *models.JobRun
+	if rf, ok := ret.Get(0).(func(*models.ID) *models.JobRun); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.JobRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.ID) error); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *Application) Start() error {
 	ret := _m.Called()
@@ -289,6 +480,11 @@ func (_m *Application) Start() error {
 	return r0
 }
 
+// StartMaintenance provides a mock function with given fields:
+func (_m *Application) StartMaintenance() {
+	_m.Called()
+}
+
 // Stop provides a mock function with given fields:
 func (_m *Application) Stop() error {
 	ret := _m.Called()
@@ -303,6 +499,39 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// StopMaintenance provides a mock function with given fields:
+func (_m *Application) StopMaintenance() {
+	_m.Called()
+}
+
+// UpdateFluxMonitorJob provides a mock function with given fields: job
+func (_m *Application) UpdateFluxMonitorJob(job models.JobSpec) error {
+	ret := _m.Called(job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.JobSpec) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateJob provides a mock function with given fields: job
+func (_m *Application) UpdateJob(job models.JobSpec) error {
+	ret := _m.Called(job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.JobSpec) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
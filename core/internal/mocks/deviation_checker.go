@@ -2,18 +2,41 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	fluxmonitor "github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
+	models "github.com/smartcontractkit/chainlink/core/store/models"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // DeviationChecker is an autogenerated mock type for the DeviationChecker type
 type DeviationChecker struct {
 	mock.Mock
 }
 
+// Reload provides a mock function with given fields: initr, fetcher
+func (_m *DeviationChecker) Reload(initr models.Initiator, fetcher fluxmonitor.Fetcher) {
+	_m.Called(initr, fetcher)
+}
+
 // Start provides a mock function with given fields:
 func (_m *DeviationChecker) Start() {
 	_m.Called()
 }
 
+// State provides a mock function with given fields:
+func (_m *DeviationChecker) State() fluxmonitor.CheckerState {
+	ret := _m.Called()
+
+	var r0 fluxmonitor.CheckerState
+	if rf, ok := ret.Get(0).(func() fluxmonitor.CheckerState); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(fluxmonitor.CheckerState)
+	}
+
+	return r0
+}
+
 // Stop provides a mock function with given fields:
 func (_m *DeviationChecker) Stop() {
 	_m.Called()
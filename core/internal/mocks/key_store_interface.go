@@ -197,6 +197,29 @@ func (_m *KeyStoreInterface) SignHash(hash common.Hash) (models.Signature, error
 	return r0, r1
 }
 
+// SignHashWithAccount provides a mock function with given fields: account, hash
+func (_m *KeyStoreInterface) SignHashWithAccount(account accounts.Account, hash common.Hash) (models.Signature, error) {
+	ret := _m.Called(account, hash)
+
+	var r0 models.Signature
+	if rf, ok := ret.Get(0).(func(accounts.Account, common.Hash) models.Signature); ok {
+		r0 = rf(account, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.Signature)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(accounts.Account, common.Hash) error); ok {
+		r1 = rf(account, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: account, tx, chainID
 func (_m *KeyStoreInterface) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(account, tx, chainID)
@@ -5,6 +5,8 @@ package mocks
 import (
 	models "github.com/smartcontractkit/chainlink/core/store/models"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // RunExecutor is an autogenerated mock type for the RunExecutor type
@@ -25,3 +27,13 @@ func (_m *RunExecutor) Execute(_a0 *models.ID) error {
 
 	return r0
 }
+
+// OnRetryScheduled provides a mock function with given fields: _a0
+func (_m *RunExecutor) OnRetryScheduled(_a0 func(*models.JobRun, time.Time)) {
+	_m.Called(_a0)
+}
+
+// OnRunFinished provides a mock function with given fields: _a0
+func (_m *RunExecutor) OnRunFinished(_a0 func(*models.JobRun)) {
+	_m.Called(_a0)
+}
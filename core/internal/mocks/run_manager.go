@@ -4,6 +4,7 @@ package mocks
 
 import (
 	big "math/big"
+	time "time"
 
 	models "github.com/smartcontractkit/chainlink/core/store/models"
 	mock "github.com/stretchr/testify/mock"
@@ -83,6 +84,41 @@ func (_m *RunManager) CreateErrored(jobSpecID *models.ID, initiator models.Initi
 	return r0, r1
 }
 
+// ExpireAllPastDeadline provides a mock function with given fields:
+func (_m *RunManager) ExpireAllPastDeadline() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MaintenanceSince provides a mock function with given fields:
+func (_m *RunManager) MaintenanceSince() (time.Time, bool) {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // ResumeAllInProgress provides a mock function with given fields:
 func (_m *RunManager) ResumeAllInProgress() error {
 	ret := _m.Called()
@@ -97,8 +133,8 @@ func (_m *RunManager) ResumeAllInProgress() error {
 	return r0
 }
 
-// ResumeAllPendingConnection provides a mock function with given fields:
-func (_m *RunManager) ResumeAllPendingConnection() error {
+// ResumeAllPendingConcurrency provides a mock function with given fields:
+func (_m *RunManager) ResumeAllPendingConcurrency() error {
 	ret := _m.Called()
 
 	var r0 error
@@ -111,13 +147,13 @@ func (_m *RunManager) ResumeAllPendingConnection() error {
 	return r0
 }
 
-// ResumeAllPendingNextBlock provides a mock function with given fields: currentBlockHeight
-func (_m *RunManager) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error {
-	ret := _m.Called(currentBlockHeight)
+// ResumeAllPendingConnection provides a mock function with given fields: chainID
+func (_m *RunManager) ResumeAllPendingConnection(chainID *big.Int) error {
+	ret := _m.Called(chainID)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
-		r0 = rf(currentBlockHeight)
+		r0 = rf(chainID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -125,6 +161,25 @@ func (_m *RunManager) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) err
 	return r0
 }
 
+// ResumeAllPendingNextBlock provides a mock function with given fields: chainID, currentBlockHeight
+func (_m *RunManager) ResumeAllPendingNextBlock(chainID *big.Int, currentBlockHeight *big.Int) error {
+	ret := _m.Called(chainID, currentBlockHeight)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int, *big.Int) error); ok {
+		r0 = rf(chainID, currentBlockHeight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResumeAt provides a mock function with given fields: run, at
+func (_m *RunManager) ResumeAt(run *models.JobRun, at time.Time) {
+	_m.Called(run, at)
+}
+
 // ResumePendingBridge provides a mock function with given fields: runID, input
 func (_m *RunManager) ResumePendingBridge(runID *models.ID, input models.BridgeRunResult) error {
 	ret := _m.Called(runID, input)
@@ -138,3 +193,73 @@ func (_m *RunManager) ResumePendingBridge(runID *models.ID, input models.BridgeR
 
 	return r0
 }
+
+// ResumePendingConcurrency provides a mock function with given fields: jobSpecID
+func (_m *RunManager) ResumePendingConcurrency(jobSpecID *models.ID) error {
+	ret := _m.Called(jobSpecID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ID) error); ok {
+		r0 = rf(jobSpecID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RetryDeadLetters provides a mock function with given fields: runIDs
+func (_m *RunManager) RetryDeadLetters(runIDs []*models.ID) ([]models.JobRun, error) {
+	ret := _m.Called(runIDs)
+
+	var r0 []models.JobRun
+	if rf, ok := ret.Get(0).(func([]*models.ID) []models.JobRun); ok {
+		r0 = rf(runIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JobRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*models.ID) error); ok {
+		r1 = rf(runIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryRun provides a mock function with given fields: runID
+func (_m *RunManager) RetryRun(runID *models.ID) (*models.JobRun, error) {
+	ret := _m.Called(runID)
+
+	var r0 *models.JobRun
+	if rf, ok := ret.Get(0).(func(*models.ID) *models.JobRun); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.JobRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.ID) error); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartMaintenance provides a mock function with given fields:
+func (_m *RunManager) StartMaintenance() {
+	_m.Called()
+}
+
+// StopMaintenance provides a mock function with given fields:
+func (_m *RunManager) StopMaintenance() {
+	_m.Called()
+}
@@ -13,8 +13,17 @@ type RunQueue struct {
 }
 
 // Run provides a mock function with given fields: _a0
-func (_m *RunQueue) Run(_a0 *models.JobRun) {
-	_m.Called(_a0)
+func (_m *RunQueue) Run(_a0 *models.JobRun) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.JobRun) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // Start provides a mock function with given fields:
@@ -20,6 +20,8 @@ import (
 
 	null "gopkg.in/guregu/null.v3"
 
+	rpc "github.com/ethereum/go-ethereum/rpc"
+
 	store "github.com/smartcontractkit/chainlink/core/store"
 
 	types "github.com/ethereum/go-ethereum/core/types"
@@ -53,6 +55,20 @@ func (_m *TxManager) BalanceAt(ctx context.Context, account common.Address, bloc
 	return r0, r1
 }
 
+// BatchCallContext provides a mock function with given fields: ctx, b
+func (_m *TxManager) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	ret := _m.Called(ctx, b)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []rpc.BatchElem) error); ok {
+		r0 = rf(ctx, b)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // BlockByNumber provides a mock function with given fields: ctx, number
 func (_m *TxManager) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
 	ret := _m.Called(ctx, number)
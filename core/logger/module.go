@@ -0,0 +1,139 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Subsystems which log through their own module logger, whose level can be
+// changed at runtime independently of the node's LOG_LEVEL.
+const (
+	ModuleHeadTracker = "headtracker"
+	ModuleRunQueue    = "runqueue"
+	ModuleTxManager   = "txmanager"
+	ModuleWeb         = "web"
+)
+
+// Modules lists every subsystem with a module logger.
+var Modules = []string{ModuleHeadTracker, ModuleRunQueue, ModuleTxManager, ModuleWeb}
+
+var (
+	moduleLevelsMtx sync.RWMutex
+	moduleLevels    = make(map[string]zapcore.Level)
+)
+
+// Module returns the logger for the named subsystem. Entries are tagged with
+// the module's name and written through whichever logger is the Default when
+// they are logged, so a module logger can be created when its package is
+// initialized, before the node's logger has been configured.
+func Module(name string) *Logger {
+	zl := zap.New(&moduleCore{module: name}, zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel)).Named(name)
+	return CreateLogger(zl.Sugar())
+}
+
+// SetModuleLevel overrides the level of the named module's logger.
+func SetModuleLevel(module string, level zapcore.Level) error {
+	if !IsModule(module) {
+		return fmt.Errorf("unknown log module %q, expected one of %v", module, Modules)
+	}
+	moduleLevelsMtx.Lock()
+	defer moduleLevelsMtx.Unlock()
+	moduleLevels[module] = level
+	return nil
+}
+
+// ResetModuleLevel removes any override of the named module's level, so that
+// it logs at the level of the Default logger again.
+func ResetModuleLevel(module string) error {
+	if !IsModule(module) {
+		return fmt.Errorf("unknown log module %q, expected one of %v", module, Modules)
+	}
+	moduleLevelsMtx.Lock()
+	defer moduleLevelsMtx.Unlock()
+	delete(moduleLevels, module)
+	return nil
+}
+
+// ModuleLevels returns the level each module is currently logging at.
+func ModuleLevels() map[string]zapcore.Level {
+	levels := make(map[string]zapcore.Level, len(Modules))
+	for _, module := range Modules {
+		levels[module] = moduleLevel(module)
+	}
+	return levels
+}
+
+// DefaultLevel returns the lowest level logged by the Default logger.
+func DefaultLevel() zapcore.Level {
+	core := Default.Desugar().Core()
+	for level := zapcore.DebugLevel; level < zapcore.FatalLevel; level++ {
+		if core.Enabled(level) {
+			return level
+		}
+	}
+	return zapcore.FatalLevel
+}
+
+// IsModule returns true if the name is that of a module logger.
+func IsModule(module string) bool {
+	for _, m := range Modules {
+		if m == module {
+			return true
+		}
+	}
+	return false
+}
+
+func moduleLevel(module string) zapcore.Level {
+	moduleLevelsMtx.RLock()
+	level, ok := moduleLevels[module]
+	moduleLevelsMtx.RUnlock()
+	if ok {
+		return level
+	}
+	return DefaultLevel()
+}
+
+// moduleCore filters entries by the module's level, then hands them to the
+// core of the Default logger to be encoded and written.
+type moduleCore struct {
+	module string
+	fields []zapcore.Field
+}
+
+func (mc *moduleCore) Enabled(level zapcore.Level) bool {
+	moduleLevelsMtx.RLock()
+	override, ok := moduleLevels[mc.module]
+	moduleLevelsMtx.RUnlock()
+	if ok {
+		return override.Enabled(level)
+	}
+	return Default.Desugar().Core().Enabled(level)
+}
+
+func (mc *moduleCore) With(fields []zapcore.Field) zapcore.Core {
+	return &moduleCore{module: mc.module, fields: mc.withFields(fields)}
+}
+
+func (mc *moduleCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if mc.Enabled(entry.Level) {
+		return checked.AddCore(entry, mc)
+	}
+	return checked
+}
+
+func (mc *moduleCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return Default.Desugar().Core().Write(entry, mc.withFields(fields))
+}
+
+func (mc *moduleCore) Sync() error {
+	return Default.Desugar().Core().Sync()
+}
+
+func (mc *moduleCore) withFields(fields []zapcore.Field) []zapcore.Field {
+	all := make([]zapcore.Field, 0, len(mc.fields)+len(fields))
+	return append(append(all, mc.fields...), fields...)
+}
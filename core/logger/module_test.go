@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestModule_Level(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	previous := Default
+	Default = CreateLogger(zap.New(core).Sugar())
+	defer func() {
+		Default = previous
+		require.NoError(t, ResetModuleLevel(ModuleRunQueue))
+	}()
+
+	moduleLogger := Module(ModuleRunQueue)
+
+	moduleLogger.Debugw("hidden")
+	moduleLogger.Infow("shown", "key", "value")
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "shown", entry.Message)
+	assert.Equal(t, ModuleRunQueue, entry.LoggerName)
+	assert.Equal(t, "value", entry.ContextMap()["key"])
+	assert.Equal(t, zapcore.InfoLevel, ModuleLevels()[ModuleRunQueue])
+
+	require.NoError(t, SetModuleLevel(ModuleRunQueue, zapcore.DebugLevel))
+	moduleLogger.Debugw("now shown")
+	Default.Debugw("still hidden")
+	require.Equal(t, 2, logs.Len())
+	assert.Equal(t, "now shown", logs.All()[1].Message)
+	assert.Equal(t, zapcore.DebugLevel, ModuleLevels()[ModuleRunQueue])
+
+	require.NoError(t, SetModuleLevel(ModuleRunQueue, zapcore.ErrorLevel))
+	moduleLogger.Warnw("hidden again")
+	require.Equal(t, 2, logs.Len())
+
+	require.NoError(t, ResetModuleLevel(ModuleRunQueue))
+	moduleLogger.Warnw("shown again")
+	require.Equal(t, 3, logs.Len())
+}
+
+func TestSetModuleLevel_UnknownModule(t *testing.T) {
+	assert.Error(t, SetModuleLevel("nonexistent", zapcore.DebugLevel))
+	assert.Error(t, ResetModuleLevel("nonexistent"))
+}
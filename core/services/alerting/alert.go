@@ -0,0 +1,50 @@
+package alerting
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity is how urgently an alert needs attention, using the levels
+// understood by PagerDuty.
+type Severity string
+
+const (
+	// SeverityCritical alerts need immediate attention, as the node is unable
+	// to do its job or soon will be.
+	SeverityCritical = Severity("critical")
+	// SeverityError alerts indicate that the node is doing its job badly.
+	SeverityError = Severity("error")
+	// SeverityWarning alerts indicate a problem which may need attention.
+	SeverityWarning = Severity("warning")
+)
+
+// Alert is a notification that a condition has begun or stopped firing.
+type Alert struct {
+	// Key identifies the problem being alerted on, such as the low balance of
+	// one particular key. While an alert with a given key is firing, further
+	// occurrences are not notified again.
+	Key      string
+	Severity Severity
+	Summary  string
+	// Details are further facts about the problem, such as the run and task
+	// which errored, for notifiers able to include them.
+	Details map[string]string
+	// Resolved is true if the alert is a notification that the problem
+	// identified by Key has cleared.
+	Resolved bool
+}
+
+// Status returns FIRING or RESOLVED.
+func (a Alert) Status() string {
+	if a.Resolved {
+		return "RESOLVED"
+	}
+	return "FIRING"
+}
+
+// String returns a one line description of the alert, suitable for chat
+// messages and email subjects.
+func (a Alert) String() string {
+	return fmt.Sprintf("[%s] [%s] %s", a.Status(), strings.ToUpper(string(a.Severity)), a.Summary)
+}
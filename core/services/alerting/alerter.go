@@ -0,0 +1,211 @@
+package alerting
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"go.uber.org/multierr"
+)
+
+// Service periodically checks the node for problems and notifies the
+// operator when one begins, and again when it clears.
+type Service interface {
+	Start() error
+	Stop() error
+}
+
+// New returns an alerting service checking each condition enabled in the
+// config, and sending alerts through each configured notifier. The service
+// does nothing if no notifier is configured.
+func New(store *store.Store, balances EthBalanceGetter) Service {
+	notifiers := NotifiersFromConfig(store.Config)
+	if len(notifiers) == 0 {
+		return &disabled{}
+	}
+	return NewAlerter(ConditionsFromConfig(store, balances), notifiers, store.Config.AlertCheckInterval())
+}
+
+// NotifiersFromConfig returns a notifier for each alerting integration
+// configured.
+func NotifiersFromConfig(config orm.ConfigReader) []Notifier {
+	var notifiers []Notifier
+	if url := config.AlertSlackWebhookURL(); url != nil {
+		notifiers = append(notifiers, NewSlackNotifier(url.String()))
+	}
+	if url := config.AlertWebhookURL(); url != nil {
+		notifiers = append(notifiers, NewWebhookNotifier(url.String()))
+	}
+	if key := config.AlertPagerDutyRoutingKey(); key != "" {
+		notifiers = append(notifiers, NewPagerDutyNotifier(DefaultPagerDutyEventsURL, key))
+	}
+	if address := config.AlertEmailSMTPAddress(); address != "" {
+		notifiers = append(notifiers, NewEmailNotifier(
+			address,
+			config.AlertEmailSMTPUsername(),
+			config.AlertEmailSMTPPassword(),
+			config.AlertEmailFrom(),
+			config.AlertEmailTo(),
+		))
+	}
+	return notifiers
+}
+
+// ConditionsFromConfig returns each condition enabled in the config.
+func ConditionsFromConfig(store *store.Store, balances EthBalanceGetter) []Condition {
+	config := store.Config
+	var conditions []Condition
+	if minimum := config.AlertMinEthBalanceWei(); minimum.Sign() > 0 {
+		conditions = append(conditions, NewEthBalanceCondition(store.ORM, balances, minimum))
+	}
+	if rate := config.AlertRunErrorRate(); rate > 0 {
+		conditions = append(conditions, NewRunErrorRateCondition(
+			store.ORM, rate, config.AlertRunErrorRateMinRuns(), config.AlertRunErrorRateWindow(),
+		))
+	}
+	if threshold := config.AlertStaleHeadThreshold(); threshold > 0 {
+		conditions = append(conditions, NewStaleHeadCondition(store.ORM, threshold))
+	}
+	if threshold := config.AlertStuckTxThreshold(); threshold > 0 {
+		conditions = append(conditions, NewStuckTxCondition(store.ORM, threshold))
+	}
+	return conditions
+}
+
+// Alerter checks its conditions on every interval. Each alert is notified
+// once when it begins firing, and a resolve event is notified when the
+// condition stops returning it.
+type Alerter struct {
+	conditions []Condition
+	notifiers  []Notifier
+	interval   time.Duration
+
+	// active holds the firing alerts by key, along with the name of the
+	// condition which raised them.
+	active    map[string]activeAlert
+	activeMtx sync.Mutex
+
+	utils.StartStopOnce
+	chStop chan struct{}
+	chDone chan struct{}
+}
+
+type activeAlert struct {
+	Alert
+	condition string
+}
+
+// NewAlerter returns an Alerter for the given conditions and notifiers.
+func NewAlerter(conditions []Condition, notifiers []Notifier, interval time.Duration) *Alerter {
+	return &Alerter{
+		conditions: conditions,
+		notifiers:  notifiers,
+		interval:   interval,
+		active:     make(map[string]activeAlert),
+		chStop:     make(chan struct{}),
+		chDone:     make(chan struct{}),
+	}
+}
+
+// Start begins checking the conditions.
+func (a *Alerter) Start() error {
+	return a.StartOnce("Alerter", func() error {
+		go a.run()
+		return nil
+	})
+}
+
+// Stop stops checking the conditions. Alerts which are firing are left open.
+func (a *Alerter) Stop() error {
+	return a.StopOnce("Alerter", func() error {
+		close(a.chStop)
+		<-a.chDone
+		return nil
+	})
+}
+
+func (a *Alerter) run() {
+	defer close(a.chDone)
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.chStop:
+			return
+		case <-ticker.C:
+			a.Check()
+		}
+	}
+}
+
+// Check runs each condition once, notifying the alerts which have begun
+// firing or have resolved since the last check.
+func (a *Alerter) Check() {
+	for _, condition := range a.conditions {
+		alerts, err := condition.Check()
+		if err != nil {
+			logger.Errorw(fmt.Sprintf("Alerter: error checking condition %s", condition.Name()), "error", err)
+			continue
+		}
+		for _, alert := range a.update(condition.Name(), alerts) {
+			a.notify(alert)
+		}
+	}
+}
+
+// update records the alerts currently raised by the condition, returning
+// those which need to be notified.
+func (a *Alerter) update(condition string, alerts []Alert) []Alert {
+	a.activeMtx.Lock()
+	defer a.activeMtx.Unlock()
+
+	var changed []Alert
+	firing := make(map[string]bool)
+	for _, alert := range alerts {
+		firing[alert.Key] = true
+		if _, ok := a.active[alert.Key]; ok {
+			continue
+		}
+		a.active[alert.Key] = activeAlert{Alert: alert, condition: condition}
+		changed = append(changed, alert)
+	}
+	for key, active := range a.active {
+		if active.condition != condition || firing[key] {
+			continue
+		}
+		delete(a.active, key)
+		resolved := active.Alert
+		resolved.Resolved = true
+		changed = append(changed, resolved)
+	}
+	return changed
+}
+
+func (a *Alerter) notify(alert Alert) {
+	if alert.Resolved {
+		logger.Infow(fmt.Sprintf("Alerter: %s", alert), "key", alert.Key)
+	} else {
+		logger.Warnw(fmt.Sprintf("Alerter: %s", alert), "key", alert.Key)
+	}
+	var merr error
+	for _, notifier := range a.notifiers {
+		if err := notifier.Notify(alert); err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("%s: %v", notifier.Name(), err))
+		}
+	}
+	if merr != nil {
+		logger.Errorw("Alerter: error sending alert", "key", alert.Key, "error", merr)
+	}
+}
+
+type disabled struct{}
+
+func (disabled) Start() error { return nil }
+func (disabled) Stop() error  { return nil }
@@ -0,0 +1,113 @@
+package alerting_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCondition struct {
+	name   string
+	alerts []alerting.Alert
+	err    error
+}
+
+func (c *fakeCondition) Name() string                     { return c.name }
+func (c *fakeCondition) Check() ([]alerting.Alert, error) { return c.alerts, c.err }
+
+type fakeNotifier struct {
+	mu     sync.Mutex
+	alerts []alerting.Alert
+}
+
+func (n *fakeNotifier) Name() string { return "fake" }
+
+func (n *fakeNotifier) Notify(alert alerting.Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func (n *fakeNotifier) take() []alerting.Alert {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	alerts := n.alerts
+	n.alerts = nil
+	return alerts
+}
+
+func TestAlerter_Check_DedupesAndResolves(t *testing.T) {
+	t.Parallel()
+
+	lowBalance := alerting.Alert{Key: "eth_balance/0x1", Severity: alerting.SeverityCritical, Summary: "low balance"}
+	staleHead := alerting.Alert{Key: "stale_head", Severity: alerting.SeverityCritical, Summary: "stale head"}
+	balance := &fakeCondition{name: "eth_balance", alerts: []alerting.Alert{lowBalance}}
+	heads := &fakeCondition{name: "stale_head", alerts: []alerting.Alert{staleHead}}
+	notifier := &fakeNotifier{}
+	alerter := alerting.NewAlerter([]alerting.Condition{balance, heads}, []alerting.Notifier{notifier}, time.Minute)
+
+	alerter.Check()
+	assert.Equal(t, []alerting.Alert{lowBalance, staleHead}, notifier.take())
+
+	// Alerts still firing are not notified again
+	alerter.Check()
+	assert.Empty(t, notifier.take())
+
+	// A failing check leaves the condition's alerts open
+	heads.alerts, heads.err = nil, errors.New("database unavailable")
+	alerter.Check()
+	assert.Empty(t, notifier.take())
+
+	heads.err = nil
+	alerter.Check()
+	resolved := staleHead
+	resolved.Resolved = true
+	assert.Equal(t, []alerting.Alert{resolved}, notifier.take())
+
+	// Resolving one condition does not resolve the alerts of another
+	alerter.Check()
+	assert.Empty(t, notifier.take())
+
+	// An alert which fires again after resolving is notified again
+	heads.alerts = []alerting.Alert{staleHead}
+	alerter.Check()
+	assert.Equal(t, []alerting.Alert{staleHead}, notifier.take())
+}
+
+func TestAlerter_StartStop(t *testing.T) {
+	t.Parallel()
+
+	alert := alerting.Alert{Key: "stuck_txes", Severity: alerting.SeverityWarning, Summary: "stuck"}
+	notifier := &fakeNotifier{}
+	alerter := alerting.NewAlerter(
+		[]alerting.Condition{&fakeCondition{name: "stuck_txes", alerts: []alerting.Alert{alert}}},
+		[]alerting.Notifier{notifier},
+		10*time.Millisecond,
+	)
+	require.NoError(t, alerter.Start())
+
+	require.Eventually(t, func() bool {
+		notifier.mu.Lock()
+		defer notifier.mu.Unlock()
+		return len(notifier.alerts) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, alerter.Stop())
+	assert.Equal(t, []alerting.Alert{alert}, notifier.take())
+}
+
+func TestAlert_String(t *testing.T) {
+	t.Parallel()
+
+	alert := alerting.Alert{Key: "stale_head", Severity: alerting.SeverityCritical, Summary: "No new head"}
+	assert.Equal(t, "[FIRING] [CRITICAL] No new head", alert.String())
+	alert.Resolved = true
+	assert.Equal(t, "[RESOLVED] [CRITICAL] No new head", alert.String())
+}
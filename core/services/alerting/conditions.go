@@ -0,0 +1,160 @@
+package alerting
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Condition is a check run periodically by the alerter. Check returns an
+// alert for each problem currently present, or an error if the check could
+// not be made, in which case the alerts previously raised by the condition are
+// left as they were.
+type Condition interface {
+	Name() string
+	Check() ([]Alert, error)
+}
+
+// EthBalanceGetter returns the last known ETH balance of an address, or nil
+// if it is not known. It is satisfied by services.BalanceMonitor.
+type EthBalanceGetter interface {
+	GetEthBalance(gethCommon.Address) *assets.Eth
+}
+
+type ethBalanceCondition struct {
+	orm      *orm.ORM
+	balances EthBalanceGetter
+	minimum  *big.Int
+}
+
+// NewEthBalanceCondition raises an alert for each sending key whose ETH
+// balance is below minimum.
+func NewEthBalanceCondition(orm *orm.ORM, balances EthBalanceGetter, minimum *big.Int) Condition {
+	return &ethBalanceCondition{orm: orm, balances: balances, minimum: minimum}
+}
+
+func (c *ethBalanceCondition) Name() string { return "eth_balance" }
+
+func (c *ethBalanceCondition) Check() ([]Alert, error) {
+	keys, err := c.orm.SendKeys()
+	if err != nil {
+		return nil, err
+	}
+	var alerts []Alert
+	for _, key := range keys {
+		address := key.Address.Address()
+		balance := c.balances.GetEthBalance(address)
+		if balance == nil || balance.ToInt().Cmp(c.minimum) >= 0 {
+			continue
+		}
+		alerts = append(alerts, Alert{
+			Key:      fmt.Sprintf("%s/%s", c.Name(), address.Hex()),
+			Severity: SeverityCritical,
+			Summary: fmt.Sprintf(
+				"ETH balance of %s is %s, below the minimum of %s",
+				address.Hex(), balance.String(), (*assets.Eth)(c.minimum).String(),
+			),
+		})
+	}
+	return alerts, nil
+}
+
+type runErrorRateCondition struct {
+	orm     *orm.ORM
+	maxRate float64
+	minRuns uint
+	window  time.Duration
+}
+
+// NewRunErrorRateCondition raises an alert when more than maxRate of the runs
+// finished within the window have errored, provided at least minRuns have
+// finished.
+func NewRunErrorRateCondition(orm *orm.ORM, maxRate float64, minRuns uint, window time.Duration) Condition {
+	return &runErrorRateCondition{orm: orm, maxRate: maxRate, minRuns: minRuns, window: window}
+}
+
+func (c *runErrorRateCondition) Name() string { return "run_error_rate" }
+
+func (c *runErrorRateCondition) Check() ([]Alert, error) {
+	finished, errored, err := c.orm.JobRunsFinishedSince(time.Now().Add(-c.window))
+	if err != nil {
+		return nil, err
+	}
+	if finished == 0 || uint(finished) < c.minRuns {
+		return nil, nil
+	}
+	rate := float64(errored) / float64(finished)
+	if rate <= c.maxRate {
+		return nil, nil
+	}
+	return []Alert{{
+		Key:      c.Name(),
+		Severity: SeverityError,
+		Summary: fmt.Sprintf(
+			"%d of %d runs finished in the last %s errored (%.1f%%), above the threshold of %.1f%%",
+			errored, finished, c.window, rate*100, c.maxRate*100,
+		),
+	}}, nil
+}
+
+type staleHeadCondition struct {
+	orm       *orm.ORM
+	threshold time.Duration
+}
+
+// NewStaleHeadCondition raises an alert when no new head has been received
+// for longer than threshold.
+func NewStaleHeadCondition(orm *orm.ORM, threshold time.Duration) Condition {
+	return &staleHeadCondition{orm: orm, threshold: threshold}
+}
+
+func (c *staleHeadCondition) Name() string { return "stale_head" }
+
+func (c *staleHeadCondition) Check() ([]Alert, error) {
+	head, err := c.orm.LastHead()
+	if err != nil || head == nil {
+		return nil, err
+	}
+	age := time.Since(head.CreatedAt)
+	if age <= c.threshold {
+		return nil, nil
+	}
+	return []Alert{{
+		Key:      c.Name(),
+		Severity: SeverityCritical,
+		Summary: fmt.Sprintf(
+			"No new head received for %s, the last was block %d",
+			age.Round(time.Second), head.Number,
+		),
+	}}, nil
+}
+
+type stuckTxCondition struct {
+	orm       *orm.ORM
+	threshold time.Duration
+}
+
+// NewStuckTxCondition raises an alert when any transaction has remained
+// unconfirmed for longer than threshold after it was broadcast.
+func NewStuckTxCondition(orm *orm.ORM, threshold time.Duration) Condition {
+	return &stuckTxCondition{orm: orm, threshold: threshold}
+}
+
+func (c *stuckTxCondition) Name() string { return "stuck_txes" }
+
+func (c *stuckTxCondition) Check() ([]Alert, error) {
+	count, err := c.orm.CountEthTxesUnconfirmedSince(time.Now().Add(-c.threshold))
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	return []Alert{{
+		Key:      c.Name(),
+		Severity: SeverityWarning,
+		Summary:  fmt.Sprintf("%d transactions have been unconfirmed for more than %s", count, c.threshold),
+	}}, nil
+}
@@ -0,0 +1,180 @@
+package alerting
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"go.uber.org/multierr"
+)
+
+// JobFailureNotifier sends the failure notifications of jobs which declare
+// them. On every interval it looks for the runs which have errored since it
+// last looked, and notifies each job's webhook and email recipients of the
+// run, the task which failed and its error. Unlike the alerts of the node's
+// conditions, which fire once until they clear, each errored run, or each
+// streak of the given number of consecutive errored runs, is notified.
+type JobFailureNotifier struct {
+	orm         *orm.ORM
+	config      orm.ConfigReader
+	interval    time.Duration
+	lastChecked time.Time
+
+	utils.StartStopOnce
+	chStop chan struct{}
+	chDone chan struct{}
+}
+
+// NewJobFailureNotifier returns a JobFailureNotifier for the runs which error
+// from now on.
+func NewJobFailureNotifier(orm *orm.ORM, config orm.ConfigReader, interval time.Duration) *JobFailureNotifier {
+	return &JobFailureNotifier{
+		orm:         orm,
+		config:      config,
+		interval:    interval,
+		lastChecked: time.Now(),
+		chStop:      make(chan struct{}),
+		chDone:      make(chan struct{}),
+	}
+}
+
+// Start begins checking for errored runs.
+func (jfn *JobFailureNotifier) Start() error {
+	return jfn.StartOnce("JobFailureNotifier", func() error {
+		go jfn.run()
+		return nil
+	})
+}
+
+// Stop stops checking for errored runs.
+func (jfn *JobFailureNotifier) Stop() error {
+	return jfn.StopOnce("JobFailureNotifier", func() error {
+		close(jfn.chStop)
+		<-jfn.chDone
+		return nil
+	})
+}
+
+func (jfn *JobFailureNotifier) run() {
+	defer close(jfn.chDone)
+
+	ticker := time.NewTicker(jfn.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-jfn.chStop:
+			return
+		case <-ticker.C:
+			if err := jfn.Check(); err != nil {
+				logger.Errorw("JobFailureNotifier: error checking for errored runs", "error", err)
+			}
+		}
+	}
+}
+
+// Check notifies the runs which have errored since the last check. A failure
+// to deliver a notification is logged rather than retried, so that a broken
+// webhook does not hold up the notifications of other jobs.
+func (jfn *JobFailureNotifier) Check() error {
+	until := time.Now()
+	runs, err := jfn.orm.ErroredJobRunsWithNotifications(jfn.lastChecked, until)
+	if err != nil {
+		return err
+	}
+	jfn.lastChecked = until
+
+	jobs := make(map[string]models.JobSpec)
+	for _, run := range runs {
+		job, ok := jobs[run.JobSpecID.String()]
+		if !ok {
+			job, err = jfn.orm.Unscoped().FindJob(run.JobSpecID)
+			if err != nil {
+				logger.Errorw("JobFailureNotifier: error finding job", "job", run.JobSpecID.String(), "error", err)
+				continue
+			}
+			jobs[run.JobSpecID.String()] = job
+		}
+
+		consecutive, err := jfn.orm.ConsecutiveErroredJobRunsCount(run.JobSpecID, run.FinishedAt.Time)
+		if err != nil {
+			logger.Errorw("JobFailureNotifier: error counting errored runs", "job", job.ID.String(), "error", err)
+			continue
+		}
+		if !job.FailureNotifications.ShouldNotify(consecutive) {
+			continue
+		}
+		jfn.notify(job, JobRunErroredAlert(job, run, consecutive))
+	}
+	return nil
+}
+
+func (jfn *JobFailureNotifier) notify(job models.JobSpec, alert Alert) {
+	logger.Warnw(fmt.Sprintf("JobFailureNotifier: %s", alert), "job", job.ID.String(), "run", alert.Details["run"])
+
+	var notifiers []Notifier
+	fn := job.FailureNotifications
+	if fn.WebhookURL != nil {
+		notifiers = append(notifiers, NewWebhookNotifier(fn.WebhookURL.String()))
+	}
+	if len(fn.Email) > 0 && jfn.config.AlertEmailSMTPAddress() != "" {
+		notifiers = append(notifiers, NewEmailNotifier(
+			jfn.config.AlertEmailSMTPAddress(),
+			jfn.config.AlertEmailSMTPUsername(),
+			jfn.config.AlertEmailSMTPPassword(),
+			jfn.config.AlertEmailFrom(),
+			fn.Email,
+		))
+	}
+
+	var merr error
+	for _, notifier := range notifiers {
+		if err := notifier.Notify(alert); err != nil {
+			merr = multierr.Append(merr, fmt.Errorf("%s: %v", notifier.Name(), err))
+		}
+	}
+	if merr != nil {
+		logger.Errorw("JobFailureNotifier: error sending notification", "job", job.ID.String(), "error", merr)
+	}
+}
+
+// JobRunErroredAlert returns the alert notifying that the run errored, as the
+// latest of the given number of consecutive errored runs of the job.
+func JobRunErroredAlert(job models.JobSpec, run models.JobRun, consecutive int) Alert {
+	details := map[string]string{
+		"job":               job.ID.String(),
+		"jobName":           job.Name,
+		"run":               run.ID.String(),
+		"error":             run.ErrorString(),
+		"consecutiveErrors": strconv.Itoa(consecutive),
+	}
+	task := "unknown task"
+	for i, tr := range run.TaskRuns {
+		if tr.Status != models.RunStatusErrored {
+			continue
+		}
+		task = fmt.Sprintf("task %d (%s)", i, tr.TaskSpec.Type)
+		details["taskIndex"] = strconv.Itoa(i)
+		details["taskType"] = tr.TaskSpec.Type.String()
+		if msg := tr.Result.ErrorMessage.ValueOrZero(); msg != "" {
+			details["error"] = msg
+		}
+		break
+	}
+
+	summary := fmt.Sprintf("Run %s of job %s errored on %s: %s", run.ID, job.Name, task, details["error"])
+	if consecutive > 1 {
+		summary = fmt.Sprintf("%d consecutive runs of job %s have errored, the latest, %s, on %s: %s", consecutive, job.Name, run.ID, task, details["error"])
+	}
+	return Alert{
+		Key:      fmt.Sprintf("job_run_errored/%s", job.ID),
+		Severity: SeverityError,
+		Summary:  summary,
+		Details:  details,
+	}
+}
@@ -0,0 +1,92 @@
+package alerting_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/alerting"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v3"
+)
+
+func newErroredRun(job models.JobSpec, message string) models.JobRun {
+	run := cltest.NewJobRun(job)
+	run.TaskRuns[0].Status = models.RunStatusErrored
+	run.TaskRuns[0].Result.ErrorMessage = null.StringFrom(message)
+	run.Result.ErrorMessage = null.StringFrom(message)
+	run.SetStatus(models.RunStatusErrored)
+	return run
+}
+
+func TestJobFailureNotifier_Check_ConsecutiveErrors(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	server, bodies := newRecordingServer(t, http.StatusOK)
+	defer server.Close()
+
+	job := cltest.NewJobWithWebInitiator()
+	webhookURL := cltest.WebURL(t, server.URL)
+	job.FailureNotifications = &models.FailureNotifications{WebhookURL: &webhookURL, ConsecutiveErrors: 2}
+	require.NoError(t, store.CreateJob(&job))
+
+	notifier := alerting.NewJobFailureNotifier(store.ORM, store.Config, time.Minute)
+
+	run := newErroredRun(job, "connection refused")
+	require.NoError(t, store.CreateJobRun(&run))
+	require.NoError(t, notifier.Check())
+	assert.Len(t, *bodies, 0)
+
+	run = newErroredRun(job, "connection refused")
+	require.NoError(t, store.CreateJobRun(&run))
+	require.NoError(t, notifier.Check())
+	require.Len(t, *bodies, 1)
+	details := (*bodies)[0]["details"].(map[string]interface{})
+	assert.Equal(t, run.ID.String(), details["run"])
+	assert.Equal(t, "2", details["consecutiveErrors"])
+	assert.Equal(t, "connection refused", details["error"])
+
+	run = newErroredRun(job, "connection refused")
+	require.NoError(t, store.CreateJobRun(&run))
+	require.NoError(t, notifier.Check())
+	assert.Len(t, *bodies, 1)
+
+	completed := cltest.NewJobRun(job)
+	completed.TaskRuns[0].Status = models.RunStatusCompleted
+	completed.SetStatus(models.RunStatusCompleted)
+	require.NoError(t, store.CreateJobRun(&completed))
+	run = newErroredRun(job, "connection refused")
+	require.NoError(t, store.CreateJobRun(&run))
+	require.NoError(t, notifier.Check())
+	assert.Len(t, *bodies, 1)
+}
+
+func TestJobRunErroredAlert(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Name = "eth-usd"
+	job.Tasks = append(job.Tasks, models.TaskSpec{Type: adapters.TaskTypeHTTPGet})
+	run := cltest.NewJobRun(job)
+	run.TaskRuns[0].Status = models.RunStatusCompleted
+	run.TaskRuns[1].Status = models.RunStatusErrored
+	run.TaskRuns[1].Result.ErrorMessage = null.StringFrom("503 Service Unavailable")
+
+	alert := alerting.JobRunErroredAlert(job, run, 1)
+	assert.Equal(t, "job_run_errored/"+job.ID.String(), alert.Key)
+	assert.Equal(t, alerting.SeverityError, alert.Severity)
+	assert.Equal(t, "Run "+run.ID.String()+" of job eth-usd errored on task 1 (httpget): 503 Service Unavailable", alert.Summary)
+	assert.Equal(t, "1", alert.Details["taskIndex"])
+	assert.Equal(t, "httpget", alert.Details["taskType"])
+
+	alert = alerting.JobRunErroredAlert(job, run, 3)
+	assert.Equal(t, "3 consecutive runs of job eth-usd have errored, the latest, "+run.ID.String()+", on task 1 (httpget): 503 Service Unavailable", alert.Summary)
+}
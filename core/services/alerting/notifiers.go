@@ -0,0 +1,206 @@
+package alerting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPagerDutyEventsURL is the endpoint of the PagerDuty Events API v2.
+const DefaultPagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+const notifyTimeout = 15 * time.Second
+
+// Notifier delivers alerts to an operator.
+type Notifier interface {
+	Name() string
+	Notify(Alert) error
+}
+
+// SlackNotifier posts alerts to a Slack incoming webhook.
+type SlackNotifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewSlackNotifier returns a notifier posting to the given webhook URL.
+func NewSlackNotifier(webhookURL string) *SlackNotifier {
+	return &SlackNotifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: notifyTimeout},
+	}
+}
+
+// Name returns the name of the notifier
+func (sn *SlackNotifier) Name() string { return "slack" }
+
+// Notify posts the alert as a message to the webhook.
+func (sn *SlackNotifier) Notify(alert Alert) error {
+	return postJSON(sn.client, sn.webhookURL, map[string]string{"text": alert.String()})
+}
+
+// PagerDutyNotifier sends alerts as events to the PagerDuty Events API v2,
+// which opens an incident when an alert fires and resolves it when the alert
+// clears.
+type PagerDutyNotifier struct {
+	eventsURL  string
+	routingKey string
+	source     string
+	client     *http.Client
+}
+
+// NewPagerDutyNotifier returns a notifier sending events for the service with
+// the given integration key.
+func NewPagerDutyNotifier(eventsURL, routingKey string) *PagerDutyNotifier {
+	source, err := os.Hostname()
+	if err != nil {
+		source = "chainlink"
+	}
+	return &PagerDutyNotifier{
+		eventsURL:  eventsURL,
+		routingKey: routingKey,
+		source:     source,
+		client:     &http.Client{Timeout: notifyTimeout},
+	}
+}
+
+// Name returns the name of the notifier
+func (pn *PagerDutyNotifier) Name() string { return "pagerduty" }
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary  string   `json:"summary"`
+	Source   string   `json:"source"`
+	Severity Severity `json:"severity"`
+}
+
+// Notify triggers or resolves the incident deduplicated by the alert's key.
+func (pn *PagerDutyNotifier) Notify(alert Alert) error {
+	event := pagerDutyEvent{
+		RoutingKey:  pn.routingKey,
+		EventAction: "trigger",
+		DedupKey:    alert.Key,
+	}
+	if alert.Resolved {
+		event.EventAction = "resolve"
+	} else {
+		event.Payload = &pagerDutyPayload{
+			Summary:  alert.Summary,
+			Source:   pn.source,
+			Severity: alert.Severity,
+		}
+	}
+	return postJSON(pn.client, pn.eventsURL, event)
+}
+
+// WebhookNotifier posts each alert as JSON to an arbitrary URL, for
+// integrations with no dedicated notifier.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier returns a notifier posting to url.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: notifyTimeout},
+	}
+}
+
+// Name returns the name of the notifier
+func (wn *WebhookNotifier) Name() string { return "webhook" }
+
+type webhookAlert struct {
+	Key      string            `json:"key"`
+	Status   string            `json:"status"`
+	Severity Severity          `json:"severity"`
+	Summary  string            `json:"summary"`
+	Details  map[string]string `json:"details,omitempty"`
+}
+
+// Notify posts the alert to the webhook.
+func (wn *WebhookNotifier) Notify(alert Alert) error {
+	return postJSON(wn.client, wn.url, webhookAlert{
+		Key:      alert.Key,
+		Status:   alert.Status(),
+		Severity: alert.Severity,
+		Summary:  alert.Summary,
+		Details:  alert.Details,
+	})
+}
+
+// EmailNotifier sends alerts by email through an SMTP server.
+type EmailNotifier struct {
+	address string
+	auth    smtp.Auth
+	from    string
+	to      []string
+}
+
+// NewEmailNotifier returns a notifier sending email through the SMTP server at
+// address, which is given as host:port. No authentication is attempted if
+// username is empty.
+func NewEmailNotifier(address, username, password, from string, to []string) *EmailNotifier {
+	var auth smtp.Auth
+	if username != "" {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			host = address
+		}
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &EmailNotifier{address: address, auth: auth, from: from, to: to}
+}
+
+// Name returns the name of the notifier
+func (en *EmailNotifier) Name() string { return "email" }
+
+// Notify sends the alert as an email to each of the recipients.
+func (en *EmailNotifier) Notify(alert Alert) error {
+	var details strings.Builder
+	keys := make([]string, 0, len(alert.Details))
+	for key := range alert.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&details, "%s: %s\r\n", key, alert.Details[key])
+	}
+	msg := fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: Chainlink alert: %s\r\n\r\n%s\r\n\r\n%sAlert key: %s\r\n",
+		en.from, strings.Join(en.to, ", "), alert.String(), alert.Summary, details.String(), alert.Key,
+	)
+	return smtp.SendMail(en.address, en.auth, en.from, en.to, []byte(msg))
+}
+
+func postJSON(client *http.Client, url string, body interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package alerting_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecordingServer(t *testing.T, status int) (*httptest.Server, *[]map[string]interface{}) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(b, &body))
+		bodies = append(bodies, body)
+		w.WriteHeader(status)
+	}))
+	return server, &bodies
+}
+
+func TestSlackNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	server, bodies := newRecordingServer(t, http.StatusOK)
+	defer server.Close()
+
+	notifier := alerting.NewSlackNotifier(server.URL)
+	alert := alerting.Alert{Key: "stale_head", Severity: alerting.SeverityCritical, Summary: "No new head"}
+	require.NoError(t, notifier.Notify(alert))
+
+	require.Len(t, *bodies, 1)
+	assert.Equal(t, "[FIRING] [CRITICAL] No new head", (*bodies)[0]["text"])
+}
+
+func TestSlackNotifier_Notify_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newRecordingServer(t, http.StatusForbidden)
+	defer server.Close()
+
+	notifier := alerting.NewSlackNotifier(server.URL)
+	assert.Error(t, notifier.Notify(alerting.Alert{Key: "stale_head"}))
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	server, bodies := newRecordingServer(t, http.StatusOK)
+	defer server.Close()
+
+	notifier := alerting.NewWebhookNotifier(server.URL)
+	alert := alerting.Alert{Key: "eth_balance/0x1", Severity: alerting.SeverityCritical, Summary: "ETH balance is low"}
+	require.NoError(t, notifier.Notify(alert))
+	alert.Resolved = true
+	require.NoError(t, notifier.Notify(alert))
+
+	require.Len(t, *bodies, 2)
+	assert.Equal(t, map[string]interface{}{
+		"key":      "eth_balance/0x1",
+		"status":   "FIRING",
+		"severity": "critical",
+		"summary":  "ETH balance is low",
+	}, (*bodies)[0])
+	assert.Equal(t, "RESOLVED", (*bodies)[1]["status"])
+}
+
+func TestPagerDutyNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	server, bodies := newRecordingServer(t, http.StatusAccepted)
+	defer server.Close()
+
+	notifier := alerting.NewPagerDutyNotifier(server.URL, "routing-key")
+	alert := alerting.Alert{Key: "stuck_txes", Severity: alerting.SeverityWarning, Summary: "2 transactions stuck"}
+	require.NoError(t, notifier.Notify(alert))
+	alert.Resolved = true
+	require.NoError(t, notifier.Notify(alert))
+
+	require.Len(t, *bodies, 2)
+	trigger, resolve := (*bodies)[0], (*bodies)[1]
+
+	assert.Equal(t, "routing-key", trigger["routing_key"])
+	assert.Equal(t, "trigger", trigger["event_action"])
+	assert.Equal(t, "stuck_txes", trigger["dedup_key"])
+	payload := trigger["payload"].(map[string]interface{})
+	assert.Equal(t, "2 transactions stuck", payload["summary"])
+	assert.Equal(t, "warning", payload["severity"])
+	assert.NotEmpty(t, payload["source"])
+
+	assert.Equal(t, "resolve", resolve["event_action"])
+	assert.Equal(t, "stuck_txes", resolve["dedup_key"])
+	assert.NotContains(t, resolve, "payload")
+}
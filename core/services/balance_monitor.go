@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -16,7 +17,9 @@ import (
 )
 
 type (
-	// BalanceMonitor checks the balance for each key on every new head
+	// BalanceMonitor checks the balance for each key on every new head. Keys
+	// whose ETH balance drops below ALERT_MIN_ETH_BALANCE_WEI are reported, and
+	// topped up from ETH_BALANCE_TOP_UP_FUNDING_ADDRESS if it is set.
 	BalanceMonitor interface {
 		store.HeadTrackable
 		GetEthBalance(gethCommon.Address) *assets.Eth
@@ -26,6 +29,8 @@ type (
 	balanceMonitor struct {
 		store          *store.Store
 		ethBalances    map[gethCommon.Address]*assets.Eth
+		belowMinimum   map[gethCommon.Address]bool
+		linkCheckedAt  map[gethCommon.Address]time.Time
 		ethBalancesMtx *sync.RWMutex
 		sleeperTask    utils.SleeperTask
 	}
@@ -38,6 +43,8 @@ func NewBalanceMonitor(store *store.Store) BalanceMonitor {
 	bm := &balanceMonitor{
 		store:          store,
 		ethBalances:    make(map[gethCommon.Address]*assets.Eth),
+		belowMinimum:   make(map[gethCommon.Address]bool),
+		linkCheckedAt:  make(map[gethCommon.Address]time.Time),
 		ethBalancesMtx: new(sync.RWMutex),
 	}
 	bm.sleeperTask = utils.NewSleeperTask(&worker{bm: bm})
@@ -94,6 +101,69 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 	}
 }
 
+// checkMinimumBalance reports whether the balance of address is below
+// ALERT_MIN_ETH_BALANCE_WEI, logging a warning when it drops below, and sends
+// a top-up to it if it is.
+func (bm *balanceMonitor) checkMinimumBalance(ethBal assets.Eth, address gethCommon.Address) {
+	minimum := bm.store.Config.AlertMinEthBalanceWei()
+	if minimum.Sign() <= 0 {
+		return
+	}
+	below := ethBal.ToInt().Cmp(minimum) < 0
+	store.PromUpdateEthBalanceBelowMinimum(below, address)
+
+	bm.ethBalancesMtx.Lock()
+	wasBelow := bm.belowMinimum[address]
+	bm.belowMinimum[address] = below
+	bm.ethBalancesMtx.Unlock()
+
+	if !below {
+		return
+	}
+	if !wasBelow {
+		logger.Warnw(fmt.Sprintf("ETH balance for %s of %s is below the minimum of %s", address.Hex(), ethBal.String(), (*assets.Eth)(minimum).String()),
+			"address", address.Hex(),
+			"weiBalance", ethBal.ToInt(),
+			"minimumWei", minimum,
+		)
+	}
+	bm.topUp(address)
+}
+
+// topUp sends ETH_BALANCE_TOP_UP_AMOUNT_WEI to address from the funding key,
+// unless a top-up is already on its way
+func (bm *balanceMonitor) topUp(address gethCommon.Address) {
+	funding := bm.store.Config.EthBalanceTopUpFundingAddress()
+	amount := bm.store.Config.EthBalanceTopUpAmountWei()
+	if funding == utils.ZeroAddress || funding == address || amount.Sign() <= 0 {
+		return
+	}
+
+	pending, err := bm.store.CountPendingEthTxesBetween(funding, address)
+	if err != nil {
+		logger.Errorw("BalanceMonitor: error checking for pending top-ups", "error", err, "address", address.Hex())
+		return
+	} else if pending > 0 {
+		return
+	}
+
+	etx, err := bulletprooftxmanager.SendEther(bm.store, funding, address, assets.Eth(*amount), nil)
+	if err != nil {
+		logger.Errorw(fmt.Sprintf("BalanceMonitor: error topping up %s from %s", address.Hex(), funding.Hex()),
+			"error", err,
+			"address", address.Hex(),
+			"fundingAddress", funding.Hex(),
+		)
+		return
+	}
+	logger.Infow(fmt.Sprintf("BalanceMonitor: topping up %s with %s from %s", address.Hex(), (*assets.Eth)(amount).String(), funding.Hex()),
+		"address", address.Hex(),
+		"fundingAddress", funding.Hex(),
+		"weiAmount", amount,
+		"ethTxID", etx.ID,
+	)
+}
+
 func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth {
 	bm.ethBalancesMtx.RLock()
 	defer bm.ethBalancesMtx.RUnlock()
@@ -116,6 +186,7 @@ func (w *worker) Work() {
 	for _, key := range keys {
 		go func(k models.Key) {
 			w.checkAccountBalance(k)
+			w.checkLinkBalance(k)
 			wg.Done()
 		}(key)
 	}
@@ -143,7 +214,40 @@ func (w *worker) checkAccountBalance(k models.Key) {
 	} else {
 		ethBal := assets.Eth(*bal)
 		w.bm.updateBalance(ethBal, k.Address.Address())
+		w.bm.checkMinimumBalance(ethBal, k.Address.Address())
+	}
+}
+
+// linkBalanceCheckInterval is the least time between checks of the LINK
+// balance of a key, which unlike its ETH balance is not needed on every head.
+const linkBalanceCheckInterval = time.Minute
+
+// checkLinkBalance only reports the LINK balance of the key to Prometheus, as
+// nothing in the node acts on it. It is checked at most once every
+// linkBalanceCheckInterval, whether or not the last check succeeded.
+func (w *worker) checkLinkBalance(k models.Key) {
+	now := w.bm.store.Clock.Now()
+	w.bm.ethBalancesMtx.Lock()
+	checkedAt, checked := w.bm.linkCheckedAt[k.Address.Address()]
+	due := !checked || now.Sub(checkedAt) >= linkBalanceCheckInterval
+	if due {
+		w.bm.linkCheckedAt[k.Address.Address()] = now
+	}
+	w.bm.ethBalancesMtx.Unlock()
+	if !due {
+		return
+	}
+
+	linkAddress := gethCommon.HexToAddress(w.bm.store.Config.LinkContractAddress())
+	bal, err := w.bm.store.EthClient.GetERC20Balance(k.Address.Address(), linkAddress)
+	if err != nil {
+		logger.Warnw(fmt.Sprintf("BalanceMonitor: error getting LINK balance for key %s", k.Address.Hex()),
+			"error", err,
+			"address", k.Address,
+		)
+		return
 	}
+	store.PromUpdateLinkBalance((*assets.Link)(bal), k.Address.Address())
 }
 
 func (*NullBalanceMonitor) GetEthBalance(gethCommon.Address) *assets.Eth {
@@ -12,10 +12,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/stretchr/testify/assert"
-	// "github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pkg/errors"
 )
@@ -28,9 +29,11 @@ func TestBalanceMonitor_Connect(t *testing.T) {
 		defer cleanup()
 
 		gethClient := new(mocks.GethClient)
+		rpcClient := new(mocks.RPCClient)
 		cltest.MockEthOnStore(t, store,
-			eth.NewClientWith(nil, gethClient),
+			eth.NewClientWith(rpcClient, gethClient),
 		)
+		rpcClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Maybe().Return(nil)
 
 		k0 := cltest.MustDefaultKey(t, store)
 		k0Addr := k0.Address.Address()
@@ -68,9 +71,11 @@ func TestBalanceMonitor_Connect(t *testing.T) {
 		defer cleanup()
 
 		gethClient := new(mocks.GethClient)
+		rpcClient := new(mocks.RPCClient)
 		cltest.MockEthOnStore(t, store,
-			eth.NewClientWith(nil, gethClient),
+			eth.NewClientWith(rpcClient, gethClient),
 		)
+		rpcClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Maybe().Return(nil)
 
 		k0 := cltest.MustDefaultKey(t, store)
 		k0Addr := k0.Address.Address()
@@ -96,9 +101,11 @@ func TestBalanceMonitor_Connect(t *testing.T) {
 		defer cleanup()
 
 		gethClient := new(mocks.GethClient)
+		rpcClient := new(mocks.RPCClient)
 		cltest.MockEthOnStore(t, store,
-			eth.NewClientWith(nil, gethClient),
+			eth.NewClientWith(rpcClient, gethClient),
 		)
+		rpcClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Maybe().Return(nil)
 
 		k0 := cltest.MustDefaultKey(t, store)
 		k0Addr := k0.Address.Address()
@@ -127,9 +134,11 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		defer cleanup()
 
 		gethClient := new(mocks.GethClient)
+		rpcClient := new(mocks.RPCClient)
 		cltest.MockEthOnStore(t, store,
-			eth.NewClientWith(nil, gethClient),
+			eth.NewClientWith(rpcClient, gethClient),
 		)
+		rpcClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Maybe().Return(nil)
 
 		k0 := cltest.MustDefaultKey(t, store)
 		k0Addr := k0.Address.Address()
@@ -180,14 +189,64 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 	})
 }
 
+func TestBalanceMonitor_TopsUpKeysBelowMinimum(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	gethClient := new(mocks.GethClient)
+	rpcClient := new(mocks.RPCClient)
+	cltest.MockEthOnStore(t, store,
+		eth.NewClientWith(rpcClient, gethClient),
+	)
+	rpcClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Maybe().Return(nil)
+
+	k0 := cltest.MustDefaultKey(t, store)
+	k0Addr := k0.Address.Address()
+	k1 := cltest.MustInsertRandomKey(t, store)
+	k1Addr := k1.Address.Address()
+
+	store.Config.Set("ALERT_MIN_ETH_BALANCE_WEI", 100)
+	store.Config.Set("ETH_BALANCE_TOP_UP_FUNDING_ADDRESS", k0Addr.Hex())
+	store.Config.Set("ETH_BALANCE_TOP_UP_AMOUNT_WEI", 1000)
+
+	bm := services.NewBalanceMonitor(store)
+	defer bm.Stop()
+
+	// The funding key is below the minimum too, but is not topped up
+	gethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Return(big.NewInt(99), nil)
+	gethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Return(big.NewInt(42), nil)
+
+	pendingTopUps := func() int {
+		count, err := store.CountPendingEthTxesBetween(k0Addr, k1Addr)
+		assert.NoError(t, err)
+		return count
+	}
+
+	bm.OnNewLongestChain(context.TODO(), *cltest.Head(0))
+	gomega.NewGomegaWithT(t).Eventually(pendingTopUps).Should(gomega.Equal(1))
+
+	// No second top-up while the first is pending
+	bm.OnNewLongestChain(context.TODO(), *cltest.Head(1))
+	gomega.NewGomegaWithT(t).Consistently(pendingTopUps).Should(gomega.Equal(1))
+
+	var etxs []models.EthTx
+	require.NoError(t, store.DB.Find(&etxs).Error)
+	require.Len(t, etxs, 1)
+	assert.Equal(t, k0Addr, etxs[0].FromAddress)
+	assert.Equal(t, k1Addr, etxs[0].ToAddress)
+	assert.Equal(t, big.NewInt(1000), etxs[0].Value.ToInt())
+}
+
 func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
 
 	gethClient := new(mocks.GethClient)
+	rpcClient := new(mocks.RPCClient)
 	cltest.MockEthOnStore(t, store,
-		eth.NewClientWith(nil, gethClient),
+		eth.NewClientWith(rpcClient, gethClient),
 	)
+	rpcClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").Maybe().Return(nil)
 
 	bm := services.NewBalanceMonitor(store)
 
@@ -224,3 +283,43 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, atomic.LoadInt32(&callCount), int32(1))
 	gethClient.AssertExpectations(t)
 }
+
+func TestBalanceMonitor_ChecksLinkBalanceLessOften(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	gethClient := new(mocks.GethClient)
+	rpcClient := new(mocks.RPCClient)
+	cltest.MockEthOnStore(t, store,
+		eth.NewClientWith(rpcClient, gethClient),
+	)
+	var linkCalls int32
+	rpcClient.On("Call", mock.Anything, "eth_call", mock.Anything, "latest").
+		Run(func(mock.Arguments) { atomic.AddInt32(&linkCalls, 1) }).
+		Return(errors.New("connection reset"))
+
+	k0 := cltest.MustDefaultKey(t, store)
+	k0Addr := k0.Address.Address()
+
+	bm := services.NewBalanceMonitor(store)
+	defer bm.Stop()
+
+	gethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+	bm.OnNewLongestChain(context.TODO(), *cltest.Head(0))
+	gomega.NewGomegaWithT(t).Eventually(func() *big.Int {
+		return bm.GetEthBalance(k0Addr).ToInt()
+	}).Should(gomega.Equal(big.NewInt(42)))
+
+	// The ETH balance is checked on the next head, but the LINK balance is
+	// not checked again so soon, even though the last check failed
+	gethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(43), nil)
+	bm.OnNewLongestChain(context.TODO(), *cltest.Head(1))
+	gomega.NewGomegaWithT(t).Eventually(func() *big.Int {
+		return bm.GetEthBalance(k0Addr).ToInt()
+	}).Should(gomega.Equal(big.NewInt(43)))
+
+	gomega.NewGomegaWithT(t).Consistently(func() int32 {
+		return atomic.LoadInt32(&linkCalls)
+	}).Should(gomega.Equal(int32(1)))
+	gethClient.AssertExpectations(t)
+}
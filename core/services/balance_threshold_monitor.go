@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"go.uber.org/multierr"
+)
+
+// BalanceThresholdMonitor checks the balances watched by balancethreshold
+// initiators on every new head, and creates a job run each time a balance
+// crosses its threshold.
+type BalanceThresholdMonitor interface {
+	store.HeadTrackable
+	AddJob(job models.JobSpec) error
+	RemoveJob(ID *models.ID)
+	Stop() error
+}
+
+type balanceThresholdMonitor struct {
+	store       *store.Store
+	runManager  RunManager
+	watches     map[string][]*balanceWatch
+	watchesMtx  sync.RWMutex
+	head        *big.Int
+	headMtx     sync.RWMutex
+	sleeperTask utils.SleeperTask
+}
+
+// balanceWatch tracks whether the balance watched by a single initiator was
+// on the triggering side of its threshold at the last check, so that a run is
+// only created when the threshold is crossed rather than on every head.
+type balanceWatch struct {
+	initiator models.Initiator
+	crossed   bool
+}
+
+// NewBalanceThresholdMonitor returns a new BalanceThresholdMonitor
+func NewBalanceThresholdMonitor(store *store.Store, runManager RunManager) BalanceThresholdMonitor {
+	btm := &balanceThresholdMonitor{
+		store:      store,
+		runManager: runManager,
+		watches:    make(map[string][]*balanceWatch),
+	}
+	btm.sleeperTask = utils.NewSleeperTask(btm)
+	return btm
+}
+
+// AddJob starts watching the balances of each balancethreshold initiator in
+// the job spec. Jobs bound to another chain than the store's are ignored.
+func (btm *balanceThresholdMonitor) AddJob(job models.JobSpec) error {
+	initrs := job.InitiatorsFor(models.InitiatorBalanceThreshold)
+	if len(initrs) == 0 || !btm.store.Config.ServesChain(job.ChainID) {
+		return nil
+	}
+
+	var watches []*balanceWatch
+	for _, initr := range initrs {
+		if initr.BalanceThreshold == nil {
+			return fmt.Errorf("BalanceThresholdMonitor: job %s has no balanceThreshold", job.ID)
+		}
+		watches = append(watches, &balanceWatch{initiator: initr})
+	}
+
+	btm.watchesMtx.Lock()
+	btm.watches[job.ID.String()] = watches
+	btm.watchesMtx.Unlock()
+	return nil
+}
+
+// RemoveJob stops watching the balances for the job.
+func (btm *balanceThresholdMonitor) RemoveJob(ID *models.ID) {
+	btm.watchesMtx.Lock()
+	delete(btm.watches, ID.String())
+	btm.watchesMtx.Unlock()
+}
+
+// Connect complies with HeadTrackable, loading all jobs with a
+// balancethreshold initiator.
+func (btm *balanceThresholdMonitor) Connect(head *models.Head) error {
+	if head != nil {
+		btm.setHead(head.ToInt())
+	}
+	var merr error
+	err := btm.store.Jobs(
+		func(j *models.JobSpec) bool {
+			merr = multierr.Append(merr, btm.AddJob(*j))
+			return true
+		},
+		models.InitiatorBalanceThreshold,
+	)
+	return multierr.Append(merr, err)
+}
+
+// Disconnect complies with HeadTrackable
+func (btm *balanceThresholdMonitor) Disconnect() {}
+
+// OnNewLongestChain checks the watched balances against their thresholds
+func (btm *balanceThresholdMonitor) OnNewLongestChain(_ context.Context, head models.Head) {
+	btm.setHead(head.ToInt())
+	btm.sleeperTask.WakeUp()
+}
+
+// Stop shuts down the BalanceThresholdMonitor, should not be used after this
+func (btm *balanceThresholdMonitor) Stop() error {
+	return btm.sleeperTask.Stop()
+}
+
+func (btm *balanceThresholdMonitor) setHead(head *big.Int) {
+	btm.headMtx.Lock()
+	btm.head = head
+	btm.headMtx.Unlock()
+}
+
+func (btm *balanceThresholdMonitor) getHead() *big.Int {
+	btm.headMtx.RLock()
+	defer btm.headMtx.RUnlock()
+	return btm.head
+}
+
+// Work complies with utils.Worker
+func (btm *balanceThresholdMonitor) Work() {
+	btm.watchesMtx.RLock()
+	var watches []*balanceWatch
+	for _, ws := range btm.watches {
+		watches = append(watches, ws...)
+	}
+	btm.watchesMtx.RUnlock()
+
+	head := btm.getHead()
+	for _, watch := range watches {
+		btm.check(watch, head)
+	}
+}
+
+func (btm *balanceThresholdMonitor) check(watch *balanceWatch, head *big.Int) {
+	initr := watch.initiator
+	balance, err := btm.balanceOf(initr)
+	if err != nil {
+		logger.Errorw("BalanceThresholdMonitor: error getting balance",
+			"error", err,
+			"job", initr.JobSpecID.String(),
+			"address", initr.Address.Hex(),
+			"tokenAddress", initr.TokenAddress.Hex(),
+		)
+		return
+	}
+
+	crossed := initr.BalanceCrossed(balance)
+	if !crossed || watch.crossed {
+		watch.crossed = crossed
+		return
+	}
+
+	logger.Infow(fmt.Sprintf("BalanceThresholdMonitor: balance of %s crossed threshold", initr.Address.Hex()),
+		"job", initr.JobSpecID.String(),
+		"balance", balance.String(),
+		"threshold", initr.BalanceThreshold.String(),
+		"crossing", initr.Crossing,
+	)
+
+	data, err := models.JSON{}.MultiAdd(models.KV{
+		"address":   initr.Address.Hex(),
+		"balance":   balance.String(),
+		"threshold": initr.BalanceThreshold.String(),
+	})
+	if err != nil {
+		logger.Errorw("BalanceThresholdMonitor: error building run request", "error", err)
+		return
+	}
+	runRequest := models.NewRunRequest(data)
+	if _, err := btm.runManager.Create(initr.JobSpecID, &initr, head, runRequest); err != nil {
+		logger.Errorw("BalanceThresholdMonitor: error creating run", "error", err, "job", initr.JobSpecID.String())
+		return
+	}
+	watch.crossed = true
+}
+
+func (btm *balanceThresholdMonitor) balanceOf(initr models.Initiator) (*big.Int, error) {
+	if initr.TokenAddress == utils.ZeroAddress {
+		ctx, cancel := context.WithTimeout(context.Background(), ethFetchTimeout)
+		defer cancel()
+		return btm.store.EthClient.BalanceAt(ctx, initr.Address, nil)
+	}
+	return btm.store.EthClient.GetERC20Balance(initr.Address, initr.TokenAddress)
+}
@@ -0,0 +1,76 @@
+package services_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceThresholdMonitor_OnNewLongestChain(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	gethClient := new(mocks.GethClient)
+	rpcClient := new(mocks.RPCClient)
+	cltest.MockEthOnStore(t, store, eth.NewClientWith(rpcClient, gethClient))
+
+	address := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators[0].Type = models.InitiatorBalanceThreshold
+	job.Initiators[0].Address = address
+	job.Initiators[0].BalanceThreshold = utils.NewBigI(10)
+
+	var balance, checks int64
+	gethClient.On("BalanceAt", mock.Anything, address, nilBigInt).
+		Return(func(context.Context, common.Address, *big.Int) *big.Int {
+			defer atomic.AddInt64(&checks, 1)
+			return big.NewInt(atomic.LoadInt64(&balance))
+		}, nil)
+
+	var created []models.JSON
+	var createdMtx sync.Mutex
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			createdMtx.Lock()
+			defer createdMtx.Unlock()
+			created = append(created, args.Get(3).(*models.RunRequest).RequestParams)
+		})
+
+	monitor := services.NewBalanceThresholdMonitor(store, runManager)
+	defer monitor.Stop()
+	require.NoError(t, monitor.AddJob(job))
+
+	g := gomega.NewGomegaWithT(t)
+	for i, b := range []int64{11, 9, 8, 12, 5, 11} {
+		atomic.StoreInt64(&balance, b)
+		monitor.OnNewLongestChain(context.Background(), *cltest.Head(i))
+		g.Eventually(func() int64 { return atomic.LoadInt64(&checks) }).Should(gomega.Equal(int64(i + 1)))
+	}
+
+	// Runs are only created when the balance drops below the threshold, not
+	// for every head it stays below it.
+	createdMtx.Lock()
+	defer createdMtx.Unlock()
+	require.Len(t, created, 2)
+	assert.Equal(t, "9", created[0].Get("balance").String())
+	assert.Equal(t, "10", created[0].Get("threshold").String())
+	assert.Equal(t, address.Hex(), created[0].Get("address").String())
+	assert.Equal(t, "5", created[1].Get("balance").String())
+}
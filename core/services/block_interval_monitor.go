@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"go.uber.org/multierr"
+)
+
+// BlockIntervalMonitor creates a job run for each blockinterval initiator
+// every given number of blocks, as new heads arrive, with the number and hash
+// of the block which triggered it as the run's params.
+type BlockIntervalMonitor interface {
+	store.HeadTrackable
+	AddJob(job models.JobSpec) error
+	RemoveJob(ID *models.ID)
+	Stop() error
+}
+
+type blockIntervalMonitor struct {
+	store       *store.Store
+	runManager  RunManager
+	watches     map[string][]*blockIntervalWatch
+	watchesMtx  sync.RWMutex
+	head        *models.Head
+	headMtx     sync.RWMutex
+	sleeperTask utils.SleeperTask
+}
+
+// blockIntervalWatch tracks the last block seen by a single initiator, so
+// that a run is created for the first block on or past each interval, even
+// when the head it fell on was skipped.
+type blockIntervalWatch struct {
+	initiator models.Initiator
+	last      *int64
+}
+
+// NewBlockIntervalMonitor returns a new BlockIntervalMonitor
+func NewBlockIntervalMonitor(store *store.Store, runManager RunManager) BlockIntervalMonitor {
+	bim := &blockIntervalMonitor{
+		store:      store,
+		runManager: runManager,
+		watches:    make(map[string][]*blockIntervalWatch),
+	}
+	bim.sleeperTask = utils.NewSleeperTask(bim)
+	return bim
+}
+
+// AddJob starts counting blocks for each blockinterval initiator in the job
+// spec. Jobs bound to another chain than the store's are ignored.
+func (bim *blockIntervalMonitor) AddJob(job models.JobSpec) error {
+	initrs := job.InitiatorsFor(models.InitiatorBlockInterval)
+	if len(initrs) == 0 || !bim.store.Config.ServesChain(job.ChainID) {
+		return nil
+	}
+
+	var watches []*blockIntervalWatch
+	for _, initr := range initrs {
+		if initr.BlockInterval == 0 {
+			return fmt.Errorf("BlockIntervalMonitor: job %s has no blockInterval", job.ID)
+		}
+		watches = append(watches, &blockIntervalWatch{initiator: initr})
+	}
+
+	bim.watchesMtx.Lock()
+	bim.watches[job.ID.String()] = watches
+	bim.watchesMtx.Unlock()
+	return nil
+}
+
+// RemoveJob stops counting blocks for the job.
+func (bim *blockIntervalMonitor) RemoveJob(ID *models.ID) {
+	bim.watchesMtx.Lock()
+	delete(bim.watches, ID.String())
+	bim.watchesMtx.Unlock()
+}
+
+// Connect complies with HeadTrackable, loading all jobs with a blockinterval
+// initiator.
+func (bim *blockIntervalMonitor) Connect(*models.Head) error {
+	var merr error
+	err := bim.store.Jobs(
+		func(j *models.JobSpec) bool {
+			merr = multierr.Append(merr, bim.AddJob(*j))
+			return true
+		},
+		models.InitiatorBlockInterval,
+	)
+	return multierr.Append(merr, err)
+}
+
+// Disconnect complies with HeadTrackable
+func (bim *blockIntervalMonitor) Disconnect() {}
+
+// OnNewLongestChain checks whether each initiator is due to run on the head
+func (bim *blockIntervalMonitor) OnNewLongestChain(_ context.Context, head models.Head) {
+	bim.headMtx.Lock()
+	bim.head = &head
+	bim.headMtx.Unlock()
+	bim.sleeperTask.WakeUp()
+}
+
+// Stop shuts down the BlockIntervalMonitor, should not be used after this
+func (bim *blockIntervalMonitor) Stop() error {
+	return bim.sleeperTask.Stop()
+}
+
+// Work complies with utils.Worker
+func (bim *blockIntervalMonitor) Work() {
+	bim.headMtx.RLock()
+	head := bim.head
+	bim.headMtx.RUnlock()
+	if head == nil {
+		return
+	}
+
+	bim.watchesMtx.RLock()
+	var watches []*blockIntervalWatch
+	for _, ws := range bim.watches {
+		watches = append(watches, ws...)
+	}
+	bim.watchesMtx.RUnlock()
+
+	for _, watch := range watches {
+		bim.check(watch, *head)
+	}
+}
+
+// check creates a run if the initiator is due on the head. After a reorg to
+// a lower block the initiator runs again on the new chain's block on the
+// interval, since the one it ran on may no longer be canonical.
+func (bim *blockIntervalMonitor) check(watch *blockIntervalWatch, head models.Head) {
+	initr := watch.initiator
+	due := initr.BlockIntervalDue(watch.last, head.Number)
+	number := head.Number
+	watch.last = &number
+	if !due {
+		return
+	}
+
+	data, err := models.JSON{}.MultiAdd(models.KV{
+		"blockNumber": head.Number,
+		"blockHash":   head.Hash.Hex(),
+	})
+	if err != nil {
+		logger.Errorw("BlockIntervalMonitor: error building run request", "error", err)
+		return
+	}
+	runRequest := models.NewRunRequest(data)
+	if _, err := bim.runManager.Create(initr.JobSpecID, &initr, head.ToInt(), runRequest); err != nil && !ExpectedRecurringScheduleJobError(err) {
+		logger.Errorw("BlockIntervalMonitor: error creating run", "error", err, "job", initr.JobSpecID.String(), "blockNumber", head.Number)
+	}
+}
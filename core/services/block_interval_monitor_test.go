@@ -0,0 +1,53 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockIntervalMonitor_OnNewLongestChain(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators[0].Type = models.InitiatorBlockInterval
+	job.Initiators[0].BlockInterval = 10
+
+	created := make(chan models.JSON, 2)
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			created <- args.Get(3).(*models.RunRequest).RequestParams
+		}).
+		Twice()
+
+	monitor := services.NewBlockIntervalMonitor(store, runManager)
+	defer monitor.Stop()
+	require.NoError(t, monitor.AddJob(job))
+
+	hash := common.HexToHash("0x10")
+	monitor.OnNewLongestChain(context.Background(), models.Head{Number: 10, Hash: hash})
+	params := <-created
+	assert.Equal(t, int64(10), params.Get("blockNumber").Int())
+	assert.Equal(t, hash.Hex(), params.Get("blockHash").String())
+
+	monitor.OnNewLongestChain(context.Background(), models.Head{Number: 15})
+	monitor.OnNewLongestChain(context.Background(), models.Head{Number: 21})
+	assert.Equal(t, int64(21), (<-created).Get("blockNumber").Int())
+
+	monitor.RemoveJob(job.ID)
+	monitor.OnNewLongestChain(context.Background(), models.Head{Number: 30})
+
+	runManager.AssertExpectations(t)
+}
@@ -0,0 +1,93 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// ErrEthTxNotAbandonable is returned when abandoning an eth_tx which is not
+// waiting to be confirmed, or which is sent on another chain than the store's
+var ErrEthTxNotAbandonable = errors.New("only unconfirmed transactions of the chain can be abandoned")
+
+// AbandonEthTx replaces an unconfirmed eth_tx with an empty self-transfer at
+// the same nonce, priced above its most expensive attempt, so that a nonce
+// held up by a stuck transaction can be cleared. The eth_tx is flagged as
+// abandoned and stays unconfirmed, so that the EthConfirmer keeps bumping the
+// replacement until one of the attempts is mined.
+//
+// The EthConfirmer lock is held while abandoning, so that the eth_tx is not
+// bumped or rebroadcast with its original payload in the meantime.
+func AbandonEthTx(s *store.Store, etxID int64) (attempt models.EthTxAttempt, err error) {
+	err = s.AdvisoryLocker.WithAdvisoryLock(context.TODO(), postgres.AdvisoryLockClassID_EthConfirmer, postgres.AdvisoryLockObjectID_EthConfirmer, func() error {
+		attempt, err = abandonEthTx(s, etxID)
+		return err
+	})
+	return attempt, err
+}
+
+func abandonEthTx(s *store.Store, etxID int64) (models.EthTxAttempt, error) {
+	etx, err := s.FindEthTxWithAttempts(etxID)
+	if err != nil {
+		return models.EthTxAttempt{}, errors.Wrap(err, "AbandonEthTx failed")
+	}
+	if etx.State != models.EthTxUnconfirmed || etx.Nonce == nil || !s.Config.SendsFrom(etx.FromAddress) {
+		return models.EthTxAttempt{}, ErrEthTxNotAbandonable
+	}
+
+	gasPrice := initialGasPrice(s.Config, etx)
+	for _, a := range etx.EthTxAttempts {
+		if a.GasPrice.ToInt().Cmp(gasPrice) > 0 {
+			gasPrice = a.GasPrice.ToInt()
+		}
+	}
+	bumpedGasPrice, err := BumpGas(s.Config, gasPrice)
+	if err != nil {
+		return models.EthTxAttempt{}, errors.Wrap(err, "AbandonEthTx failed")
+	}
+
+	etx.ToAddress = etx.FromAddress
+	etx.EncodedPayload = []byte{}
+	etx.Value = assets.NewEthValue(0)
+	etx.Abandoned = true
+	attempt, err := newAttempt(s, etx, bumpedGasPrice)
+	if err != nil {
+		return attempt, errors.Wrap(err, "AbandonEthTx failed")
+	}
+
+	err = s.Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(`
+			UPDATE eth_txes SET to_address = from_address, encoded_payload = ?, value = 0, abandoned = true
+			WHERE id = ? AND state = 'unconfirmed'
+		`, etx.EncodedPayload, etx.ID)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrEthTxNotAbandonable
+		}
+		return tx.Save(&attempt).Error
+	})
+	if errors.Cause(err) == ErrEthTxNotAbandonable {
+		return attempt, err
+	}
+	if err != nil {
+		return attempt, errors.Wrap(err, "AbandonEthTx failed")
+	}
+
+	txManagerLogger.Infow("BulletproofTxManager: abandoning transaction", "ethTxID", etx.ID, "nonce", *etx.Nonce, "txHash", attempt.Hash, "gasPriceWei", bumpedGasPrice.String())
+
+	// If sending fails the attempt is left in progress, and the EthConfirmer
+	// will send it on the next head
+	if sendError := sendTransaction(context.Background(), s.EthClient, attempt); sendError != nil {
+		txManagerLogger.Warnw("BulletproofTxManager: failed to send replacement of abandoned transaction", "ethTxID", etx.ID, "err", sendError)
+		return attempt, nil
+	}
+	return attempt, errors.Wrap(saveSentAttempt(s.DB, &attempt), "AbandonEthTx failed")
+}
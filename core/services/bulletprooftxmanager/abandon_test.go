@@ -0,0 +1,46 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbandonEthTx_NotUnconfirmed(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	etx := cltest.MustInsertConfirmedEthTxWithAttempt(t, store, 0, 1)
+
+	_, err := bulletprooftxmanager.AbandonEthTx(store, etx.ID)
+	assert.Equal(t, bulletprooftxmanager.ErrEthTxNotAbandonable, err)
+}
+
+func TestAbandonEthTx_Locking(t *testing.T) {
+	advisoryLocker := new(mocks.AdvisoryLocker)
+	store, cleanup := cltest.NewStore(t, advisoryLocker)
+	defer cleanup()
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 0)
+
+	advisoryLocker.On("WithAdvisoryLock", mock.Anything, postgres.AdvisoryLockClassID_EthConfirmer, postgres.AdvisoryLockObjectID_EthConfirmer, mock.AnythingOfType("func() error")).Return(nil)
+
+	_, err := bulletprooftxmanager.AbandonEthTx(store, etx.ID)
+	require.NoError(t, err)
+
+	// Nothing is changed without the lock
+	etx, err = store.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	assert.False(t, etx.Abandoned)
+	assert.Len(t, etx.EthTxAttempts, 1)
+
+	advisoryLocker.AssertExpectations(t)
+	advisoryLocker.On("Close").Return(nil)
+}
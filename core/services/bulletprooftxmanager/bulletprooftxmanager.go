@@ -18,9 +18,19 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+var txManagerLogger = logger.Module(logger.ModuleTxManager)
+
+var promGasPriceCapped = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "tx_manager_gas_price_capped",
+	Help: "Number of times a transaction was held at its gas price instead of being bumped past its ceiling",
+})
+
 // For more information about the BulletproofTxManager architecture, see the design doc:
 // https://www.notion.so/chainlink/BulletproofTxManager-Architecture-Overview-9dc62450cd7a443ba9e7dceffa1a8d6b
 
@@ -30,11 +40,22 @@ const (
 	maxEthNodeRequestTime = 15 * time.Second
 )
 
-// SendEther creates a transaction that transfers the given value of ether
-func SendEther(s *strpkg.Store, from, to gethCommon.Address, value assets.Eth) (etx models.EthTx, err error) {
+// ErrIdempotencyKeyReused is returned by SendEther when the idempotency key
+// was already used to send a different transfer
+var ErrIdempotencyKeyReused = errors.New("idempotency key was already used for a different transfer")
+
+// SendEther creates a transaction that transfers the given value of ether.
+// If an idempotency key is given and a transfer was already created with it,
+// that transfer is returned instead of creating a new one.
+func SendEther(s *strpkg.Store, from, to gethCommon.Address, value assets.Eth, idempotencyKey *string) (etx models.EthTx, err error) {
 	if to == utils.ZeroAddress {
 		return etx, errors.New("cannot send ether to zero address")
 	}
+	if idempotencyKey != nil {
+		if existing, found, err := findTransferByIdempotencyKey(s, *idempotencyKey, from, to, value); found || err != nil {
+			return existing, err
+		}
+	}
 	etx = models.EthTx{
 		FromAddress:    from,
 		ToAddress:      to,
@@ -42,11 +63,30 @@ func SendEther(s *strpkg.Store, from, to gethCommon.Address, value assets.Eth) (
 		Value:          value,
 		GasLimit:       s.Config.EthGasLimitDefault(),
 		State:          models.EthTxUnstarted,
+		IdempotencyKey: idempotencyKey,
 	}
 	err = s.DB.Create(&etx).Error
+	if pqErr, ok := errors.Cause(err).(*pq.Error); ok && idempotencyKey != nil && pqErr.Constraint == "idx_eth_txes_idempotency_key" {
+		// A concurrent request with the same key won the race
+		existing, _, err := findTransferByIdempotencyKey(s, *idempotencyKey, from, to, value)
+		return existing, err
+	}
 	return etx, err
 }
 
+func findTransferByIdempotencyKey(s *strpkg.Store, key string, from, to gethCommon.Address, value assets.Eth) (models.EthTx, bool, error) {
+	etx, err := s.FindEthTxByIdempotencyKey(key)
+	if gorm.IsRecordNotFoundError(err) {
+		return etx, false, nil
+	} else if err != nil {
+		return etx, false, errors.Wrap(err, "findTransferByIdempotencyKey failed")
+	}
+	if etx.FromAddress != from || etx.ToAddress != to || etx.Value.Cmp(&value) != 0 || len(etx.EncodedPayload) != 0 {
+		return etx, true, ErrIdempotencyKeyReused
+	}
+	return etx, true, nil
+}
+
 func newAttempt(s *strpkg.Store, etx models.EthTx, gasPrice *big.Int) (models.EthTxAttempt, error) {
 	attempt := models.EthTxAttempt{}
 	account, err := s.KeyStore.GetAccountByAddress(etx.FromAddress)
@@ -95,10 +135,10 @@ func sendTransaction(ctx context.Context, ethClient eth.Client, a models.EthTxAt
 	err = ethClient.SendTransaction(ctx, signedTx)
 	err = errors.WithStack(err)
 
-	logger.Debugw("BulletproofTxManager: Broadcasting transaction", "ethTxAttemptID", a.ID, "txHash", signedTx.Hash(), "gasPriceWei", a.GasPrice.ToInt().Int64())
+	txManagerLogger.Debugw("BulletproofTxManager: Broadcasting transaction", "ethTxAttemptID", a.ID, "txHash", signedTx.Hash(), "gasPriceWei", a.GasPrice.ToInt().Int64())
 	sendErr := eth.NewSendError(err)
 	if sendErr.IsTransactionAlreadyInMempool() {
-		logger.Debugw("transaction already in mempool", "txHash", signedTx.Hash(), "nodeErr", sendErr.Error())
+		txManagerLogger.Debugw("transaction already in mempool", "txHash", signedTx.Hash(), "nodeErr", sendErr.Error())
 		return nil
 	}
 	return eth.NewSendError(err)
@@ -131,6 +171,23 @@ func sendEmptyTransaction(
 	return signedTx, err
 }
 
+// initialGasPrice returns the gas price of the first attempt of the
+// transaction, which is the gas price set by the job if any, but no more than
+// the job's max gas price. Private chains are always sent a gas price of 0.
+func initialGasPrice(config orm.ConfigReader, etx models.EthTx) *big.Int {
+	if config.EthPrivateChainMode() {
+		return big.NewInt(0)
+	}
+	gasPrice := config.EthGasPriceDefault()
+	if etx.GasPrice != nil {
+		gasPrice = etx.GasPrice.ToInt()
+	}
+	if etx.MaxGasPrice != nil && gasPrice.Cmp(etx.MaxGasPrice.ToInt()) > 0 {
+		return etx.MaxGasPrice.ToInt()
+	}
+	return gasPrice
+}
+
 // BumpGas returns a new gas price increased by the largest of:
 // - A configured percentage bump (ETH_GAS_BUMP_PERCENT)
 // - A configured fixed amount of Wei (ETH_GAS_PRICE_WEI)
@@ -141,6 +198,56 @@ func BumpGas(config orm.ConfigReader, originalGasPrice *big.Int) (*big.Int, erro
 	return strpkg.BumpGas(config, originalGasPrice)
 }
 
+// bumpEthTxGas bumps the gas price of an attempt of etx like BumpGas, but also
+// refuses to go past the max gas price set by the job which created etx. In
+// both cases the cause of the error is strpkg.ErrGasPriceCapped.
+func bumpEthTxGas(config orm.ConfigReader, etx models.EthTx, originalGasPrice *big.Int) (*big.Int, error) {
+	bumpedGasPrice, err := BumpGas(config, originalGasPrice)
+	if err != nil {
+		return bumpedGasPrice, err
+	}
+	if etx.MaxGasPrice != nil && bumpedGasPrice.Cmp(etx.MaxGasPrice.ToInt()) > 0 {
+		return etx.MaxGasPrice.ToInt(), errors.Wrapf(strpkg.ErrGasPriceCapped, "bumped gas price of %s would exceed max gas price of %s set for eth_tx %v (original price was %s)",
+			bumpedGasPrice.String(), etx.MaxGasPrice.String(), etx.ID, originalGasPrice.String())
+	}
+	return bumpedGasPrice, nil
+}
+
+// bumpReplacementGas returns the gas price for a new attempt of etx after the
+// eth node rejected an attempt at rejectedGasPrice as an underpriced
+// replacement. The price is raised by ETH_GAS_BUMP_REPLACEMENT_PERCENT, but by
+// no less than a regular bump, and is subject to the same ceilings.
+func bumpReplacementGas(config orm.ConfigReader, etx models.EthTx, rejectedGasPrice *big.Int) (*big.Int, error) {
+	bumpedGasPrice, err := bumpEthTxGas(config, etx, rejectedGasPrice)
+	if err != nil {
+		return bumpedGasPrice, err
+	}
+	replacementGasPrice := new(big.Int).Mul(rejectedGasPrice, big.NewInt(int64(100+config.EthGasBumpReplacementPercent())))
+	replacementGasPrice.Div(replacementGasPrice, big.NewInt(100))
+	if replacementGasPrice.Cmp(bumpedGasPrice) <= 0 {
+		return bumpedGasPrice, nil
+	}
+	ceiling := config.EthMaxGasPriceWei()
+	if etx.MaxGasPrice != nil && etx.MaxGasPrice.ToInt().Cmp(ceiling) < 0 {
+		ceiling = etx.MaxGasPrice.ToInt()
+	}
+	if replacementGasPrice.Cmp(ceiling) > 0 {
+		return ceiling, nil
+	}
+	return replacementGasPrice, nil
+}
+
+// setGasPriceCapped records whether etx is being held at its gas price
+// ceiling, which the API reports as the gas_price_capped state
+func setGasPriceCapped(db *gorm.DB, etx *models.EthTx, capped bool) error {
+	if etx.GasPriceCapped == capped {
+		return nil
+	}
+	etx.GasPriceCapped = capped
+	err := db.Exec(`UPDATE eth_txes SET gas_price_capped = ? WHERE id = ?`, capped, etx.ID).Error
+	return errors.Wrap(err, "setGasPriceCapped failed")
+}
+
 func saveReplacementInProgressAttempt(store *strpkg.Store, oldAttempt models.EthTxAttempt, replacementAttempt *models.EthTxAttempt) error {
 	if oldAttempt.State != models.EthTxAttemptInProgress || replacementAttempt.State != models.EthTxAttemptInProgress {
 		return errors.New("expected attempts to be in_progress")
@@ -139,7 +139,31 @@ func TestBulletproofTxManager_SendEther_DoesNotSendToZero(t *testing.T) {
 	to := utils.ZeroAddress
 	value := assets.NewEth(1)
 
-	_, err := bulletprooftxmanager.SendEther(store, from, to, *value)
+	_, err := bulletprooftxmanager.SendEther(store, from, to, *value, nil)
 	require.Error(t, err)
 	require.EqualError(t, err, "cannot send ether to zero address")
 }
+
+func TestBulletproofTxManager_SendEther_IdempotencyKey(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	from := cltest.MustInsertRandomKey(t, store).Address.Address()
+	to := cltest.NewAddress()
+	value := assets.NewEth(1)
+	key := "b0b3e4d2-transfer"
+
+	etx, err := bulletprooftxmanager.SendEther(store, from, to, *value, &key)
+	require.NoError(t, err)
+
+	replayed, err := bulletprooftxmanager.SendEther(store, from, to, *value, &key)
+	require.NoError(t, err)
+	require.Equal(t, etx.ID, replayed.ID)
+
+	var count int
+	require.NoError(t, store.DB.Table("eth_txes").Where("idempotency_key = ?", key).Count(&count).Error)
+	require.Equal(t, 1, count)
+
+	_, err = bulletprooftxmanager.SendEther(store, from, cltest.NewAddress(), *value, &key)
+	require.Equal(t, bulletprooftxmanager.ErrIdempotencyKeyReused, err)
+}
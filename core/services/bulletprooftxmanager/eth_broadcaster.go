@@ -8,7 +8,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store"
@@ -85,10 +84,10 @@ func (eb *ethBroadcaster) Start() error {
 	if !eb.OkayToStart() {
 		return errors.New("EthBroadcaster is already started")
 	} else if !eb.config.EnableBulletproofTxManager() {
-		logger.Info("BulletproofTxManager: Disabled, falling back to legacy TxManager")
+		txManagerLogger.Info("BulletproofTxManager: Disabled, falling back to legacy TxManager")
 		return nil
 	}
-	logger.Info("BulletproofTxManager: Enabled")
+	txManagerLogger.Info("BulletproofTxManager: Enabled")
 
 	var err error
 	eb.ethTxInsertListener, err = eb.eventBroadcaster.Subscribe(postgres.ChannelInsertOnEthTx, "")
@@ -149,7 +148,7 @@ func (eb *ethBroadcaster) monitorEthTxs() {
 		keys, err := eb.store.SendKeys()
 
 		if err != nil {
-			logger.Error(errors.Wrap(err, "monitorEthTxs failed getting key"))
+			txManagerLogger.Error(errors.Wrap(err, "monitorEthTxs failed getting key"))
 		} else {
 			var wg sync.WaitGroup
 
@@ -159,7 +158,7 @@ func (eb *ethBroadcaster) monitorEthTxs() {
 			for _, key := range keys {
 				go func(k models.Key) {
 					if err := eb.ProcessUnstartedEthTxs(k); err != nil {
-						logger.Errorw("Error in ProcessUnstartedEthTxs", "error", err)
+						txManagerLogger.Errorw("Error in ProcessUnstartedEthTxs", "error", err)
 					}
 
 					wg.Done()
@@ -202,7 +201,7 @@ func (eb *ethBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 	mark := time.Now()
 	defer func() {
 		if n > 0 {
-			logger.Debugw("EthBroadcaster: finished processUnstartedEthTxs", "address", fromAddress, "time", time.Since(mark), "n", n, "id", "eth_broadcaster")
+			txManagerLogger.Debugw("EthBroadcaster: finished processUnstartedEthTxs", "address", fromAddress, "time", time.Since(mark), "n", n, "id", "eth_broadcaster")
 		}
 	}()
 
@@ -219,7 +218,7 @@ func (eb *ethBroadcaster) processUnstartedEthTxs(fromAddress gethCommon.Address)
 			return nil
 		}
 		n++
-		a, err := newAttempt(eb.store, *etx, eb.config.EthGasPriceDefault())
+		a, err := newAttempt(eb.store, *etx, initialGasPrice(eb.config, *etx))
 		if err != nil {
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
@@ -331,7 +330,7 @@ func (eb *ethBroadcaster) handleInProgressEthTx(etx models.EthTx, attempt models
 		// success (even though the transaction will never confirm) and hand
 		// off to the ethConfirmer to bump gas periodically until we _can_ get
 		// it in
-		logger.Infow("EthBroadcaster: Transaction temporarily underpriced", "ethTxID", etx.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPrice.String())
+		txManagerLogger.Infow("EthBroadcaster: Transaction temporarily underpriced", "ethTxID", etx.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPrice.String())
 		sendError = nil
 	}
 
@@ -381,11 +380,12 @@ func (eb *ethBroadcaster) saveInProgressTransaction(etx *models.EthTx, attempt *
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
+// Finds the highest priority transaction that has yet to be broadcast from the
+// given address, earliest saved first among transactions of equal priority
 func findNextUnstartedTransactionFromAddress(db *gorm.DB, etx *models.EthTx, fromAddress gethCommon.Address) error {
 	return db.
 		Where("from_address = ? AND state = 'unstarted'", fromAddress).
-		Order("value ASC, created_at ASC, id ASC").
+		Order("priority DESC, value ASC, created_at ASC, id ASC").
 		First(etx).
 		Error
 }
@@ -397,7 +397,7 @@ func saveUnconfirmed(store *store.Store, etx *models.EthTx, attempt models.EthTx
 	if attempt.State != models.EthTxAttemptInProgress {
 		return errors.New("attempt must be in in_progress state")
 	}
-	logger.Debugw("EthBroadcaster: successfully broadcast transaction", "ethTxID", etx.ID, "txHash", attempt.Hash.Hex())
+	txManagerLogger.Debugw("EthBroadcaster: successfully broadcast transaction", "ethTxID", etx.ID, "txHash", attempt.Hash.Hex())
 	etx.State = models.EthTxUnconfirmed
 	attempt.State = models.EthTxAttemptBroadcast
 	return store.Transaction(func(tx *gorm.DB) error {
@@ -420,11 +420,11 @@ func saveUnconfirmed(store *store.Store, etx *models.EthTx, attempt models.EthTx
 }
 
 func (eb *ethBroadcaster) tryAgainWithHigherGasPrice(sendError *eth.SendError, etx models.EthTx, attempt models.EthTxAttempt, initialBroadcastAt time.Time) error {
-	bumpedGasPrice, err := BumpGas(eb.config, attempt.GasPrice.ToInt())
+	bumpedGasPrice, err := bumpEthTxGas(eb.config, etx, attempt.GasPrice.ToInt())
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithHigherGasPrice failed")
 	}
-	logger.Errorw(fmt.Sprintf("default gas price %v wei was rejected by the eth node for being too low. "+
+	txManagerLogger.Errorw(fmt.Sprintf("default gas price %v wei was rejected by the eth node for being too low. "+
 		"Eth node returned: '%s'. "+
 		"Bumping to %v wei and retrying. ACTION REQUIRED: This is a configuration error. "+
 		"Consider increasing ETH_GAS_PRICE_DEFAULT", eb.config.EthGasPriceDefault(), sendError.Error(), bumpedGasPrice), "err", err)
@@ -449,7 +449,7 @@ func saveFatallyErroredTransaction(store *store.Store, etx *models.EthTx) error
 	if etx.Error == nil {
 		return errors.New("expected error field to be set")
 	}
-	logger.Errorw("EthBroadcaster: fatal error sending transaction", "ethTxID", etx.ID, "error", *etx.Error)
+	txManagerLogger.Errorw("EthBroadcaster: fatal error sending transaction", "ethTxID", etx.ID, "error", *etx.Error)
 	etx.Nonce = nil
 	etx.State = models.EthTxFatalError
 	return store.Transaction(func(tx *gorm.DB) error {
@@ -485,7 +485,7 @@ func (eb *ethBroadcaster) getNextNonceWithInitialLoad(address gethCommon.Address
 }
 
 func (eb *ethBroadcaster) loadAndSaveNonce(address gethCommon.Address) (int64, error) {
-	logger.Debugw("EthBroadcaster: loading next nonce from eth node", "address", address.Hex())
+	txManagerLogger.Debugw("EthBroadcaster: loading next nonce from eth node", "address", address.Hex())
 	nonce, err := eb.loadInitialNonceFromEthClient(address)
 	if err != nil {
 		return 0, errors.Wrap(err, "GetNextNonce failed to loadInitialNonceFromEthClient")
@@ -498,10 +498,10 @@ func (eb *ethBroadcaster) loadAndSaveNonce(address gethCommon.Address) (int64, e
 		return 0, errors.Errorf("GetNextNonce optimistic locking failed; someone else modified key %s", address.Hex())
 	}
 	if nonce == 0 {
-		logger.Infow(fmt.Sprintf("EthBroadcaster: first use of address %s, starting from nonce 0",
+		txManagerLogger.Infow(fmt.Sprintf("EthBroadcaster: first use of address %s, starting from nonce 0",
 			address.Hex()), "address", address.Hex(), "nextNonce", nonce)
 	} else {
-		logger.Warnw(fmt.Sprintf("EthBroadcaster: address %s has been used before. Starting from nonce %v."+
+		txManagerLogger.Warnw(fmt.Sprintf("EthBroadcaster: address %s has been used before. Starting from nonce %v."+
 			" Please note that using the chainlink keys with an external wallet is NOT SUPPORTED and can lead to missed or stuck transactions.",
 			address.Hex(), nonce),
 			"address", address.Hex(), "nextNonce", nonce)
@@ -1,6 +1,7 @@
 package bulletprooftxmanager_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
@@ -244,6 +246,109 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_GasPriceOverride(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.KeyStore.Unlock(cltest.Password)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, store, config)
+	defer cleanup()
+
+	keys, err := store.SendKeys()
+	require.NoError(t, err)
+	key := keys[0]
+
+	gasPrice := big.NewInt(142000000000)
+	require.NotEqual(t, config.EthGasPriceDefault(), gasPrice)
+
+	etx := models.EthTx{
+		FromAddress:    key.Address.Address(),
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       uint64(242),
+		GasPrice:       utils.NewBig(gasPrice),
+		State:          models.EthTxUnstarted,
+	}
+	require.NoError(t, store.DB.Save(&etx).Error)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.GasPrice().Cmp(gasPrice) == 0
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+	etx, err = store.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	require.Len(t, etx.EthTxAttempts, 1)
+	assert.Equal(t, gasPrice.String(), etx.EthTxAttempts[0].GasPrice.String())
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Priority(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.KeyStore.Unlock(cltest.Password)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	eb, cleanup := cltest.NewEthBroadcaster(t, store, config)
+	defer cleanup()
+
+	keys, err := store.SendKeys()
+	require.NoError(t, err)
+	key := keys[0]
+
+	lowPriorityEthTx := models.EthTx{
+		FromAddress:    key.Address.Address(),
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       uint64(242),
+		CreatedAt:      time.Unix(0, 0),
+		State:          models.EthTxUnstarted,
+	}
+	require.NoError(t, store.DB.Save(&lowPriorityEthTx).Error)
+	highPriorityEthTx := models.EthTx{
+		FromAddress:    key.Address.Address(),
+		ToAddress:      cltest.NewAddress(),
+		EncodedPayload: []byte{4, 5, 6},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       uint64(242),
+		CreatedAt:      time.Unix(0, 1),
+		State:          models.EthTxUnstarted,
+		Priority:       10,
+	}
+	require.NoError(t, store.DB.Save(&highPriorityEthTx).Error)
+
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(0) && bytes.Equal(tx.Data(), highPriorityEthTx.EncodedPayload)
+	})).Return(nil).Once()
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == uint64(1) && bytes.Equal(tx.Data(), lowPriorityEthTx.EncodedPayload)
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(key))
+
+	highPriorityEthTx, err = store.FindEthTxWithAttempts(highPriorityEthTx.ID)
+	require.NoError(t, err)
+	require.NotNil(t, highPriorityEthTx.Nonce)
+	assert.Equal(t, int64(0), *highPriorityEthTx.Nonce)
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthBroadcaster_AssignsNonceOnFirstRun(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
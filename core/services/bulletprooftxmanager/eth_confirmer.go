@@ -8,7 +8,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
@@ -19,6 +18,7 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -65,7 +65,7 @@ func (ec *ethConfirmer) Disconnect() {
 func (ec *ethConfirmer) OnNewLongestChain(ctx context.Context, head models.Head) {
 	if ec.config.EnableBulletproofTxManager() {
 		if err := ec.ProcessHead(ctx, head); err != nil {
-			logger.Errorw("EthConfirmer error", "err", err)
+			txManagerLogger.Errorw("EthConfirmer error", "err", err)
 		}
 	}
 }
@@ -89,7 +89,7 @@ func (ec *ethConfirmer) processHead(ctx context.Context, head models.Head) error
 		return errors.Wrap(err, "CheckForReceipts failed")
 	}
 
-	logger.Debugw("EthConfirmer: finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	txManagerLogger.Debugw("EthConfirmer: finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
 	keys, err := ec.store.SendKeys()
@@ -100,11 +100,11 @@ func (ec *ethConfirmer) processHead(ctx context.Context, head models.Head) error
 		return errors.Wrap(err, "BumpGasWhereNecessary failed")
 	}
 
-	logger.Debugw("EthConfirmer: finished BumpGasWhereNecessary", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	txManagerLogger.Debugw("EthConfirmer: finished BumpGasWhereNecessary", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
 	defer func() {
-		logger.Debugw("EthConfirmer: finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+		txManagerLogger.Debugw("EthConfirmer: finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	}()
 
 	return errors.Wrap(ec.EnsureConfirmedTransactionsInLongestChain(ctx, keys, head), "EnsureConfirmedTransactionsInLongestChain failed")
@@ -112,7 +112,8 @@ func (ec *ethConfirmer) processHead(ctx context.Context, head models.Head) error
 
 func (ec *ethConfirmer) SetBroadcastBeforeBlockNum(blockNum int64) error {
 	return ec.store.DB.Exec(
-		`UPDATE eth_tx_attempts SET broadcast_before_block_num = ? WHERE broadcast_before_block_num IS NULL AND state = 'broadcast'`,
+		`UPDATE eth_tx_attempts SET broadcast_before_block_num = ? WHERE broadcast_before_block_num IS NULL AND state = 'broadcast'
+		AND eth_tx_id IN (SELECT id FROM eth_txes WHERE `+ec.store.FromChainKeysCondition("eth_txes")+`)`,
 		blockNum,
 	).Error
 }
@@ -130,9 +131,13 @@ func (ec *ethConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) er
 		return nil
 	}
 
-	logger.Debugf("EthConfirmer: fetching receipt for %v transactions", len(etxs))
+	txManagerLogger.Debugf("EthConfirmer: fetching receipt for %v transactions", len(etxs))
 
-	ec.concurrentlyFetchReceipts(ctx, etxs)
+	if batchSize := ec.config.EthReceiptFetchBatchSize(); batchSize > 0 {
+		ec.batchFetchReceipts(ctx, etxs, int(batchSize))
+	} else {
+		ec.concurrentlyFetchReceipts(ctx, etxs)
+	}
 
 	if err := ec.markConfirmedMissingReceipt(ctx); err != nil {
 		return errors.Wrap(err, "unable to mark eth_txes as 'confirmed_missing_receipt'")
@@ -150,6 +155,7 @@ func (ec *ethConfirmer) findEthTxsRequiringReceiptFetch() (etxs []models.EthTx,
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
 			return db.Order("eth_tx_attempts.gas_price DESC")
 		}).
+		Where(ec.store.FromChainKeysCondition("eth_txes")).
 		Order("nonce ASC").
 		Find(&etxs, "state IN ('unconfirmed', 'confirmed_missing_receipt')").Error
 
@@ -178,32 +184,92 @@ func (ec *ethConfirmer) fetchReceipts(ctx context.Context, chEthTxes <-chan mode
 		if !ok {
 			return
 		}
-		for _, attempt := range etx.EthTxAttempts {
-			// NOTE: This could conceivably be optimised even further at the
-			// expense of slightly higher load for the remote eth node, by
-			// batch requesting all receipts at once
-			receipt, err := ec.fetchReceipt(ctx, attempt.Hash)
-			if eth.IsParityQueriedReceiptTooEarly(err) || (receipt != nil && receipt.BlockNumber == nil) {
-				logger.Debugw("EthConfirmer#fetchReceipts: got receipt for transaction but it's still in the mempool and not included in a block yet", "txHash", attempt.Hash.Hex())
-				break
-			} else if err != nil {
-				logger.Errorw("EthConfirmer#fetchReceipts: fetchReceipt failed", "txHash", attempt.Hash.Hex(), "err", err)
+		ec.checkAttemptsForReceipt(etx, func(attempt models.EthTxAttempt) (*gethTypes.Receipt, error) {
+			return ec.fetchReceipt(ctx, attempt.Hash)
+		})
+	}
+}
+
+// batchFetchReceipts requests the receipts of all attempts of etxs at once,
+// in JSON-RPC batches of up to batchSize calls, instead of making one request
+// per attempt
+func (ec *ethConfirmer) batchFetchReceipts(ctx context.Context, etxs []models.EthTx, batchSize int) {
+	var attempts []models.EthTxAttempt
+	for _, etx := range etxs {
+		attempts = append(attempts, etx.EthTxAttempts...)
+	}
+
+	receipts := make(map[gethCommon.Hash]*gethTypes.Receipt)
+	fetchErrors := make(map[gethCommon.Hash]error)
+	for i := 0; i < len(attempts); i += batchSize {
+		j := i + batchSize
+		if j > len(attempts) {
+			j = len(attempts)
+		}
+		batch := attempts[i:j]
+
+		reqs := make([]rpc.BatchElem, len(batch))
+		for k, attempt := range batch {
+			reqs[k] = rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []interface{}{attempt.Hash},
+				Result: new(*gethTypes.Receipt),
+			}
+		}
+
+		err := ec.batchFetchReceiptsWithTimeout(ctx, reqs)
+		for k, attempt := range batch {
+			if err != nil {
+				fetchErrors[attempt.Hash] = err
+			} else if reqs[k].Error != nil {
+				fetchErrors[attempt.Hash] = reqs[k].Error
+			} else {
+				// A null result, meaning the transaction was not mined, leaves
+				// a nil receipt
+				receipts[attempt.Hash] = *reqs[k].Result.(**gethTypes.Receipt)
+			}
+		}
+	}
+
+	for _, etx := range etxs {
+		ec.checkAttemptsForReceipt(etx, func(attempt models.EthTxAttempt) (*gethTypes.Receipt, error) {
+			return receipts[attempt.Hash], fetchErrors[attempt.Hash]
+		})
+	}
+}
+
+func (ec *ethConfirmer) batchFetchReceiptsWithTimeout(ctx context.Context, reqs []rpc.BatchElem) error {
+	ctx, cancel := context.WithTimeout(ctx, maxEthNodeRequestTime)
+	defer cancel()
+	return errors.Wrap(ec.ethClient.BatchCallContext(ctx, reqs), "EthConfirmer#batchFetchReceipts failed")
+}
+
+// checkAttemptsForReceipt goes through the attempts of etx from the highest
+// gas price down, and saves the first receipt of a mined attempt that
+// fetchReceipt returns
+func (ec *ethConfirmer) checkAttemptsForReceipt(etx models.EthTx, fetchReceipt func(models.EthTxAttempt) (*gethTypes.Receipt, error)) {
+	for _, attempt := range etx.EthTxAttempts {
+		receipt, err := fetchReceipt(attempt)
+		if eth.IsParityQueriedReceiptTooEarly(err) || (receipt != nil && receipt.BlockNumber == nil) {
+			txManagerLogger.Debugw("EthConfirmer#fetchReceipts: got receipt for transaction but it's still in the mempool and not included in a block yet", "txHash", attempt.Hash.Hex())
+			break
+		} else if err != nil {
+			txManagerLogger.Errorw("EthConfirmer#fetchReceipts: fetchReceipt failed", "txHash", attempt.Hash.Hex(), "err", err)
+			break
+		}
+		if receipt != nil {
+			txManagerLogger.Debugw("EthConfirmer#fetchReceipts: got receipt for transaction", "txHash", attempt.Hash.Hex(), "blockNumber", receipt.BlockNumber)
+			if receipt.TxHash != attempt.Hash {
+				txManagerLogger.Errorf("EthConfirmer#fetchReceipts: invariant violation, expected receipt with hash %s to have same hash as attempt with hash %s", receipt.TxHash.Hex(), attempt.Hash.Hex())
 				break
 			}
-			if receipt != nil {
-				logger.Debugw("EthConfirmer#fetchReceipts: got receipt for transaction", "txHash", attempt.Hash.Hex(), "blockNumber", receipt.BlockNumber)
-				if receipt.TxHash != attempt.Hash {
-					logger.Errorf("EthConfirmer#fetchReceipts: invariant violation, expected receipt with hash %s to have same hash as attempt with hash %s", receipt.TxHash.Hex(), attempt.Hash.Hex())
-					break
-				}
-				if err := ec.saveReceipt(*receipt, etx.ID); err != nil {
-					logger.Errorw("EthConfirmer#fetchReceipts: saveReceipt failed", "err", err)
-					break
-				}
+			if err := ec.saveReceipt(*receipt, etx.ID); err != nil {
+				txManagerLogger.Errorw("EthConfirmer#fetchReceipts: saveReceipt failed", "err", err)
 				break
-			} else {
-				logger.Debugw("EthConfirmer#fetchReceipts: still waiting for receipt", "txHash", attempt.Hash.Hex(), "ethTxAttemptID", attempt.ID, "ethTxID", etx.ID)
 			}
+			break
+		} else {
+			txManagerLogger.Debugw("EthConfirmer#fetchReceipts: still waiting for receipt", "txHash", attempt.Hash.Hex(), "ethTxAttemptID", attempt.ID, "ethTxID", etx.ID)
 		}
 	}
 }
@@ -267,9 +333,11 @@ func (ec *ethConfirmer) markConfirmedMissingReceipt(ctx context.Context) (err er
 UPDATE eth_txes
 SET state = 'confirmed_missing_receipt'
 WHERE state = 'unconfirmed'
+AND `+ec.store.FromChainKeysCondition("eth_txes")+`
 AND nonce < (
 	SELECT MAX(nonce) FROM eth_txes
 	WHERE state = 'confirmed'
+	AND `+ec.store.FromChainKeysCondition("eth_txes")+`
 )
 	`)
 	return
@@ -297,6 +365,7 @@ WHERE id IN (
 	SELECT eth_txes.id FROM eth_txes
 	INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id
 	WHERE eth_txes.state = 'confirmed_missing_receipt'
+	AND `+ec.store.FromChainKeysCondition("eth_txes")+`
 	GROUP BY eth_txes.id
 	HAVING max(eth_tx_attempts.broadcast_before_block_num) < $2
 )
@@ -314,7 +383,7 @@ RETURNING id, nonce, from_address`, ErrCouldNotGetReceipt, cutoff)
 			return errors.Wrap(err, "error scanning row")
 		}
 
-		logger.Errorf("EthConfirmer: eth_tx with ID %v expired without ever getting a receipt for any of our attempts. "+
+		txManagerLogger.Errorf("EthConfirmer: eth_tx with ID %v expired without ever getting a receipt for any of our attempts. "+
 			"Current block height is %v. This transaction has not been sent and will be marked as fatally errored. "+
 			"This can happen if an external wallet has been used to send a transaction from account %s with nonce %v."+
 			" Please note that using the chainlink keys with an external wallet is NOT SUPPORTED and WILL lead to missed transactions",
@@ -338,7 +407,7 @@ func (ec *ethConfirmer) BumpGasWhereNecessary(ctx context.Context, keys []models
 				errMu.Lock()
 				errors = append(errors, err)
 				errMu.Unlock()
-				logger.Errorw("Error in BumpGasWhereNecessary", "error", err, "fromAddress", fromAddress)
+				txManagerLogger.Errorw("Error in BumpGasWhereNecessary", "error", err, "fromAddress", fromAddress)
 			}
 
 			wg.Done()
@@ -355,6 +424,11 @@ func (ec *ethConfirmer) bumpGasWhereNecessary(ctx context.Context, address gethC
 		return errors.Wrap(err, "handleAnyInProgressAttempts failed")
 	}
 
+	if ec.config.EthPrivateChainMode() {
+		// Gas is not priced, so bumping would not get anything mined sooner
+		return nil
+	}
+
 	threshold := int64(ec.config.EthGasBumpThreshold())
 	depth := int64(ec.config.EthGasBumpTxDepth())
 	etxs, err := FindEthTxsRequiringNewAttempt(ec.store.DB, address, blockHeight, threshold, depth)
@@ -362,7 +436,7 @@ func (ec *ethConfirmer) bumpGasWhereNecessary(ctx context.Context, address gethC
 		return errors.Wrap(err, "FindEthTxsRequiringNewAttempt failed")
 	}
 	if len(etxs) > 0 {
-		logger.Debugf("EthConfirmer: Bumping gas for %v transactions", len(etxs))
+		txManagerLogger.Debugf("EthConfirmer: Bumping gas for %v transactions", len(etxs))
 	}
 	for _, etx := range etxs {
 		attempt, err := ec.newAttemptWithGasBump(etx)
@@ -442,20 +516,35 @@ func (ec *ethConfirmer) newAttemptWithGasBump(etx models.EthTx) (attempt models.
 			return previousAttempt, nil
 		}
 		previousGasPrice := previousAttempt.GasPrice
-		bumpedGasPrice, err = BumpGas(ec.config, previousGasPrice.ToInt())
+		bumpedGasPrice, err = bumpEthTxGas(ec.config, etx, previousGasPrice.ToInt())
+		if errors.Cause(err) == store.ErrGasPriceCapped {
+			promGasPriceCapped.Inc()
+			txManagerLogger.Warnw("EthConfirmer: holding transaction at its gas price ceiling. "+
+				"ACTION REQUIRED: Raise ETH_MAX_GAS_PRICE_WEI or the job's maxGasPrice if this transaction should be mined",
+				"err", err, "etxID", etx.ID, "originalGasPrice", previousGasPrice.String())
+			if err = setGasPriceCapped(ec.store.DB, &etx, true); err != nil {
+				return attempt, err
+			}
+			previousAttempt.BroadcastBeforeBlockNum = nil
+			previousAttempt.State = models.EthTxAttemptInProgress
+			return previousAttempt, nil
+		}
 		if err != nil {
-			logger.Errorw("Failed to bump gas", "err", err, "etxID", etx.ID, "txHash", attempt.Hash, "originalGasPrice", previousGasPrice.String(), "maxGasPrice", ec.config.EthMaxGasPriceWei())
+			txManagerLogger.Errorw("Failed to bump gas", "err", err, "etxID", etx.ID, "txHash", attempt.Hash, "originalGasPrice", previousGasPrice.String(), "maxGasPrice", ec.config.EthMaxGasPriceWei())
 			// Do not create a new attempt if bumping gas would put us over the limit or cause some other problem
 			// Instead try to resubmit the previous attempt, and keep resubmitting until its accepted
 			previousAttempt.BroadcastBeforeBlockNum = nil
 			previousAttempt.State = models.EthTxAttemptInProgress
 			return previousAttempt, nil
 		}
+		if err = setGasPriceCapped(ec.store.DB, &etx, false); err != nil {
+			return attempt, err
+		}
 	} else {
-		logger.Errorf("invariant violation: EthTx %v was unconfirmed but didn't have any attempts. "+
+		txManagerLogger.Errorf("invariant violation: EthTx %v was unconfirmed but didn't have any attempts. "+
 			"Falling back to default gas price instead."+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", etx.ID)
-		bumpedGasPrice = ec.config.EthGasPriceDefault()
+		bumpedGasPrice = initialGasPrice(ec.config, etx)
 	}
 	return newAttempt(ec.store, etx, bumpedGasPrice)
 }
@@ -479,11 +568,11 @@ func (ec *ethConfirmer) handleInProgressAttempt(ctx context.Context, etx models.
 		// already bumped above the required minimum in ethBroadcaster.
 		//
 		// It could conceivably happen if the remote eth node changed it's configuration.
-		bumpedGasPrice, err := BumpGas(ec.config, attempt.GasPrice.ToInt())
+		bumpedGasPrice, err := bumpEthTxGas(ec.config, etx, attempt.GasPrice.ToInt())
 		if err != nil {
 			return errors.Wrap(err, "could not bump gas for terminally underpriced transaction")
 		}
-		logger.Errorf("gas price %v wei was rejected by the eth node for being too low. "+
+		txManagerLogger.Errorf("gas price %v wei was rejected by the eth node for being too low. "+
 			"Eth node returned: '%s'. "+
 			"Bumping to %v wei and retrying. "+
 			"ACTION REQUIRED: You should consider increasing ETH_GAS_PRICE_DEFAULT", attempt.GasPrice, sendError.Error(), bumpedGasPrice)
@@ -505,7 +594,7 @@ func (ec *ethConfirmer) handleInProgressAttempt(ctx context.Context, etx models.
 		// In that case, the safest thing to do is to pretend the transaction
 		// was accepted and continue the normal gas bumping cycle until we can
 		// get it into the mempool
-		logger.Infow("EthConfirmer: Transaction temporarily underpriced", "ethTxID", etx.ID, "attemptID", attempt.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPrice.String())
+		txManagerLogger.Infow("EthConfirmer: Transaction temporarily underpriced", "ethTxID", etx.ID, "attemptID", attempt.ID, "err", sendError.Error(), "gasPriceWei", attempt.GasPrice.String())
 		sendError = nil
 	}
 
@@ -517,7 +606,7 @@ func (ec *ethConfirmer) handleInProgressAttempt(ctx context.Context, etx models.
 		//
 		// The only scenario imaginable where this might take place is if
 		// geth/parity have been updated between broadcasting and confirming steps.
-		logger.Errorw("invariant violation: fatal error while re-attempting transaction",
+		txManagerLogger.Errorw("invariant violation: fatal error while re-attempting transaction",
 			"ethTxID", etx.ID,
 			"err", sendError,
 			"signedRawTx", hexutil.Encode(attempt.SignedRawTx),
@@ -543,10 +632,31 @@ func (ec *ethConfirmer) handleInProgressAttempt(ctx context.Context, etx models.
 		// 2. An external wallet used the account to manually send a transaction
 		// at a higher gas price
 		//
-		// In this case the simplest and most robust way to recover is to ignore
+		// If ETH_GAS_BUMP_REPLACEMENT_PERCENT is set, we try again straight
+		// away at a higher price, until the eth node accepts it or we reach
+		// the gas price ceiling.
+		//
+		// Otherwise the simplest and most robust way to recover is to ignore
 		// this attempt and wait until the next bump threshold is reached in
 		// order to bump again.
-		logger.Errorw(fmt.Sprintf("EthConfirmer: replacement transaction underpriced at %v wei for eth_tx %v. "+
+		if ec.config.EthGasBumpReplacementPercent() > 0 {
+			bumpedGasPrice, err := bumpReplacementGas(ec.config, etx, attempt.GasPrice.ToInt())
+			if err == nil {
+				txManagerLogger.Warnw("EthConfirmer: replacement transaction underpriced, retrying at a higher gas price",
+					"ethTxID", etx.ID, "attemptID", attempt.ID, "err", sendError.Error(),
+					"gasPriceWei", attempt.GasPrice.String(), "bumpedGasPriceWei", bumpedGasPrice.String())
+				replacementAttempt, err := newAttempt(ec.store, etx, bumpedGasPrice)
+				if err != nil {
+					return errors.Wrap(err, "newAttempt failed")
+				}
+				if err := saveReplacementInProgressAttempt(ec.store, attempt, &replacementAttempt); err != nil {
+					return errors.Wrap(err, "saveReplacementInProgressAttempt failed")
+				}
+				return ec.handleInProgressAttempt(ctx, etx, replacementAttempt, blockHeight)
+			}
+			txManagerLogger.Warnw("EthConfirmer: could not bump gas for replacement transaction", "ethTxID", etx.ID, "err", err)
+		}
+		txManagerLogger.Errorw(fmt.Sprintf("EthConfirmer: replacement transaction underpriced at %v wei for eth_tx %v. "+
 			"Eth node returned error: '%s'. "+
 			"Either you have set ETH_GAS_BUMP_PERCENT (currently %v%%) too low or an external wallet used this account. "+
 			"Please note that using your node's private keys outside of the chainlink node is NOT SUPPORTED and can lead to missed transactions.",
@@ -557,7 +667,7 @@ func (ec *ethConfirmer) handleInProgressAttempt(ctx context.Context, etx models.
 	}
 
 	if sendError.IsInsufficientEth() {
-		logger.Errorw(fmt.Sprintf("EthConfirmer: EthTxAttempt %v (hash 0x%x) at gas price (%s Wei) was rejected due to insufficient eth. "+
+		txManagerLogger.Errorw(fmt.Sprintf("EthConfirmer: EthTxAttempt %v (hash 0x%x) at gas price (%s Wei) was rejected due to insufficient eth. "+
 			"The eth node returned %s. "+
 			"ACTION REQUIRED: Chainlink wallet with address 0x%x is OUT OF FUNDS",
 			attempt.ID, attempt.Hash, attempt.GasPrice.String(), sendError.Error(), etx.FromAddress,
@@ -610,7 +720,7 @@ func saveInsufficientEthAttempt(db *gorm.DB, attempt *models.EthTxAttempt) error
 // If any of the confirmed transactions does not have a receipt in the chain, it has been
 // re-org'd out and will be rebroadcast.
 func (ec *ethConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Context, keys []models.Key, head models.Head) error {
-	etxs, err := findTransactionsConfirmedAtOrAboveBlockHeight(ec.store.DB, head.EarliestInChain().Number)
+	etxs, err := findTransactionsConfirmedAtOrAboveBlockHeight(ec.store.DB, ec.store.FromChainKeysCondition("eth_txes"), head.EarliestInChain().Number)
 	if err != nil {
 		return errors.Wrap(err, "findTransactionsConfirmedAtOrAboveBlockHeight failed")
 	}
@@ -635,7 +745,7 @@ func (ec *ethConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Co
 				errMu.Lock()
 				errors = append(errors, err)
 				errMu.Unlock()
-				logger.Errorw("Error in BumpGasWhereNecessary", "error", err, "fromAddress", fromAddress)
+				txManagerLogger.Errorw("Error in BumpGasWhereNecessary", "error", err, "fromAddress", fromAddress)
 			}
 
 			wg.Done()
@@ -647,7 +757,7 @@ func (ec *ethConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Co
 	return multierr.Combine(errors...)
 }
 
-func findTransactionsConfirmedAtOrAboveBlockHeight(db *gorm.DB, blockNumber int64) ([]models.EthTx, error) {
+func findTransactionsConfirmedAtOrAboveBlockHeight(db *gorm.DB, fromChainKeys string, blockNumber int64) ([]models.EthTx, error) {
 	var etxs []models.EthTx
 	err := db.
 		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
@@ -658,6 +768,7 @@ func findTransactionsConfirmedAtOrAboveBlockHeight(db *gorm.DB, blockNumber int6
 		Joins("INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash").
 		Order("nonce ASC").
 		Where("eth_txes.state IN ('confirmed', 'confirmed_missing_receipt') AND block_number >= ?", blockNumber).
+		Where(fromChainKeys).
 		Find(&etxs).Error
 	return etxs, errors.Wrap(err, "findTransactionsConfirmedAtOrAboveBlockHeight failed")
 }
@@ -730,7 +841,7 @@ func unbroadcastAttempt(db *gorm.DB, attempt models.EthTxAttempt) error {
 // Deliberately does not take the advisory lock (we don't write to the database so this is safe from a data integrity perspective).
 // This is in case of some unforeseen scenario where the node is refusing to release the lock. KISS.
 func (ec *ethConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint, gasPriceWei uint64, address gethCommon.Address, overrideGasLimit uint64) error {
-	logger.Infof("ForceRebroadcast: will rebroadcast transactions for all nonces between %v and %v", beginningNonce, endingNonce)
+	txManagerLogger.Infof("ForceRebroadcast: will rebroadcast transactions for all nonces between %v and %v", beginningNonce, endingNonce)
 
 	for n := beginningNonce; n <= endingNonce; n++ {
 		etx, err := findEthTxWithNonce(ec.store.DB, address, n)
@@ -738,28 +849,28 @@ func (ec *ethConfirmer) ForceRebroadcast(beginningNonce uint, endingNonce uint,
 			return errors.Wrap(err, "ForceRebroadcast failed")
 		}
 		if etx == nil {
-			logger.Debugf("ForceRebroadcast: no eth_tx found with nonce %v, will rebroadcast empty transaction", n)
+			txManagerLogger.Debugf("ForceRebroadcast: no eth_tx found with nonce %v, will rebroadcast empty transaction", n)
 			hash, err := ec.sendEmptyTransaction(context.TODO(), address, n, overrideGasLimit, gasPriceWei)
 			if err != nil {
-				logger.Errorw("ForceRebroadcast: failed to send empty transaction", "nonce", n, "err", err)
+				txManagerLogger.Errorw("ForceRebroadcast: failed to send empty transaction", "nonce", n, "err", err)
 				continue
 			}
-			logger.Infow("ForceRebroadcast: successfully rebroadcast empty transaction", "nonce", n, "hash", hash.String())
+			txManagerLogger.Infow("ForceRebroadcast: successfully rebroadcast empty transaction", "nonce", n, "hash", hash.String())
 		} else {
-			logger.Debugf("ForceRebroadcast: got eth_tx %v with nonce %v, will rebroadcast this transaction", etx.ID, *etx.Nonce)
+			txManagerLogger.Debugf("ForceRebroadcast: got eth_tx %v with nonce %v, will rebroadcast this transaction", etx.ID, *etx.Nonce)
 			if overrideGasLimit != 0 {
 				etx.GasLimit = overrideGasLimit
 			}
 			attempt, err := newAttempt(ec.store, *etx, big.NewInt(int64(gasPriceWei)))
 			if err != nil {
-				logger.Errorw("ForceRebroadcast: failed to create new attempt", "ethTxID", etx.ID, "err", err)
+				txManagerLogger.Errorw("ForceRebroadcast: failed to create new attempt", "ethTxID", etx.ID, "err", err)
 				continue
 			}
 			if err := sendTransaction(context.TODO(), ec.ethClient, attempt); err != nil {
-				logger.Errorw(fmt.Sprintf("ForceRebroadcast: failed to rebroadcast eth_tx %v with nonce %v at gas price %s wei and gas limit %v: %s", etx.ID, *etx.Nonce, attempt.GasPrice.String(), etx.GasLimit, err.Error()), "err", err)
+				txManagerLogger.Errorw(fmt.Sprintf("ForceRebroadcast: failed to rebroadcast eth_tx %v with nonce %v at gas price %s wei and gas limit %v: %s", etx.ID, *etx.Nonce, attempt.GasPrice.String(), etx.GasLimit, err.Error()), "err", err)
 				continue
 			}
-			logger.Infof("ForceRebroadcast: successfully rebroadcast eth_tx %v with hash: 0x%x", etx.ID, attempt.Hash)
+			txManagerLogger.Infof("ForceRebroadcast: successfully rebroadcast eth_tx %v with hash: 0x%x", etx.ID, attempt.Hash)
 		}
 	}
 	return nil
@@ -21,6 +21,7 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -354,6 +355,73 @@ func TestEthConfirmer_CheckForReceipts(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_CheckForReceipts_batching(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_RECEIPT_FETCH_BATCH_SIZE", 2)
+	ec := bulletprooftxmanager.NewEthConfirmer(store, config)
+
+	ctx := context.Background()
+
+	etx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 0)
+	attempt1_1 := etx1.EthTxAttempts[0]
+	etx2 := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 1)
+	attempt2_1 := etx2.EthTxAttempts[0]
+	attempt2_2 := newBroadcastEthTxAttempt(t, etx2.ID, store, 30)
+	require.NoError(t, store.DB.Create(&attempt2_2).Error)
+
+	receipt1_1 := gethTypes.Receipt{TxHash: attempt1_1.Hash, BlockHash: cltest.NewHash(), BlockNumber: big.NewInt(42), TransactionIndex: uint(1)}
+	receipt2_1 := gethTypes.Receipt{TxHash: attempt2_1.Hash, BlockHash: cltest.NewHash(), BlockNumber: big.NewInt(43), TransactionIndex: uint(2)}
+
+	// Attempts are fetched in nonce order and then from the highest gas price
+	// down, two at a time
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 2 &&
+			b[0].Method == "eth_getTransactionReceipt" && b[0].Args[0] == attempt1_1.Hash &&
+			b[1].Method == "eth_getTransactionReceipt" && b[1].Args[0] == attempt2_2.Hash
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		*(elems[0].Result.(**gethTypes.Receipt)) = &receipt1_1
+		// The most expensive attempt of etx2 was not mined
+		*(elems[1].Result.(**gethTypes.Receipt)) = nil
+	}).Once()
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 && b[0].Args[0] == attempt2_1.Hash
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		*(elems[0].Result.(**gethTypes.Receipt)) = &receipt2_1
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(ctx, 42))
+
+	etx1, err := store.FindEthTxWithAttempts(etx1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.EthTxConfirmed, etx1.State)
+	require.Len(t, etx1.EthTxAttempts[0].EthReceipts, 1)
+	assert.Equal(t, receipt1_1.BlockHash, etx1.EthTxAttempts[0].EthReceipts[0].BlockHash)
+
+	etx2, err = store.FindEthTxWithAttempts(etx2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.EthTxConfirmed, etx2.State)
+	for _, attempt := range etx2.EthTxAttempts {
+		if attempt.ID == attempt2_1.ID {
+			require.Len(t, attempt.EthReceipts, 1)
+			assert.Equal(t, receipt2_1.BlockHash, attempt.EthReceipts[0].BlockHash)
+		} else {
+			assert.Len(t, attempt.EthReceipts, 0)
+		}
+	}
+
+	ethClient.AssertExpectations(t)
+}
+
 func TestEthConfirmer_CheckForReceipts_confirmed_missing_receipt(t *testing.T) {
 	t.Parallel()
 
@@ -1722,3 +1790,170 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 		ethClient.AssertExpectations(t)
 	})
 }
+
+func TestEthConfirmer_BumpGasWhereNecessary_MaxGasPriceOfJob(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	// Use the real KeyStore loaded from database fixtures
+	store.KeyStore.Unlock(cltest.Password)
+	keys, err := store.SendKeys()
+	require.NoError(t, err)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+
+	ec := bulletprooftxmanager.NewEthConfirmer(store, config)
+	currentHead := int64(30)
+	oldEnough := int64(19)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 0)
+	attempt1_1 := etx.EthTxAttempts[0]
+	attempt1_1.BroadcastBeforeBlockNum = &oldEnough
+	require.NoError(t, store.DB.Save(&attempt1_1).Error)
+
+	t.Run("resubmits at the old price and flags the transaction if the bump would exceed the job's maxGasPrice", func(t *testing.T) {
+		require.NoError(t, store.DB.Exec(`UPDATE eth_txes SET max_gas_price = 22000000000 WHERE id = ?`, etx.ID).Error)
+
+		// The existing attempt is sent again as is
+		ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(errors.New("already known")).Once()
+
+		require.NoError(t, ec.BumpGasWhereNecessary(context.TODO(), keys, currentHead))
+
+		etx, err = store.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 1)
+		assert.True(t, etx.GasPriceCapped)
+		assert.Equal(t, models.EthTxUnconfirmed, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	attempt1_1 = etx.EthTxAttempts[0]
+	attempt1_1.BroadcastBeforeBlockNum = &oldEnough
+	require.NoError(t, store.DB.Save(&attempt1_1).Error)
+
+	t.Run("bumps gas and clears the flag once the job's maxGasPrice allows it", func(t *testing.T) {
+		require.NoError(t, store.DB.Exec(`UPDATE eth_txes SET max_gas_price = 30000000000 WHERE id = ?`, etx.ID).Error)
+		expectedBumpedGasPrice := big.NewInt(25000000000)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return expectedBumpedGasPrice.Cmp(tx.GasPrice()) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.BumpGasWhereNecessary(context.TODO(), keys, currentHead))
+
+		etx, err = store.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, expectedBumpedGasPrice.Int64(), etx.EthTxAttempts[1].GasPrice.ToInt().Int64())
+		assert.False(t, etx.GasPriceCapped)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthConfirmer_BumpGasWhereNecessary_PrivateChainMode(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	// Use the real KeyStore loaded from database fixtures
+	store.KeyStore.Unlock(cltest.Password)
+	keys, err := store.SendKeys()
+	require.NoError(t, err)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_PRIVATE_CHAIN_MODE", true)
+
+	ec := bulletprooftxmanager.NewEthConfirmer(store, config)
+	currentHead := int64(30)
+	oldEnough := int64(19)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 0)
+	attempt1_1 := etx.EthTxAttempts[0]
+	attempt1_1.BroadcastBeforeBlockNum = &oldEnough
+	require.NoError(t, store.DB.Save(&attempt1_1).Error)
+
+	// Nothing is sent
+	require.NoError(t, ec.BumpGasWhereNecessary(context.TODO(), keys, currentHead))
+
+	etx, err = store.FindEthTxWithAttempts(etx.ID)
+	require.NoError(t, err)
+	require.Len(t, etx.EthTxAttempts, 1)
+	assert.Equal(t, models.EthTxUnconfirmed, etx.State)
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthConfirmer_BumpGasWhereNecessary_ReplacementUnderpriced(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	// Use the real KeyStore loaded from database fixtures
+	store.KeyStore.Unlock(cltest.Password)
+	keys, err := store.SendKeys()
+	require.NoError(t, err)
+
+	config, cleanup := cltest.NewConfig(t)
+	defer cleanup()
+	config.Set("ETH_GAS_BUMP_REPLACEMENT_PERCENT", 50)
+
+	ec := bulletprooftxmanager.NewEthConfirmer(store, config)
+	currentHead := int64(30)
+	oldEnough := int64(19)
+
+	tests := []struct {
+		name    string
+		sendErr string
+		nonce   int64
+	}{
+		{"geth", "replacement transaction underpriced", 0},
+		{"parity", "Transaction gas price 25000000000wei is too low. There is another transaction with same nonce in the queue with gas price 30000000000wei. Try increasing the gas price or incrementing the nonce.", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, test.nonce)
+			attempt1 := etx.EthTxAttempts[0]
+			attempt1.BroadcastBeforeBlockNum = &oldEnough
+			require.NoError(t, store.DB.Save(&attempt1).Error)
+
+			bumpedGasPrice := big.NewInt(25000000000)
+			// 50% over the rejected attempt
+			replacementGasPrice := big.NewInt(37500000000)
+
+			ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+				return int64(tx.Nonce()) == test.nonce && bumpedGasPrice.Cmp(tx.GasPrice()) == 0
+			})).Return(errors.New(test.sendErr)).Once()
+			ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+				return int64(tx.Nonce()) == test.nonce && replacementGasPrice.Cmp(tx.GasPrice()) == 0
+			})).Return(nil).Once()
+
+			require.NoError(t, ec.BumpGasWhereNecessary(context.TODO(), keys, currentHead))
+
+			etx, err = store.FindEthTxWithAttempts(etx.ID)
+			require.NoError(t, err)
+			assert.Equal(t, models.EthTxUnconfirmed, etx.State)
+			// The rejected attempt is replaced rather than kept
+			require.Len(t, etx.EthTxAttempts, 2)
+			assert.Equal(t, attempt1.ID, etx.EthTxAttempts[0].ID)
+			assert.Equal(t, replacementGasPrice.Int64(), etx.EthTxAttempts[1].GasPrice.ToInt().Int64())
+			assert.Equal(t, models.EthTxAttemptBroadcast, etx.EthTxAttempts[1].State)
+
+			ethClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// fillerGasLimit is the gas used by the empty self-transfers which fill
+// nonce gaps
+const fillerGasLimit = 21000
+
+// ErrNonceGapInProgressEthTx is returned when repairing the nonces of a key
+// whose eth_tx is halfway through being broadcast
+var ErrNonceGapInProgressEthTx = errors.New("key has an in_progress transaction, try again once it has been broadcast")
+
+// NonceGap compares the next nonce tracked in keys.next_nonce with the
+// pending nonce of the key on chain.
+//
+// When the local nonce is behind, e.g. after the database was restored from
+// a backup, the nonces in between were used without the node knowing, and
+// the local nonce must be moved up to the on-chain one. When it is ahead,
+// MissingNonces are those in between for which no transaction was ever saved,
+// so that every later transaction of the key is stuck until they are used.
+type NonceGap struct {
+	Address       gethCommon.Address
+	LocalNonce    int64
+	OnChainNonce  int64
+	MissingNonces []int64
+}
+
+// Behind is true if the local nonce is lower than the on-chain one.
+func (g NonceGap) Behind() bool {
+	return g.LocalNonce < g.OnChainNonce
+}
+
+// Found is true if the nonces need repairing.
+func (g NonceGap) Found() bool {
+	return g.Behind() || len(g.MissingNonces) > 0
+}
+
+// CheckNonceGap compares the local and on-chain nonces of address. A key
+// which has not sent anything yet has no local nonce, and never has a gap.
+func CheckNonceGap(s *store.Store, address gethCommon.Address) (NonceGap, error) {
+	gap := NonceGap{Address: address}
+	localNonce, err := GetNextNonce(s.DB, address)
+	if err != nil {
+		return gap, errors.Wrap(err, "CheckNonceGap failed")
+	}
+	if localNonce == nil {
+		return gap, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), maxEthNodeRequestTime)
+	defer cancel()
+	onChainNonce, err := s.EthClient.PendingNonceAt(ctx, address)
+	if err != nil {
+		return gap, errors.Wrap(err, "CheckNonceGap failed to get pending nonce from eth node")
+	}
+
+	gap.LocalNonce = *localNonce
+	gap.OnChainNonce = int64(onChainNonce)
+	if gap.LocalNonce <= gap.OnChainNonce {
+		return gap, nil
+	}
+	gap.MissingNonces, err = findMissingNonces(s.DB, address, gap.OnChainNonce, gap.LocalNonce)
+	return gap, errors.Wrap(err, "CheckNonceGap failed")
+}
+
+// findMissingNonces returns the nonces in [from, to) which were not assigned
+// to any eth_tx of address
+func findMissingNonces(db *gorm.DB, address gethCommon.Address, from, to int64) ([]int64, error) {
+	var used []int64
+	err := db.Model(&models.EthTx{}).
+		Where("from_address = ? AND nonce >= ? AND nonce < ?", address, from, to).
+		Pluck("nonce", &used).
+		Error
+	if err != nil {
+		return nil, errors.Wrap(err, "findMissingNonces failed")
+	}
+
+	isUsed := make(map[int64]bool, len(used))
+	for _, n := range used {
+		isUsed[n] = true
+	}
+	var missing []int64
+	for n := from; n < to; n++ {
+		if !isUsed[n] {
+			missing = append(missing, n)
+		}
+	}
+	return missing, nil
+}
+
+// RepairNonceGap checks the nonces of key and repairs any gap found. A local
+// nonce which is behind is moved up to the on-chain nonce. Missing nonces are
+// each filled with an empty self-transfer at the default gas price, which is
+// saved as an unconfirmed eth_tx so that the EthConfirmer bumps it like any
+// other transaction until it is mined.
+//
+// The EthBroadcaster lock of the key is held while repairing, so that no
+// nonce is assigned in the meantime.
+func RepairNonceGap(s *store.Store, key models.Key) (gap NonceGap, attempts []models.EthTxAttempt, err error) {
+	address := key.Address.Address()
+	err = s.AdvisoryLocker.WithAdvisoryLock(context.TODO(), postgres.AdvisoryLockClassID_EthBroadcaster, key.ID, func() error {
+		etx, err := getInProgressEthTx(s, address)
+		if err != nil {
+			return err
+		} else if etx != nil {
+			return ErrNonceGapInProgressEthTx
+		}
+
+		gap, err = CheckNonceGap(s, address)
+		if err != nil {
+			return err
+		}
+		if gap.Behind() {
+			return resyncNextNonce(s.DB, gap)
+		}
+		for _, nonce := range gap.MissingNonces {
+			attempt, err := fillNonce(s, address, nonce)
+			if err != nil {
+				return err
+			}
+			attempts = append(attempts, attempt)
+		}
+		return nil
+	})
+	if errors.Cause(err) == ErrNonceGapInProgressEthTx {
+		return gap, attempts, err
+	}
+	return gap, attempts, errors.Wrap(err, "RepairNonceGap failed")
+}
+
+func resyncNextNonce(db *gorm.DB, gap NonceGap) error {
+	res := db.Exec(`UPDATE keys SET next_nonce = ?, updated_at = NOW() WHERE address = ? AND next_nonce = ?`, gap.OnChainNonce, gap.Address, gap.LocalNonce)
+	if res.Error != nil {
+		return errors.Wrap(res.Error, "resyncNextNonce failed")
+	}
+	if res.RowsAffected == 0 {
+		return errors.Errorf("resyncNextNonce optimistic locking failed; someone else modified key %s", gap.Address.Hex())
+	}
+	txManagerLogger.Warnw(fmt.Sprintf("BulletproofTxManager: moved next nonce of %s up from %v to %v to match the eth node", gap.Address.Hex(), gap.LocalNonce, gap.OnChainNonce),
+		"address", gap.Address.Hex(), "localNonce", gap.LocalNonce, "onChainNonce", gap.OnChainNonce)
+	return nil
+}
+
+// fillNonce saves and sends an empty self-transfer with the given nonce. If
+// sending fails the attempt is left in progress, for the EthConfirmer to send
+// on the next head.
+func fillNonce(s *store.Store, address gethCommon.Address, nonce int64) (models.EthTxAttempt, error) {
+	now := time.Now()
+	etx := models.EthTx{
+		Nonce:          &nonce,
+		FromAddress:    address,
+		ToAddress:      address,
+		EncodedPayload: []byte{},
+		Value:          assets.NewEthValue(0),
+		GasLimit:       fillerGasLimit,
+		State:          models.EthTxUnconfirmed,
+		BroadcastAt:    &now,
+	}
+	var attempt models.EthTxAttempt
+	err := s.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&etx).Error; err != nil {
+			return errors.Wrap(err, "failed to save eth_tx")
+		}
+		var err error
+		attempt, err = newAttempt(s, etx, s.Config.EthGasPriceDefault())
+		if err != nil {
+			return err
+		}
+		return errors.Wrap(tx.Create(&attempt).Error, "failed to save eth_tx_attempt")
+	})
+	if err != nil {
+		return attempt, errors.Wrap(err, "fillNonce failed")
+	}
+	attempt.EthTx = etx
+
+	if sendError := sendTransaction(context.Background(), s.EthClient, attempt); sendError != nil {
+		txManagerLogger.Warnw("BulletproofTxManager: failed to send transaction filling nonce gap", "ethTxID", etx.ID, "nonce", nonce, "err", sendError)
+		return attempt, nil
+	}
+	if err := saveSentAttempt(s.DB, &attempt); err != nil {
+		return attempt, errors.Wrap(err, "fillNonce failed")
+	}
+	txManagerLogger.Infow(fmt.Sprintf("BulletproofTxManager: filled nonce %v of %s", nonce, address.Hex()), "ethTxID", etx.ID, "nonce", nonce, "txHash", attempt.Hash)
+	return attempt, nil
+}
+
+type (
+	// NonceGapMonitor periodically checks the nonces of every sending key,
+	// logging any gap found, and repairing it if ETH_NONCE_GAP_AUTO_REPAIR is
+	// set.
+	NonceGapMonitor interface {
+		Start() error
+		Stop() error
+		Check()
+	}
+
+	nonceGapMonitor struct {
+		store      *store.Store
+		interval   time.Duration
+		autoRepair bool
+
+		utils.StartStopOnce
+		chStop chan struct{}
+		chDone chan struct{}
+	}
+
+	// NullNonceGapMonitor does not check anything, for when
+	// ETH_NONCE_GAP_CHECK_INTERVAL is 0
+	NullNonceGapMonitor struct{}
+)
+
+// NewNonceGapMonitor returns a NonceGapMonitor checking the keys of store on
+// every interval.
+func NewNonceGapMonitor(store *store.Store, interval time.Duration, autoRepair bool) NonceGapMonitor {
+	return &nonceGapMonitor{
+		store:      store,
+		interval:   interval,
+		autoRepair: autoRepair,
+		chStop:     make(chan struct{}),
+		chDone:     make(chan struct{}),
+	}
+}
+
+// Start begins checking the keys.
+func (m *nonceGapMonitor) Start() error {
+	return m.StartOnce("NonceGapMonitor", func() error {
+		go m.run()
+		return nil
+	})
+}
+
+// Stop stops checking the keys.
+func (m *nonceGapMonitor) Stop() error {
+	return m.StopOnce("NonceGapMonitor", func() error {
+		close(m.chStop)
+		<-m.chDone
+		return nil
+	})
+}
+
+func (m *nonceGapMonitor) run() {
+	defer close(m.chDone)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.chStop:
+			return
+		case <-ticker.C:
+			m.Check()
+		}
+	}
+}
+
+// Check checks the nonces of each sending key once.
+func (m *nonceGapMonitor) Check() {
+	keys, err := m.store.SendKeys()
+	if err != nil {
+		txManagerLogger.Errorw("NonceGapMonitor: error getting keys", "error", err)
+		return
+	}
+	for _, key := range keys {
+		m.checkKey(key)
+	}
+}
+
+func (m *nonceGapMonitor) checkKey(key models.Key) {
+	address := key.Address.Address()
+	gap, err := CheckNonceGap(m.store, address)
+	if err != nil {
+		txManagerLogger.Errorw(fmt.Sprintf("NonceGapMonitor: error checking nonces of %s", address.Hex()), "error", err, "address", address.Hex())
+		return
+	}
+	if !gap.Found() {
+		return
+	}
+	txManagerLogger.Warnw(fmt.Sprintf("NonceGapMonitor: nonce gap detected for %s, local next nonce is %v but the eth node has %v", address.Hex(), gap.LocalNonce, gap.OnChainNonce),
+		"address", address.Hex(),
+		"localNonce", gap.LocalNonce,
+		"onChainNonce", gap.OnChainNonce,
+		"missingNonces", gap.MissingNonces,
+		"autoRepair", m.autoRepair,
+	)
+	if !m.autoRepair {
+		return
+	}
+	if _, _, err := RepairNonceGap(m.store, key); err != nil {
+		txManagerLogger.Errorw(fmt.Sprintf("NonceGapMonitor: error repairing nonces of %s", address.Hex()), "error", err, "address", address.Hex())
+	}
+}
+
+func (*NullNonceGapMonitor) Start() error { return nil }
+func (*NullNonceGapMonitor) Stop() error  { return nil }
+func (*NullNonceGapMonitor) Check()       {}
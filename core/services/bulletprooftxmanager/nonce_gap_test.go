@@ -0,0 +1,107 @@
+package bulletprooftxmanager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceGap_CheckAndRepair(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	// Use the real KeyStore loaded from database fixtures
+	store.KeyStore.Unlock(cltest.Password)
+
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+
+	key := cltest.MustDefaultKey(t, store)
+	address := key.Address.Address()
+
+	t.Run("with no local nonce", func(t *testing.T) {
+		require.NoError(t, store.DB.Exec(`UPDATE keys SET next_nonce = NULL`).Error)
+
+		gap, err := bulletprooftxmanager.CheckNonceGap(store, address)
+		require.NoError(t, err)
+		assert.False(t, gap.Found())
+	})
+
+	t.Run("when the local nonce is behind", func(t *testing.T) {
+		require.NoError(t, store.DB.Exec(`UPDATE keys SET next_nonce = 3`).Error)
+		ethClient.On("PendingNonceAt", mock.Anything, address).Return(uint64(5), nil).Twice()
+
+		gap, err := bulletprooftxmanager.CheckNonceGap(store, address)
+		require.NoError(t, err)
+		assert.True(t, gap.Found())
+		assert.True(t, gap.Behind())
+		assert.Empty(t, gap.MissingNonces)
+
+		_, attempts, err := bulletprooftxmanager.RepairNonceGap(store, key)
+		require.NoError(t, err)
+		assert.Empty(t, attempts)
+
+		nonce, err := bulletprooftxmanager.GetNextNonce(store.DB, address)
+		require.NoError(t, err)
+		require.NotNil(t, nonce)
+		assert.Equal(t, int64(5), *nonce)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("when nonces are missing", func(t *testing.T) {
+		require.NoError(t, store.DB.Exec(`UPDATE keys SET next_nonce = 4`).Error)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 1, address)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 3, address)
+		ethClient.On("PendingNonceAt", mock.Anything, address).Return(uint64(0), nil).Twice()
+
+		gap, err := bulletprooftxmanager.CheckNonceGap(store, address)
+		require.NoError(t, err)
+		assert.True(t, gap.Found())
+		assert.False(t, gap.Behind())
+		assert.Equal(t, []int64{0, 2}, gap.MissingNonces)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && *tx.To() == address && tx.Value().Sign() == 0 && len(tx.Data()) == 0
+		})).Return(nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 2
+		})).Return(errors.New("connection refused")).Once()
+
+		_, attempts, err := bulletprooftxmanager.RepairNonceGap(store, key)
+		require.NoError(t, err)
+		require.Len(t, attempts, 2)
+		assert.Equal(t, models.EthTxAttemptBroadcast, attempts[0].State)
+		// Left for the EthConfirmer to send again
+		assert.Equal(t, models.EthTxAttemptInProgress, attempts[1].State)
+
+		for _, attempt := range attempts {
+			etx, err := store.FindEthTxWithAttempts(attempt.EthTxID)
+			require.NoError(t, err)
+			assert.Equal(t, models.EthTxUnconfirmed, etx.State)
+			assert.Equal(t, address, etx.ToAddress)
+		}
+
+		nonce, err := bulletprooftxmanager.GetNextNonce(store.DB, address)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), *nonce)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("refuses to repair while a transaction is in progress", func(t *testing.T) {
+		cltest.MustInsertInProgressEthTxWithAttempt(t, store, 4)
+
+		_, _, err := bulletprooftxmanager.RepairNonceGap(store, key)
+		require.Error(t, err)
+		assert.Equal(t, bulletprooftxmanager.ErrNonceGapInProgressEthTx, err)
+	})
+}
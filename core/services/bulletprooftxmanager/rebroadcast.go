@@ -0,0 +1,71 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// RebroadcastUnconfirmed re-signs every unconfirmed eth_tx with a nonce of at
+// least beginningNonce at the given gas price, and sends it again. This is
+// meant for recovering after transactions were dropped from the mempool, e.g.
+// following a chain halt. If address is nil the transactions of all keys are
+// rebroadcast, and a gasLimit of 0 keeps the gas limit of each transaction.
+//
+// Unlike ForceRebroadcast the new attempts are saved, so the EthConfirmer
+// tracks them and bumps their gas price as usual. An attempt which fails to
+// send is left in progress, to be retried by the EthConfirmer on the next
+// head.
+func RebroadcastUnconfirmed(s *store.Store, address *gethCommon.Address, beginningNonce uint, gasPrice *big.Int, gasLimit uint64) ([]models.EthTxAttempt, error) {
+	if gasPrice.Cmp(s.Config.EthMaxGasPriceWei()) > 0 {
+		return nil, errors.Errorf("gas price of %s wei exceeds ETH_MAX_GAS_PRICE_WEI of %s wei", gasPrice, s.Config.EthMaxGasPriceWei())
+	}
+
+	q := s.DB.Where("state = 'unconfirmed' AND nonce >= ?", beginningNonce).Where(s.FromChainKeysCondition("eth_txes"))
+	if address != nil {
+		q = q.Where("from_address = ?", *address)
+	}
+	var etxs []models.EthTx
+	if err := q.Order("from_address, nonce ASC").Find(&etxs).Error; err != nil {
+		return nil, errors.Wrap(err, "RebroadcastUnconfirmed failed")
+	}
+
+	var attempts []models.EthTxAttempt
+	for _, etx := range etxs {
+		if gasLimit != 0 {
+			etx.GasLimit = gasLimit
+		}
+		attempt, err := newAttempt(s, etx, gasPrice)
+		if err != nil {
+			return attempts, errors.Wrap(err, "RebroadcastUnconfirmed failed")
+		}
+		err = s.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(`UPDATE eth_txes SET gas_limit = ? WHERE id = ?`, etx.GasLimit, etx.ID).Error; err != nil {
+				return err
+			}
+			return tx.Save(&attempt).Error
+		})
+		if err != nil {
+			return attempts, errors.Wrap(err, "RebroadcastUnconfirmed failed")
+		}
+
+		if sendError := sendTransaction(context.Background(), s.EthClient, attempt); sendError != nil {
+			txManagerLogger.Warnw("RebroadcastUnconfirmed: failed to send transaction", "ethTxID", etx.ID, "nonce", *etx.Nonce, "err", sendError)
+		} else {
+			if err := saveSentAttempt(s.DB, &attempt); err != nil {
+				return attempts, errors.Wrap(err, "RebroadcastUnconfirmed failed")
+			}
+			txManagerLogger.Infow("RebroadcastUnconfirmed: rebroadcast transaction", "ethTxID", etx.ID, "nonce", *etx.Nonce, "txHash", attempt.Hash, "gasPriceWei", gasPrice.String())
+		}
+
+		attempt.EthTx = etx
+		attempts = append(attempts, attempt)
+	}
+	return attempts, nil
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+)
+
+var promChainCurrentHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "evm_chain_head_number",
+	Help: "The highest seen head number of each secondary EVM chain",
+},
+	[]string{"chain_id"},
+)
+
+// ChainSet holds the chains served by the node in addition to its primary
+// chain, as configured with EVM_CHAINS. Like the primary chain, each chain
+// has its own keys, head tracker, tx managers and log broadcaster, and runs
+// the initiators of the jobs bound to it.
+type ChainSet interface {
+	// ServeJobs gives each chain the services which run the initiators of
+	// its jobs, starting runs through runManager. It must be called before
+	// Start.
+	ServeJobs(runManager RunManager)
+	Start() error
+	Stop() error
+	Get(chainID *big.Int) (*Chain, error)
+	Chains() []*Chain
+	AddJob(job models.JobSpec) error
+	RemoveJob(ID *models.ID)
+	ReloadFluxMonitorJob(job models.JobSpec) error
+}
+
+// Chain is a secondary chain, with its own connection to an Ethereum node.
+// Its store sends transactions from the keys of the chain and records the
+// chain's heads.
+type Chain struct {
+	ID              *big.Int
+	Config          *orm.Config
+	Store           *strpkg.Store
+	TxManager       *strpkg.EthTxManager
+	HeadTracker     *HeadTracker
+	LogBroadcaster  eth.LogBroadcaster
+	EthBroadcaster  bulletprooftxmanager.EthBroadcaster
+	NonceGapMonitor bulletprooftxmanager.NonceGapMonitor
+	JobSubscriber   JobSubscriber
+	FluxMonitor     fluxmonitor.Service
+
+	ethConfirmer             bulletprooftxmanager.EthConfirmer
+	balanceThresholdMonitor  BalanceThresholdMonitor
+	blockIntervalMonitor     BlockIntervalMonitor
+	gasPriceThresholdMonitor GasPriceThresholdMonitor
+	client                   eth.Client
+	dialed                   bool
+	started                  bool
+}
+
+// LatestHead returns the highest head received from the chain, or nil if
+// none has been received yet.
+func (c *Chain) LatestHead() *models.Head {
+	return c.HeadTracker.HighestSeenHead()
+}
+
+// Connected returns true while the chain's head tracker is connected.
+func (c *Chain) Connected() bool {
+	return c.HeadTracker.Connected()
+}
+
+func (c *Chain) start() error {
+	if !c.Config.EnableBulletproofTxManager() {
+		c.TxManager.Register(c.Store.SendingAccounts())
+	}
+	for _, task := range []func() error{
+		c.LogBroadcaster.Start,
+		c.FluxMonitor.Start,
+		c.EthBroadcaster.Start,
+		c.NonceGapMonitor.Start,
+		c.HeadTracker.Start,
+	} {
+		if err := task(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Chain) stop() error {
+	var merr error
+	merr = multierr.Append(merr, c.LogBroadcaster.Stop())
+	merr = multierr.Append(merr, c.HeadTracker.Stop())
+	merr = multierr.Append(merr, c.balanceThresholdMonitor.Stop())
+	merr = multierr.Append(merr, c.blockIntervalMonitor.Stop())
+	merr = multierr.Append(merr, c.JobSubscriber.Stop())
+	c.FluxMonitor.Stop()
+	merr = multierr.Append(merr, c.NonceGapMonitor.Stop())
+	merr = multierr.Append(merr, c.EthBroadcaster.Stop())
+	return merr
+}
+
+type chainSet struct {
+	store  *strpkg.Store
+	chains []*Chain
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+	utils.StartStopOnce
+}
+
+// NewChainSet returns a ChainSet for the chains configured in EVM_CHAINS.
+func NewChainSet(store *strpkg.Store, eventBroadcaster postgres.EventBroadcaster) (ChainSet, error) {
+	configs := store.Config.EVMChains()
+	if len(configs) == 0 || store.Config.EthereumDisabled() {
+		return &NullChainSet{}, nil
+	}
+
+	cs := &chainSet{store: store, chStop: make(chan struct{})}
+	for _, chainConfig := range configs {
+		config := store.Config.ForChain(chainConfig)
+		client, err := eth.NewClient(config.EthereumURL(), config.EthereumSecondaryURL())
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to create ETH client for chain %s", chainConfig.ChainID)
+		}
+		chainORM := store.ORM.ForChain(config.ChainID())
+		txManager := strpkg.NewEthTxManager(client, config, store.KeyStore, chainORM)
+		chainStore := store.ForChain(config, client, txManager)
+
+		ethBroadcaster := bulletprooftxmanager.NewEthBroadcaster(chainStore, config, eventBroadcaster)
+		chainStore.NotifyNewEthTx = ethBroadcaster
+		var nonceGapMonitor bulletprooftxmanager.NonceGapMonitor
+		if interval := config.EthNonceGapCheckInterval(); config.EnableBulletproofTxManager() && interval > 0 {
+			nonceGapMonitor = bulletprooftxmanager.NewNonceGapMonitor(chainStore, interval, config.EthNonceGapAutoRepair())
+		} else {
+			nonceGapMonitor = &bulletprooftxmanager.NullNonceGapMonitor{}
+		}
+
+		cs.chains = append(cs.chains, &Chain{
+			ID:              config.ChainID(),
+			Config:          config,
+			Store:           chainStore,
+			TxManager:       txManager,
+			LogBroadcaster:  eth.NewLogBroadcaster(client, chainORM, config.BlockBackfillDepth()),
+			EthBroadcaster:  ethBroadcaster,
+			NonceGapMonitor: nonceGapMonitor,
+			ethConfirmer:    bulletprooftxmanager.NewEthConfirmer(chainStore, config),
+			client:          client,
+		})
+	}
+	return cs, nil
+}
+
+// ServeJobs gives each chain a job subscriber, flux monitor and the
+// monitors of chain-event initiators, and a head tracker which passes the
+// chain's heads on to them and to the chain's tx manager.
+func (cs *chainSet) ServeJobs(runManager RunManager) {
+	for _, chain := range cs.chains {
+		chain.JobSubscriber = NewJobSubscriber(chain.Store, runManager)
+		chain.FluxMonitor = fluxmonitor.New(chain.Store, runManager, chain.LogBroadcaster)
+		chain.balanceThresholdMonitor = NewBalanceThresholdMonitor(chain.Store, runManager)
+		chain.blockIntervalMonitor = NewBlockIntervalMonitor(chain.Store, runManager)
+		chain.gasPriceThresholdMonitor = NewGasPriceThresholdMonitor(chain.Store, runManager)
+
+		var headTrackables []strpkg.HeadTrackable
+		if chain.Config.EnableBulletproofTxManager() {
+			headTrackables = append(headTrackables, chain.ethConfirmer)
+		} else {
+			headTrackables = append(headTrackables, chain.TxManager)
+		}
+		headTrackables = append(
+			headTrackables,
+			chain.JobSubscriber,
+			NewPendingConnectionResumer(runManager, chain.ID),
+			chain.balanceThresholdMonitor,
+			chain.blockIntervalMonitor,
+			chain.gasPriceThresholdMonitor,
+		)
+		chain.HeadTracker = NewHeadTracker(chain.Store, headTrackables)
+	}
+}
+
+// Start connects to each of the chains, retrying until the connection
+// succeeds, and then starts the chain's services.
+func (cs *chainSet) Start() error {
+	return cs.StartOnce("ChainSet", func() error {
+		for _, chain := range cs.chains {
+			cs.wg.Add(1)
+			go cs.run(chain)
+		}
+		return nil
+	})
+}
+
+// Stop stops the services of all chains and disconnects from them.
+func (cs *chainSet) Stop() error {
+	return cs.StopOnce("ChainSet", func() error {
+		close(cs.chStop)
+		cs.wg.Wait()
+		var merr error
+		for _, chain := range cs.chains {
+			if chain.started {
+				merr = multierr.Append(merr, chain.stop())
+			}
+			if chain.dialed {
+				chain.client.Close()
+			}
+		}
+		return merr
+	})
+}
+
+// Get returns the secondary chain with the given ID.
+func (cs *chainSet) Get(chainID *big.Int) (*Chain, error) {
+	for _, chain := range cs.chains {
+		if chain.ID.Cmp(chainID) == 0 {
+			return chain, nil
+		}
+	}
+	return nil, fmt.Errorf("chain %s is not configured", chainID)
+}
+
+// Chains returns all secondary chains.
+func (cs *chainSet) Chains() []*Chain {
+	return cs.chains
+}
+
+// AddJob starts the initiators of a job bound to one of the chains.
+func (cs *chainSet) AddJob(job models.JobSpec) error {
+	var merr error
+	for _, chain := range cs.chains {
+		merr = multierr.Combine(
+			merr,
+			chain.FluxMonitor.AddJob(job),
+			chain.JobSubscriber.AddJob(job, nil),
+			chain.balanceThresholdMonitor.AddJob(job),
+			chain.blockIntervalMonitor.AddJob(job),
+			chain.gasPriceThresholdMonitor.AddJob(job),
+		)
+	}
+	return merr
+}
+
+// RemoveJob stops the initiators of a job bound to one of the chains.
+func (cs *chainSet) RemoveJob(ID *models.ID) {
+	for _, chain := range cs.chains {
+		_ = chain.JobSubscriber.RemoveJob(ID)
+		chain.FluxMonitor.RemoveJob(ID)
+		chain.balanceThresholdMonitor.RemoveJob(ID)
+		chain.blockIntervalMonitor.RemoveJob(ID)
+		chain.gasPriceThresholdMonitor.RemoveJob(ID)
+	}
+}
+
+// ReloadFluxMonitorJob hands the updated fluxmonitor initiators of a job
+// bound to one of the chains to their running checkers.
+func (cs *chainSet) ReloadFluxMonitorJob(job models.JobSpec) error {
+	for _, chain := range cs.chains {
+		if chain.Config.ServesChain(job.ChainID) {
+			return chain.FluxMonitor.ReloadJob(job)
+		}
+	}
+	return nil
+}
+
+func (cs *chainSet) run(chain *Chain) {
+	defer cs.wg.Done()
+
+	ctx, cancel := utils.ContextFromChan(cs.chStop)
+	defer cancel()
+
+	sleeper := utils.NewBackoffSleeper()
+	for {
+		select {
+		case <-cs.chStop:
+			return
+		case <-time.After(sleeper.After()):
+		}
+
+		logger.Infow("ChainSet: connecting to chain", "chainID", chain.ID, "url", chain.Config.EthereumURL())
+		if err := chain.client.Dial(ctx); err != nil {
+			logger.Warnw("ChainSet: failed to connect to chain", "chainID", chain.ID, "error", err)
+			continue
+		}
+		chain.dialed = true
+		break
+	}
+
+	if err := chain.start(); err != nil {
+		logger.Errorw("ChainSet: failed to start chain", "chainID", chain.ID, "error", err)
+	}
+	// Even if some services failed to start, Stop stops those which did
+	chain.started = true
+}
+
+// NullChainSet is used when no secondary chains are configured.
+type NullChainSet struct{}
+
+// ServeJobs does nothing.
+func (*NullChainSet) ServeJobs(RunManager) {}
+
+// Start does nothing.
+func (*NullChainSet) Start() error { return nil }
+
+// Stop does nothing.
+func (*NullChainSet) Stop() error { return nil }
+
+// Get always returns an error, as there are no secondary chains.
+func (*NullChainSet) Get(chainID *big.Int) (*Chain, error) {
+	return nil, fmt.Errorf("chain %s is not configured", chainID)
+}
+
+// Chains returns no chains.
+func (*NullChainSet) Chains() []*Chain { return nil }
+
+// AddJob does nothing.
+func (*NullChainSet) AddJob(models.JobSpec) error { return nil }
+
+// RemoveJob does nothing.
+func (*NullChainSet) RemoveJob(*models.ID) {}
+
+// ReloadFluxMonitorJob does nothing.
+func (*NullChainSet) ReloadFluxMonitorJob(models.JobSpec) error { return nil }
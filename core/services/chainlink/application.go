@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/gracefulpanic"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerting"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
@@ -21,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	strpkg "github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -49,10 +51,17 @@ type Application interface {
 	Stop() error
 	GetStore() *strpkg.Store
 	GetStatsPusher() synchronization.StatsPusher
+	GetEventBroadcaster() postgres.EventBroadcaster
+	GetFluxMonitor() fluxmonitor.Service
 	WakeSessionReaper()
 	AddJob(job models.JobSpec) error
+	AddJobBundle(job models.JobSpec, bridges []models.BridgeType, externalInitiators []models.ExternalInitiator) error
 	AddJobV2(ctx context.Context, job job.Spec) (int32, error)
 	ArchiveJob(*models.ID) error
+	UpdateJob(job models.JobSpec) error
+	UpdateFluxMonitorJob(job models.JobSpec) error
+	PauseJob(*models.ID) error
+	ResumeJob(*models.ID) error
 	DeleteJobV2(ctx context.Context, jobID int32) error
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	AddServiceAgreement(*models.ServiceAgreement) error
@@ -73,6 +82,7 @@ type ChainlinkApplication struct {
 	JobSubscriber            services.JobSubscriber
 	GasUpdater               services.GasUpdater
 	EthBroadcaster           bulletprooftxmanager.EthBroadcaster
+	NonceGapMonitor          bulletprooftxmanager.NonceGapMonitor
 	LogBroadcaster           eth.LogBroadcaster
 	EventBroadcaster         postgres.EventBroadcaster
 	jobSpawner               job.Spawner
@@ -81,12 +91,21 @@ type ChainlinkApplication struct {
 	Scheduler                *services.Scheduler
 	Store                    *strpkg.Store
 	SessionReaper            utils.SleeperTask
-	pendingConnectionResumer *pendingConnectionResumer
+	RunReaper                services.RunReaper
 	shutdownOnce             sync.Once
 	shutdownSignal           gracefulpanic.Signal
 	balanceMonitor           services.BalanceMonitor
+	balanceThresholdMonitor  services.BalanceThresholdMonitor
+	blockIntervalMonitor     services.BlockIntervalMonitor
+	gasPriceThresholdMonitor services.GasPriceThresholdMonitor
+	kafkaConsumer            services.KafkaConsumer
+	mqttSubscriber           services.MQTTSubscriber
+	alerter                  alerting.Service
+	jobFailureNotifier       alerting.Service
+	chainSet                 services.ChainSet
 	monitoringEndpoint       telemetry.MonitoringEndpoint
 	explorerClient           synchronization.ExplorerClient
+	traceExporter            tracing.Exporter
 }
 
 // NewApplication initializes a new store if one is not already
@@ -105,25 +124,60 @@ func NewApplication(config *orm.Config, ethClient eth.Client, advisoryLocker pos
 	if config.ExplorerURL() != nil {
 		explorerClient = synchronization.NewExplorerClient(config.ExplorerURL(), config.ExplorerAccessKey(), config.ExplorerSecret())
 		statsPusher = synchronization.NewStatsPusher(store.ORM, explorerClient)
-		telemetryAgent = telemetry.NewAgent(explorerClient)
+		telemetryAgent = telemetry.NewAgent(store.ORM, statsPusher)
 	}
 
-	runExecutor := services.NewRunExecutor(store, statsPusher)
-	runQueue := services.NewRunQueue(runExecutor)
+	traceExporter := tracing.Exporter(&tracing.NoopExporter{})
+	if config.TracingOTLPEndpoint() != nil {
+		traceExporter = tracing.NewOTLPExporter(config.TracingOTLPEndpoint(), config.TracingSampleRatio())
+	}
+
+	eventBroadcaster := postgres.NewEventBroadcaster(config.DatabaseURL(), config.DatabaseListenerMinReconnectInterval(), config.DatabaseListenerMaxReconnectDuration())
+	chainSet, err := services.NewChainSet(store, eventBroadcaster)
+	if err != nil {
+		logger.Fatalf("Unable to create EVM chains: %+v", err)
+	}
+	runExecutor := services.NewRunExecutor(store, statsPusher, chainSet)
+	runQueue := services.NewRunQueue(runExecutor, config)
 	runManager := services.NewRunManager(runQueue, config, store.ORM, statsPusher, store.TxManager, store.Clock)
+	runExecutor.OnRunFinished(func(run *models.JobRun) {
+		if err := runManager.ResumePendingConcurrency(run.JobSpecID); err != nil {
+			logger.Errorw("Error dequeueing runs", run.ForLogger("error", err)...)
+		}
+	})
+	runExecutor.OnRetryScheduled(runManager.ResumeAt)
+	chainSet.ServeJobs(runManager)
 	jobSubscriber := services.NewJobSubscriber(store, runManager)
 	gasUpdater := services.NewGasUpdater(store)
 	logBroadcaster := eth.NewLogBroadcaster(ethClient, store.ORM, store.Config.BlockBackfillDepth())
-	eventBroadcaster := postgres.NewEventBroadcaster(config.DatabaseURL(), config.DatabaseListenerMinReconnectInterval(), config.DatabaseListenerMaxReconnectDuration())
 	fluxMonitor := fluxmonitor.New(store, runManager, logBroadcaster)
 	ethBroadcaster := bulletprooftxmanager.NewEthBroadcaster(store, config, eventBroadcaster)
 	ethConfirmer := bulletprooftxmanager.NewEthConfirmer(store, config)
+	var nonceGapMonitor bulletprooftxmanager.NonceGapMonitor
+	if interval := config.EthNonceGapCheckInterval(); config.EnableBulletproofTxManager() && interval > 0 {
+		nonceGapMonitor = bulletprooftxmanager.NewNonceGapMonitor(store, interval, config.EthNonceGapAutoRepair())
+	} else {
+		nonceGapMonitor = &bulletprooftxmanager.NullNonceGapMonitor{}
+	}
 	var balanceMonitor services.BalanceMonitor
 	if config.BalanceMonitorEnabled() {
 		balanceMonitor = services.NewBalanceMonitor(store)
 	} else {
 		balanceMonitor = &services.NullBalanceMonitor{}
 	}
+	balanceThresholdMonitor := services.NewBalanceThresholdMonitor(store, runManager)
+	blockIntervalMonitor := services.NewBlockIntervalMonitor(store, runManager)
+	gasPriceThresholdMonitor := services.NewGasPriceThresholdMonitor(store, runManager)
+	kafkaConsumer := services.NewKafkaConsumer(store, runManager, services.NewKafkaReader)
+	mqttSubscriber := services.NewMQTTSubscriber(store, runManager, services.NewMQTTClient)
+	var runReaper services.RunReaper
+	if interval := config.JobRunReaperInterval(); interval > 0 {
+		runReaper = services.NewRunReaper(store, interval)
+	} else {
+		runReaper = &services.NullRunReaper{}
+	}
+	alerter := alerting.New(store, balanceMonitor)
+	jobFailureNotifier := alerting.NewJobFailureNotifier(store.ORM, store.Config, config.AlertCheckInterval())
 
 	var (
 		pipelineORM    = pipeline.NewORM(store.ORM.DB, store.Config, eventBroadcaster)
@@ -133,17 +187,16 @@ func NewApplication(config *orm.Config, ethClient eth.Client, advisoryLocker pos
 	)
 
 	if config.Dev() || config.FeatureOffchainReporting() {
-		offchainreporting.RegisterJobType(store.ORM.DB, jobORM, store.Config, store.OCRKeyStore, jobSpawner, pipelineRunner, ethClient, logBroadcaster)
+		offchainreporting.RegisterJobType(store.ORM.DB, jobORM, store.Config, store.OCRKeyStore, jobSpawner, pipelineRunner, ethClient, logBroadcaster, telemetryAgent)
 	}
 
 	store.NotifyNewEthTx = ethBroadcaster
 
-	pendingConnectionResumer := newPendingConnectionResumer(runManager)
-
 	app := &ChainlinkApplication{
 		JobSubscriber:            jobSubscriber,
 		GasUpdater:               gasUpdater,
 		EthBroadcaster:           ethBroadcaster,
+		NonceGapMonitor:          nonceGapMonitor,
 		LogBroadcaster:           logBroadcaster,
 		EventBroadcaster:         eventBroadcaster,
 		jobSpawner:               jobSpawner,
@@ -155,12 +208,21 @@ func NewApplication(config *orm.Config, ethClient eth.Client, advisoryLocker pos
 		Scheduler:                services.NewScheduler(store, runManager),
 		Store:                    store,
 		SessionReaper:            services.NewStoreReaper(store),
+		RunReaper:                runReaper,
 		Exiter:                   os.Exit,
-		pendingConnectionResumer: pendingConnectionResumer,
 		shutdownSignal:           shutdownSignal,
 		balanceMonitor:           balanceMonitor,
+		balanceThresholdMonitor:  balanceThresholdMonitor,
+		blockIntervalMonitor:     blockIntervalMonitor,
+		gasPriceThresholdMonitor: gasPriceThresholdMonitor,
+		kafkaConsumer:            kafkaConsumer,
+		mqttSubscriber:           mqttSubscriber,
+		alerter:                  alerter,
+		jobFailureNotifier:       jobFailureNotifier,
+		chainSet:                 chainSet,
 		monitoringEndpoint:       telemetryAgent,
 		explorerClient:           explorerClient,
+		traceExporter:            traceExporter,
 	}
 
 	headTrackables := []strpkg.HeadTrackable{gasUpdater}
@@ -174,8 +236,11 @@ func NewApplication(config *orm.Config, ethClient eth.Client, advisoryLocker pos
 	headTrackables = append(
 		headTrackables,
 		jobSubscriber,
-		pendingConnectionResumer,
+		services.NewPendingConnectionResumer(runManager, config.ChainID()),
 		balanceMonitor,
+		balanceThresholdMonitor,
+		blockIntervalMonitor,
+		gasPriceThresholdMonitor,
 	)
 
 	for _, onConnectCallback := range onConnectCallbacks {
@@ -210,7 +275,9 @@ func (app *ChainlinkApplication) Start() error {
 	}
 
 	subtasks := []func() error{
+		app.traceExporter.Start,
 		app.Store.Start,
+		app.chainSet.Start,
 		app.explorerClient.Start,
 		app.StatsPusher.Start,
 		app.RunQueue.Start,
@@ -219,6 +286,8 @@ func (app *ChainlinkApplication) Start() error {
 		app.EventBroadcaster.Start,
 		app.FluxMonitor.Start,
 		app.EthBroadcaster.Start,
+		app.NonceGapMonitor.Start,
+		app.RunReaper.Start,
 
 		// HeadTracker deliberately started after
 		// RunManager.ResumeAllInProgress since it Connects JobSubscriber
@@ -227,6 +296,10 @@ func (app *ChainlinkApplication) Start() error {
 		app.HeadTracker.Start,
 
 		app.Scheduler.Start,
+		app.kafkaConsumer.Start,
+		app.mqttSubscriber.Start,
+		app.alerter.Start,
+		app.jobFailureNotifier.Start,
 	}
 
 	for _, task := range subtasks {
@@ -262,15 +335,25 @@ func (app *ChainlinkApplication) Stop() error {
 		app.Scheduler.Stop()
 		merr = multierr.Append(merr, app.HeadTracker.Stop())
 		merr = multierr.Append(merr, app.balanceMonitor.Stop())
+		merr = multierr.Append(merr, app.balanceThresholdMonitor.Stop())
+		merr = multierr.Append(merr, app.blockIntervalMonitor.Stop())
+		merr = multierr.Append(merr, app.kafkaConsumer.Stop())
+		merr = multierr.Append(merr, app.mqttSubscriber.Stop())
+		merr = multierr.Append(merr, app.alerter.Stop())
+		merr = multierr.Append(merr, app.jobFailureNotifier.Stop())
 		merr = multierr.Append(merr, app.JobSubscriber.Stop())
 		app.FluxMonitor.Stop()
+		merr = multierr.Append(merr, app.NonceGapMonitor.Stop())
 		merr = multierr.Append(merr, app.EthBroadcaster.Stop())
 		app.RunQueue.Stop()
+		merr = multierr.Append(merr, app.chainSet.Stop())
 		merr = multierr.Append(merr, app.StatsPusher.Close())
 		merr = multierr.Append(merr, app.explorerClient.Close())
 		merr = multierr.Append(merr, app.SessionReaper.Stop())
+		merr = multierr.Append(merr, app.RunReaper.Stop())
 		app.pipelineRunner.Stop()
 		app.jobSpawner.Stop()
+		merr = multierr.Append(merr, app.traceExporter.Stop())
 		merr = multierr.Append(merr, app.Store.Close())
 	})
 	return merr
@@ -285,6 +368,16 @@ func (app *ChainlinkApplication) GetStatsPusher() synchronization.StatsPusher {
 	return app.StatsPusher
 }
 
+// GetEventBroadcaster returns the broadcaster of Postgres notifications
+func (app *ChainlinkApplication) GetEventBroadcaster() postgres.EventBroadcaster {
+	return app.EventBroadcaster
+}
+
+// GetFluxMonitor returns the service running fluxmonitor initiators.
+func (app *ChainlinkApplication) GetFluxMonitor() fluxmonitor.Service {
+	return app.FluxMonitor
+}
+
 // WakeSessionReaper wakes up the reaper to do its reaping.
 func (app *ChainlinkApplication) WakeSessionReaper() {
 	app.SessionReaper.WakeUp()
@@ -298,10 +391,18 @@ func (app *ChainlinkApplication) AddJob(job models.JobSpec) error {
 	if err != nil {
 		return err
 	}
+	app.startJob(job)
+	return nil
+}
 
-	app.Scheduler.AddJob(job)
-	logger.ErrorIf(app.FluxMonitor.AddJob(job))
-	logger.ErrorIf(app.JobSubscriber.AddJob(job, nil))
+// AddJobBundle saves the job of an imported job bundle together with the
+// bridges and external initiators it needs, and begins running it.
+func (app *ChainlinkApplication) AddJobBundle(job models.JobSpec, bridges []models.BridgeType, externalInitiators []models.ExternalInitiator) error {
+	err := app.Store.CreateJobBundle(&job, bridges, externalInitiators)
+	if err != nil {
+		return err
+	}
+	app.startJob(job)
 	return nil
 }
 
@@ -321,9 +422,97 @@ func (app *ChainlinkApplication) AwaitRun(ctx context.Context, runID int64) erro
 func (app *ChainlinkApplication) ArchiveJob(ID *models.ID) error {
 	_ = app.JobSubscriber.RemoveJob(ID)
 	app.FluxMonitor.RemoveJob(ID)
+	app.balanceThresholdMonitor.RemoveJob(ID)
+	app.blockIntervalMonitor.RemoveJob(ID)
+	app.gasPriceThresholdMonitor.RemoveJob(ID)
+	app.chainSet.RemoveJob(ID)
+	app.kafkaConsumer.RemoveJob(ID)
+	app.mqttSubscriber.RemoveJob(ID)
 	return app.Store.ArchiveJob(ID)
 }
 
+// UpdateJob replaces a job with a new version of it, keeping its ID and runs,
+// and restarts its initiators with those of the new version.
+func (app *ChainlinkApplication) UpdateJob(job models.JobSpec) error {
+	if err := app.Store.UpdateJob(&job); err != nil {
+		return err
+	}
+
+	app.stopJob(job.ID)
+	if !job.Paused() {
+		app.startJob(job)
+	}
+	return nil
+}
+
+// UpdateFluxMonitorJob saves the feeds and deviation thresholds of the job's
+// fluxmonitor initiators, and hands them to their running checkers. Unlike
+// UpdateJob, the checkers are not restarted, and keep track of the rounds of
+// their aggregators.
+func (app *ChainlinkApplication) UpdateFluxMonitorJob(job models.JobSpec) error {
+	if err := app.Store.UpdateFluxMonitorInitiators(&job); err != nil {
+		return err
+	}
+
+	if job.Paused() {
+		return nil
+	}
+	if err := app.FluxMonitor.ReloadJob(job); err != nil {
+		return err
+	}
+	return app.chainSet.ReloadFluxMonitorJob(job)
+}
+
+// PauseJob stops the job's initiators from starting new runs, keeping the job
+// and its runs, until it is resumed.
+func (app *ChainlinkApplication) PauseJob(ID *models.ID) error {
+	if err := app.Store.PauseJob(ID); err != nil {
+		return err
+	}
+	app.stopJob(ID)
+	return nil
+}
+
+// ResumeJob restarts the initiators of a paused job.
+func (app *ChainlinkApplication) ResumeJob(ID *models.ID) error {
+	job, err := app.Store.FindJob(ID)
+	if err != nil {
+		return err
+	}
+	if !job.Paused() {
+		return nil
+	}
+	if err := app.Store.ResumeJob(ID); err != nil {
+		return err
+	}
+	app.startJob(job)
+	return nil
+}
+
+func (app *ChainlinkApplication) startJob(job models.JobSpec) {
+	app.Scheduler.AddJob(job)
+	logger.ErrorIf(app.FluxMonitor.AddJob(job))
+	logger.ErrorIf(app.JobSubscriber.AddJob(job, nil))
+	logger.ErrorIf(app.balanceThresholdMonitor.AddJob(job))
+	logger.ErrorIf(app.blockIntervalMonitor.AddJob(job))
+	logger.ErrorIf(app.gasPriceThresholdMonitor.AddJob(job))
+	logger.ErrorIf(app.chainSet.AddJob(job))
+	logger.ErrorIf(app.kafkaConsumer.AddJob(job))
+	logger.ErrorIf(app.mqttSubscriber.AddJob(job))
+}
+
+func (app *ChainlinkApplication) stopJob(ID *models.ID) {
+	app.Scheduler.RemoveJob(ID)
+	_ = app.JobSubscriber.RemoveJob(ID)
+	app.FluxMonitor.RemoveJob(ID)
+	app.balanceThresholdMonitor.RemoveJob(ID)
+	app.blockIntervalMonitor.RemoveJob(ID)
+	app.gasPriceThresholdMonitor.RemoveJob(ID)
+	app.chainSet.RemoveJob(ID)
+	app.kafkaConsumer.RemoveJob(ID)
+	app.mqttSubscriber.RemoveJob(ID)
+}
+
 func (app *ChainlinkApplication) DeleteJobV2(ctx context.Context, jobID int32) error {
 	return app.jobSpawner.DeleteJob(ctx, jobID)
 }
@@ -343,6 +532,7 @@ func (app *ChainlinkApplication) AddServiceAgreement(sa *models.ServiceAgreement
 	// https://www.pivotaltracker.com/story/show/170349568
 	logger.ErrorIf(app.FluxMonitor.AddJob(sa.JobSpec))
 	logger.ErrorIf(app.JobSubscriber.AddJob(sa.JobSpec, nil))
+	logger.ErrorIf(app.chainSet.AddJob(sa.JobSpec))
 	return nil
 }
 
@@ -351,18 +541,3 @@ func (app *ChainlinkApplication) AddServiceAgreement(sa *models.ServiceAgreement
 func (app *ChainlinkApplication) NewBox() packr.Box {
 	return packr.NewBox("../../../operator_ui/dist")
 }
-
-type pendingConnectionResumer struct {
-	runManager services.RunManager
-}
-
-func newPendingConnectionResumer(runManager services.RunManager) *pendingConnectionResumer {
-	return &pendingConnectionResumer{runManager: runManager}
-}
-
-func (p *pendingConnectionResumer) Connect(head *models.Head) error {
-	return p.runManager.ResumeAllPendingConnection()
-}
-
-func (p *pendingConnectionResumer) Disconnect()                                    {}
-func (p *pendingConnectionResumer) OnNewLongestChain(context.Context, models.Head) {}
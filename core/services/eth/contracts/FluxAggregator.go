@@ -30,6 +30,9 @@ var (
 	// AggregatorAnswerUpdatedLogTopic20191220 is the AnswerUpdated filter topic for
 	// the FluxAggregator as of Dec. 20th 2019. Eagerly fails if not found.
 	AggregatorAnswerUpdatedLogTopic20191220 = eth.MustGetV6ContractEventID("FluxAggregator", "AnswerUpdated")
+	// AggregatorSubmissionReceivedLogTopic20191220 is the SubmissionReceived filter topic for
+	// the FluxAggregator as of Dec. 20th 2019. Eagerly fails if not found.
+	AggregatorSubmissionReceivedLogTopic20191220 = eth.MustGetV6ContractEventID("FluxAggregator", "SubmissionReceived")
 )
 
 type fluxAggregator struct {
@@ -53,9 +56,19 @@ type LogAnswerUpdated struct {
 	UpdatedAt *big.Int
 }
 
+// LogSubmissionReceived is emitted for the submission of each oracle to a
+// round, including the node's own.
+type LogSubmissionReceived struct {
+	types.Log
+	Submission *big.Int
+	Round      uint32
+	Oracle     common.Address
+}
+
 var fluxAggregatorLogTypes = map[common.Hash]interface{}{
-	AggregatorNewRoundLogTopic20191220:      &LogNewRound{},
-	AggregatorAnswerUpdatedLogTopic20191220: &LogAnswerUpdated{},
+	AggregatorNewRoundLogTopic20191220:           &LogNewRound{},
+	AggregatorAnswerUpdatedLogTopic20191220:      &LogAnswerUpdated{},
+	AggregatorSubmissionReceivedLogTopic20191220: &LogSubmissionReceived{},
 }
 
 func NewFluxAggregator(address common.Address, ethClient eth.Client, logBroadcaster eth.LogBroadcaster) (FluxAggregator, error) {
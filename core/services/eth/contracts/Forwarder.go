@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ForwarderABI is the interface of the forwarder contracts which the node can
+// send its transactions through. The forwarder calls to with data, and
+// reverts if the call reverts. Only authorized senders may call forward.
+const ForwarderABI = `[{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"forward","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+var forwarderABI = mustGetABI(ForwarderABI)
+
+// EncodeForwardCall returns the payload of a call to a forwarder contract
+// which forwards data to the given address.
+func EncodeForwardCall(to common.Address, data []byte) ([]byte, error) {
+	return forwarderABI.Pack("forward", to, data)
+}
@@ -7,6 +7,7 @@ import (
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
@@ -47,6 +48,11 @@ func (nc *NullClient) CallContext(ctx context.Context, result interface{}, metho
 	return nil
 }
 
+func (nc *NullClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	logger.Debug("NullClient#BatchCallContext")
+	return nil
+}
+
 func (nc *NullClient) HeaderByNumber(ctx context.Context, n *big.Int) (*models.Head, error) {
 	logger.Debug("NullClient#HeaderByNumber")
 	return nil, nil
@@ -0,0 +1,43 @@
+package eth
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// ExtractRevertReason returns the reason given by the contract when err was
+// returned by an eth_call or eth_estimateGas which reverted. The second return
+// value is false if err does not come from a revert, e.g. when the node could
+// not be reached.
+//
+// Geth returns the revert data in the error's data field, Parity prefixes it
+// with "Reverted ". If the data cannot be decoded as a call to Error(string),
+// the error message itself is returned as the reason.
+func ExtractRevertReason(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			data = strings.TrimPrefix(data, "Reverted ")
+			if b, decodeErr := hexutil.Decode(data); decodeErr == nil {
+				if reason, unpackErr := abi.UnpackRevert(b); unpackErr == nil {
+					return reason, true
+				}
+			}
+			if strings.HasPrefix(data, "0x") {
+				return err.Error(), true
+			}
+		}
+	}
+	msg := err.Error()
+	if strings.Contains(msg, "execution reverted") || strings.Contains(msg, "VM execution error") {
+		return msg, true
+	}
+	return "", false
+}
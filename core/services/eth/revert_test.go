@@ -0,0 +1,46 @@
+package eth_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/stretchr/testify/assert"
+)
+
+type dataError struct {
+	msg  string
+	data interface{}
+}
+
+func (e dataError) Error() string          { return e.msg }
+func (e dataError) ErrorData() interface{} { return e.data }
+
+func Test_ExtractRevertReason(t *testing.T) {
+	t.Parallel()
+
+	encodedReason := "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000"
+
+	tests := []struct {
+		name     string
+		err      error
+		reason   string
+		reverted bool
+	}{
+		{"nil", nil, "", false},
+		{"unrelated error", errors.New("dial tcp: connection refused"), "", false},
+		{"geth with reason", dataError{"execution reverted: not allowed", encodedReason}, "not allowed", true},
+		{"parity with reason", dataError{"VM execution error.", "Reverted " + encodedReason}, "not allowed", true},
+		{"geth without reason", dataError{"execution reverted", "0x"}, "execution reverted", true},
+		{"message only", errors.New("execution reverted"), "execution reverted", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			reason, reverted := eth.ExtractRevertReason(test.err)
+			assert.Equal(t, test.reverted, reverted)
+			assert.Equal(t, test.reason, reason)
+		})
+	}
+}
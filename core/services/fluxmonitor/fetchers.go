@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -32,8 +35,11 @@ type Fetcher interface {
 type httpFetcher struct {
 	client      *http.Client
 	url         *url.URL
+	method      string
+	headers     map[string]string
 	requestData map[string]interface{}
 	sizeLimit   int64
+	weight      float64
 }
 
 func newHTTPFetcher(
@@ -49,19 +55,66 @@ func newHTTPFetcher(
 	return &httpFetcher{
 		client:      client,
 		url:         url,
+		method:      http.MethodPost,
 		requestData: requestData,
 		sizeLimit:   sizeLimit,
+		weight:      1,
 	}
 }
 
+// newFeedFetcher returns an httpFetcher for a feed whose bridge, if any, has
+// been resolved to its URL, requesting it as the feed sets out and otherwise
+// with the given timeout and request data.
+func newFeedFetcher(
+	feed models.Feed,
+	timeout models.Duration,
+	requestData map[string]interface{},
+	sizeLimit int64,
+) (Fetcher, error) {
+	feedURL, err := url.ParseRequestURI(feed.URL)
+	if err != nil {
+		return nil, err
+	}
+	if feed.Timeout != nil {
+		timeout = *feed.Timeout
+	}
+	if feed.Body != nil {
+		if requestData, err = feed.Body.AsMap(); err != nil {
+			return nil, errors.Wrap(err, "feed body must be a JSON object")
+		}
+	}
+
+	fetcher := newHTTPFetcher(timeout, requestData, feedURL, sizeLimit).(*httpFetcher)
+	fetcher.method = feed.RequestMethod()
+	fetcher.headers = feed.Headers
+	fetcher.weight = feed.AggregationWeight()
+	promFMFeedWeight.WithLabelValues(feedURL.String()).Set(fetcher.weight)
+	return fetcher, nil
+}
+
 func (p *httpFetcher) Fetch(meta map[string]interface{}) (decimal.Decimal, error) {
-	request := withIDAndMeta(p.requestData, meta)
-	body, err := json.Marshal(request)
+	var body io.Reader
+	if p.method != http.MethodGet {
+		request := withIDAndMeta(p.requestData, meta)
+		encoded, err := json.Marshal(request)
+		if err != nil {
+			return decimal.Decimal{}, errors.Wrap(err, "error encoding request body as JSON")
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(p.method, p.url.String(), body)
 	if err != nil {
-		return decimal.Decimal{}, errors.Wrap(err, "error encoding request body as JSON")
+		return decimal.Decimal{}, errors.Wrap(err, fmt.Sprintf("unable to build request to %s", p.url.String()))
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
 	}
 
-	r, err := p.client.Post(p.url.String(), "application/json", bytes.NewReader(body))
+	r, err := p.client.Do(req)
 	if err != nil {
 		return decimal.Decimal{}, errors.Wrap(err, fmt.Sprintf("unable to fetch price from %s with payload '%s'", p.url.String(), p.requestData))
 	}
@@ -90,10 +143,15 @@ func (p *httpFetcher) Fetch(meta map[string]interface{}) (decimal.Decimal, error
 		fmt.Sprintf("fetched price %v from %s", *result, p.url.String()),
 		"price", result,
 		"url", p.url.String(),
+		"weight", p.weight,
 	)
 	return *result, nil
 }
 
+func (p *httpFetcher) aggregationWeight() float64 {
+	return p.weight
+}
+
 func (p *httpFetcher) String() string {
 	return fmt.Sprintf("http price fetcher: %s", p.url.String())
 }
@@ -123,72 +181,134 @@ func (pr adapterResponse) Result() *decimal.Decimal {
 	return pr.Data.Result
 }
 
-// medianFetcher fetches from all fetchers, and returns the median value, or
-// average if even number of results.
-type medianFetcher struct {
-	fetchers []Fetcher
+// outlierRejectingFetcher is a Fetcher which discards the answers of feeds
+// that lie too far from the others, and reports the feeds it discarded.
+type outlierRejectingFetcher interface {
+	fetchRejectingOutliers(meta map[string]interface{}) (decimal.Decimal, []string, error)
+}
+
+// weightedFetcher is a Fetcher whose answers count for more, or less, than
+// those of other fetchers in an aggregate.
+type weightedFetcher interface {
+	aggregationWeight() float64
+}
+
+// aggregateFetcher fetches from all fetchers, and returns their answers
+// combined by the strategy of its aggregation config, by default the median,
+// or average of the middle two if even number of results, each answer
+// weighted by its fetcher's weight. Outliers, as set by the config, are
+// discarded first.
+type aggregateFetcher struct {
+	fetchers    []Fetcher
+	aggregation models.AggregationConfig
+
+	// latest holds the last answer, or error, of each fetcher.
+	latest    []FeedState
+	latestMtx sync.RWMutex
 }
 
-// newMedianFetcherFromURLs creates a median fetcher that retrieves a price
-// from all passed URLs using httpFetcher, and returns the median.
-func newMedianFetcherFromURLs(
+// newAggregateFetcherFromFeeds creates an aggregate fetcher that retrieves a
+// price from all passed feeds using httpFetcher, and returns their aggregate.
+func newAggregateFetcherFromFeeds(
+	aggregation models.AggregationConfig,
 	timeout models.Duration,
 	requestData map[string]interface{},
-	priceURLs []*url.URL,
+	feeds []models.Feed,
 	sizeLimit int64,
 ) (Fetcher, error) {
 	fetchers := []Fetcher{}
-	for _, url := range priceURLs {
-		ps := newHTTPFetcher(timeout, requestData, url, sizeLimit)
+	for _, feed := range feeds {
+		ps, err := newFeedFetcher(feed, timeout, requestData, sizeLimit)
+		if err != nil {
+			return nil, err
+		}
 		fetchers = append(fetchers, ps)
 	}
 
-	medianFetcher, err := newMedianFetcher(fetchers...)
+	aggregateFetcher, err := newAggregateFetcher(aggregation, fetchers...)
 	if err != nil {
 		return nil, err
 	}
 
-	return medianFetcher, nil
+	return aggregateFetcher, nil
 }
 
-func newMedianFetcher(fetchers ...Fetcher) (Fetcher, error) {
+func newAggregateFetcher(aggregation models.AggregationConfig, fetchers ...Fetcher) (Fetcher, error) {
 	if len(fetchers) == 0 {
-		return nil, errors.New("must pass in at least one price fetcher to newMedianFetcher")
+		return nil, errors.New("must pass in at least one price fetcher to newAggregateFetcher")
+	}
+	latest := make([]FeedState, len(fetchers))
+	for i, fetcher := range fetchers {
+		latest[i].Feed = feedName(fetcher)
 	}
-	return &medianFetcher{
-		fetchers: fetchers,
+	return &aggregateFetcher{
+		fetchers:    fetchers,
+		aggregation: aggregation.WithDefaults(),
+		latest:      latest,
 	}, nil
 }
 
-func (m *medianFetcher) Fetch(meta map[string]interface{}) (decimal.Decimal, error) {
-	prices := []decimal.Decimal{}
+func (m *aggregateFetcher) Fetch(meta map[string]interface{}) (decimal.Decimal, error) {
+	price, _, err := m.fetchRejectingOutliers(meta)
+	return price, err
+}
+
+func (m *aggregateFetcher) fetchRejectingOutliers(meta map[string]interface{}) (decimal.Decimal, []string, error) {
+	answers := []feedAnswer{}
 	fetchErrors := []error{}
 
 	type result struct {
-		price decimal.Decimal
-		err   error
+		index  int
+		answer feedAnswer
+		err    error
 	}
 
 	chResults := make(chan result)
-	for _, fetcher := range m.fetchers {
-		fetcher := fetcher
+	for i, fetcher := range m.fetchers {
+		i, fetcher := i, fetcher
 		go func() {
 			price, err := fetcher.Fetch(meta)
 			if err != nil {
 				logger.Error(err)
-				chResults <- result{err: err}
+				chResults <- result{index: i, err: err}
 			} else {
-				chResults <- result{price: price}
+				chResults <- result{index: i, answer: feedAnswer{feed: feedName(fetcher), price: price, weight: feedWeight(fetcher)}}
 			}
 		}()
 	}
 
-	for i := 0; i < len(m.fetchers); i++ {
+	fetchedAt := time.Now()
+	results := make([]result, len(m.fetchers))
+	for range m.fetchers {
 		r := <-chResults
+		results[r.index] = r
 		if r.err != nil {
 			fetchErrors = append(fetchErrors, r.err)
 		} else {
-			prices = append(prices, r.price)
+			answers = append(answers, r.answer)
+		}
+	}
+
+	m.latestMtx.Lock()
+	for i, r := range results {
+		m.latest[i].FetchedAt = &fetchedAt
+		if r.err != nil {
+			m.latest[i].Answer = nil
+			m.latest[i].Error = r.err.Error()
+		} else {
+			answer := r.answer.price
+			m.latest[i].Answer = &answer
+			m.latest[i].Error = ""
+		}
+	}
+	m.latestMtx.Unlock()
+
+	if len(answers) < int(m.aggregation.MinAnswers) {
+		return decimal.Decimal{}, nil, insufficientAnswersError{
+			answers:    len(answers),
+			feeds:      len(m.fetchers),
+			minAnswers: int(m.aggregation.MinAnswers),
+			cause:      multierr.Combine(fetchErrors...),
 		}
 	}
 
@@ -196,24 +316,187 @@ func (m *medianFetcher) Fetch(meta map[string]interface{}) (decimal.Decimal, err
 	fetchErrorsCount := len(fetchErrors)
 	errorRate := float64(fetchErrorsCount) / float64(fetchersCount)
 	if errorRate >= 0.5 {
-		err := errors.Wrap(multierr.Combine(fetchErrors...), fmt.Sprintf("at least 50%% of the fetchers in median failed (%d/%d)", fetchErrorsCount, fetchersCount))
-		return decimal.Decimal{}, err
+		err := errors.Wrap(multierr.Combine(fetchErrors...), fmt.Sprintf("at least 50%% of the fetchers in %s failed (%d/%d)", m.aggregation.Strategy, fetchErrorsCount, fetchersCount))
+		return decimal.Decimal{}, nil, err
 	}
 
-	sort.Slice(prices, func(i, j int) bool {
-		return prices[i].LessThan(prices[j])
-	})
-	k := len(prices) / 2
-	if len(prices)%2 == 1 {
-		return prices[k], nil
-	}
-	return prices[k].Add(prices[k-1]).Div(decimal.NewFromInt(2)), nil
+	kept, rejected := rejectOutliers(m.aggregation, answers)
+	return aggregate(m.aggregation, kept), rejected, nil
+}
+
+func (m *aggregateFetcher) feedStates() []FeedState {
+	m.latestMtx.RLock()
+	defer m.latestMtx.RUnlock()
+	return append([]FeedState{}, m.latest...)
 }
 
-func (m *medianFetcher) String() string {
+func (m *aggregateFetcher) String() string {
 	fetcherDescriptions := make([]string, len(m.fetchers))
 	for i, fetcher := range m.fetchers {
 		fetcherDescriptions[i] = fmt.Sprintf("%s", fetcher)
 	}
-	return fmt.Sprintf("median fetcher: %s", strings.Join(fetcherDescriptions, ","))
+	return fmt.Sprintf("%s fetcher: %s", m.aggregation.Strategy, strings.Join(fetcherDescriptions, ","))
+}
+
+// insufficientAnswersError is returned by an aggregateFetcher when fewer of
+// its feeds answered than the MinAnswers of its aggregation config.
+type insufficientAnswersError struct {
+	answers    int
+	feeds      int
+	minAnswers int
+	cause      error
+}
+
+func (e insufficientAnswersError) Error() string {
+	return fmt.Sprintf("%d of %d feeds answered, fewer than the minimum of %d: %v", e.answers, e.feeds, e.minAnswers, e.cause)
+}
+
+// feedAnswer is the price fetched from a feed, and the weight of the feed.
+type feedAnswer struct {
+	feed   string
+	price  decimal.Decimal
+	weight float64
+}
+
+// feedName returns the URL of an http fetcher, by which its feed is known in
+// logs and runs, or else the fetcher's description.
+func feedName(fetcher Fetcher) string {
+	if hf, ok := fetcher.(*httpFetcher); ok {
+		return hf.url.String()
+	}
+	return fmt.Sprintf("%s", fetcher)
+}
+
+// feedWeight returns the weight of a fetcher's answers in an aggregate, 1
+// unless it is weighted.
+func feedWeight(fetcher Fetcher) float64 {
+	if wf, ok := fetcher.(weightedFetcher); ok {
+		return wf.aggregationWeight()
+	}
+	return 1
+}
+
+// rejectOutliers returns the answers which are not outliers by
+// the bounds of the aggregation config, along with the feeds of those which
+// are. An answer is an outlier if it differs from the median of the answers
+// by more than MaxDeviationPercent of the median, or by more than MaxMADs
+// median absolute deviations of the answers. Neither test rejects anything
+// when the median, or the median absolute deviation, is zero. The weights of
+// the feeds play no part in which answers are outliers.
+func rejectOutliers(aggregation models.AggregationConfig, answers []feedAnswer) ([]feedAnswer, []string) {
+	if aggregation.MaxDeviationPercent <= 0 && aggregation.MaxMADs <= 0 {
+		return answers, nil
+	}
+
+	prices := make([]decimal.Decimal, len(answers))
+	for i, answer := range answers {
+		prices[i] = answer.price
+	}
+
+	mid := median(prices)
+	deviations := make([]decimal.Decimal, len(answers))
+	for i, answer := range answers {
+		deviations[i] = answer.price.Sub(mid).Abs()
+	}
+	mad := median(append([]decimal.Decimal{}, deviations...))
+
+	var maxDeviation *decimal.Decimal
+	bound := func(max decimal.Decimal) {
+		if maxDeviation == nil || max.LessThan(*maxDeviation) {
+			maxDeviation = &max
+		}
+	}
+	if aggregation.MaxDeviationPercent > 0 && !mid.IsZero() {
+		bound(mid.Abs().Mul(decimal.NewFromFloat(aggregation.MaxDeviationPercent)).Div(decimal.NewFromInt(100)))
+	}
+	if aggregation.MaxMADs > 0 && !mad.IsZero() {
+		bound(mad.Mul(decimal.NewFromFloat(aggregation.MaxMADs)))
+	}
+	if maxDeviation == nil {
+		return answers, nil
+	}
+
+	var kept []feedAnswer
+	var rejected []string
+	for i, answer := range answers {
+		if deviations[i].GreaterThan(*maxDeviation) {
+			logger.Warnw("Discarding outlying answer from feed",
+				"feed", answer.feed,
+				"answer", answer.price,
+				"median", mid,
+				"maxDeviation", *maxDeviation,
+			)
+			rejected = append(rejected, answer.feed)
+			continue
+		}
+		kept = append(kept, answer)
+	}
+	return kept, rejected
+}
+
+// aggregate combines the answers, of which there is at least one, by the
+// strategy of the aggregation config, weighting each by its feed's weight.
+// The trimmed mean trims TrimPercent of the answers from each end by count,
+// and then takes the weighted mean of the rest.
+func aggregate(aggregation models.AggregationConfig, answers []feedAnswer) decimal.Decimal {
+	sort.SliceStable(answers, func(i, j int) bool {
+		return answers[i].price.LessThan(answers[j].price)
+	})
+	switch aggregation.Strategy {
+	case models.AggregationMean:
+		return weightedMean(answers)
+	case models.AggregationTrimmedMean:
+		trim := int(float64(len(answers)) * aggregation.TrimPercent / 100)
+		return weightedMean(answers[trim : len(answers)-trim])
+	default:
+		return weightedMedian(answers)
+	}
+}
+
+// weightedMedian returns the price at which half the total weight of the
+// answers, sorted by price, lies on either side. Where that falls exactly
+// between two answers, it is their average, so that answers of equal weight
+// have their ordinary median.
+func weightedMedian(answers []feedAnswer) decimal.Decimal {
+	total := decimal.Zero
+	for _, answer := range answers {
+		total = total.Add(decimal.NewFromFloat(answer.weight))
+	}
+	half := total.Div(decimal.NewFromInt(2))
+
+	cumulative := decimal.Zero
+	for i, answer := range answers {
+		cumulative = cumulative.Add(decimal.NewFromFloat(answer.weight))
+		if cumulative.Equal(half) && i+1 < len(answers) {
+			return answer.price.Add(answers[i+1].price).Div(decimal.NewFromInt(2))
+		}
+		if cumulative.GreaterThan(half) {
+			return answer.price
+		}
+	}
+	return answers[len(answers)-1].price
+}
+
+func weightedMean(answers []feedAnswer) decimal.Decimal {
+	sum := decimal.Zero
+	total := decimal.Zero
+	for _, answer := range answers {
+		weight := decimal.NewFromFloat(answer.weight)
+		sum = sum.Add(answer.price.Mul(weight))
+		total = total.Add(weight)
+	}
+	return sum.Div(total)
+}
+
+// median returns the median of the prices, of which there is at least one,
+// sorting them in place.
+func median(prices []decimal.Decimal) decimal.Decimal {
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LessThan(prices[j])
+	})
+	k := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[k]
+	}
+	return prices[k].Add(prices[k-1]).Div(decimal.NewFromInt(2))
 }
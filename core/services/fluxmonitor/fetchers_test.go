@@ -64,16 +64,14 @@ func TestNewMedianFetcherFromURLs_Happy(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			var urls []*url.URL
+			var feeds []models.Feed
 			for _, price := range test.prices {
 				s := httptest.NewServer(fakePriceResponder(t, ethUSDPairing, price))
 				defer s.Close()
-				newURL, err := url.ParseRequestURI(s.URL)
-				require.NoError(t, err)
-				urls = append(urls, newURL)
+				feeds = append(feeds, models.Feed{URL: s.URL})
 			}
 
-			medianFetcher, err := newMedianFetcherFromURLs(defaultHTTPTimeout, ethUSDPairing, urls, 32768)
+			medianFetcher, err := newAggregateFetcherFromFeeds(models.AggregationConfig{}, defaultHTTPTimeout, ethUSDPairing, feeds, 32768)
 			require.NoError(t, err)
 
 			medianPrice, err := medianFetcher.Fetch(emptyMeta)
@@ -83,12 +81,12 @@ func TestNewMedianFetcherFromURLs_Happy(t *testing.T) {
 	}
 }
 
-func TestNewMedianFetcherFromURLs_EmptyError(t *testing.T) {
+func TestNewMedianFetcherFromFeeds_EmptyError(t *testing.T) {
 	s1 := httptest.NewServer(fakePriceResponder(t, ethUSDPairing, decimal.NewFromInt(101)))
 	defer s1.Close()
-	var urls []*url.URL
+	var feeds []models.Feed
 
-	_, err := newMedianFetcherFromURLs(defaultHTTPTimeout, ethUSDPairing, urls, 32768)
+	_, err := newAggregateFetcherFromFeeds(models.AggregationConfig{}, defaultHTTPTimeout, ethUSDPairing, feeds, 32768)
 	require.Error(t, err)
 }
 
@@ -105,6 +103,56 @@ func TestHTTPFetcher_Happy(t *testing.T) {
 	assert.Equal(t, decimal.NewFromInt(9700), price)
 }
 
+func TestFeedFetcher_RequestsAsFeedSetsOut(t *testing.T) {
+	response := adapterResponse{Data: dataWithResult(t, decimal.NewFromInt(9700))}
+
+	t.Run("get with headers", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "s3cret", r.Header.Get("X-Api-Key"))
+			body, _ := ioutil.ReadAll(r.Body)
+			assert.Empty(t, body)
+			require.NoError(t, json.NewEncoder(w).Encode(response))
+		}))
+		defer s.Close()
+
+		fetcher, err := newFeedFetcher(models.Feed{URL: s.URL, Method: http.MethodGet, Headers: map[string]string{"X-Api-Key": "s3cret"}}, defaultHTTPTimeout, ethUSDPairing, 32768)
+		require.NoError(t, err)
+		price, err := fetcher.Fetch(emptyMeta)
+		require.NoError(t, err)
+		assert.Equal(t, "9700", price.String())
+	})
+
+	t.Run("post with body", func(t *testing.T) {
+		btcUSDPairing := utils.MustUnmarshalToMap(`{"data":{"coin":"BTC","market":"USD"}}`)
+		s := httptest.NewServer(fakePriceResponder(t, btcUSDPairing, decimal.NewFromInt(9700)))
+		defer s.Close()
+
+		body, err := models.ParseJSON([]byte(`{"data":{"coin":"BTC","market":"USD"}}`))
+		require.NoError(t, err)
+		fetcher, err := newFeedFetcher(models.Feed{URL: s.URL, Body: &body}, defaultHTTPTimeout, ethUSDPairing, 32768)
+		require.NoError(t, err)
+		price, err := fetcher.Fetch(emptyMeta)
+		require.NoError(t, err)
+		assert.Equal(t, "9700", price.String())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		chDone := make(chan struct{})
+		defer close(chDone)
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-chDone
+		}))
+		defer s.Close()
+
+		timeout := models.MustMakeDuration(10 * time.Millisecond)
+		fetcher, err := newFeedFetcher(models.Feed{URL: s.URL, Timeout: &timeout}, defaultHTTPTimeout, ethUSDPairing, 32768)
+		require.NoError(t, err)
+		_, err = fetcher.Fetch(emptyMeta)
+		require.Error(t, err)
+	})
+}
+
 func TestHTTPFetcher_Meta(t *testing.T) {
 	empty := adapterResponse{}
 
@@ -223,14 +271,14 @@ func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
 }
 
 func TestNewMedianFetcher_EmptyFetchersError(t *testing.T) {
-	_, err := newMedianFetcher()
+	_, err := newAggregateFetcher(models.AggregationConfig{})
 	require.Error(t, err)
 }
 
 func TestMedianFetcher_FetchError(t *testing.T) {
 	s1 := newFixedPricedFetcher(decimal.NewFromInt(102))
 	s2 := newErroringPricedFetcher()
-	medianFetcher, err := newMedianFetcher(s1, s2)
+	medianFetcher, err := newAggregateFetcher(models.AggregationConfig{}, s1, s2)
 	require.NoError(t, err)
 	price, err := medianFetcher.Fetch(emptyMeta)
 	assert.Error(t, err)
@@ -253,7 +301,7 @@ func TestMedianFetcher_MajorityFetches(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			medianFetcher, err := newMedianFetcher(test.fetchers...)
+			medianFetcher, err := newAggregateFetcher(models.AggregationConfig{}, test.fetchers...)
 			require.NoError(t, err)
 
 			medianPrice, err := medianFetcher.Fetch(emptyMeta)
@@ -281,7 +329,7 @@ func TestMedianFetcher_MajorityFetchesCalculatesCorrectMedian(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			medianFetcher, err := newMedianFetcher(test.fetchers...)
+			medianFetcher, err := newAggregateFetcher(models.AggregationConfig{}, test.fetchers...)
 			require.NoError(t, err)
 
 			medianPrice, err := medianFetcher.Fetch(emptyMeta)
@@ -307,7 +355,7 @@ func TestMedianFetcher_MinorityErrors(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			medianFetcher, err := newMedianFetcher(test.fetchers...)
+			medianFetcher, err := newAggregateFetcher(models.AggregationConfig{}, test.fetchers...)
 			require.NoError(t, err)
 
 			medianPrice, err := medianFetcher.Fetch(emptyMeta)
@@ -317,6 +365,121 @@ func TestMedianFetcher_MinorityErrors(t *testing.T) {
 	}
 }
 
+func TestAggregateFetcher_Strategies(t *testing.T) {
+	var fetchers []Fetcher
+	for _, price := range []int64{1, 50, 60, 70, 80, 90, 100, 110, 120, 1000} {
+		fetchers = append(fetchers, newFixedPricedFetcher(decimal.NewFromInt(price)))
+	}
+
+	tests := []struct {
+		name        string
+		aggregation models.AggregationConfig
+		expected    string
+	}{
+		{"default", models.AggregationConfig{}, "85"},
+		{"median", models.AggregationConfig{Strategy: models.AggregationMedian}, "85"},
+		{"mean", models.AggregationConfig{Strategy: models.AggregationMean}, "168.1"},
+		{"trimmed mean 10%", models.AggregationConfig{Strategy: models.AggregationTrimmedMean, TrimPercent: 10}, "85"},
+		{"trimmed mean 25%", models.AggregationConfig{Strategy: models.AggregationTrimmedMean, TrimPercent: 25}, "85"},
+		{"trimmed mean 5%", models.AggregationConfig{Strategy: models.AggregationTrimmedMean, TrimPercent: 5}, "168.1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aggregateFetcher, err := newAggregateFetcher(test.aggregation, fetchers...)
+			require.NoError(t, err)
+
+			price, err := aggregateFetcher.Fetch(emptyMeta)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, price.String())
+		})
+	}
+}
+
+func TestAggregateFetcher_WeightedFeeds(t *testing.T) {
+	tests := []struct {
+		name        string
+		weights     []float64
+		aggregation models.AggregationConfig
+		expected    string
+	}{
+		{"median", []float64{1, 1, 3}, models.AggregationConfig{Strategy: models.AggregationMedian}, "200"},
+		{"median between answers", []float64{1, 1, 2}, models.AggregationConfig{Strategy: models.AggregationMedian}, "155"},
+		{"mean", []float64{1, 1, 3}, models.AggregationConfig{Strategy: models.AggregationMean}, "162"},
+		{"fractional weights", []float64{0.5, 0.5, 0.25}, models.AggregationConfig{Strategy: models.AggregationMean}, "124"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var fetchers []Fetcher
+			for i, price := range []int64{100, 110, 200} {
+				fetchers = append(fetchers, newWeightedFixedPricedFetcher(decimal.NewFromInt(price), test.weights[i]))
+			}
+			aggregateFetcher, err := newAggregateFetcher(test.aggregation, fetchers...)
+			require.NoError(t, err)
+
+			price, err := aggregateFetcher.Fetch(emptyMeta)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, price.String())
+		})
+	}
+}
+
+func TestAggregateFetcher_MinAnswers(t *testing.T) {
+	fetchers := []Fetcher{
+		newFixedPricedFetcher(decimal.NewFromInt(100)),
+		newFixedPricedFetcher(decimal.NewFromInt(110)),
+		newFixedPricedFetcher(decimal.NewFromInt(120)),
+		newErroringPricedFetcher(),
+	}
+
+	fetcher, err := newAggregateFetcher(models.AggregationConfig{MinAnswers: 3}, fetchers...)
+	require.NoError(t, err)
+	price, err := fetcher.Fetch(emptyMeta)
+	require.NoError(t, err)
+	assert.Equal(t, "110", price.String())
+
+	fetcher, err = newAggregateFetcher(models.AggregationConfig{MinAnswers: 4}, fetchers...)
+	require.NoError(t, err)
+	_, err = fetcher.Fetch(emptyMeta)
+	require.Error(t, err)
+	tooFew, ok := err.(insufficientAnswersError)
+	require.True(t, ok)
+	assert.Equal(t, 3, tooFew.answers)
+	assert.Equal(t, 4, tooFew.feeds)
+}
+
+func TestAggregateFetcher_RejectsOutliers(t *testing.T) {
+	answers := []int64{98, 99, 100, 101, 102, 150}
+	tests := []struct {
+		name        string
+		aggregation models.AggregationConfig
+		expected    string
+		rejected    int
+	}{
+		{"no bounds", models.AggregationConfig{Strategy: models.AggregationMean}, "108.3333333333333333", 0},
+		{"max deviation percent", models.AggregationConfig{Strategy: models.AggregationMean, MaxDeviationPercent: 10}, "100", 1},
+		{"max MADs", models.AggregationConfig{Strategy: models.AggregationMean, MaxMADs: 3}, "100", 1},
+		{"loose bounds", models.AggregationConfig{Strategy: models.AggregationMean, MaxDeviationPercent: 60, MaxMADs: 50}, "108.3333333333333333", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var fetchers []Fetcher
+			for _, answer := range answers {
+				fetchers = append(fetchers, newFixedPricedFetcher(decimal.NewFromInt(answer)))
+			}
+			fetcher, err := newAggregateFetcher(test.aggregation, fetchers...)
+			require.NoError(t, err)
+
+			price, rejected, err := fetcher.(outlierRejectingFetcher).fetchRejectingOutliers(emptyMeta)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, price.String())
+			assert.Len(t, rejected, test.rejected)
+		})
+	}
+}
+
 func TestHTTPFetcher_AddsArbitraryRequestID(t *testing.T) {
 	empty := adapterResponse{}
 
@@ -4,14 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flags_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerting"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
 	"github.com/smartcontractkit/chainlink/core/store"
@@ -23,13 +26,23 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/jinzhu/gorm"
+	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
 	"github.com/shopspring/decimal"
 	"github.com/tevino/abool"
 )
 
 const hibernationPollPeriod = 24 * time.Hour
 
+// maxUnderfundedBackoff is the longest a checker waits between polls of an
+// underfunded aggregator.
+const maxUnderfundedBackoff = time.Hour
+
+// maxLaggingRounds is how many rounds in a row the node may submit after a
+// supermajority of the other oracles before the operator is alerted.
+const maxLaggingRounds = 3
+
 //go:generate mockery --name Service --output ../../internal/mocks/ --case=underscore
 //go:generate mockery --name DeviationCheckerFactory --output ../../internal/mocks/ --case=underscore
 //go:generate mockery --name DeviationChecker --output ../../internal/mocks/ --case=underscore
@@ -48,6 +61,8 @@ type RunManager interface {
 type Service interface {
 	AddJob(models.JobSpec) error
 	RemoveJob(*models.ID)
+	ReloadJob(models.JobSpec) error
+	State(models.ID) ([]CheckerState, bool)
 	Start() error
 	Stop()
 }
@@ -59,6 +74,8 @@ type concreteFluxMonitor struct {
 	checkerFactory DeviationCheckerFactory
 	chAdd          chan addEntry
 	chRemove       chan models.ID
+	chReload       chan reloadEntry
+	chState        chan stateRequest
 	chConnect      chan *models.Head
 	chDisconnect   chan struct{}
 	chStop         chan struct{}
@@ -67,8 +84,24 @@ type concreteFluxMonitor struct {
 }
 
 type addEntry struct {
+	jobID        models.ID
+	initiatorIDs []int64
+	checkers     []DeviationChecker
+}
+
+type reloadEntry struct {
+	jobID    models.ID
+	reloaded map[int64]reloadedInitiator
+}
+
+type reloadedInitiator struct {
+	initr   models.Initiator
+	fetcher Fetcher
+}
+
+type stateRequest struct {
 	jobID    models.ID
-	checkers []DeviationChecker
+	chStates chan []CheckerState
 }
 
 // New creates a service that manages a collection of DeviationCheckers,
@@ -85,9 +118,12 @@ func New(
 		checkerFactory: pollingDeviationCheckerFactory{
 			store:          store,
 			logBroadcaster: logBroadcaster,
+			roundStates:    newRoundStateCache(),
 		},
 		chAdd:        make(chan addEntry),
 		chRemove:     make(chan models.ID),
+		chReload:     make(chan reloadEntry),
+		chState:      make(chan stateRequest),
 		chConnect:    make(chan *models.Head),
 		chDisconnect: make(chan struct{}),
 		chStop:       make(chan struct{}),
@@ -141,6 +177,7 @@ func (fm *concreteFluxMonitor) serveInternalRequests() {
 	defer close(fm.chDone)
 
 	jobMap := map[models.ID][]DeviationChecker{}
+	initiatorIDs := map[models.ID][]int64{}
 
 	for {
 		select {
@@ -153,6 +190,7 @@ func (fm *concreteFluxMonitor) serveInternalRequests() {
 				checker.Start()
 			}
 			jobMap[entry.jobID] = entry.checkers
+			initiatorIDs[entry.jobID] = entry.initiatorIDs
 
 		case jobID := <-fm.chRemove:
 			checkers, ok := jobMap[jobID]
@@ -164,6 +202,26 @@ func (fm *concreteFluxMonitor) serveInternalRequests() {
 				checker.Stop()
 			}
 			delete(jobMap, jobID)
+			delete(initiatorIDs, jobID)
+
+		case entry := <-fm.chReload:
+			for i, checker := range jobMap[entry.jobID] {
+				if reloaded, ok := entry.reloaded[initiatorIDs[entry.jobID][i]]; ok {
+					checker.Reload(reloaded.initr, reloaded.fetcher)
+				}
+			}
+
+		case request := <-fm.chState:
+			checkers, ok := jobMap[request.jobID]
+			if !ok {
+				request.chStates <- nil
+				continue
+			}
+			states := make([]CheckerState, len(checkers))
+			for i, checker := range checkers {
+				states[i] = checker.State()
+			}
+			request.chStates <- states
 
 		case <-fm.chStop:
 			for _, checkers := range jobMap {
@@ -177,15 +235,20 @@ func (fm *concreteFluxMonitor) serveInternalRequests() {
 }
 
 // AddJob created a DeviationChecker for any job initiators of type
-// InitiatorFluxMonitor.
+// InitiatorFluxMonitor. Jobs bound to another chain than the store's are
+// ignored.
 func (fm *concreteFluxMonitor) AddJob(job models.JobSpec) error {
 	if job.ID == nil {
 		err := errors.New("received job with nil ID")
 		logger.Error(err)
 		return err
 	}
+	if !fm.store.Config.ServesChain(job.ChainID) {
+		return nil
+	}
 
 	var validCheckers []DeviationChecker
+	var initiatorIDs []int64
 	for _, initr := range job.InitiatorsFor(models.InitiatorFluxMonitor) {
 		logger.Debugw("Adding job to flux monitor",
 			"job", job.ID.String(),
@@ -205,12 +268,13 @@ func (fm *concreteFluxMonitor) AddJob(job models.JobSpec) error {
 			return errors.Wrap(err, "factory unable to create checker")
 		}
 		validCheckers = append(validCheckers, checker)
+		initiatorIDs = append(initiatorIDs, initr.ID)
 	}
 	if len(validCheckers) == 0 {
 		return nil
 	}
 
-	fm.chAdd <- addEntry{*job.ID, validCheckers}
+	fm.chAdd <- addEntry{*job.ID, initiatorIDs, validCheckers}
 	return nil
 }
 
@@ -224,6 +288,50 @@ func (fm *concreteFluxMonitor) RemoveJob(id *models.ID) {
 	fm.chRemove <- *id
 }
 
+// ReloadJob replaces the feeds and deviation thresholds of the checkers of
+// the job's fluxmonitor initiators with those of the given job, without
+// restarting them, so that they keep track of the rounds of their
+// aggregators. It does nothing if the flux monitor is not running the job.
+func (fm *concreteFluxMonitor) ReloadJob(job models.JobSpec) error {
+	if job.ID == nil {
+		return errors.New("received job with nil ID")
+	}
+
+	entry := reloadEntry{jobID: *job.ID, reloaded: make(map[int64]reloadedInitiator)}
+	for _, initr := range job.InitiatorsFor(models.InitiatorFluxMonitor) {
+		fetcher, err := newFetcher(initr, fm.store, fm.store.Config.DefaultHTTPTimeout())
+		if err != nil {
+			return errors.Wrap(err, "unable to create fetcher")
+		}
+		entry.reloaded[initr.ID] = reloadedInitiator{initr, fetcher}
+	}
+	if !fm.started {
+		return nil
+	}
+
+	select {
+	case fm.chReload <- entry:
+	case <-fm.chDone:
+	}
+	return nil
+}
+
+// State returns the state of the checker of each fluxmonitor initiator of the
+// job, and false if the flux monitor is not running the job.
+func (fm *concreteFluxMonitor) State(jobID models.ID) ([]CheckerState, bool) {
+	if !fm.started {
+		return nil, false
+	}
+	request := stateRequest{jobID: jobID, chStates: make(chan []CheckerState, 1)}
+	select {
+	case fm.chState <- request:
+	case <-fm.chDone:
+		return nil, false
+	}
+	states := <-request.chStates
+	return states, states != nil
+}
+
 // DeviationCheckerFactory holds the New method needed to create a new instance
 // of a DeviationChecker.
 type DeviationCheckerFactory interface {
@@ -233,6 +341,7 @@ type DeviationCheckerFactory interface {
 type pollingDeviationCheckerFactory struct {
 	store          *store.Store
 	logBroadcaster eth.LogBroadcaster
+	roundStates    *roundStateCache
 }
 
 func (f pollingDeviationCheckerFactory) New(
@@ -249,21 +358,7 @@ func (f pollingDeviationCheckerFactory) New(
 		return nil, fmt.Errorf("pollTimer.period must be equal or greater than %s", minimumPollingInterval)
 	}
 
-	urls, err := ExtractFeedURLs(initr.Feeds, orm)
-	if err != nil {
-		return nil, err
-	}
-
-	requestData, err := initr.RequestData.AsMap()
-	if err != nil {
-		return nil, err
-	}
-
-	fetcher, err := newMedianFetcherFromURLs(
-		timeout,
-		requestData,
-		urls,
-		f.store.Config.DefaultHTTPLimit())
+	fetcher, err := newFetcher(initr, f.store, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -274,15 +369,19 @@ func (f pollingDeviationCheckerFactory) New(
 		return nil, err
 	}
 
+	flagsContractAddress := initr.FlagsContractAddress
+	if flagsContractAddress == utils.ZeroAddress && f.store.Config.FlagsContractAddress() != "" {
+		flagsContractAddress = common.HexToAddress(f.store.Config.FlagsContractAddress())
+	}
+
 	var flagsContract *contracts.Flags
-	if f.store.Config.FlagsContractAddress() != "" {
-		flagsContractAddress := common.HexToAddress(f.store.Config.FlagsContractAddress())
+	if flagsContractAddress != utils.ZeroAddress {
 		flagsContract, err = contracts.NewFlagsContract(flagsContractAddress, f.store.EthClient)
-		errorMsg := fmt.Sprintf("unable to create Flags contract instance, check address: %s", f.store.Config.FlagsContractAddress())
+		errorMsg := fmt.Sprintf("unable to create Flags contract instance, check address: %s", flagsContractAddress.Hex())
 		logger.ErrorIf(err, errorMsg)
 	}
 
-	return NewPollingDeviationChecker(
+	checker, err := NewPollingDeviationChecker(
 		f.store,
 		fluxAggregator,
 		f.logBroadcaster,
@@ -293,41 +392,89 @@ func (f pollingDeviationCheckerFactory) New(
 		flagsContract,
 		func() { f.logBroadcaster.DependentReady() },
 	)
+	if err != nil {
+		return nil, err
+	}
+	checker.roundStates = f.roundStates
+	return checker, nil
 }
 
-// ExtractFeedURLs extracts a list of url.URLs from the feeds parameter of the initiator params
-func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
-	var feedsData []interface{}
-	var urls []*url.URL
+// newFetcher returns the fetcher aggregating the answers of the feeds of a
+// fluxmonitor initiator.
+func newFetcher(initr models.Initiator, store *store.Store, timeout models.Duration) (Fetcher, error) {
+	feeds, err := ExtractFeeds(initr.Feeds, store.ORM)
+	if err != nil {
+		return nil, err
+	}
+
+	requestData, err := initr.RequestData.AsMap()
+	if err != nil {
+		return nil, err
+	}
+
+	return newAggregateFetcherFromFeeds(
+		initr.Aggregation,
+		timeout,
+		requestData,
+		feeds,
+		store.Config.DefaultHTTPLimit())
+}
 
-	err := json.Unmarshal(feeds.Bytes(), &feedsData)
+// ExtractFeeds returns the feeds of the feeds parameter of the initiator
+// params, with the URL and token of each bridge feed set to those of its
+// bridge.
+func ExtractFeeds(feeds models.Feeds, orm *orm.ORM) ([]models.Feed, error) {
+	parsed, err := models.ParseFeeds(feeds)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, entry := range feedsData {
-		var bridgeURL *url.URL
-		var err error
+	for i, feed := range parsed {
+		if feed.Bridge == "" {
+			continue
+		}
+		bridge, err := orm.FindBridge(models.TaskType(feed.Bridge)) // XXX: currently an n query
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = withBridge(feed, bridge)
+	}
+	return parsed, nil
+}
 
-		switch feed := entry.(type) {
-		case string: // feed url - ex: "http://example.com"
-			bridgeURL, err = url.ParseRequestURI(feed)
-		case map[string]interface{}: // named feed - ex: {"bridge": "bridgeName"}
-			bridgeName, ok := feed["bridge"].(string)
-			if !ok {
-				return nil, errors.New("failed to convert bridge type into string")
-			}
-			bridgeURL, err = GetBridgeURLFromName(bridgeName, orm) // XXX: currently an n query
-		default:
-			err = errors.New("unable to extract feed URLs from json")
+// withBridge returns the feed requesting the bridge's URL, authenticated with
+// its outgoing token as the bridge adapter's requests are, unless the feed
+// sets its own Authorization header.
+func withBridge(feed models.Feed, bridge models.BridgeType) models.Feed {
+	headers := map[string]string{"Authorization": "Bearer " + bridge.OutgoingToken}
+	for key, value := range feed.Headers {
+		if http.CanonicalHeaderKey(key) == "Authorization" {
+			delete(headers, "Authorization")
 		}
+		headers[key] = value
+	}
 
+	bridgeURL := url.URL(bridge.URL)
+	feed.URL = bridgeURL.String()
+	feed.Headers = headers
+	return feed
+}
+
+// ExtractFeedURLs extracts a list of url.URLs from the feeds parameter of the initiator params
+func ExtractFeedURLs(feeds models.Feeds, orm *orm.ORM) ([]*url.URL, error) {
+	extracted, err := ExtractFeeds(feeds, orm)
+	if err != nil {
+		return nil, err
+	}
+
+	var urls []*url.URL
+	for _, feed := range extracted {
+		feedURL, err := url.ParseRequestURI(feed.URL)
 		if err != nil {
 			return nil, err
 		}
-		urls = append(urls, bridgeURL)
+		urls = append(urls, feedURL)
 	}
-
 	return urls, nil
 }
 
@@ -347,6 +494,8 @@ func GetBridgeURLFromName(name string, orm *orm.ORM) (*url.URL, error) {
 type DeviationChecker interface {
 	Start()
 	Stop()
+	Reload(initr models.Initiator, fetcher Fetcher)
+	State() CheckerState
 }
 
 // PollingDeviationChecker polls external price adapters via HTTP to check for price swings.
@@ -357,11 +506,16 @@ type PollingDeviationChecker struct {
 	logBroadcaster eth.LogBroadcaster
 	fetcher        Fetcher
 	flagsContract  *contracts.Flags
+	notifiers      []alerting.Notifier
 
 	initr         models.Initiator
 	minJobPayment *assets.Link
 	requestData   models.JSON
 	precision     int32
+	// roundStates, when set, is where the checker keeps the round state of
+	// its aggregator between polls, shared with the other checkers of the
+	// flux monitor.
+	roundStates *roundStateCache
 
 	isHibernating    bool
 	connected        *abool.AtomicBool
@@ -371,6 +525,46 @@ type PollingDeviationChecker struct {
 	hibernationTimer utils.ResettableTimer
 	idleTimer        utils.ResettableTimer
 	roundTimer       utils.ResettableTimer
+	drumbeat         cron.Schedule
+	drumbeatTimer    utils.ResettableTimer
+	awaitingRelease  bool
+
+	// tooFewAnswers is true while polls find fewer feeds answering than
+	// the initiator's minAnswers, and the operator has been alerted so.
+	tooFewAnswers bool
+
+	// underfunded is true while the aggregator cannot pay its oracles, and
+	// the checker polls it only when underfundedTimer fires, backing off
+	// further each time it is still underfunded.
+	underfunded        bool
+	underfundedBackoff backoff.Backoff
+	underfundedTimer   utils.ResettableTimer
+
+	// roundSubmissions are the oracles which have submitted to the latest
+	// rounds of the aggregator. laggingRounds is how many rounds in a row a
+	// supermajority of them submitted before the node, and lagging is true
+	// while that is at least maxLaggingRounds and the operator has been
+	// alerted so.
+	roundSubmissions *roundSubmissions
+	laggingRounds    int
+	lagging          bool
+
+	// The last round state and answer seen by the consume goroutine, which
+	// publishState copies to state for State to read.
+	lastRoundState    *contracts.FluxAggregatorRoundState
+	lastAggregate     *decimal.Decimal
+	lastRejectedFeeds []string
+	lastPolledAt      time.Time
+	lastPollError     string
+	state             CheckerState
+	stateFeeds        feedStateReporter
+	stateMtx          sync.RWMutex
+
+	// reload is the feeds and thresholds which Reload has handed to the
+	// consume goroutine, and which it has yet to take.
+	reload    *reloadedInitiator
+	reloadMtx sync.Mutex
+	chReload  chan struct{}
 
 	readyForLogs func()
 	chStop       chan struct{}
@@ -389,6 +583,15 @@ func NewPollingDeviationChecker(
 	flagsContract *contracts.Flags,
 	readyForLogs func(),
 ) (*PollingDeviationChecker, error) {
+	var drumbeat cron.Schedule
+	if initr.DrumbeatSchedule != "" {
+		var err error
+		drumbeat, err = models.CronParser.Parse(initr.DrumbeatSchedule.String())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid drumbeatSchedule")
+		}
+	}
+
 	return &PollingDeviationChecker{
 		readyForLogs:     readyForLogs,
 		store:            store,
@@ -401,10 +604,19 @@ func NewPollingDeviationChecker(
 		precision:        initr.Precision,
 		runManager:       runManager,
 		fetcher:          fetcher,
+		notifiers:        alerting.NotifiersFromConfig(store.Config),
 		pollTicker:       utils.NewPausableTicker(initr.PollTimer.Period.Duration()),
 		hibernationTimer: utils.NewResettableTimer(),
 		idleTimer:        utils.NewResettableTimer(),
 		roundTimer:       utils.NewResettableTimer(),
+		drumbeat:         drumbeat,
+		drumbeatTimer:    utils.NewResettableTimer(),
+		underfundedBackoff: backoff.Backoff{
+			Min: minUnderfundedBackoff(initr),
+			Max: maxUnderfundedBackoff,
+		},
+		underfundedTimer: utils.NewResettableTimer(),
+		roundSubmissions: newRoundSubmissions(),
 		isHibernating:    false,
 		connected:        abool.New(),
 		backlog: utils.NewBoundedPriorityQueue(map[uint]uint{
@@ -415,6 +627,7 @@ func NewPollingDeviationChecker(
 			PriorityFlagChangedLog:   2,
 		}),
 		chProcessLogs: make(chan struct{}, 1),
+		chReload:      make(chan struct{}, 1),
 		chStop:        make(chan struct{}),
 		waitOnStop:    make(chan struct{}),
 	}, nil
@@ -471,6 +684,8 @@ func (p *PollingDeviationChecker) Stop() {
 	p.hibernationTimer.Stop()
 	p.idleTimer.Stop()
 	p.roundTimer.Stop()
+	p.drumbeatTimer.Stop()
+	p.underfundedTimer.Stop()
 	close(p.chStop)
 	<-p.waitOnStop
 }
@@ -480,6 +695,8 @@ func (p *PollingDeviationChecker) OnConnect() {
 		"jobID", p.initr.JobSpecID.String(),
 		"address", p.initr.Address.Hex(),
 	)
+	// Logs may have been missed while disconnected
+	p.invalidateRoundState()
 	p.connected.Set()
 }
 
@@ -514,6 +731,12 @@ func (p *PollingDeviationChecker) HandleLog(broadcast eth.LogBroadcast, err erro
 	case *contracts.LogAnswerUpdated:
 		p.backlog.Add(PriorityAnswerUpdatedLog, broadcast)
 
+	case *contracts.LogSubmissionReceived:
+		// Recorded straight away, rather than queued behind the round's
+		// NewRound log, and never marked consumed, as submissions are only
+		// kept in memory and backfilled logs are ignored if already recorded.
+		p.roundSubmissions.add(log.Round, log.Oracle)
+
 	case *flags_wrapper.FlagsFlagRaised:
 		if log.Subject == utils.ZeroAddress || log.Subject == p.initr.Address {
 			p.backlog.Add(PriorityFlagChangedLog, broadcast)
@@ -561,6 +784,7 @@ func (p *PollingDeviationChecker) consume() {
 		StartedAt: uint64(time.Now().Unix()),
 	})
 	p.performInitialPoll()
+	p.publishState()
 
 	for {
 		select {
@@ -570,6 +794,9 @@ func (p *PollingDeviationChecker) consume() {
 		case <-p.chProcessLogs:
 			p.processLogs()
 
+		case <-p.chReload:
+			p.applyReload()
+
 		case <-p.pollTicker.Ticks():
 			logger.Debugw("Poll ticker fired",
 				"pollPeriod", p.initr.PollTimer.Period,
@@ -600,12 +827,66 @@ func (p *PollingDeviationChecker) consume() {
 				Abs: float64(p.initr.AbsoluteThreshold),
 			})
 
+		case <-p.drumbeatTimer.Ticks():
+			logger.Debugw("Drumbeat ticker fired",
+				"drumbeatSchedule", p.initr.DrumbeatSchedule,
+				"contract", p.initr.Address.Hex(),
+			)
+			p.pollIfEligible(DeviationThresholds{Rel: 0, Abs: 0})
+			p.resetDrumbeatTimer()
+
 		case <-p.hibernationTimer.Ticks():
 			p.pollIfEligible(DeviationThresholds{Rel: 0, Abs: 0})
+
+		case <-p.underfundedTimer.Ticks():
+			logger.Debugw("Underfunded backoff timer fired",
+				"backoff", p.underfundedBackoff.ForAttempt(p.underfundedBackoff.Attempt()-1),
+				"contract", p.initr.Address.Hex(),
+			)
+			p.pollIfEligible(DeviationThresholds{
+				Rel: float64(p.initr.Threshold),
+				Abs: float64(p.initr.AbsoluteThreshold),
+			})
 		}
+		p.publishState()
 	}
 }
 
+// Reload replaces the feeds and deviation thresholds of the checker with
+// those of initr, polling with fetcher from then on. The checker carries on
+// from the round it is on, rather than starting over as a new checker would.
+func (p *PollingDeviationChecker) Reload(initr models.Initiator, fetcher Fetcher) {
+	p.reloadMtx.Lock()
+	p.reload = &reloadedInitiator{initr, fetcher}
+	p.reloadMtx.Unlock()
+
+	select {
+	case p.chReload <- struct{}{}:
+	default:
+	}
+}
+
+func (p *PollingDeviationChecker) applyReload() {
+	p.reloadMtx.Lock()
+	reload := p.reload
+	p.reload = nil
+	p.reloadMtx.Unlock()
+	if reload == nil {
+		return
+	}
+
+	p.initr.Feeds = reload.initr.Feeds
+	p.initr.Threshold = reload.initr.Threshold
+	p.initr.AbsoluteThreshold = reload.initr.AbsoluteThreshold
+	p.fetcher = reload.fetcher
+	logger.Infow("Reloaded feeds and thresholds",
+		"fetcher", reload.fetcher,
+		"threshold", p.initr.Threshold,
+		"absoluteThreshold", p.initr.AbsoluteThreshold,
+		"contract", p.initr.Address.Hex(),
+	)
+}
+
 func (p *PollingDeviationChecker) performInitialPoll() {
 	if !p.initr.PollTimer.Disabled && !p.isHibernating {
 		p.pollIfEligible(DeviationThresholds{
@@ -653,6 +934,7 @@ func (p *PollingDeviationChecker) processLogs() {
 
 		switch log := broadcast.DecodedLog().(type) {
 		case *contracts.LogNewRound:
+			p.invalidateRoundState()
 			p.respondToNewRoundLog(*log)
 			err = broadcast.MarkConsumed()
 			if err != nil {
@@ -660,6 +942,7 @@ func (p *PollingDeviationChecker) processLogs() {
 			}
 
 		case *contracts.LogAnswerUpdated:
+			p.invalidateRoundState()
 			p.respondToAnswerUpdatedLog(*log)
 			err = broadcast.MarkConsumed()
 			if err != nil {
@@ -687,6 +970,7 @@ func (p *PollingDeviationChecker) processLogs() {
 			logger.Errorf("unknown log %v of type %T", log, log)
 		}
 	}
+	p.settleRounds()
 }
 
 // The AnswerUpdated log tells us that round has successfully closed with a new
@@ -819,6 +1103,10 @@ func (p *PollingDeviationChecker) respondToNewRoundLog(log contracts.LogNewRound
 		logger.Infow(fmt.Sprintf("Ignoring new round request: %v", err), p.loggerFieldsForNewRound(log)...)
 		return
 	}
+	if p.skipSupermajorityRound(logRoundID, roundState) {
+		logger.Infow("Ignoring new round request: a supermajority of oracles has already submitted", p.loggerFieldsForNewRound(log)...)
+		return
+	}
 
 	logger.Infow("Responding to new round request", p.loggerFieldsForNewRound(log)...)
 
@@ -828,7 +1116,7 @@ func (p *PollingDeviationChecker) respondToNewRoundLog(log contracts.LogNewRound
 		return
 	}
 
-	polledAnswer, err := p.fetcher.Fetch(request)
+	polledAnswer, rejectedFeeds, err := p.fetch(request)
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("unable to fetch median price: %v", err), p.loggerFieldsForNewRound(log)...)
 		return
@@ -841,7 +1129,7 @@ func (p *PollingDeviationChecker) respondToNewRoundLog(log contracts.LogNewRound
 		payment = assets.Link(*roundState.PaymentAmount)
 	}
 
-	err = p.createJobRun(polledAnswer, logRoundID, &payment)
+	err = p.createJobRun(polledAnswer, rejectedFeeds, logRoundID, &payment)
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("unable to create job run: %v", err), p.loggerFieldsForNewRound(log)...)
 		return
@@ -854,8 +1142,12 @@ var (
 	ErrPaymentTooLow = errors.New("round payment amount < minimum contract payment")
 )
 
+// checkEligibilityAndAggregatorFunding returns why the node can't submit to
+// the round, if it can't. A simulated initiator records what it would submit
+// whether or not the node is eligible, as another of the node's jobs may be
+// submitting to the aggregator.
 func (p *PollingDeviationChecker) checkEligibilityAndAggregatorFunding(roundState contracts.FluxAggregatorRoundState) error {
-	if !roundState.EligibleToSubmit {
+	if !roundState.EligibleToSubmit && !p.initr.Simulate {
 		return ErrNotEligible
 	} else if !p.sufficientFunds(roundState) {
 		return ErrUnderfunded
@@ -867,6 +1159,13 @@ func (p *PollingDeviationChecker) checkEligibilityAndAggregatorFunding(roundStat
 
 const MinFundedRounds int64 = 3
 
+// skipSupermajorityRound returns true if the initiator skips rounds which a
+// supermajority of the aggregator's oracles have already submitted to, and
+// the round is one of them.
+func (p *PollingDeviationChecker) skipSupermajorityRound(roundID uint32, roundState contracts.FluxAggregatorRoundState) bool {
+	return p.initr.SkipSupermajorityRounds && isSupermajority(p.roundSubmissions.count(roundID), roundState.OracleCount)
+}
+
 // sufficientFunds checks if the contract has sufficient funding to pay all the oracles on a
 // conract for a minimum number of rounds, based on the payment amount in the contract
 func (p *PollingDeviationChecker) sufficientFunds(state contracts.FluxAggregatorRoundState) bool {
@@ -926,6 +1225,17 @@ func (p *PollingDeviationChecker) pollIfEligible(thresholds DeviationThresholds)
 	}
 	loggerFields = append(loggerFields, "reportableRound", roundState.ReportableRoundID)
 
+	// Back off polling an aggregator which can't pay, rather than failing
+	// every poll until it is funded
+	if !p.sufficientFunds(roundState) {
+		p.backOffUnderfunded(roundState)
+		logger.Warnw(fmt.Sprintf("skipping poll: %v, polling again in %s", ErrUnderfunded, p.underfundedBackoff.ForAttempt(p.underfundedBackoff.Attempt()-1)), loggerFields...)
+		return
+	} else if p.underfunded {
+		p.resumeFunded(roundState)
+		logger.Infow("aggregator is funded again, resuming polling", loggerFields...)
+	}
+
 	// If we've just submitted to this round (as the result of a NewRound log, for example) don't submit again
 	roundStats, err := p.store.FindOrCreateFluxMonitorRoundStats(p.initr.Address, roundState.ReportableRoundID)
 	if err != nil {
@@ -934,7 +1244,9 @@ func (p *PollingDeviationChecker) pollIfEligible(thresholds DeviationThresholds)
 		return
 	}
 
-	if roundStats.NumSubmissions > 0 {
+	if roundStats.NumSubmissions > 0 && !p.initr.Simulate {
+		// The round state will show the round answered once the tx is mined
+		p.invalidateRoundState()
 		logger.Infow("skipping poll: round already answered, tx unconfirmed", loggerFields...)
 		return
 	}
@@ -945,6 +1257,10 @@ func (p *PollingDeviationChecker) pollIfEligible(thresholds DeviationThresholds)
 		logger.Infow(fmt.Sprintf("skipping poll: %v", err), loggerFields...)
 		return
 	}
+	if p.skipSupermajorityRound(roundState.ReportableRoundID, roundState) {
+		logger.Infow("skipping poll: a supermajority of oracles has already submitted", loggerFields...)
+		return
+	}
 
 	request, err := models.MarshalToMap(&roundState)
 	if err != nil {
@@ -952,12 +1268,18 @@ func (p *PollingDeviationChecker) pollIfEligible(thresholds DeviationThresholds)
 		return
 	}
 
-	polledAnswer, err := p.fetcher.Fetch(request)
-	if err != nil {
+	polledAnswer, rejectedFeeds, err := p.fetch(request)
+	if tooFew, ok := errors.Cause(err).(insufficientAnswersError); ok {
+		logger.Errorw(fmt.Sprintf("skipping poll: %v", err), loggerFields...)
+		p.store.UpsertErrorFor(p.JobID(), "Too few feeds answered to submit")
+		p.alertTooFewAnswers(&tooFew)
+		return
+	} else if err != nil {
 		logger.Errorw(fmt.Sprintf("can't fetch answer: %v", err), loggerFields...)
 		p.store.UpsertErrorFor(p.JobID(), "Error polling")
 		return
 	}
+	p.alertTooFewAnswers(nil)
 
 	jobSpecID := p.initr.JobSpecID.String()
 	latestAnswer := decimal.NewFromBigInt(roundState.LatestAnswer, -p.precision)
@@ -967,11 +1289,22 @@ func (p *PollingDeviationChecker) pollIfEligible(thresholds DeviationThresholds)
 		"latestAnswer", latestAnswer,
 		"polledAnswer", polledAnswer,
 	)
+	if p.awaitingRelease && !OutsideDeviation(latestAnswer, polledAnswer, p.releaseThresholds()) {
+		logger.Debugw("deviation < release threshold, deviation may trigger a submission again", loggerFields...)
+		p.awaitingRelease = false
+	}
+
 	if roundState.ReportableRoundID > 1 && !OutsideDeviation(latestAnswer, polledAnswer, thresholds) {
 		logger.Debugw("deviation < threshold, not submitting", loggerFields...)
 		return
 	}
 
+	deviationTriggered := roundState.ReportableRoundID > 1 && (thresholds.Rel != 0 || thresholds.Abs != 0)
+	if deviationTriggered && p.awaitingRelease {
+		logger.Debugw("deviation > threshold, but not yet back within release threshold since last submission, not submitting", loggerFields...)
+		return
+	}
+
 	if roundState.ReportableRoundID > 1 {
 		logger.Infow("deviation > threshold, starting new round", loggerFields...)
 	} else {
@@ -985,25 +1318,253 @@ func (p *PollingDeviationChecker) pollIfEligible(thresholds DeviationThresholds)
 		payment = assets.Link(*roundState.PaymentAmount)
 	}
 
-	err = p.createJobRun(polledAnswer, roundState.ReportableRoundID, &payment)
+	err = p.createJobRun(polledAnswer, rejectedFeeds, roundState.ReportableRoundID, &payment)
 	if err != nil {
 		logger.Errorw(fmt.Sprintf("can't create job run: %v", err), loggerFields...)
 		return
 	}
+	p.awaitingRelease = deviationTriggered && p.hasHysteresis()
 
-	promSetDecimal(promFMReportedValue.WithLabelValues(jobSpecID), polledAnswer)
-	promSetUint32(promFMReportedRound.WithLabelValues(jobSpecID), roundState.ReportableRoundID)
+	if !p.initr.Simulate {
+		promSetDecimal(promFMReportedValue.WithLabelValues(jobSpecID), polledAnswer)
+		promSetUint32(promFMReportedRound.WithLabelValues(jobSpecID), roundState.ReportableRoundID)
+	}
 }
 
-func (p *PollingDeviationChecker) roundState(roundID uint32) (contracts.FluxAggregatorRoundState, error) {
+// fetch returns the answer of the feeds, along with those feeds whose
+// answers were discarded as outliers if the fetcher rejects them. The outcome
+// is kept for the checker's State.
+func (p *PollingDeviationChecker) fetch(request map[string]interface{}) (answer decimal.Decimal, rejectedFeeds []string, err error) {
+	defer func() {
+		p.lastPolledAt = time.Now()
+		if err != nil {
+			p.lastAggregate, p.lastRejectedFeeds, p.lastPollError = nil, nil, err.Error()
+		} else {
+			p.lastAggregate, p.lastRejectedFeeds, p.lastPollError = &answer, rejectedFeeds, ""
+		}
+	}()
+
+	if fetcher, ok := p.fetcher.(outlierRejectingFetcher); ok {
+		return fetcher.fetchRejectingOutliers(request)
+	}
+	answer, err = p.fetcher.Fetch(request)
+	return answer, nil, err
+}
+
+// alertTooFewAnswers notifies the operator when a poll first finds fewer
+// feeds answering than the initiator's minAnswers, and notifies that the
+// alert has resolved once a poll finds enough of them. It is passed nil when
+// enough answered.
+func (p *PollingDeviationChecker) alertTooFewAnswers(tooFew *insufficientAnswersError) {
+	firing := tooFew != nil
+	if firing == p.tooFewAnswers {
+		return
+	}
+	p.tooFewAnswers = firing
+
+	jobID := p.initr.JobSpecID.String()
+	alert := alerting.Alert{
+		Key:      fmt.Sprintf("fluxmonitor_min_answers/%s", jobID),
+		Severity: alerting.SeverityError,
+		Summary: fmt.Sprintf(
+			"Fewer feeds of job %s than its minimum of %d are answering, so it is not submitting",
+			jobID, p.initr.Aggregation.MinAnswers,
+		),
+		Details: map[string]string{
+			"job":      jobID,
+			"contract": p.initr.Address.Hex(),
+		},
+		Resolved: !firing,
+	}
+	if firing {
+		alert.Details["answers"] = fmt.Sprintf("%d of %d", tooFew.answers, tooFew.feeds)
+		logger.Warnw(fmt.Sprintf("FluxMonitor: %s", alert), "key", alert.Key)
+	} else {
+		logger.Infow(fmt.Sprintf("FluxMonitor: %s", alert), "key", alert.Key)
+	}
+
+	p.notify(alert)
+}
+
+// settleRounds counts the rounds in a row in which a supermajority of the
+// aggregator's oracles submitted before the node, or without it, as their
+// submissions are seen. Simulated initiators, whose node need not be one of
+// the oracles, don't count them, nor do checkers while they hibernate.
+func (p *PollingDeviationChecker) settleRounds() {
+	rounds := p.roundSubmissions.states()
+	if len(rounds) == 0 {
+		return
+	}
+	jobSpecID := p.initr.JobSpecID.String()
+	promFMRoundSubmissions.WithLabelValues(jobSpecID).Set(float64(len(rounds[0].Oracles)))
+	if p.initr.Simulate || p.lastRoundState == nil {
+		return
+	}
+
 	acct, err := p.store.KeyStore.GetFirstAccount()
 	if err != nil {
-		return contracts.FluxAggregatorRoundState{}, err
+		logger.Errorw(fmt.Sprintf("error fetching account from keystore: %v", err), "contract", p.initr.Address.Hex())
+		return
 	}
-	roundState, err := p.fluxAggregator.RoundState(acct.Address, roundID)
-	if err != nil {
-		return contracts.FluxAggregatorRoundState{}, err
+	settled := p.roundSubmissions.settle(acct.Address, p.lastRoundState.OracleCount)
+	if p.isHibernating || len(settled) == 0 {
+		return
+	}
+	for _, round := range settled {
+		if !round.lagged {
+			p.laggingRounds = 0
+			continue
+		}
+		p.laggingRounds++
+		logger.Debugw("Lagged the other oracles",
+			"round", round.roundID,
+			"submittedBefore", round.peers,
+			"laggingRounds", p.laggingRounds,
+			"contract", p.initr.Address.Hex(),
+		)
+	}
+	promFMLaggingRounds.WithLabelValues(jobSpecID).Set(float64(p.laggingRounds))
+	p.alertLagging()
+}
+
+// alertLagging notifies the operator when the node first lags the other
+// oracles maxLaggingRounds rounds in a row, and notifies that the alert has
+// resolved once it no longer lags them in a round.
+func (p *PollingDeviationChecker) alertLagging() {
+	firing := p.laggingRounds >= maxLaggingRounds
+	if firing == p.lagging {
+		return
+	}
+	p.lagging = firing
+
+	jobID := p.initr.JobSpecID.String()
+	contract := p.initr.Address.Hex()
+	alert := alerting.Alert{
+		Key:      fmt.Sprintf("fluxmonitor_lagging/%s", jobID),
+		Severity: alerting.SeverityWarning,
+		Summary: fmt.Sprintf(
+			"Job %s has submitted to aggregator %s after a supermajority of its oracles, or not at all, in %d or more rounds in a row",
+			jobID, contract, maxLaggingRounds,
+		),
+		Details: map[string]string{
+			"job":           jobID,
+			"contract":      contract,
+			"laggingRounds": strconv.Itoa(p.laggingRounds),
+		},
+		Resolved: !firing,
+	}
+	if firing {
+		logger.Warnw(fmt.Sprintf("FluxMonitor: %s", alert), "key", alert.Key)
+	} else {
+		logger.Infow(fmt.Sprintf("FluxMonitor: %s", alert), "key", alert.Key)
+	}
+	p.notify(alert)
+}
+
+// backOffUnderfunded stops the checker's timers from polling the underfunded
+// aggregator, polling it instead once the next backoff has passed, and
+// alerts the operator the first time.
+func (p *PollingDeviationChecker) backOffUnderfunded(roundState contracts.FluxAggregatorRoundState) {
+	// The aggregator emits no log the checker listens for when it is funded
+	p.invalidateRoundState()
+	p.underfundedTimer.Reset(p.underfundedBackoff.Duration())
+	if p.underfunded {
+		return
+	}
+	p.underfunded = true
+	p.resetTickers(roundState)
+	p.alertUnderfunded(roundState)
+}
+
+// resumeFunded restarts the checker's timers once the aggregator is funded
+// again, and resolves the alert.
+func (p *PollingDeviationChecker) resumeFunded(roundState contracts.FluxAggregatorRoundState) {
+	p.underfunded = false
+	p.underfundedBackoff.Reset()
+	p.underfundedTimer.Stop()
+	p.resetTickers(roundState)
+	p.alertUnderfunded(roundState)
+}
+
+// alertUnderfunded notifies the operator that the aggregator has become
+// underfunded, or, once it no longer is, that the alert has resolved. The
+// alert is keyed by the aggregator, as every job submitting to it is
+// affected alike.
+func (p *PollingDeviationChecker) alertUnderfunded(roundState contracts.FluxAggregatorRoundState) {
+	contract := p.initr.Address.Hex()
+	alert := alerting.Alert{
+		Key:      fmt.Sprintf("fluxmonitor_underfunded/%s", contract),
+		Severity: alerting.SeverityError,
+		Summary:  fmt.Sprintf("Aggregator %s has too few funds to pay its oracles, so the node is not submitting to it", contract),
+		Details: map[string]string{
+			"job":      p.initr.JobSpecID.String(),
+			"contract": contract,
+		},
+		Resolved: !p.underfunded,
+	}
+	if roundState.AvailableFunds != nil {
+		alert.Details["availableFunds"] = roundState.AvailableFunds.String()
 	}
+	if roundState.PaymentAmount != nil {
+		alert.Details["paymentAmount"] = roundState.PaymentAmount.String()
+	}
+	if p.underfunded {
+		logger.Warnw(fmt.Sprintf("FluxMonitor: %s", alert), "key", alert.Key)
+	} else {
+		logger.Infow(fmt.Sprintf("FluxMonitor: %s", alert), "key", alert.Key)
+	}
+	p.notify(alert)
+}
+
+func (p *PollingDeviationChecker) notify(alert alerting.Alert) {
+	notifiers := p.notifiers
+	go func() {
+		for _, notifier := range notifiers {
+			if err := notifier.Notify(alert); err != nil {
+				logger.Errorw(fmt.Sprintf("FluxMonitor: error sending alert through %s", notifier.Name()), "key", alert.Key, "error", err)
+			}
+		}
+	}()
+}
+
+// minUnderfundedBackoff is the first wait between polls of an underfunded
+// aggregator, which is the initiator's poll period, or its idle timeout when
+// it does not poll.
+func minUnderfundedBackoff(initr models.Initiator) time.Duration {
+	min := initr.PollTimer.Period.Duration()
+	if initr.PollTimer.Disabled || min <= 0 {
+		min = initr.IdleTimer.Duration.Duration()
+	}
+	if min <= 0 || min > maxUnderfundedBackoff {
+		min = maxUnderfundedBackoff
+	}
+	return min
+}
+
+// roundState returns the state of the round of the aggregator, or, given 0,
+// of the round the node should submit to next. That is read from the cache of
+// round states, if the checker has one, unless it has been invalidated since
+// it was last read from the aggregator.
+func (p *PollingDeviationChecker) roundState(roundID uint32) (contracts.FluxAggregatorRoundState, error) {
+	var roundState contracts.FluxAggregatorRoundState
+	cached := false
+	if roundID == 0 && p.roundStates != nil {
+		roundState, cached = p.roundStates.get(p.initr.Address)
+	}
+	if !cached {
+		acct, err := p.store.KeyStore.GetFirstAccount()
+		if err != nil {
+			return contracts.FluxAggregatorRoundState{}, err
+		}
+		roundState, err = p.fluxAggregator.RoundState(acct.Address, roundID)
+		if err != nil {
+			return contracts.FluxAggregatorRoundState{}, err
+		}
+		if roundID == 0 && p.roundStates != nil {
+			p.roundStates.set(p.initr.Address, roundState)
+		}
+	}
+	p.lastRoundState = &roundState
 
 	// Update our tickers to reflect the current on-chain round
 	p.resetTickers(roundState)
@@ -1011,11 +1572,34 @@ func (p *PollingDeviationChecker) roundState(roundID uint32) (contracts.FluxAggr
 	return roundState, nil
 }
 
+func (p *PollingDeviationChecker) invalidateRoundState() {
+	if p.roundStates != nil {
+		p.roundStates.invalidate(p.initr.Address)
+	}
+}
+
 func (p *PollingDeviationChecker) resetTickers(roundState contracts.FluxAggregatorRoundState) {
+	if p.underfunded && !p.isHibernating {
+		// Only the underfunded timer polls until the aggregator is funded
+		p.pollTicker.Pause()
+		p.hibernationTimer.Stop()
+		p.idleTimer.Stop()
+		p.roundTimer.Stop()
+		p.drumbeatTimer.Stop()
+		if _, running := p.underfundedTimer.Deadline(); !running {
+			p.underfundedTimer.Reset(p.underfundedBackoff.ForAttempt(p.underfundedBackoff.Attempt()))
+		}
+		return
+	}
+	if p.isHibernating {
+		p.underfundedTimer.Stop()
+	}
+
 	p.resetPollTicker()
 	p.resetHibernationTimer()
 	p.resetIdleTimer(roundState.StartedAt)
 	p.resetRoundTimer(roundState.TimesOutAt())
+	p.resetDrumbeatTimer()
 }
 
 func (p *PollingDeviationChecker) resetPollTicker() {
@@ -1034,6 +1618,17 @@ func (p *PollingDeviationChecker) resetHibernationTimer() {
 	}
 }
 
+// resetDrumbeatTimer sets the drumbeat timer to fire at the next time of the
+// drumbeat schedule, if there is one and the checker is not hibernating.
+func (p *PollingDeviationChecker) resetDrumbeatTimer() {
+	if p.drumbeat == nil || p.isHibernating {
+		p.drumbeatTimer.Stop()
+		return
+	}
+	now := time.Now()
+	p.drumbeatTimer.Reset(p.drumbeat.Next(now).Sub(now))
+}
+
 func (p *PollingDeviationChecker) resetRoundTimer(roundTimesOutAt uint64) {
 	if p.isHibernating {
 		p.roundTimer.Stop()
@@ -1092,13 +1687,22 @@ type jobRunRequest struct {
 	Address          string          `json:"address"`
 	FunctionSelector string          `json:"functionSelector"`
 	DataPrefix       string          `json:"dataPrefix"`
+	// Aggregation is how the answers of the feeds were combined into Result.
+	Aggregation models.AggregationConfig `json:"aggregation"`
+	// RejectedFeeds are those whose answers were discarded as outliers.
+	RejectedFeeds []string `json:"rejectedFeeds,omitempty"`
 }
 
 func (p *PollingDeviationChecker) createJobRun(
 	polledAnswer decimal.Decimal,
+	rejectedFeeds []string,
 	roundID uint32,
 	paymentAmount *assets.Link,
 ) error {
+	if p.initr.Simulate {
+		return p.recordSimulatedSubmission(polledAnswer, rejectedFeeds, roundID)
+	}
+
 	methodID, err := p.fluxAggregator.GetMethodID("submit")
 	if err != nil {
 		return err
@@ -1111,6 +1715,8 @@ func (p *PollingDeviationChecker) createJobRun(
 		Address:          p.initr.Address.Hex(),
 		FunctionSelector: hexutil.Encode(methodID),
 		DataPrefix:       hexutil.Encode(roundIDData),
+		Aggregation:      p.initr.Aggregation.WithDefaults(),
+		RejectedFeeds:    rejectedFeeds,
 	})
 	if err != nil {
 		return errors.Wrapf(err, "unable to encode Job Run request in JSON")
@@ -1126,6 +1732,7 @@ func (p *PollingDeviationChecker) createJobRun(
 	if err != nil {
 		return err
 	}
+	p.invalidateRoundState()
 
 	err = p.store.UpdateFluxMonitorRoundStats(p.initr.Address, roundID, jobRun.ID)
 	if err != nil {
@@ -1140,6 +1747,39 @@ func (p *PollingDeviationChecker) createJobRun(
 	return nil
 }
 
+// recordSimulatedSubmission records the answer which a simulated initiator
+// would have submitted to the round, in place of starting a run to submit it.
+func (p *PollingDeviationChecker) recordSimulatedSubmission(
+	polledAnswer decimal.Decimal,
+	rejectedFeeds []string,
+	roundID uint32,
+) error {
+	submission := models.FluxMonitorSimulatedSubmission{
+		JobSpecID:     p.initr.JobSpecID,
+		InitiatorID:   p.initr.ID,
+		Aggregator:    p.initr.Address,
+		RoundID:       roundID,
+		Answer:        polledAnswer,
+		RejectedFeeds: rejectedFeeds,
+	}
+	if p.lastRoundState != nil && p.lastRoundState.LatestAnswer != nil {
+		latestAnswer := decimal.NewFromBigInt(p.lastRoundState.LatestAnswer, -p.precision)
+		submission.LatestAnswer = &latestAnswer
+	}
+	if err := p.store.CreateFluxMonitorSimulatedSubmission(&submission); err != nil {
+		return errors.Wrap(err, "unable to record simulated submission")
+	}
+
+	promFMSimulatedSubmissions.WithLabelValues(p.initr.JobSpecID.String()).Inc()
+	logger.Infow("simulated initiator would submit answer",
+		"answer", polledAnswer,
+		"roundID", roundID,
+		"contract", p.initr.Address.Hex(),
+		"jobID", p.initr.JobSpecID.String(),
+	)
+	return nil
+}
+
 func (p *PollingDeviationChecker) loggerFields(added ...interface{}) []interface{} {
 	return append(added, []interface{}{
 		"pollFrequency", p.initr.PollTimer.Period,
@@ -1169,6 +1809,19 @@ func (p *PollingDeviationChecker) loggerFieldsForAnswerUpdated(log contracts.Log
 	}
 }
 
+// hasHysteresis returns true if the initiator sets a release threshold, below
+// which the answer must return before deviation triggers another submission.
+func (p *PollingDeviationChecker) hasHysteresis() bool {
+	return p.initr.ReleaseThreshold != 0 || p.initr.AbsoluteReleaseThreshold != 0
+}
+
+func (p *PollingDeviationChecker) releaseThresholds() DeviationThresholds {
+	return DeviationThresholds{
+		Rel: float64(p.initr.ReleaseThreshold),
+		Abs: float64(p.initr.AbsoluteReleaseThreshold),
+	}
+}
+
 // OutsideDeviation checks whether the next price is outside the threshold.
 // If both thresholds are zero (default value), always returns true.
 func OutsideDeviation(curAnswer, nextAnswer decimal.Decimal, thresholds DeviationThresholds) bool {
@@ -85,6 +85,26 @@ func TestConcreteFluxMonitor_AddJobRemoveJob(t *testing.T) {
 		checkerFactory.AssertExpectations(t)
 		dc.AssertExpectations(t)
 
+		// State of the job's checkers
+		dc.On("State").Return(fluxmonitor.CheckerState{InitiatorID: 7})
+		states, ok := fm.State(*job.ID)
+		require.True(t, ok)
+		require.Len(t, states, 1)
+		assert.Equal(t, int64(7), states[0].InitiatorID)
+		_, ok = fm.State(*models.NewID())
+		assert.False(t, ok)
+
+		// Reload the job's feeds and thresholds
+		reloaded := make(chan models.Initiator, 1)
+		dc.On("Reload", mock.Anything, mock.Anything).Return().Run(func(args mock.Arguments) {
+			reloaded <- args.Get(0).(models.Initiator)
+		})
+		job.Initiators[0].Threshold = 0.25
+		require.NoError(t, fm.ReloadJob(job))
+		cltest.CallbackOrTimeout(t, "deviation checker reloaded", func() {
+			assert.Equal(t, float32(0.25), (<-reloaded).Threshold)
+		})
+
 		// Remove Job
 		removed := make(chan struct{})
 		dc.On("Stop").Return().Run(func(mock.Arguments) {
@@ -349,6 +369,446 @@ func TestPollingDeviationChecker_PollIfEligible_Creates_JobSpecErr(t *testing.T)
 	rm.AssertExpectations(t)
 }
 
+func TestPollingDeviationChecker_PollIfEligible_ReleaseThreshold(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+	logBroadcaster := new(mocks.LogBroadcaster)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	initr.Threshold = 1
+	initr.ReleaseThreshold = 0.5
+	require.NoError(t, store.CreateJob(&job))
+
+	run := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	paymentAmount := store.Config.MinimumContractPayment().ToInt()
+	availableFunds := big.NewInt(1).Mul(paymentAmount, big.NewInt(1000))
+	latestAnswer := big.NewInt(100 * int64(math.Pow10(int(initr.Precision))))
+
+	// Each poll is of a new round, while the answer on chain stays at 100
+	poll := func(roundID uint32, polledAnswer float64) {
+		fluxAggregator.On("RoundState", nodeAddr, uint32(0)).
+			Return(contracts.FluxAggregatorRoundState{
+				ReportableRoundID: roundID,
+				LatestAnswer:      latestAnswer,
+				EligibleToSubmit:  true,
+				AvailableFunds:    availableFunds,
+				PaymentAmount:     paymentAmount,
+				OracleCount:       oracleCount,
+			}, nil).
+			Once()
+		fetcher.On("Fetch", mock.Anything).
+			Return(decimal.NewFromFloat(polledAnswer), nil).
+			Once()
+	}
+	expectSubmission := func(result string) {
+		fluxAggregator.On("GetMethodID", "submit").
+			Return(submitSelector, nil).
+			Once()
+		rm.On("Create", job.ID, &initr, mock.Anything, mock.MatchedBy(func(runRequest *models.RunRequest) bool {
+			return runRequest.RequestParams.Get("result").String() == result
+		})).
+			Return(&run, nil).
+			Once()
+	}
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(
+		store,
+		fluxAggregator,
+		logBroadcaster,
+		initr,
+		nil,
+		rm,
+		fetcher,
+		nil,
+		func() {},
+	)
+	require.NoError(t, err)
+	checker.OnConnect()
+
+	// Deviating by 2% submits
+	poll(2, 102)
+	expectSubmission("102")
+	checker.ExportedPollIfEligible(1, 0)
+
+	// Deviating again, before the answer has come back within the release
+	// threshold, does not
+	poll(3, 98)
+	checker.ExportedPollIfEligible(1, 0)
+
+	// Within 0.5% releases the threshold, without submitting
+	poll(4, 100.2)
+	checker.ExportedPollIfEligible(1, 0)
+
+	// So that deviating again submits
+	poll(5, 98)
+	expectSubmission("98")
+	checker.ExportedPollIfEligible(1, 0)
+
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+	rm.AssertExpectations(t)
+}
+
+func TestPollingDeviationChecker_State(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+	logBroadcaster := new(mocks.LogBroadcaster)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	require.NoError(t, store.CreateJob(&job))
+
+	roundState := contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 2,
+		EligibleToSubmit:  true,
+		LatestAnswer:      big.NewInt(100 * int64(math.Pow10(int(initr.Precision)))),
+		AvailableFunds:    big.NewInt(1).Mul(store.Config.MinimumContractPayment().ToInt(), big.NewInt(1000)),
+		PaymentAmount:     store.Config.MinimumContractPayment().ToInt(),
+		OracleCount:       oracleCount,
+	}
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState, nil)
+	fetcher.On("Fetch", mock.Anything).Return(decimal.NewFromInt(100), nil)
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(
+		store,
+		fluxAggregator,
+		logBroadcaster,
+		initr,
+		nil,
+		rm,
+		fetcher,
+		nil,
+		func() {},
+	)
+	require.NoError(t, err)
+
+	state := checker.State()
+	assert.Nil(t, state.RoundState)
+	assert.Nil(t, state.Aggregate)
+
+	checker.OnConnect()
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+
+	state = checker.State()
+	assert.Equal(t, initr.ID, state.InitiatorID)
+	assert.True(t, state.Connected)
+	require.NotNil(t, state.RoundState)
+	assert.Equal(t, uint32(2), state.RoundState.ReportableRoundID)
+	require.NotNil(t, state.Aggregate)
+	assert.Equal(t, "100", state.Aggregate.String())
+	assert.NotNil(t, state.PolledAt)
+	assert.Empty(t, state.PollError)
+	assert.True(t, state.PollTimer.Running)
+	assert.Equal(t, initr.PollTimer.Period, *state.PollTimer.Period)
+
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+	rm.AssertExpectations(t)
+}
+
+func TestPollingDeviationChecker_PollIfEligible_UnderfundedBackoff(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+	logBroadcaster := new(mocks.LogBroadcaster)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	require.NoError(t, store.CreateJob(&job))
+
+	minPayment := store.Config.MinimumContractPayment().ToInt()
+	roundState := contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 2,
+		EligibleToSubmit:  true,
+		LatestAnswer:      big.NewInt(100 * int64(math.Pow10(int(initr.Precision)))),
+		AvailableFunds:    big.NewInt(1),
+		PaymentAmount:     minPayment,
+		OracleCount:       oracleCount,
+	}
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState, nil).Twice()
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(
+		store,
+		fluxAggregator,
+		logBroadcaster,
+		initr,
+		nil,
+		rm,
+		fetcher,
+		nil,
+		func() {},
+	)
+	require.NoError(t, err)
+	checker.OnConnect()
+
+	// Underfunded, the checker stops polling on its timers and backs off
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+	state := checker.State()
+	assert.True(t, state.Underfunded)
+	assert.False(t, state.PollTimer.Running)
+	assert.False(t, state.IdleTimer.Running)
+	require.True(t, state.UnderfundedTimer.Running)
+	firstBackoff := time.Until(*state.UnderfundedTimer.FiresAt)
+	assert.InDelta(t, initr.PollTimer.Period.Duration().Seconds(), firstBackoff.Seconds(), 5)
+
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+	state = checker.State()
+	assert.True(t, state.Underfunded)
+	require.True(t, state.UnderfundedTimer.Running)
+	assert.InDelta(t, 2*firstBackoff.Seconds(), time.Until(*state.UnderfundedTimer.FiresAt).Seconds(), 5)
+
+	// Once funded, it polls on its timers again
+	roundState.AvailableFunds = big.NewInt(1).Mul(minPayment, big.NewInt(1000))
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState, nil).Once()
+	fetcher.On("Fetch", mock.Anything).Return(decimal.NewFromInt(100), nil).Once()
+
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+	state = checker.State()
+	assert.False(t, state.Underfunded)
+	assert.False(t, state.UnderfundedTimer.Running)
+	assert.True(t, state.PollTimer.Running)
+
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+	rm.AssertExpectations(t)
+}
+
+func TestPollingDeviationChecker_PollIfEligible_Simulate(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+	logBroadcaster := new(mocks.LogBroadcaster)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	job.Initiators[0].Simulate = true
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+
+	// The node is not eligible to submit, as its live job has already
+	// submitted to the round, and the simulated job records its answer all
+	// the same
+	roundState := contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 2,
+		EligibleToSubmit:  false,
+		LatestAnswer:      big.NewInt(100 * int64(math.Pow10(int(initr.Precision)))),
+		AvailableFunds:    big.NewInt(1).Mul(store.Config.MinimumContractPayment().ToInt(), big.NewInt(1000)),
+		PaymentAmount:     store.Config.MinimumContractPayment().ToInt(),
+		OracleCount:       oracleCount,
+	}
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState, nil)
+	fetcher.On("Fetch", mock.Anything).Return(decimal.NewFromInt(200), nil)
+	require.NoError(t, store.UpdateFluxMonitorRoundStats(initr.Address, 2, nil))
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(
+		store,
+		fluxAggregator,
+		logBroadcaster,
+		initr,
+		nil,
+		rm,
+		fetcher,
+		nil,
+		func() {},
+	)
+	require.NoError(t, err)
+	checker.OnConnect()
+
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+
+	submissions, count, err := store.FluxMonitorSimulatedSubmissions(job.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, submissions, 1)
+	assert.Equal(t, initr.ID, submissions[0].InitiatorID)
+	assert.Equal(t, initr.Address, submissions[0].Aggregator)
+	assert.Equal(t, uint32(2), submissions[0].RoundID)
+	assert.Equal(t, "200", submissions[0].Answer.String())
+	require.NotNil(t, submissions[0].LatestAnswer)
+	assert.Equal(t, "100", submissions[0].LatestAnswer.String())
+	assert.True(t, checker.State().Simulated)
+
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+	rm.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPollingDeviationChecker_PollIfEligible_CachesRoundState(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+	logBroadcaster := new(mocks.LogBroadcaster)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	require.NoError(t, store.CreateJob(&job))
+	run := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	roundState := contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 2,
+		EligibleToSubmit:  true,
+		LatestAnswer:      big.NewInt(100 * int64(math.Pow10(int(initr.Precision)))),
+		AvailableFunds:    big.NewInt(1).Mul(store.Config.MinimumContractPayment().ToInt(), big.NewInt(1000)),
+		PaymentAmount:     store.Config.MinimumContractPayment().ToInt(),
+		OracleCount:       oracleCount,
+	}
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(
+		store,
+		fluxAggregator,
+		logBroadcaster,
+		initr,
+		nil,
+		rm,
+		fetcher,
+		nil,
+		func() {},
+	)
+	require.NoError(t, err)
+	checker.ExportedUseRoundStateCache()
+	checker.OnConnect()
+
+	// The round state is read once for both polls which don't submit
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState, nil).Once()
+	fetcher.On("Fetch", mock.Anything).Return(decimal.NewFromInt(100), nil).Twice()
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+
+	// Submitting invalidates it, so that the next poll reads it again
+	fetcher.On("Fetch", mock.Anything).Return(decimal.NewFromInt(200), nil).Once()
+	fluxAggregator.On("GetMethodID", "submit").Return(submitSelector, nil).Once()
+	rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything).Return(&run, nil).Once()
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState, nil).Once()
+	checker.ExportedPollIfEligible(float64(initr.Threshold), float64(initr.AbsoluteThreshold))
+
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+	rm.AssertExpectations(t)
+}
+
+func TestPollingDeviationChecker_SupermajorityRounds(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	nodeAddr := ensureAccount(t, store)
+
+	rm := new(mocks.RunManager)
+	fetcher := new(mocks.Fetcher)
+	fluxAggregator := new(mocks.FluxAggregator)
+	logBroadcaster := new(mocks.LogBroadcaster)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	job.Initiators[0].SkipSupermajorityRounds = true
+	require.NoError(t, store.CreateJob(&job))
+	initr := job.Initiators[0]
+	run := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	roundState := func(roundID uint32) contracts.FluxAggregatorRoundState {
+		return contracts.FluxAggregatorRoundState{
+			ReportableRoundID: roundID,
+			EligibleToSubmit:  true,
+			LatestAnswer:      big.NewInt(100 * int64(math.Pow10(int(initr.Precision)))),
+			AvailableFunds:    big.NewInt(1).Mul(store.Config.MinimumContractPayment().ToInt(), big.NewInt(1000)),
+			PaymentAmount:     store.Config.MinimumContractPayment().ToInt(),
+			OracleCount:       4,
+		}
+	}
+
+	checker, err := fluxmonitor.NewPollingDeviationChecker(
+		store,
+		fluxAggregator,
+		logBroadcaster,
+		initr,
+		nil,
+		rm,
+		fetcher,
+		nil,
+		func() {},
+	)
+	require.NoError(t, err)
+	checker.OnConnect()
+
+	peers := []common.Address{cltest.NewAddress(), cltest.NewAddress(), cltest.NewAddress()}
+	submitted := func(roundID uint32, oracles ...common.Address) {
+		for _, oracle := range oracles {
+			logBroadcast := new(mocks.LogBroadcast)
+			logBroadcast.On("DecodedLog").Return(&contracts.LogSubmissionReceived{Round: roundID, Oracle: oracle})
+			checker.HandleLog(logBroadcast, nil)
+		}
+	}
+
+	// Three of the four oracles have submitted to round 2, so the node skips it
+	submitted(2, peers...)
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState(2), nil).Once()
+	checker.ExportedPollIfEligible(0, 0)
+	fetcher.AssertNotCalled(t, "Fetch", mock.Anything)
+
+	// Only two have submitted to round 3, so the node submits
+	submitted(3, peers[0], peers[1])
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(roundState(3), nil).Once()
+	fetcher.On("Fetch", mock.Anything).Return(decimal.NewFromInt(100), nil).Once()
+	fluxAggregator.On("GetMethodID", "submit").Return(submitSelector, nil).Once()
+	rm.On("Create", job.ID, &initr, mock.Anything, mock.Anything).Return(&run, nil).Once()
+	checker.ExportedPollIfEligible(0, 0)
+	fluxAggregator.AssertExpectations(t)
+	fetcher.AssertExpectations(t)
+	rm.AssertExpectations(t)
+
+	// The node lagged in round 2, but not in round 3
+	submitted(3, nodeAddr)
+	checker.ExportedProcessLogs()
+	state := checker.State()
+	assert.Equal(t, 0, state.LaggingRounds)
+	require.Len(t, state.Rounds, 2)
+	assert.Equal(t, uint32(3), state.Rounds[0].RoundID)
+	assert.Equal(t, []common.Address{peers[0], peers[1], nodeAddr}, state.Rounds[0].Oracles)
+	require.NotNil(t, state.Rounds[0].Lagged)
+	assert.False(t, *state.Rounds[0].Lagged)
+	require.NotNil(t, state.Rounds[1].Lagged)
+	assert.True(t, *state.Rounds[1].Lagged)
+
+	// Rounds in which it submits after a supermajority are counted
+	for roundID := uint32(4); roundID <= 6; roundID++ {
+		submitted(roundID, append(peers, nodeAddr)...)
+	}
+	checker.ExportedProcessLogs()
+	assert.Equal(t, 3, checker.State().LaggingRounds)
+}
+
 func TestPollingDeviationChecker_BuffersLogs(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -705,6 +1165,68 @@ func TestPollingDeviationChecker_RoundTimeoutCausesPoll_timesOutNotZero(t *testi
 	fluxAggregator.AssertExpectations(t)
 }
 
+func TestPollingDeviationChecker_DrumbeatCausesPoll(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	nodeAddr := ensureAccount(t, store)
+
+	fetcher := new(mocks.Fetcher)
+	runManager := new(mocks.RunManager)
+	fluxAggregator := new(mocks.FluxAggregator)
+	logBroadcaster := new(mocks.LogBroadcaster)
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 1
+	initr.PollTimer.Disabled = true
+	initr.IdleTimer.Disabled = true
+	initr.DrumbeatSchedule = "CRON_TZ=UTC * * * * * *"
+
+	const fetchedAnswer = 100
+	answerBigInt := big.NewInt(fetchedAnswer * int64(math.Pow10(int(initr.InitiatorParams.Precision))))
+
+	chRoundState := make(chan struct{}, 2)
+
+	fluxAggregator.On("SubscribeToLogs", mock.Anything).Return(true, eth.UnsubscribeFunc(func() {}), nil)
+	fluxAggregator.On("RoundState", nodeAddr, uint32(0)).Return(contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 1,
+		EligibleToSubmit:  false,
+		LatestAnswer:      answerBigInt,
+	}, nil).
+		Run(func(mock.Arguments) {
+			select {
+			case chRoundState <- struct{}{}:
+			default:
+			}
+		})
+
+	deviationChecker, err := fluxmonitor.NewPollingDeviationChecker(
+		store,
+		fluxAggregator,
+		logBroadcaster,
+		initr,
+		nil,
+		runManager,
+		fetcher,
+		nil,
+		func() {},
+	)
+	require.NoError(t, err)
+
+	deviationChecker.Start()
+	deviationChecker.OnConnect()
+
+	// Each beat polls, and resets the timer to the next beat
+	cltest.CallbackOrTimeout(t, "first drumbeat", func() { <-chRoundState }, 3*time.Second)
+	cltest.CallbackOrTimeout(t, "second drumbeat", func() { <-chRoundState }, 3*time.Second)
+
+	deviationChecker.Stop()
+
+	fetcher.AssertExpectations(t)
+	runManager.AssertExpectations(t)
+}
+
 func TestPollingDeviationChecker_RespondToNewRound(t *testing.T) {
 
 	type roundIDCase struct {
@@ -1013,6 +1535,34 @@ func TestExtractFeedURLs(t *testing.T) {
 	}
 }
 
+func TestExtractFeeds_BridgeOutgoingToken(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	bridge := &models.BridgeType{
+		Name:          models.MustNewTaskType("testbridge"),
+		URL:           cltest.WebURL(t, "https://testing.com/bridges"),
+		OutgoingToken: "outgoing",
+	}
+	require.NoError(t, store.CreateBridgeType(bridge))
+
+	feeds, err := fluxmonitor.ExtractFeeds(cltest.JSONFromString(t, `[
+		"https://lambda.staging.devnet.tools/bnc/call",
+		{"bridge": "testbridge", "headers": {"X-Api-Key": "key"}},
+		{"bridge": "testbridge", "headers": {"authorization": "Basic abc"}}
+	]`), store.ORM)
+	require.NoError(t, err)
+	require.Len(t, feeds, 3)
+
+	assert.Empty(t, feeds[0].Headers)
+	assert.Equal(t, "https://testing.com/bridges", feeds[1].URL)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer outgoing", "X-Api-Key": "key"}, feeds[1].Headers)
+	assert.Equal(t, map[string]string{"authorization": "Basic abc"}, feeds[2].Headers)
+
+	_, err = fluxmonitor.ExtractFeeds(cltest.JSONFromString(t, `[{"bridge": "missing"}]`), store.ORM)
+	assert.Error(t, err)
+}
+
 func TestPollingDeviationChecker_SufficientPayment(t *testing.T) {
 	t.Parallel()
 
@@ -1543,3 +2093,63 @@ func TestFluxMonitor_PollingDeviationChecker_IsFlagLowered(t *testing.T) {
 		})
 	}
 }
+
+func TestFluxMonitor_PollingDeviationChecker_FlagsContractAddress(t *testing.T) {
+	t.Parallel()
+
+	allLowered := "0x0000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+	globalAddress := cltest.NewAddress()
+	jobAddress := cltest.NewAddress()
+
+	tests := []struct {
+		name       string
+		jobAddress common.Address
+		expected   common.Address
+	}{
+		{"global contract", utils.ZeroAddress, globalAddress},
+		{"per-job contract", jobAddress, jobAddress},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			config, configCleanup := cltest.NewConfig(t)
+			defer configCleanup()
+			config.Set("FLAGS_CONTRACT_ADDRESS", globalAddress.Hex())
+			store, storeCleanup := cltest.NewStoreWithConfig(config)
+			defer storeCleanup()
+
+			gethClient := new(mocks.GethClient)
+			cltest.MockEthOnStore(t, store,
+				eth.NewClientWith(nil, gethClient),
+			)
+
+			logBroadcaster := new(mocks.LogBroadcaster)
+			logBroadcaster.On("AddDependents", 1).Once()
+			fm := fluxmonitor.New(store, new(mocks.RunManager), logBroadcaster)
+
+			job := cltest.NewJobWithFluxMonitorInitiator()
+			initr := job.Initiators[0]
+			initr.FlagsContractAddress = test.jobAddress
+			checker := fluxmonitor.ExportedNewChecker(t, fm, initr)
+
+			getFlagsResultBytes, err := hexutil.Decode(allLowered)
+			require.NoError(t, err)
+
+			gethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				return msg.To != nil && *msg.To == test.expected
+			}), mock.Anything).
+				Return(getFlagsResultBytes, nil).
+				Once()
+
+			lowered, err := checker.ExportedIsFlagLowered()
+			require.NoError(t, err)
+			assert.True(t, lowered)
+
+			gethClient.AssertExpectations(t)
+			logBroadcaster.AssertExpectations(t)
+		})
+	}
+}
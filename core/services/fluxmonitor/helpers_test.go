@@ -26,6 +26,7 @@ func ExportedSetCheckerFactory(fm Service, fac DeviationCheckerFactory) {
 
 func (p *PollingDeviationChecker) ExportedPollIfEligible(threshold, absoluteThreshold float64) {
 	p.pollIfEligible(DeviationThresholds{Rel: threshold, Abs: absoluteThreshold})
+	p.publishState()
 }
 
 func (p *PollingDeviationChecker) ExportedRespondToNewRoundLog(log *contracts.LogNewRound) {
@@ -42,6 +43,7 @@ func (p *PollingDeviationChecker) ExportedSufficientPayment(payment *big.Int) bo
 
 func (p *PollingDeviationChecker) ExportedProcessLogs() {
 	p.processLogs()
+	p.publishState()
 }
 
 func (p *PollingDeviationChecker) ExportedBacklog() *utils.BoundedPriorityQueue {
@@ -56,6 +58,10 @@ func (p *PollingDeviationChecker) ExportedRoundState() {
 	p.roundState(0)
 }
 
+func (p *PollingDeviationChecker) ExportedUseRoundStateCache() {
+	p.roundStates = newRoundStateCache()
+}
+
 func (p *PollingDeviationChecker) ExportedSetFluxAggregator(fa contracts.FluxAggregator) {
 	p.fluxAggregator = fa
 }
@@ -80,6 +86,19 @@ func (ps *fixedFetcher) Fetch(map[string]interface{}) (decimal.Decimal, error) {
 	return ps.price, nil
 }
 
+type weightedFixedFetcher struct {
+	fixedFetcher
+	weight float64
+}
+
+func newWeightedFixedPricedFetcher(price decimal.Decimal, weight float64) *weightedFixedFetcher {
+	return &weightedFixedFetcher{fixedFetcher{price: price}, weight}
+}
+
+func (ps *weightedFixedFetcher) aggregationWeight() float64 {
+	return ps.weight
+}
+
 type erroringFetcher struct{}
 
 func newErroringPricedFetcher() *erroringFetcher {
@@ -138,9 +157,16 @@ func (fm *concreteFluxMonitor) CreateJob(t *testing.T, jobSpecId *models.ID, pol
 	require.NoError(t, err, "could not create deviation checker")
 
 	payment := fm.store.Config.MinimumContractPayment()
-	return checker.(*PollingDeviationChecker).createJobRun(polledAnswer, uint32(nextRound.Uint64()), payment)
+	return checker.(*PollingDeviationChecker).createJobRun(polledAnswer, nil, uint32(nextRound.Uint64()), payment)
 }
 
 func (p *PollingDeviationChecker) ExportedIsFlagLowered() (bool, error) {
 	return p.isFlagLowered()
 }
+
+func ExportedNewChecker(t *testing.T, fm Service, initr models.Initiator) *PollingDeviationChecker {
+	impl := fm.(*concreteFluxMonitor)
+	checker, err := impl.checkerFactory.New(initr, nil, impl.runManager, impl.store.ORM, models.MustMakeDuration(100*time.Second))
+	require.NoError(t, err, "could not create deviation checker")
+	return checker.(*PollingDeviationChecker)
+}
@@ -25,6 +25,13 @@ var (
 		},
 		[]string{"url"},
 	)
+	promFMFeedWeight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "flux_monitor_feed_weight",
+			Help: "Weight of each individual endpoint's price in the flux monitor's aggregate",
+		},
+		[]string{"url"},
+	)
 	promFMSeenValue = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "flux_monitor_seen_value",
@@ -46,6 +53,27 @@ var (
 		},
 		[]string{"job_spec_id"},
 	)
+	promFMSimulatedSubmissions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "flux_monitor_simulated_submissions",
+			Help: "Number of answers a simulated flux monitor job would have submitted",
+		},
+		[]string{"job_spec_id"},
+	)
+	promFMRoundSubmissions = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "flux_monitor_round_submissions",
+			Help: "Number of oracles which have submitted to the latest round seen",
+		},
+		[]string{"job_spec_id"},
+	)
+	promFMLaggingRounds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "flux_monitor_lagging_rounds",
+			Help: "Number of rounds in a row a supermajority of oracles submitted to before this node",
+		},
+		[]string{"job_spec_id"},
+	)
 	promFMResponseTime = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "flux_monitor_request_duration_seconds",
@@ -0,0 +1,71 @@
+package fluxmonitor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// roundStateCacheMaxAge is the longest a round state is reused, so that the
+// changes to an aggregator which emit no log the checkers listen for, such as
+// its funding, are seen in time.
+const roundStateCacheMaxAge = 5 * time.Minute
+
+// roundStateCache keeps the round state last read from each aggregator by
+// the checkers polling it, so that the checkers of the jobs submitting to the
+// same aggregator, and each of their polls, don't call it again while nothing
+// has changed. The checkers invalidate an aggregator's round state when a log
+// shows a change to its rounds, and after they submit to it.
+type roundStateCache struct {
+	entries map[common.Address]cachedRoundState
+	mu      sync.Mutex
+}
+
+type cachedRoundState struct {
+	roundState contracts.FluxAggregatorRoundState
+	expiresAt  time.Time
+}
+
+func newRoundStateCache() *roundStateCache {
+	return &roundStateCache{entries: make(map[common.Address]cachedRoundState)}
+}
+
+// get returns the round state of the aggregator, if one is cached and has not
+// expired.
+func (c *roundStateCache) get(aggregator common.Address) (contracts.FluxAggregatorRoundState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[aggregator]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return contracts.FluxAggregatorRoundState{}, false
+	}
+	return entry.roundState, true
+}
+
+// set caches the round state of the aggregator until its round times out, as
+// that changes what the node may submit to without any log, or for at most
+// roundStateCacheMaxAge.
+func (c *roundStateCache) set(aggregator common.Address, roundState contracts.FluxAggregatorRoundState) {
+	now := time.Now()
+	expiresAt := now.Add(roundStateCacheMaxAge)
+	if timesOutAt := roundState.TimesOutAt(); timesOutAt != 0 {
+		roundTimesOutAt := time.Unix(int64(timesOutAt), 0)
+		if roundTimesOutAt.After(now) && roundTimesOutAt.Before(expiresAt) {
+			expiresAt = roundTimesOutAt
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[aggregator] = cachedRoundState{roundState, expiresAt}
+}
+
+// invalidate drops the cached round state of the aggregator.
+func (c *roundStateCache) invalidate(aggregator common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, aggregator)
+}
@@ -0,0 +1,47 @@
+package fluxmonitor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundStateCache(t *testing.T) {
+	t.Parallel()
+
+	cache := newRoundStateCache()
+	aggregator := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	other := common.HexToAddress("0x6bAAcF2fC4bBb5B6f2c1e5bB6C26DD8b6dEf2a29")
+
+	_, ok := cache.get(aggregator)
+	assert.False(t, ok)
+
+	cache.set(aggregator, contracts.FluxAggregatorRoundState{ReportableRoundID: 2})
+	roundState, ok := cache.get(aggregator)
+	require.True(t, ok)
+	assert.Equal(t, uint32(2), roundState.ReportableRoundID)
+	_, ok = cache.get(other)
+	assert.False(t, ok)
+
+	cache.invalidate(aggregator)
+	_, ok = cache.get(aggregator)
+	assert.False(t, ok)
+
+	// A round state expires when its round times out
+	cache.set(aggregator, contracts.FluxAggregatorRoundState{
+		ReportableRoundID: 3,
+		StartedAt:         uint64(time.Now().Unix()),
+		Timeout:           1,
+	})
+	_, ok = cache.get(aggregator)
+	assert.True(t, ok)
+	assert.Eventually(t, func() bool {
+		_, ok := cache.get(aggregator)
+		return !ok
+	}, 3*time.Second, 50*time.Millisecond)
+}
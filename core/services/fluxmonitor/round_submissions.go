@@ -0,0 +1,151 @@
+package fluxmonitor
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// roundSubmissionsKept is how many of the latest rounds of its aggregator a
+// checker keeps the submissions of.
+const roundSubmissionsKept = 10
+
+// roundSubmissions are the oracles which have submitted to each of the latest
+// rounds of an aggregator, in the order their SubmissionReceived logs were
+// seen. They are recorded as the logs arrive, rather than after the logs
+// queued before them have been processed, so that a checker responding to a
+// round knows who has already answered it.
+type roundSubmissions struct {
+	rounds map[uint32]*roundSubmission
+	mu     sync.Mutex
+}
+
+type roundSubmission struct {
+	oracles []common.Address
+	// settled is true once it is known whether the node lagged the other
+	// oracles in the round, and lagged whether it did.
+	settled bool
+	lagged  bool
+}
+
+// settledRound is a round which peers other oracles had submitted to before
+// the node did, or had submitted to at all if the node did not.
+type settledRound struct {
+	roundID uint32
+	peers   int
+	lagged  bool
+}
+
+func newRoundSubmissions() *roundSubmissions {
+	return &roundSubmissions{rounds: make(map[uint32]*roundSubmission)}
+}
+
+// add records the submission of oracle to the round. A submission already
+// recorded, as when logs are backfilled, is ignored. Rounds older than the
+// latest roundSubmissionsKept are forgotten.
+func (rs *roundSubmissions) add(roundID uint32, oracle common.Address) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	round, ok := rs.rounds[roundID]
+	if !ok {
+		round = &roundSubmission{}
+		rs.rounds[roundID] = round
+	}
+	for _, o := range round.oracles {
+		if o == oracle {
+			return
+		}
+	}
+	round.oracles = append(round.oracles, oracle)
+
+	if len(rs.rounds) > roundSubmissionsKept {
+		for _, id := range rs.roundIDs()[:len(rs.rounds)-roundSubmissionsKept] {
+			delete(rs.rounds, id)
+		}
+	}
+}
+
+// count returns how many oracles have submitted to the round.
+func (rs *roundSubmissions) count(roundID uint32) int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if round, ok := rs.rounds[roundID]; ok {
+		return len(round.oracles)
+	}
+	return 0
+}
+
+// settle returns the rounds for which it has become known how many other
+// oracles submitted before the node: those the node has submitted to, and
+// those it has not submitted to although a later round has begun. A round is
+// lagged if a supermajority of the aggregator's oracles had submitted to it
+// before the node.
+func (rs *roundSubmissions) settle(node common.Address, oracleCount uint8) []settledRound {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var settled []settledRound
+	ids := rs.roundIDs()
+	for i, id := range ids {
+		round := rs.rounds[id]
+		if round.settled {
+			continue
+		}
+		peers := -1
+		for j, o := range round.oracles {
+			if o == node {
+				peers = j
+				break
+			}
+		}
+		if peers < 0 {
+			if i == len(ids)-1 {
+				continue
+			}
+			peers = len(round.oracles)
+		}
+		round.settled = true
+		round.lagged = isSupermajority(peers, oracleCount)
+		settled = append(settled, settledRound{id, peers, round.lagged})
+	}
+	return settled
+}
+
+// states returns the submissions to each of the rounds kept, latest first.
+func (rs *roundSubmissions) states() []RoundSubmissionsState {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	ids := rs.roundIDs()
+	states := make([]RoundSubmissionsState, 0, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		round := rs.rounds[ids[i]]
+		state := RoundSubmissionsState{
+			RoundID: ids[i],
+			Oracles: append([]common.Address(nil), round.oracles...),
+		}
+		if round.settled {
+			lagged := round.lagged
+			state.Lagged = &lagged
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+func (rs *roundSubmissions) roundIDs() []uint32 {
+	ids := make([]uint32, 0, len(rs.rounds))
+	for id := range rs.rounds {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// isSupermajority returns true if more than two thirds of an aggregator's
+// oracles are among the given number of them.
+func isSupermajority(oracles int, oracleCount uint8) bool {
+	return oracleCount > 0 && 3*oracles > 2*int(oracleCount)
+}
@@ -0,0 +1,77 @@
+package fluxmonitor
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundSubmissions(t *testing.T) {
+	t.Parallel()
+
+	node := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	peers := []common.Address{
+		common.HexToAddress("0x6bAAcF2fC4bBb5B6f2c1e5bB6C26DD8b6dEf2a29"),
+		common.HexToAddress("0x9FBDa871d559710256a2502A2517b794B482Db40"),
+		common.HexToAddress("0x2cE5c9a6e9bB7dCc2dB1A1aA24fE3bF87d63b1D4"),
+	}
+	const oracleCount = 4
+
+	rounds := newRoundSubmissions()
+	assert.Empty(t, rounds.settle(node, oracleCount))
+
+	// The node submitted first to round 1
+	rounds.add(1, node)
+	rounds.add(1, peers[0])
+	rounds.add(1, peers[0])
+	assert.Equal(t, 2, rounds.count(1))
+	assert.Equal(t, []settledRound{{roundID: 1, peers: 0, lagged: false}}, rounds.settle(node, oracleCount))
+
+	// Round 2 is not settled until the node submits to it, or a later round begins
+	rounds.add(2, peers[0])
+	rounds.add(2, peers[1])
+	rounds.add(2, peers[2])
+	assert.Empty(t, rounds.settle(node, oracleCount))
+	rounds.add(2, node)
+	assert.Equal(t, []settledRound{{roundID: 2, peers: 3, lagged: true}}, rounds.settle(node, oracleCount))
+
+	rounds.add(3, peers[0])
+	rounds.add(3, peers[1])
+	rounds.add(3, peers[2])
+	rounds.add(4, peers[0])
+	assert.Equal(t, []settledRound{{roundID: 3, peers: 3, lagged: true}}, rounds.settle(node, oracleCount))
+	assert.Empty(t, rounds.settle(node, oracleCount))
+
+	states := rounds.states()
+	require.Len(t, states, 4)
+	assert.Equal(t, uint32(4), states[0].RoundID)
+	assert.Nil(t, states[0].Lagged)
+	assert.Equal(t, uint32(2), states[2].RoundID)
+	assert.Equal(t, append(peers, node), states[2].Oracles)
+	require.NotNil(t, states[2].Lagged)
+	assert.True(t, *states[2].Lagged)
+
+	// Only the latest rounds are kept
+	for round := uint32(5); round < 5+roundSubmissionsKept; round++ {
+		rounds.add(round, peers[0])
+	}
+	states = rounds.states()
+	require.Len(t, states, roundSubmissionsKept)
+	assert.Equal(t, uint32(4+roundSubmissionsKept), states[0].RoundID)
+	assert.Equal(t, uint32(5), states[len(states)-1].RoundID)
+	assert.Equal(t, 0, rounds.count(4))
+}
+
+func TestIsSupermajority(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isSupermajority(0, 0))
+	assert.False(t, isSupermajority(2, 3))
+	assert.True(t, isSupermajority(3, 3))
+	assert.False(t, isSupermajority(2, 4))
+	assert.True(t, isSupermajority(3, 4))
+	assert.False(t, isSupermajority(14, 21))
+	assert.True(t, isSupermajority(15, 21))
+}
@@ -0,0 +1,145 @@
+package fluxmonitor
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth/contracts"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+)
+
+// CheckerState is what the checker of a fluxmonitor initiator last saw of
+// its feeds and its aggregator, along with the state of its timers, so that
+// operators can tell why it is, or is not, submitting.
+type CheckerState struct {
+	InitiatorID int64          `json:"initiatorId"`
+	Contract    common.Address `json:"contract"`
+	Simulated   bool           `json:"simulated"`
+	Connected   bool           `json:"connected"`
+	Hibernating bool           `json:"hibernating"`
+	// Feeds are the last answers of each feed. They are only known for
+	// checkers polling the feeds of their initiator.
+	Feeds []FeedState `json:"feeds"`
+	// Aggregate is the last answer computed from the feeds, and PolledAt
+	// when it was, or PollError why it could not be.
+	Aggregate     *decimal.Decimal `json:"aggregate"`
+	RejectedFeeds []string         `json:"rejectedFeeds,omitempty"`
+	PolledAt      *time.Time       `json:"polledAt"`
+	PollError     string           `json:"pollError,omitempty"`
+	// RoundState is the state of the aggregator last read from the chain.
+	RoundState    *contracts.FluxAggregatorRoundState `json:"roundState"`
+	PollTimer     TimerState                          `json:"pollTimer"`
+	IdleTimer     TimerState                          `json:"idleTimer"`
+	RoundTimer    TimerState                          `json:"roundTimer"`
+	DrumbeatTimer TimerState                          `json:"drumbeatTimer"`
+	// AwaitingRelease is true while deviation does not trigger submissions,
+	// until the answer returns within the release thresholds.
+	AwaitingRelease bool `json:"awaitingRelease"`
+	// TooFewAnswers is true while fewer feeds answer than minAnswers.
+	TooFewAnswers bool `json:"tooFewAnswers"`
+	// Underfunded is true while the aggregator cannot pay its oracles, and
+	// the checker only polls when UnderfundedTimer fires.
+	Underfunded      bool       `json:"underfunded"`
+	UnderfundedTimer TimerState `json:"underfundedTimer"`
+	// Rounds are the oracles which have submitted to the latest rounds of
+	// the aggregator, latest first, and LaggingRounds how many rounds in a
+	// row a supermajority of them submitted before the node.
+	Rounds        []RoundSubmissionsState `json:"rounds"`
+	LaggingRounds int                     `json:"laggingRounds"`
+}
+
+// RoundSubmissionsState is the oracles which have submitted to a round, in
+// the order their submissions were seen. Lagged is whether a supermajority of
+// them submitted before the node, once that is known.
+type RoundSubmissionsState struct {
+	RoundID uint32           `json:"roundId"`
+	Oracles []common.Address `json:"oracles"`
+	Lagged  *bool            `json:"lagged,omitempty"`
+}
+
+// FeedState is the last answer of a feed, or the error fetching it.
+type FeedState struct {
+	Feed      string           `json:"feed"`
+	Answer    *decimal.Decimal `json:"answer"`
+	Error     string           `json:"error,omitempty"`
+	FetchedAt *time.Time       `json:"fetchedAt"`
+}
+
+// TimerState is whether a timer of a checker is running, and when it next
+// fires. The poll timer has a period instead, as it fires repeatedly.
+type TimerState struct {
+	Running bool             `json:"running"`
+	Period  *models.Duration `json:"period,omitempty"`
+	FiresAt *time.Time       `json:"firesAt,omitempty"`
+}
+
+// feedStateReporter is a Fetcher which keeps the last answer of each of its
+// feeds.
+type feedStateReporter interface {
+	feedStates() []FeedState
+}
+
+// State returns the last published state of the checker. It does not wait on
+// a poll in progress.
+func (p *PollingDeviationChecker) State() CheckerState {
+	p.stateMtx.RLock()
+	state := p.state
+	reporter := p.stateFeeds
+	p.stateMtx.RUnlock()
+
+	state.Connected = p.connected.IsSet()
+	if reporter != nil {
+		state.Feeds = reporter.feedStates()
+	}
+	state.IdleTimer = timerState(&p.idleTimer)
+	state.RoundTimer = timerState(&p.roundTimer)
+	state.DrumbeatTimer = timerState(&p.drumbeatTimer)
+	state.UnderfundedTimer = timerState(&p.underfundedTimer)
+	state.Rounds = p.roundSubmissions.states()
+	return state
+}
+
+// publishState copies the state kept by the checker's goroutine to where
+// State can read it.
+func (p *PollingDeviationChecker) publishState() {
+	state := CheckerState{
+		InitiatorID:     p.initr.ID,
+		Contract:        p.initr.Address,
+		Simulated:       p.initr.Simulate,
+		Hibernating:     p.isHibernating,
+		Aggregate:       p.lastAggregate,
+		RejectedFeeds:   p.lastRejectedFeeds,
+		PollError:       p.lastPollError,
+		RoundState:      p.lastRoundState,
+		AwaitingRelease: p.awaitingRelease,
+		TooFewAnswers:   p.tooFewAnswers,
+		Underfunded:     p.underfunded,
+		LaggingRounds:   p.laggingRounds,
+	}
+	if !p.lastPolledAt.IsZero() {
+		polledAt := p.lastPolledAt
+		state.PolledAt = &polledAt
+	}
+	if !p.initr.PollTimer.Disabled {
+		period := p.initr.PollTimer.Period
+		state.PollTimer = TimerState{Running: !p.isHibernating && !p.underfunded, Period: &period}
+	}
+
+	reporter, _ := p.fetcher.(feedStateReporter)
+
+	p.stateMtx.Lock()
+	p.state = state
+	p.stateFeeds = reporter
+	p.stateMtx.Unlock()
+}
+
+func timerState(timer *utils.ResettableTimer) TimerState {
+	deadline, running := timer.Deadline()
+	if !running {
+		return TimerState{}
+	}
+	return TimerState{Running: true, FiresAt: &deadline}
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"go.uber.org/multierr"
+)
+
+// GasPriceThresholdMonitor checks the node's gas price against the
+// thresholds of gasprice initiators on every new head, and creates a job run
+// each time the price crosses one. The price checked is the default gas price
+// which the gas updater keeps at a percentile of recent blocks, so it only
+// moves while the gas updater is enabled.
+type GasPriceThresholdMonitor interface {
+	store.HeadTrackable
+	AddJob(job models.JobSpec) error
+	RemoveJob(ID *models.ID)
+}
+
+type gasPriceThresholdMonitor struct {
+	store      *store.Store
+	runManager RunManager
+	watches    map[string][]*gasPriceWatch
+	watchesMtx sync.Mutex
+}
+
+// gasPriceWatch tracks whether the gas price was on the triggering side of a
+// single initiator's threshold at the last check, so that a run is only
+// created when the threshold is crossed rather than on every head.
+type gasPriceWatch struct {
+	initiator models.Initiator
+	crossed   bool
+}
+
+// NewGasPriceThresholdMonitor returns a new GasPriceThresholdMonitor
+func NewGasPriceThresholdMonitor(store *store.Store, runManager RunManager) GasPriceThresholdMonitor {
+	return &gasPriceThresholdMonitor{
+		store:      store,
+		runManager: runManager,
+		watches:    make(map[string][]*gasPriceWatch),
+	}
+}
+
+// AddJob starts watching the gas price for each gasprice initiator in the
+// job spec. Jobs bound to another chain than the store's are ignored.
+func (gpm *gasPriceThresholdMonitor) AddJob(job models.JobSpec) error {
+	initrs := job.InitiatorsFor(models.InitiatorGasPrice)
+	if len(initrs) == 0 || !gpm.store.Config.ServesChain(job.ChainID) {
+		return nil
+	}
+
+	var watches []*gasPriceWatch
+	for _, initr := range initrs {
+		if initr.GasPriceThreshold == nil {
+			return fmt.Errorf("GasPriceThresholdMonitor: job %s has no gasPriceThreshold", job.ID)
+		}
+		watches = append(watches, &gasPriceWatch{initiator: initr})
+	}
+
+	gpm.watchesMtx.Lock()
+	gpm.watches[job.ID.String()] = watches
+	gpm.watchesMtx.Unlock()
+	return nil
+}
+
+// RemoveJob stops watching the gas price for the job.
+func (gpm *gasPriceThresholdMonitor) RemoveJob(ID *models.ID) {
+	gpm.watchesMtx.Lock()
+	delete(gpm.watches, ID.String())
+	gpm.watchesMtx.Unlock()
+}
+
+// Connect complies with HeadTrackable, loading all jobs with a gasprice
+// initiator.
+func (gpm *gasPriceThresholdMonitor) Connect(*models.Head) error {
+	var merr error
+	err := gpm.store.Jobs(
+		func(j *models.JobSpec) bool {
+			merr = multierr.Append(merr, gpm.AddJob(*j))
+			return true
+		},
+		models.InitiatorGasPrice,
+	)
+	return multierr.Append(merr, err)
+}
+
+// Disconnect complies with HeadTrackable
+func (gpm *gasPriceThresholdMonitor) Disconnect() {}
+
+// OnNewLongestChain checks the gas price against the watched thresholds.
+// Unlike balances, the gas price is known without asking the eth node, so
+// the check is made as each head arrives.
+func (gpm *gasPriceThresholdMonitor) OnNewLongestChain(_ context.Context, head models.Head) {
+	gasPrice := gpm.store.Config.EthGasPriceDefault()
+
+	gpm.watchesMtx.Lock()
+	defer gpm.watchesMtx.Unlock()
+	for _, watches := range gpm.watches {
+		for _, watch := range watches {
+			gpm.check(watch, gasPrice, head.ToInt())
+		}
+	}
+}
+
+func (gpm *gasPriceThresholdMonitor) check(watch *gasPriceWatch, gasPrice, head *big.Int) {
+	initr := watch.initiator
+	crossed := initr.GasPriceCrossed(gasPrice)
+	if !crossed || watch.crossed {
+		watch.crossed = crossed
+		return
+	}
+
+	crossing := initr.GasPriceCrossing()
+	logger.Infow(fmt.Sprintf("GasPriceThresholdMonitor: gas price crossed %s threshold", crossing),
+		"job", initr.JobSpecID.String(),
+		"gasPrice", gasPrice.String(),
+		"threshold", initr.GasPriceThreshold.String(),
+	)
+
+	data, err := models.JSON{}.MultiAdd(models.KV{
+		"gasPrice":  gasPrice.String(),
+		"threshold": initr.GasPriceThreshold.String(),
+		"crossing":  crossing,
+	})
+	if err != nil {
+		logger.Errorw("GasPriceThresholdMonitor: error building run request", "error", err)
+		return
+	}
+	runRequest := models.NewRunRequest(data)
+	if _, err := gpm.runManager.Create(initr.JobSpecID, &initr, head, runRequest); err != nil {
+		logger.Errorw("GasPriceThresholdMonitor: error creating run", "error", err, "job", initr.JobSpecID.String())
+		return
+	}
+	watch.crossed = true
+}
@@ -0,0 +1,52 @@
+package services_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasPriceThresholdMonitor_OnNewLongestChain(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators[0].Type = models.InitiatorGasPrice
+	job.Initiators[0].GasPriceThreshold = utils.NewBigI(100000000000)
+
+	created := make(chan models.JSON, 2)
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			created <- args.Get(3).(*models.RunRequest).RequestParams
+		}).
+		Twice()
+
+	monitor := services.NewGasPriceThresholdMonitor(store, runManager)
+	require.NoError(t, monitor.AddJob(job))
+
+	for i, gasPrice := range []int64{20000000000, 150000000000, 200000000000, 50000000000, 120000000000} {
+		require.NoError(t, store.Config.SetEthGasPriceDefault(big.NewInt(gasPrice)))
+		monitor.OnNewLongestChain(context.Background(), *cltest.Head(i))
+		if i == 1 {
+			params := <-created
+			assert.Equal(t, "150000000000", params.Get("gasPrice").String())
+			assert.Equal(t, "100000000000", params.Get("threshold").String())
+			assert.Equal(t, models.BalanceCrossingAbove, params.Get("crossing").String())
+		}
+	}
+	assert.Equal(t, "120000000000", (<-created).Get("gasPrice").String())
+
+	runManager.AssertExpectations(t)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -36,6 +37,13 @@ var (
 	},
 		[]string{"percentile"},
 	)
+
+	promGasUpdaterSetTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_updater_set_tip_cap",
+		Help: "Gas updater set tip cap of dynamic-fee transactions (in Wei)",
+	},
+		[]string{"percentile"},
+	)
 )
 
 // GasUpdater listens for new heads and updates the base gas price dynamically
@@ -43,12 +51,16 @@ var (
 type GasUpdater interface {
 	store.HeadTrackable
 	RollingBlockHistory() []*types.Block
+	RollingTipHistory() [][]*big.Int
 }
 
 type gasUpdater struct {
 	store                   *store.Store
 	rollingBlockHistory     []*types.Block
 	rollingBlockHistorySize int
+	// rollingTipHistory holds the effective priority fees paid by the
+	// transactions of each recent block which had any
+	rollingTipHistory [][]*big.Int
 	// HACK: blockDelay is the number of blocks that the gas updater trails behind head.
 	// E.g. if this is set to 3, and we receive block 10, gas updater will
 	// fetch block 7.
@@ -64,6 +76,7 @@ func NewGasUpdater(store *store.Store) GasUpdater {
 	gu := &gasUpdater{
 		store:                   store,
 		rollingBlockHistory:     make([]*types.Block, 0),
+		rollingTipHistory:       make([][]*big.Int, 0),
 		rollingBlockHistorySize: int(store.Config.GasUpdaterBlockHistorySize()),
 		blockDelay:              int64(store.Config.GasUpdaterBlockDelay()),
 		percentile:              int(store.Config.GasUpdaterTransactionPercentile()),
@@ -98,6 +111,10 @@ func (gu *gasUpdater) OnNewLongestChain(ctx context.Context, head models.Head) {
 		logger.Warnf("GasUpdater: skipping gas calculation, current block height %v is lower than GAS_UPDATER_BLOCK_DELAY of %v", head.Number, gu.blockDelay)
 		return
 	}
+	if gu.store.Config.GasUpdaterTipCapEnabled() {
+		gu.updateFromFees(ctx, blockToFetch)
+		return
+	}
 	block, err := gu.store.EthClient.BlockByNumber(ctx, big.NewInt(blockToFetch))
 	if err != nil {
 		logger.Errorf("GasUpdater: error retrieving block %v: %s", blockToFetch, err)
@@ -151,3 +168,119 @@ func (gu *gasUpdater) setPercentileGasPrice(gasPrice int64) error {
 func (gu *gasUpdater) RollingBlockHistory() []*types.Block {
 	return gu.rollingBlockHistory
 }
+
+func (gu *gasUpdater) RollingTipHistory() [][]*big.Int {
+	return gu.rollingTipHistory
+}
+
+// feeBlock holds the fields of a block needed to work out the priority fees
+// paid by its transactions. The go-ethereum types used by the node predate
+// EIP-1559, so blocks are decoded into this instead.
+type feeBlock struct {
+	BaseFeePerGas *hexutil.Big     `json:"baseFeePerGas"`
+	Transactions  []feeTransaction `json:"transactions"`
+}
+
+type feeTransaction struct {
+	GasPrice             *hexutil.Big `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big `json:"maxPriorityFeePerGas"`
+}
+
+// effectiveTips returns the priority fee per gas which each transaction of
+// the block paid to the miner on top of the base fee
+func (b feeBlock) effectiveTips() []*big.Int {
+	baseFee := b.BaseFeePerGas.ToInt()
+	tips := make([]*big.Int, 0, len(b.Transactions))
+	for _, tx := range b.Transactions {
+		var tip *big.Int
+		if tx.MaxFeePerGas != nil && tx.MaxPriorityFeePerGas != nil {
+			tip = new(big.Int).Sub(tx.MaxFeePerGas.ToInt(), baseFee)
+			if tx.MaxPriorityFeePerGas.ToInt().Cmp(tip) < 0 {
+				tip = new(big.Int).Set(tx.MaxPriorityFeePerGas.ToInt())
+			}
+		} else if tx.GasPrice != nil {
+			tip = new(big.Int).Sub(tx.GasPrice.ToInt(), baseFee)
+		} else {
+			continue
+		}
+		if tip.Sign() < 0 {
+			tip.SetInt64(0)
+		}
+		tips = append(tips, tip)
+	}
+	return tips
+}
+
+// updateFromFees samples the priority fees paid in the given block, once
+// enough blocks have been sampled sets the tip cap to the configured
+// percentile of them, and sets the gas price to the tip cap on top of the
+// highest base fee the block the node's transactions are next mined in can
+// have. The node sends legacy transactions, which pay miners their gas price
+// less the base fee, so this is what prices them by the tip.
+func (gu *gasUpdater) updateFromFees(ctx context.Context, blockNumber int64) {
+	var block *feeBlock
+	err := gu.store.EthClient.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeBig(big.NewInt(blockNumber)), true)
+	if err != nil {
+		logger.Errorf("GasUpdater: error retrieving fees of block %v: %s", blockNumber, err)
+		return
+	}
+	if block == nil || block.BaseFeePerGas == nil {
+		logger.Warnw(fmt.Sprintf("GasUpdater: skipping block without base fee: %v, GAS_UPDATER_TIP_CAP_ENABLED is only for chains with EIP-1559", blockNumber), "blockNumber", blockNumber)
+		return
+	}
+
+	if tips := block.effectiveTips(); len(tips) > 0 {
+		gu.rollingTipHistory = append(gu.rollingTipHistory, tips)
+		if len(gu.rollingTipHistory) > gu.rollingBlockHistorySize {
+			gu.rollingTipHistory = gu.rollingTipHistory[1:]
+			tipCap := gu.percentileTipCap()
+			if err := gu.setTipCap(tipCap); err != nil {
+				logger.Error("GasUpdater error setting tip cap: ", err)
+				return
+			}
+			tipCapFloat, _ := new(big.Float).SetInt(tipCap).Float64()
+			promGasUpdaterSetTipCap.WithLabelValues(fmt.Sprintf("%v%%", gu.percentile)).Set(tipCapFloat)
+		}
+	}
+
+	gasPrice := new(big.Int).Add(gu.maxNextBaseFee(block.BaseFeePerGas.ToInt()), gu.store.Config.EthGasTipCapDefault())
+	if !gasPrice.IsInt64() {
+		logger.Errorf("GasUpdater: gas price %s is out of range", gasPrice)
+		return
+	}
+	if err := gu.setPercentileGasPrice(gasPrice.Int64()); err != nil {
+		logger.Error("GasUpdater error setting gas price: ", err)
+		return
+	}
+	promGasUpdaterSetGasPrice.WithLabelValues(fmt.Sprintf("%v%%", gu.percentile)).Set(float64(gasPrice.Int64()))
+}
+
+// maxNextBaseFee returns the highest base fee of the block after the head,
+// given the base fee of the block blockDelay before the head. The base fee
+// rises by at most an eighth each block.
+func (gu *gasUpdater) maxNextBaseFee(baseFee *big.Int) *big.Int {
+	maxBaseFee := new(big.Int).Set(baseFee)
+	for i := int64(0); i <= gu.blockDelay; i++ {
+		maxBaseFee.Add(maxBaseFee, new(big.Int).Div(maxBaseFee, big.NewInt(8)))
+	}
+	return maxBaseFee
+}
+
+func (gu *gasUpdater) percentileTipCap() *big.Int {
+	tips := make([]*big.Int, 0)
+	for _, blockTips := range gu.rollingTipHistory {
+		tips = append(tips, blockTips...)
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	idx := ((len(tips) - 1) * gu.percentile) / 100
+	return tips[idx]
+}
+
+func (gu *gasUpdater) setTipCap(tipCap *big.Int) error {
+	if tipCap.Cmp(gu.store.Config.EthMaxGasPriceWei()) > 0 {
+		return fmt.Errorf("cannot set tip cap %s because it exceeds EthMaxGasPriceWei %s", tipCap.String(), gu.store.Config.EthMaxGasPriceWei().String())
+	}
+	logger.Debugw(fmt.Sprintf("GasUpdater: setting new default tip cap: %s wei", tipCap.String()), "tipCapWei", tipCap.String())
+	return gu.store.Config.SetEthGasTipCapDefault(tipCap)
+}
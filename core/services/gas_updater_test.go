@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"math/big"
 	"testing"
@@ -9,8 +10,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGasUpdater_OnNewLongestChain_whenDisabledDoesNothing(t *testing.T) {
@@ -180,3 +184,67 @@ func TestGasUpdater_OnNewLongestChain_WillNotSetGasHigherThanEthMaxGasPriceWei(t
 
 	assert.Equal(t, big.NewInt(42), config.EthGasPriceDefault())
 }
+
+func TestGasUpdater_OnNewLongestChain_SetsTipCapWhenHistoryFull(t *testing.T) {
+	config, _ := cltest.NewConfig(t)
+	config.Set("GAS_UPDATER_ENABLED", "true")
+	config.Set("GAS_UPDATER_TIP_CAP_ENABLED", "true")
+	config.Set("GAS_UPDATER_BLOCK_DELAY", "0")
+	config.Set("GAS_UPDATER_TRANSACTION_PERCENTILE", "50")
+	config.Set("GAS_UPDATER_BLOCK_HISTORY_SIZE", "2")
+	config.Set("ETH_GAS_TIP_CAP_DEFAULT", 5)
+	store, cleanup := cltest.NewStoreWithConfig(config)
+	config.SetRuntimeStore(store.ORM)
+	defer cleanup()
+	ethClient := new(mocks.Client)
+	store.EthClient = ethClient
+	gu := services.NewGasUpdater(store)
+
+	blocks := []string{
+		// Before EIP-1559, so ignored
+		`{"transactions": [{"gasPrice": "0x64"}]}`,
+		// Tips of 30, 10 and 20
+		`{"baseFeePerGas": "0x64", "transactions": [
+			{"gasPrice": "0x82"},
+			{"gasPrice": "0x6e", "maxFeePerGas": "0xc8", "maxPriorityFeePerGas": "0xa"},
+			{"gasPrice": "0x78", "maxFeePerGas": "0x78", "maxPriorityFeePerGas": "0x32"}
+		]}`,
+		// Tip of 40
+		`{"baseFeePerGas": "0x64", "transactions": [{"gasPrice": "0x8c"}]}`,
+		// Tips of 25 and 60
+		`{"baseFeePerGas": "0x64", "transactions": [
+			{"gasPrice": "0x7d", "maxFeePerGas": "0x12c", "maxPriorityFeePerGas": "0x19"},
+			{"gasPrice": "0xa0"}
+		]}`,
+	}
+	for i, block := range blocks {
+		block := block
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", hexutil.EncodeBig(big.NewInt(int64(i))), true).
+			Run(func(args mock.Arguments) {
+				require.NoError(t, json.Unmarshal([]byte(block), args.Get(1)))
+			}).
+			Return(nil).
+			Once()
+	}
+
+	gasPriceDefault := config.EthGasPriceDefault()
+	gu.OnNewLongestChain(context.TODO(), *cltest.Head(0))
+	assert.Len(t, gu.RollingTipHistory(), 0)
+	assert.Equal(t, gasPriceDefault, config.EthGasPriceDefault())
+
+	for i := 1; i < 3; i++ {
+		gu.OnNewLongestChain(context.TODO(), *cltest.Head(i))
+		assert.Len(t, gu.RollingTipHistory(), i)
+		assert.Equal(t, big.NewInt(5), config.EthGasTipCapDefault())
+		// The base fee of 100 may rise to 112 by the next block
+		assert.Equal(t, big.NewInt(117), config.EthGasPriceDefault())
+	}
+
+	// The block with tips of 30, 10 and 20 drops out of the history
+	gu.OnNewLongestChain(context.TODO(), *cltest.Head(3))
+	assert.Len(t, gu.RollingTipHistory(), 2)
+	assert.Equal(t, big.NewInt(40), config.EthGasTipCapDefault())
+	assert.Equal(t, big.NewInt(152), config.EthGasPriceDefault())
+
+	ethClient.AssertExpectations(t)
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+var headTrackerLogger = logger.Module(logger.ModuleHeadTracker)
+
 var (
 	promCurrentHead = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "head_tracker_current_head",
@@ -80,14 +82,14 @@ func (r *headRingBuffer) Start() {
 func (r *headRingBuffer) run() {
 	for h := range r.in {
 		if h == nil {
-			logger.Error("HeadTracker: got nil block header")
+			headTrackerLogger.Error("HeadTracker: got nil block header")
 			continue
 		}
 		promNumHeadsReceived.Inc()
 		hInQueue := len(r.out)
 		promHeadsInQueue.Set(float64(hInQueue))
 		if hInQueue > 0 {
-			logger.Infof("HeadTracker: Head %v is lagging behind, there are %v more heads in the queue. Your node is operating close to its maximum capacity and may start to miss jobs.", h.Number, hInQueue)
+			headTrackerLogger.Infof("HeadTracker: Head %v is lagging behind, there are %v more heads in the queue. Your node is operating close to its maximum capacity and may start to miss jobs.", h.Number, hInQueue)
 		}
 		select {
 		case r.out <- *h:
@@ -102,7 +104,7 @@ func (r *headRingBuffer) run() {
 			select {
 			case dropped := <-r.out:
 				promNumHeadsDropped.Inc()
-				logger.Errorf("HeadTracker: dropping head %v with hash 0x%x because queue is full. WARNING: Your node is overloaded and may start missing jobs.", dropped.Number, h.Hash)
+				headTrackerLogger.Errorf("HeadTracker: dropping head %v with hash 0x%x because queue is full. WARNING: Your node is overloaded and may start missing jobs.", dropped.Number, h.Hash)
 				r.out <- *h
 			default:
 				r.out <- *h
@@ -163,7 +165,7 @@ func (ht *HeadTracker) Start() error {
 		return err
 	}
 	if ht.highestSeenHead != nil {
-		logger.Debug("Tracking logs from last block ", presenters.FriendlyBigInt(ht.highestSeenHead.ToInt()), " with hash ", ht.highestSeenHead.Hash.Hex())
+		headTrackerLogger.Debug("Tracking logs from last block ", presenters.FriendlyBigInt(ht.highestSeenHead.ToInt()), " with hash ", ht.highestSeenHead.Hash.Hex())
 	}
 
 	ht.done = make(chan struct{})
@@ -189,7 +191,7 @@ func (ht *HeadTracker) Stop() error {
 		ht.connected = false
 		ht.disconnect()
 	}
-	logger.Info(fmt.Sprintf("Head tracker disconnecting from %v", ht.store.Config.EthereumURL()))
+	headTrackerLogger.Info(fmt.Sprintf("Head tracker disconnecting from %v", ht.store.Config.EthereumURL()))
 	close(ht.done)
 	close(ht.subscriptionSucceeded)
 	ht.started = false
@@ -237,7 +239,7 @@ func (ht *HeadTracker) Connected() bool {
 
 func (ht *HeadTracker) connect(bn *models.Head) {
 	for _, trackable := range ht.callbacks {
-		logger.WarnIf(trackable.Connect(bn))
+		headTrackerLogger.WarnIf(trackable.Connect(bn))
 	}
 }
 
@@ -251,7 +253,7 @@ func (ht *HeadTracker) listenForNewHeads() {
 	defer ht.listenForNewHeadsWg.Done()
 	defer func() {
 		err := ht.unsubscribeFromHead()
-		logger.ErrorIf(err, "failed when unsubscribe from head")
+		headTrackerLogger.ErrorIf(err, "failed when unsubscribe from head")
 	}()
 
 	for {
@@ -259,7 +261,7 @@ func (ht *HeadTracker) listenForNewHeads() {
 			return
 		}
 		if err := ht.receiveHeaders(); err != nil {
-			logger.Errorw(fmt.Sprintf("Error in new head subscription, unsubscribed: %s", err.Error()), "err", err)
+			headTrackerLogger.Errorw(fmt.Sprintf("Error in new head subscription, unsubscribed: %s", err.Error()), "err", err)
 			continue
 		} else {
 			return
@@ -274,20 +276,20 @@ func (ht *HeadTracker) subscribe() bool {
 	for {
 		err := ht.unsubscribeFromHead()
 		if err != nil {
-			logger.ErrorIf(err, "failed when unsubscribe from head")
+			headTrackerLogger.ErrorIf(err, "failed when unsubscribe from head")
 			return false
 		}
 
-		logger.Info("Connecting to ethereum node ", ht.store.Config.EthereumURL(), " in ", ht.sleeper.Duration())
+		headTrackerLogger.Info("Connecting to ethereum node ", ht.store.Config.EthereumURL(), " in ", ht.sleeper.Duration())
 		select {
 		case <-ht.done:
 			return false
 		case <-time.After(ht.sleeper.After()):
 			err := ht.subscribeToHead()
 			if err != nil {
-				logger.Warnw(fmt.Sprintf("Failed to connect to ethereum node %v", ht.store.Config.EthereumURL()), "err", err)
+				headTrackerLogger.Warnw(fmt.Sprintf("Failed to connect to ethereum node %v", ht.store.Config.EthereumURL()), "err", err)
 			} else {
-				logger.Info("Connected to ethereum node ", ht.store.Config.EthereumURL())
+				headTrackerLogger.Info("Connected to ethereum node ", ht.store.Config.EthereumURL())
 				return true
 			}
 		}
@@ -325,14 +327,14 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head models.Head) erro
 		promCallbackDuration.Set(ms)
 		promCallbackDurationHist.Observe(ms)
 		if elapsed > ht.callbackExecutionThreshold() {
-			logger.Warnw(fmt.Sprintf("HeadTracker finished processing head %v in %s which exceeds callback execution threshold of %s", number, elapsed.String(), ht.callbackExecutionThreshold().String()), "blockNumber", number, "time", elapsed, "id", "head_tracker")
+			headTrackerLogger.Warnw(fmt.Sprintf("HeadTracker finished processing head %v in %s which exceeds callback execution threshold of %s", number, elapsed.String(), ht.callbackExecutionThreshold().String()), "blockNumber", number, "time", elapsed, "id", "head_tracker")
 		} else {
-			logger.Debugw(fmt.Sprintf("HeadTracker finished processing head %v in %s", number, elapsed.String()), "blockNumber", number, "time", elapsed, "id", "head_tracker")
+			headTrackerLogger.Debugw(fmt.Sprintf("HeadTracker finished processing head %v in %s", number, elapsed.String()), "blockNumber", number, "time", elapsed, "id", "head_tracker")
 		}
 	}(time.Now(), int64(head.Number))
 	prevHead := ht.HighestSeenHead()
 
-	logger.Debugw(fmt.Sprintf("Received new head %v", presenters.FriendlyBigInt(head.ToInt())),
+	headTrackerLogger.Debugw(fmt.Sprintf("Received new head %v", presenters.FriendlyBigInt(head.ToInt())),
 		"blockHeight", head.ToInt(),
 		"blockHash", head.Hash,
 	)
@@ -346,18 +348,22 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head models.Head) erro
 	}
 	if head.Number == prevHead.Number {
 		if head.Hash != prevHead.Hash {
-			logger.Debugw("HeadTracker: got duplicate head", "blockNum", head.Number, "gotHead", head.Hash.Hex(), "highestSeenHead", ht.highestSeenHead.Hash.Hex())
+			headTrackerLogger.Debugw("HeadTracker: got duplicate head", "blockNum", head.Number, "gotHead", head.Hash.Hex(), "highestSeenHead", ht.highestSeenHead.Hash.Hex())
 		} else {
-			logger.Debugw("HeadTracker: head already in the database", "gotHead", head.Hash.Hex())
+			headTrackerLogger.Debugw("HeadTracker: head already in the database", "gotHead", head.Hash.Hex())
 		}
 	} else {
-		logger.Debugw("HeadTracker: got out of order head", "blockNum", head.Number, "gotHead", head.Hash.Hex(), "highestSeenHead", ht.highestSeenHead.Number)
+		headTrackerLogger.Debugw("HeadTracker: got out of order head", "blockNum", head.Number, "gotHead", head.Hash.Hex(), "highestSeenHead", ht.highestSeenHead.Number)
 	}
 	return nil
 }
 
 func (ht *HeadTracker) handleNewHighestHead(head models.Head) error {
-	promCurrentHead.Set(float64(head.Number))
+	if chain := ht.store.Config.EVMChain(); chain != nil {
+		promChainCurrentHead.WithLabelValues(chain.ChainID.String()).Set(float64(head.Number))
+	} else {
+		promCurrentHead.Set(float64(head.Number))
+	}
 	// NOTE: We must set a hard time limit on this, backfilling heads should
 	// not block the head tracker
 	ctx, cancel := context.WithTimeout(context.Background(), ht.backfillTimeBudget())
@@ -441,7 +447,7 @@ func (ht *HeadTracker) backfill(ctx context.Context, head models.Head, baseHeigh
 	mark := time.Now()
 	fetched := 0
 	defer func() {
-		logger.Debugw("HeadTracker: finished backfill",
+		headTrackerLogger.Debugw("HeadTracker: finished backfill",
 			"fetched", fetched,
 			"blockNumber", head.Number,
 			"time", time.Since(mark),
@@ -465,11 +471,11 @@ func (ht *HeadTracker) backfill(ctx context.Context, head models.Head, baseHeigh
 		fetched++
 		if err != nil {
 			if errors.Cause(err) == ethereum.NotFound {
-				logger.Errorw("HeadTracker: backfill failed to fetch head (not found), chain will be truncated for this head", "headNum", i)
+				headTrackerLogger.Errorw("HeadTracker: backfill failed to fetch head (not found), chain will be truncated for this head", "headNum", i)
 			} else if errors.Cause(err) == context.DeadlineExceeded {
-				logger.Infow("HeadTracker: backfill deadline exceeded, chain will be truncated for this head", "headNum", i)
+				headTrackerLogger.Infow("HeadTracker: backfill deadline exceeded, chain will be truncated for this head", "headNum", i)
 			} else {
-				logger.Errorw("HeadTracker: backfill encountered unknown error, chain will be truncated for this head", "headNum", i, "err", err)
+				headTrackerLogger.Errorw("HeadTracker: backfill encountered unknown error, chain will be truncated for this head", "headNum", i, "err", err)
 			}
 			break
 		}
@@ -478,7 +484,7 @@ func (ht *HeadTracker) backfill(ctx context.Context, head models.Head, baseHeigh
 }
 
 func (ht *HeadTracker) fetchAndSaveHead(ctx context.Context, n int64) (models.Head, error) {
-	logger.Debugw("HeadTracker: fetching head", "blockHeight", n)
+	headTrackerLogger.Debugw("HeadTracker: fetching head", "blockHeight", n)
 	head, err := ht.store.EthClient.HeaderByNumber(ctx, big.NewInt(n))
 	if err != nil {
 		return models.Head{}, err
@@ -495,7 +501,7 @@ func (ht *HeadTracker) onNewLongestChain(ctx context.Context, headWithChain mode
 	ht.headMutex.Lock()
 	defer ht.headMutex.Unlock()
 
-	logger.Debugw("HeadTracker initiating callbacks",
+	headTrackerLogger.Debugw("HeadTracker initiating callbacks",
 		"headNum", headWithChain.Number,
 		"chainLength", headWithChain.ChainLength(),
 		"numCallbacks", len(ht.callbacks),
@@ -517,7 +523,7 @@ func (ht *HeadTracker) concurrentlyExecuteCallbacks(ctx context.Context, headWit
 			start := time.Now()
 			t.OnNewLongestChain(ctx, headWithChain)
 			elapsed := time.Since(start)
-			logger.Debugw(fmt.Sprintf("HeadTracker: finished callback %v in %s", i, elapsed), "callbackType", reflect.TypeOf(t), "callbackIdx", i, "blockNumber", headWithChain.Number, "time", elapsed, "id", "head_tracker")
+			headTrackerLogger.Debugw(fmt.Sprintf("HeadTracker: finished callback %v in %s", i, elapsed), "callbackType", reflect.TypeOf(t), "callbackIdx", i, "blockNumber", headWithChain.Number, "time", elapsed, "id", "head_tracker")
 			wg.Done()
 		}(idx, trackable)
 	}
@@ -529,7 +535,7 @@ func (ht *HeadTracker) seriallyExecuteCallbacks(ctx context.Context, headWithCha
 		start := time.Now()
 		t.OnNewLongestChain(ctx, headWithChain)
 		elapsed := time.Since(start)
-		logger.Debugw(fmt.Sprintf("HeadTracker: finished callback %v in %s", i, elapsed), "callbackType", reflect.TypeOf(t), "callbackIdx", i, "blockNumber", headWithChain.Number, "time", elapsed, "id", "head_tracker")
+		headTrackerLogger.Debugw(fmt.Sprintf("HeadTracker: finished callback %v in %s", i, elapsed), "callbackType", reflect.TypeOf(t), "callbackIdx", i, "blockNumber", headWithChain.Number, "time", elapsed, "id", "head_tracker")
 	}
 }
 
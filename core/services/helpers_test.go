@@ -3,3 +3,9 @@ package services
 func (ht *HeadTracker) ExportedDone() chan struct{} {
 	return ht.done
 }
+
+var (
+	PromAdapterPerformsVec      = promAdapterPerformsVec
+	PromAdapterPerformDuration  = promAdapterPerformDuration
+	PromAdapterBytesTransferred = promAdapterBytesTransferred
+)
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/adapters"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// ExportJobBundle packages the job with the bridges its tasks use and the
+// external initiators which start its runs.
+func ExportJobBundle(job models.JobSpec, store *store.Store) (models.JobBundle, error) {
+	var bridges []models.BridgeType
+	seen := map[models.TaskType]bool{}
+	for _, task := range job.Tasks {
+		if seen[task.Type] || adapters.FindNativeAdapterFor(task) != nil {
+			continue
+		}
+		seen[task.Type] = true
+		bt, err := store.FindBridge(task.Type)
+		if err != nil {
+			return models.JobBundle{}, errors.Wrapf(err, "finding bridge %s", task.Type)
+		}
+		bridges = append(bridges, bt)
+	}
+
+	var externalInitiators []models.ExternalInitiator
+	for _, initr := range job.InitiatorsFor(models.InitiatorExternal) {
+		ei, err := store.FindExternalInitiatorByName(initr.Name)
+		if err != nil {
+			return models.JobBundle{}, errors.Wrapf(err, "finding external initiator %s", initr.Name)
+		}
+		externalInitiators = append(externalInitiators, ei)
+	}
+
+	return models.NewJobBundle(job, bridges, externalInitiators)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -47,6 +48,7 @@ type jobSubscriber struct {
 
 type nextBlockWorker struct {
 	runManager RunManager
+	config     *orm.Config
 	head       big.Int
 	headMtx    sync.RWMutex
 }
@@ -65,15 +67,28 @@ func (b *nextBlockWorker) setHead(h big.Int) {
 
 func (b *nextBlockWorker) Work() {
 	head := b.getHead()
-	err := b.runManager.ResumeAllPendingNextBlock(&head)
+	err := b.runManager.ResumeAllPendingNextBlock(b.config.ChainID(), &head)
 	if err != nil {
 		logger.Errorw("Failed to resume confirming tasks on new head", "error", err)
 	}
+	// Deadlines and concurrency limits do not depend on the chain, so runs
+	// are only expired and dequeued on the heads of the primary chain
+	if b.config.EVMChain() != nil {
+		return
+	}
+	err = b.runManager.ExpireAllPastDeadline()
+	if err != nil {
+		logger.Errorw("Failed to expire runs past their deadline on new head", "error", err)
+	}
+	err = b.runManager.ResumeAllPendingConcurrency()
+	if err != nil {
+		logger.Errorw("Failed to resume runs waiting for earlier runs of their jobs on new head", "error", err)
+	}
 }
 
 // NewJobSubscriber returns a new job subscriber.
 func NewJobSubscriber(store *store.Store, runManager RunManager) JobSubscriber {
-	b := &nextBlockWorker{runManager: runManager}
+	b := &nextBlockWorker{runManager: runManager, config: store.Config}
 	js := &jobSubscriber{
 		store:            store,
 		runManager:       runManager,
@@ -90,9 +105,10 @@ func (js *jobSubscriber) Stop() error {
 }
 
 // AddJob subscribes to ethereum log events for each "runlog" and "ethlog"
-// initiator in the passed job spec.
+// initiator in the passed job spec. Jobs bound to another chain than the
+// store's are ignored.
 func (js *jobSubscriber) AddJob(job models.JobSpec, bn *models.Head) error {
-	if !job.IsLogInitiated() {
+	if !job.IsLogInitiated() || !js.store.Config.ServesChain(job.ChainID) {
 		return nil
 	}
 
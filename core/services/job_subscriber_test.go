@@ -30,14 +30,16 @@ func TestJobSubscriber_OnNewLongestChain(t *testing.T) {
 	wg.Add(1)
 	resumeJobChannel := make(chan struct{})
 
-	runManager.On("ResumeAllPendingNextBlock", big.NewInt(1337)).
+	runManager.On("ExpireAllPastDeadline").Return(nil)
+	runManager.On("ResumeAllPendingConcurrency").Return(nil)
+	runManager.On("ResumeAllPendingNextBlock", store.Config.ChainID(), big.NewInt(1337)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
 			wg.Done()
 			resumeJobChannel <- struct{}{}
 		})
-	runManager.On("ResumeAllPendingNextBlock", big.NewInt(1339)).
+	runManager.On("ResumeAllPendingNextBlock", store.Config.ChainID(), big.NewInt(1339)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
@@ -61,7 +63,7 @@ func TestJobSubscriber_OnNewLongestChain(t *testing.T) {
 	})
 
 	// Make sure after dropping a head (because of congestion) that it resumes again
-	runManager.On("ResumeAllPendingNextBlock", big.NewInt(1340)).
+	runManager.On("ResumeAllPendingNextBlock", store.Config.ChainID(), big.NewInt(1340)).
 		Return(nil).
 		Once().
 		Run(func(mock.Arguments) {
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/tidwall/gjson"
+)
+
+// kafkaRetryInterval is how long a kafka initiator waits before fetching
+// again after an error, or retrying a message while the node is in
+// maintenance.
+const kafkaRetryInterval = 5 * time.Second
+
+// KafkaReader fetches the messages of a topic for a consumer group, and
+// commits the offsets of those which have been consumed.
+type KafkaReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// NewKafkaReader returns a reader of the topic of a kafka initiator. A group
+// which has not committed an offset starts from the latest message, rather
+// than running the job for each message already in the topic.
+func NewKafkaReader(config models.KafkaConfig) KafkaReader {
+	dialer := &kafka.Dialer{
+		Timeout:   10 * time.Second,
+		DualStack: true,
+	}
+	if config.TLS {
+		dialer.TLS = &tls.Config{}
+	}
+	if config.SASL != nil {
+		dialer.SASLMechanism = plain.Mechanism{
+			Username: config.SASL.Username,
+			Password: config.SASL.Password,
+		}
+	}
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     config.Brokers,
+		GroupID:     config.GroupID,
+		Topic:       config.Topic,
+		Dialer:      dialer,
+		StartOffset: kafka.LastOffset,
+	})
+}
+
+// KafkaConsumer consumes the topics of kafka initiators, creating a job run
+// for each message with the message as the run's params. A message's offset
+// is committed once its run has been created, so messages published while
+// the node is down are run when it restarts.
+type KafkaConsumer interface {
+	Start() error
+	Stop() error
+	AddJob(job models.JobSpec) error
+	RemoveJob(ID *models.ID)
+}
+
+type kafkaConsumer struct {
+	store      *store.Store
+	runManager RunManager
+	newReader  func(models.KafkaConfig) KafkaReader
+
+	subscriptions    map[string][]*kafkaSubscription
+	subscriptionsMtx sync.Mutex
+
+	utils.StartStopOnce
+}
+
+// NewKafkaConsumer returns a KafkaConsumer reading each topic with a reader
+// returned by newReader.
+func NewKafkaConsumer(store *store.Store, runManager RunManager, newReader func(models.KafkaConfig) KafkaReader) KafkaConsumer {
+	return &kafkaConsumer{
+		store:         store,
+		runManager:    runManager,
+		newReader:     newReader,
+		subscriptions: make(map[string][]*kafkaSubscription),
+	}
+}
+
+// Start begins consuming the topics of the jobs with kafka initiators.
+func (kc *kafkaConsumer) Start() error {
+	return kc.StartOnce("KafkaConsumer", func() error {
+		return kc.store.Jobs(func(j *models.JobSpec) bool {
+			logger.ErrorIf(kc.AddJob(*j))
+			return true
+		}, models.InitiatorKafka)
+	})
+}
+
+// Stop stops consuming every topic.
+func (kc *kafkaConsumer) Stop() error {
+	return kc.StopOnce("KafkaConsumer", func() error {
+		kc.subscriptionsMtx.Lock()
+		defer kc.subscriptionsMtx.Unlock()
+		for id, subscriptions := range kc.subscriptions {
+			for _, subscription := range subscriptions {
+				subscription.stop()
+			}
+			delete(kc.subscriptions, id)
+		}
+		return nil
+	})
+}
+
+// AddJob begins consuming the topic of each kafka initiator of the job.
+func (kc *kafkaConsumer) AddJob(job models.JobSpec) error {
+	initrs := job.InitiatorsFor(models.InitiatorKafka)
+	if len(initrs) == 0 {
+		return nil
+	}
+
+	kc.subscriptionsMtx.Lock()
+	defer kc.subscriptionsMtx.Unlock()
+	if _, ok := kc.subscriptions[job.ID.String()]; ok {
+		return nil
+	}
+
+	var subscriptions []*kafkaSubscription
+	for _, initr := range initrs {
+		if initr.Kafka == nil {
+			return fmt.Errorf("KafkaConsumer: job %s has no kafka config", job.ID)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		subscription := &kafkaSubscription{
+			job:        job,
+			initiator:  initr,
+			reader:     kc.newReader(*initr.Kafka),
+			runManager: kc.runManager,
+			ctx:        ctx,
+			cancel:     cancel,
+			chDone:     make(chan struct{}),
+		}
+		go subscription.run()
+		subscriptions = append(subscriptions, subscription)
+	}
+	kc.subscriptions[job.ID.String()] = subscriptions
+	return nil
+}
+
+// RemoveJob stops consuming the topics of the job.
+func (kc *kafkaConsumer) RemoveJob(ID *models.ID) {
+	kc.subscriptionsMtx.Lock()
+	subscriptions := kc.subscriptions[ID.String()]
+	delete(kc.subscriptions, ID.String())
+	kc.subscriptionsMtx.Unlock()
+
+	for _, subscription := range subscriptions {
+		subscription.stop()
+	}
+}
+
+// kafkaSubscription consumes the topic of a single kafka initiator.
+type kafkaSubscription struct {
+	job        models.JobSpec
+	initiator  models.Initiator
+	reader     KafkaReader
+	runManager RunManager
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	chDone chan struct{}
+}
+
+func (ks *kafkaSubscription) run() {
+	defer close(ks.chDone)
+	for {
+		msg, err := ks.reader.FetchMessage(ks.ctx)
+		if ks.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Errorw("KafkaConsumer: error fetching message", "job", ks.job.ID.String(), "topic", ks.initiator.Kafka.Topic, "error", err)
+			if !ks.wait() {
+				return
+			}
+			continue
+		}
+		if !ks.consume(msg) {
+			return
+		}
+	}
+}
+
+// consume creates a run for the message and commits its offset. A message
+// which fails to start a run is committed all the same, so that it does not
+// hold up those after it, except while the node is in maintenance, when it is
+// retried until maintenance ends. It returns false if the subscription was
+// stopped first.
+func (ks *kafkaSubscription) consume(msg kafka.Message) bool {
+	params, err := MessageRunParams(msg.Value)
+	if err != nil {
+		logger.Errorw("KafkaConsumer: error parsing message", "job", ks.job.ID.String(), "offset", msg.Offset, "error", err)
+	} else {
+		for {
+			_, err = ks.runManager.Create(ks.job.ID, &ks.initiator, nil, &models.RunRequest{RequestParams: params})
+			if errors.Cause(err) != ErrMaintenance {
+				break
+			}
+			if !ks.wait() {
+				return false
+			}
+		}
+		if err != nil && !ExpectedRecurringScheduleJobError(err) {
+			logger.Errorw("KafkaConsumer: error creating run", "job", ks.job.ID.String(), "offset", msg.Offset, "error", err)
+		}
+	}
+
+	if err := ks.reader.CommitMessages(ks.ctx, msg); err != nil && ks.ctx.Err() == nil {
+		logger.Errorw("KafkaConsumer: error committing message", "job", ks.job.ID.String(), "offset", msg.Offset, "error", err)
+	}
+	return ks.ctx.Err() == nil
+}
+
+// wait sleeps before retrying, returning false if the subscription was
+// stopped first.
+func (ks *kafkaSubscription) wait() bool {
+	select {
+	case <-ks.ctx.Done():
+		return false
+	case <-time.After(kafkaRetryInterval):
+		return true
+	}
+}
+
+func (ks *kafkaSubscription) stop() {
+	ks.cancel()
+	<-ks.chDone
+	logger.ErrorIf(ks.reader.Close())
+}
+
+// MessageRunParams returns the params of the run created for a message
+// consumed by a kafka or mqtt initiator: the message itself if it is a JSON
+// object, or else its value as a string under the key "value".
+func MessageRunParams(value []byte) (models.JSON, error) {
+	if gjson.ValidBytes(value) && gjson.ParseBytes(value).IsObject() {
+		return models.ParseJSON(value)
+	}
+	return models.JSON{}.Add("value", string(value))
+}
@@ -0,0 +1,124 @@
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/onsi/gomega"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeKafkaReader struct {
+	messages  chan kafka.Message
+	committed []int64
+	closed    bool
+	mtx       sync.Mutex
+}
+
+func (r *fakeKafkaReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	select {
+	case msg := <-r.messages:
+		return msg, nil
+	case <-ctx.Done():
+		return kafka.Message{}, ctx.Err()
+	}
+}
+
+func (r *fakeKafkaReader) CommitMessages(_ context.Context, msgs ...kafka.Message) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for _, msg := range msgs {
+		r.committed = append(r.committed, msg.Offset)
+	}
+	return nil
+}
+
+func (r *fakeKafkaReader) Close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.closed = true
+	return nil
+}
+
+func (r *fakeKafkaReader) Committed() []int64 {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return append([]int64(nil), r.committed...)
+}
+
+func TestKafkaConsumer_AddJob(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators[0].Type = models.InitiatorKafka
+	job.Initiators[0].Kafka = &models.KafkaConfig{
+		Brokers: []string{"localhost:9092"},
+		Topic:   "prices",
+		GroupID: "chainlink",
+	}
+
+	reader := &fakeKafkaReader{messages: make(chan kafka.Message)}
+	var config models.KafkaConfig
+	newReader := func(c models.KafkaConfig) services.KafkaReader {
+		config = c
+		return reader
+	}
+
+	created := make(chan models.JSON, 2)
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			created <- args.Get(3).(*models.RunRequest).RequestParams
+		}).
+		Twice()
+
+	consumer := services.NewKafkaConsumer(store, runManager, newReader)
+	require.NoError(t, consumer.AddJob(job))
+	assert.Equal(t, "prices", config.Topic)
+
+	reader.messages <- kafka.Message{Offset: 1, Value: []byte(`{"symbol": "ETH"}`)}
+	reader.messages <- kafka.Message{Offset: 2, Value: []byte(`100.5`)}
+
+	assert.Equal(t, "ETH", (<-created).Get("symbol").String())
+	assert.Equal(t, "100.5", (<-created).Get("value").String())
+	gomega.NewGomegaWithT(t).Eventually(reader.Committed).Should(gomega.Equal([]int64{1, 2}))
+
+	consumer.RemoveJob(job.ID)
+	assert.True(t, reader.closed)
+
+	select {
+	case reader.messages <- kafka.Message{Offset: 3}:
+		t.Fatal("removed job is still consuming")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	runManager.AssertExpectations(t)
+}
+
+func TestMessageRunParams(t *testing.T) {
+	t.Parallel()
+
+	params, err := services.MessageRunParams([]byte(`{"symbol": "ETH", "amount": 2}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"symbol": "ETH", "amount": 2}`, params.String())
+
+	params, err = services.MessageRunParams([]byte(`[1, 2]`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value": "[1, 2]"}`, params.String())
+
+	params, err = services.MessageRunParams([]byte(`not json`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value": "not json"}`, params.String())
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/pkg/errors"
+)
+
+// mqttRetryInterval is how long an mqtt initiator waits before retrying a
+// message while the node is in maintenance.
+const mqttRetryInterval = 5 * time.Second
+
+// MQTTClient is a connection to an MQTT broker subscribed to the topic of a
+// single mqtt initiator.
+type MQTTClient interface {
+	// Subscribe connects to the broker and calls handler with the payload of
+	// each message published to the topic, one at a time. A message is
+	// acknowledged once handler returns.
+	Subscribe(handler func(payload []byte))
+	Close()
+}
+
+type mqttClient struct {
+	config   models.MQTTConfig
+	clientID string
+	client   mqtt.Client
+}
+
+// NewMQTTClient returns a client of the broker of an mqtt initiator. It keeps
+// retrying the first connection and reconnects whenever the connection is
+// lost, subscribing again each time it connects.
+func NewMQTTClient(config models.MQTTConfig, clientID string) MQTTClient {
+	return &mqttClient{config: config, clientID: clientID}
+}
+
+func (mc *mqttClient) Subscribe(handler func(payload []byte)) {
+	config := mc.config
+	onMessage := func(_ mqtt.Client, msg mqtt.Message) {
+		handler(msg.Payload())
+	}
+	opts := mqtt.NewClientOptions().
+		AddBroker(config.Broker).
+		SetClientID(mc.clientID).
+		SetUsername(config.Username).
+		SetPassword(config.Password).
+		SetCleanSession(config.CleanSession).
+		SetOrderMatters(true).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(10 * time.Second).
+		SetOnConnectHandler(func(client mqtt.Client) {
+			logger.Infow("MQTTSubscriber: connected to broker", "broker", config.Broker, "topic", config.Topic)
+			token := client.Subscribe(config.Topic, config.QoS, onMessage)
+			if token.Wait() && token.Error() != nil {
+				logger.Errorw("MQTTSubscriber: error subscribing to topic", "broker", config.Broker, "topic", config.Topic, "error", token.Error())
+			}
+		}).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			logger.Warnw("MQTTSubscriber: lost connection to broker, reconnecting", "broker", config.Broker, "topic", config.Topic, "error", err)
+		})
+	if config.MaxReconnectInterval != nil {
+		opts.SetMaxReconnectInterval(config.MaxReconnectInterval.Duration())
+	}
+
+	mc.client = mqtt.NewClient(opts)
+	token := mc.client.Connect()
+	go func() {
+		if token.Wait() && token.Error() != nil {
+			logger.Errorw("MQTTSubscriber: error connecting to broker", "broker", config.Broker, "topic", config.Topic, "error", token.Error())
+		}
+	}()
+}
+
+func (mc *mqttClient) Close() {
+	if mc.client != nil {
+		mc.client.Disconnect(250)
+	}
+}
+
+// MQTTSubscriber subscribes to the topics of mqtt initiators, creating a job
+// run for each message published with the message as the run's params.
+type MQTTSubscriber interface {
+	Start() error
+	Stop() error
+	AddJob(job models.JobSpec) error
+	RemoveJob(ID *models.ID)
+}
+
+type mqttSubscriber struct {
+	store      *store.Store
+	runManager RunManager
+	newClient  func(config models.MQTTConfig, clientID string) MQTTClient
+
+	subscriptions    map[string][]*mqttSubscription
+	subscriptionsMtx sync.Mutex
+
+	utils.StartStopOnce
+}
+
+// NewMQTTSubscriber returns an MQTTSubscriber connecting to each broker with
+// a client returned by newClient.
+func NewMQTTSubscriber(store *store.Store, runManager RunManager, newClient func(models.MQTTConfig, string) MQTTClient) MQTTSubscriber {
+	return &mqttSubscriber{
+		store:         store,
+		runManager:    runManager,
+		newClient:     newClient,
+		subscriptions: make(map[string][]*mqttSubscription),
+	}
+}
+
+// Start subscribes to the topics of the jobs with mqtt initiators.
+func (ms *mqttSubscriber) Start() error {
+	return ms.StartOnce("MQTTSubscriber", func() error {
+		return ms.store.Jobs(func(j *models.JobSpec) bool {
+			logger.ErrorIf(ms.AddJob(*j))
+			return true
+		}, models.InitiatorMQTT)
+	})
+}
+
+// Stop disconnects from every broker.
+func (ms *mqttSubscriber) Stop() error {
+	return ms.StopOnce("MQTTSubscriber", func() error {
+		ms.subscriptionsMtx.Lock()
+		defer ms.subscriptionsMtx.Unlock()
+		for id, subscriptions := range ms.subscriptions {
+			for _, subscription := range subscriptions {
+				subscription.stop()
+			}
+			delete(ms.subscriptions, id)
+		}
+		return nil
+	})
+}
+
+// AddJob subscribes to the topic of each mqtt initiator of the job.
+func (ms *mqttSubscriber) AddJob(job models.JobSpec) error {
+	initrs := job.InitiatorsFor(models.InitiatorMQTT)
+	if len(initrs) == 0 {
+		return nil
+	}
+
+	ms.subscriptionsMtx.Lock()
+	defer ms.subscriptionsMtx.Unlock()
+	if _, ok := ms.subscriptions[job.ID.String()]; ok {
+		return nil
+	}
+
+	var subscriptions []*mqttSubscription
+	for _, initr := range initrs {
+		if initr.MQTT == nil {
+			return fmt.Errorf("MQTTSubscriber: job %s has no mqtt config", job.ID)
+		}
+		subscription := &mqttSubscription{
+			job:        job,
+			initiator:  initr,
+			client:     ms.newClient(*initr.MQTT, MQTTClientID(job, initr)),
+			runManager: ms.runManager,
+			chStop:     make(chan struct{}),
+		}
+		subscription.client.Subscribe(subscription.handle)
+		subscriptions = append(subscriptions, subscription)
+	}
+	ms.subscriptions[job.ID.String()] = subscriptions
+	return nil
+}
+
+// RemoveJob unsubscribes from the topics of the job.
+func (ms *mqttSubscriber) RemoveJob(ID *models.ID) {
+	ms.subscriptionsMtx.Lock()
+	subscriptions := ms.subscriptions[ID.String()]
+	delete(ms.subscriptions, ID.String())
+	ms.subscriptionsMtx.Unlock()
+
+	for _, subscription := range subscriptions {
+		subscription.stop()
+	}
+}
+
+// MQTTClientID returns the client ID of an mqtt initiator: the one it is
+// configured with, or else one made from the IDs of the job and initiator.
+// Brokers disconnect a client when another connects with the same ID, so
+// jobs must not share one.
+func MQTTClientID(job models.JobSpec, initr models.Initiator) string {
+	if initr.MQTT != nil && initr.MQTT.ClientID != "" {
+		return initr.MQTT.ClientID
+	}
+	return fmt.Sprintf("chainlink-%s-%d", job.ID, initr.ID)
+}
+
+// mqttSubscription is the subscription of a single mqtt initiator.
+type mqttSubscription struct {
+	job        models.JobSpec
+	initiator  models.Initiator
+	client     MQTTClient
+	runManager RunManager
+	chStop     chan struct{}
+}
+
+// handle creates a run for the message. While the node is in maintenance the
+// message is retried until maintenance ends, holding back its acknowledgement
+// and the messages after it.
+func (ms *mqttSubscription) handle(payload []byte) {
+	params, err := MessageRunParams(payload)
+	if err != nil {
+		logger.Errorw("MQTTSubscriber: error parsing message", "job", ms.job.ID.String(), "topic", ms.initiator.MQTT.Topic, "error", err)
+		return
+	}
+	for {
+		_, err = ms.runManager.Create(ms.job.ID, &ms.initiator, nil, &models.RunRequest{RequestParams: params})
+		if errors.Cause(err) != ErrMaintenance {
+			break
+		}
+		select {
+		case <-ms.chStop:
+			return
+		case <-time.After(mqttRetryInterval):
+		}
+	}
+	if err != nil && !ExpectedRecurringScheduleJobError(err) {
+		logger.Errorw("MQTTSubscriber: error creating run", "job", ms.job.ID.String(), "topic", ms.initiator.MQTT.Topic, "error", err)
+	}
+}
+
+func (ms *mqttSubscription) stop() {
+	close(ms.chStop)
+	ms.client.Close()
+}
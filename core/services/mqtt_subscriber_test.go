@@ -0,0 +1,99 @@
+package services_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMQTTClient struct {
+	handler func(payload []byte)
+	closed  bool
+	mtx     sync.Mutex
+}
+
+func (c *fakeMQTTClient) Subscribe(handler func(payload []byte)) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.handler = handler
+}
+
+func (c *fakeMQTTClient) Close() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.closed = true
+}
+
+func (c *fakeMQTTClient) Publish(payload []byte) {
+	c.mtx.Lock()
+	handler := c.handler
+	c.mtx.Unlock()
+	handler(payload)
+}
+
+func TestMQTTSubscriber_AddJob(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators[0].Type = models.InitiatorMQTT
+	job.Initiators[0].MQTT = &models.MQTTConfig{
+		Broker: "tcp://localhost:1883",
+		Topic:  "sensors/+/temperature",
+		QoS:    1,
+	}
+
+	client := &fakeMQTTClient{}
+	var config models.MQTTConfig
+	var clientID string
+	newClient := func(c models.MQTTConfig, id string) services.MQTTClient {
+		config, clientID = c, id
+		return client
+	}
+
+	var created []models.JSON
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", job.ID, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			created = append(created, args.Get(3).(*models.RunRequest).RequestParams)
+		}).
+		Twice()
+
+	subscriber := services.NewMQTTSubscriber(store, runManager, newClient)
+	require.NoError(t, subscriber.AddJob(job))
+	assert.Equal(t, "sensors/+/temperature", config.Topic)
+	assert.Equal(t, services.MQTTClientID(job, job.Initiators[0]), clientID)
+
+	client.Publish([]byte(`{"celsius": 21.5}`))
+	client.Publish([]byte(`21.5`))
+	require.Len(t, created, 2)
+	assert.Equal(t, "21.5", created[0].Get("celsius").String())
+	assert.Equal(t, "21.5", created[1].Get("value").String())
+
+	subscriber.RemoveJob(job.ID)
+	assert.True(t, client.closed)
+
+	runManager.AssertExpectations(t)
+}
+
+func TestMQTTClientID(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	initr := job.Initiators[0]
+	initr.ID = 7
+	initr.MQTT = &models.MQTTConfig{}
+	assert.Equal(t, "chainlink-"+job.ID.String()+"-7", services.MQTTClientID(job, initr))
+
+	initr.MQTT.ClientID = "weather-station"
+	assert.Equal(t, "weather-station", services.MQTTClientID(job, initr))
+}
@@ -32,20 +32,22 @@ func RegisterJobType(
 	pipelineRunner pipeline.Runner,
 	ethClient eth.Client,
 	logBroadcaster eth.LogBroadcaster,
+	monitoringEndpoint ocrtypes.MonitoringEndpoint,
 ) {
 	jobSpawner.RegisterDelegate(
-		NewJobSpawnerDelegate(db, jobORM, config, keyStore, pipelineRunner, ethClient, logBroadcaster),
+		NewJobSpawnerDelegate(db, jobORM, config, keyStore, pipelineRunner, ethClient, logBroadcaster, monitoringEndpoint),
 	)
 }
 
 type jobSpawnerDelegate struct {
-	db             *gorm.DB
-	jobORM         job.ORM
-	config         *orm.Config
-	keyStore       *KeyStore
-	pipelineRunner pipeline.Runner
-	ethClient      eth.Client
-	logBroadcaster eth.LogBroadcaster
+	db                 *gorm.DB
+	jobORM             job.ORM
+	config             *orm.Config
+	keyStore           *KeyStore
+	pipelineRunner     pipeline.Runner
+	ethClient          eth.Client
+	logBroadcaster     eth.LogBroadcaster
+	monitoringEndpoint ocrtypes.MonitoringEndpoint
 }
 
 func NewJobSpawnerDelegate(
@@ -56,8 +58,9 @@ func NewJobSpawnerDelegate(
 	pipelineRunner pipeline.Runner,
 	ethClient eth.Client,
 	logBroadcaster eth.LogBroadcaster,
+	monitoringEndpoint ocrtypes.MonitoringEndpoint,
 ) *jobSpawnerDelegate {
-	return &jobSpawnerDelegate{db, jobORM, config, keyStore, pipelineRunner, ethClient, logBroadcaster}
+	return &jobSpawnerDelegate{db, jobORM, config, keyStore, pipelineRunner, ethClient, logBroadcaster, monitoringEndpoint}
 }
 
 func (d jobSpawnerDelegate) JobType() job.Type {
@@ -198,7 +201,7 @@ func (d jobSpawnerDelegate) ServicesForSpec(spec job.Spec) ([]job.Service, error
 			ContractConfigTracker:        ocrContract,
 			PrivateKeys:                  &ocrkey,
 			BinaryNetworkEndpointFactory: peer,
-			MonitoringEndpoint:           ocrtypes.MonitoringEndpoint(nil),
+			MonitoringEndpoint:           d.monitoringEndpoint,
 			Logger:                       ocrLogger,
 			Bootstrappers:                concreteSpec.P2PBootstrapPeers,
 		})
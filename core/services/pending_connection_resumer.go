@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type pendingConnectionResumer struct {
+	runManager RunManager
+	chainID    *big.Int
+}
+
+// NewPendingConnectionResumer returns a HeadTrackable which resumes the runs
+// on the given chain waiting for a connection whenever the chain's head
+// tracker connects.
+func NewPendingConnectionResumer(runManager RunManager, chainID *big.Int) store.HeadTrackable {
+	return &pendingConnectionResumer{runManager: runManager, chainID: chainID}
+}
+
+func (p *pendingConnectionResumer) Connect(head *models.Head) error {
+	return p.runManager.ResumeAllPendingConnection(p.chainID)
+}
+
+func (p *pendingConnectionResumer) Disconnect()                                    {}
+func (p *pendingConnectionResumer) OnNewLongestChain(context.Context, models.Head) {}
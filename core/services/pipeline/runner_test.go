@@ -320,6 +320,7 @@ func TestRunner(t *testing.T) {
 			keyStore,
 			nil,
 			nil,
+			nil,
 			nil)
 		service, err := sd.ServicesForSpec(sd.FromDBRow(jb))
 		require.NoError(t, err)
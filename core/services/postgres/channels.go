@@ -7,4 +7,6 @@ const (
 
 	// Postgres channel to listen for new eth_txes
 	ChannelInsertOnEthTx = "insert_on_eth_txes"
+	// Postgres channel to listen for eth_txes being created or changing state
+	ChannelEthTxStateChanged = "eth_tx_state_changed"
 )
@@ -1,20 +1,28 @@
 package services
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -24,28 +32,84 @@ var (
 	},
 		[]string{"job_spec_id", "task_type", "status"},
 	)
+	promAdapterPerformDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "adapter_perform_duration_seconds",
+		Help:    "How long adapters take to perform, by task type and bridge",
+		Buckets: []float64{.005, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	},
+		[]string{"task_type", "bridge"},
+	)
+	promAdapterPerformsVec = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "adapter_perform_total",
+		Help: "The total number of times adapters have performed, by task type, bridge and whether they succeeded or errored",
+	},
+		[]string{"task_type", "bridge", "result"},
+	)
+	promAdapterBytesTransferred = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "adapter_perform_bytes_transferred",
+		Help:    "The size of the requests adapters send and responses they receive, by task type and bridge",
+		Buckets: prometheus.ExponentialBuckets(64, 4, 9),
+	},
+		[]string{"task_type", "bridge"},
+	)
+	promAdapterPerformsAbandoned = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "adapter_performs_abandoned",
+		Help: "The number of adapters which timed out and are still performing in the background",
+	})
 )
 
+// maxAbandonedPerforms is how many adapters which timed out may still be
+// performing in the background before tasks with a timeout are errored
+// without being performed.
+const maxAbandonedPerforms = 100
+
+// abandonedPerforms counts the adapters which timed out and have not yet
+// returned.
+var abandonedPerforms int32
+
 //go:generate mockery --name RunExecutor --output ../internal/mocks/ --case=underscore
 
 // RunExecutor handles the actual running of the job tasks
 type RunExecutor interface {
 	Execute(*models.ID) error
+	// OnRunFinished sets a function to be called with each run the executor
+	// finishes, as when a run's completion lets another run of its job start.
+	OnRunFinished(func(*models.JobRun))
+	// OnRetryScheduled sets a function to be called with each run left waiting
+	// for one of its tasks to be retried, and the time the retry is due, so
+	// that the run can be executed again then.
+	OnRetryScheduled(func(run *models.JobRun, retryAt time.Time))
 }
 
 type runExecutor struct {
 	store       *store.Store
 	statsPusher synchronization.StatsPusher
+	chainSet    ChainSet
+	onFinished  func(*models.JobRun)
+	onRetry     func(*models.JobRun, time.Time)
 }
 
 // NewRunExecutor initializes a RunExecutor.
-func NewRunExecutor(store *store.Store, statsPusher synchronization.StatsPusher) RunExecutor {
+func NewRunExecutor(store *store.Store, statsPusher synchronization.StatsPusher, chainSet ChainSet) RunExecutor {
 	return &runExecutor{
 		store:       store,
 		statsPusher: statsPusher,
+		chainSet:    chainSet,
 	}
 }
 
+// OnRunFinished sets the function called with each run the executor
+// finishes. It must be set before any runs are executed.
+func (re *runExecutor) OnRunFinished(fn func(*models.JobRun)) {
+	re.onFinished = fn
+}
+
+// OnRetryScheduled sets the function called with each run left waiting for a
+// task to be retried. It must be set before any runs are executed.
+func (re *runExecutor) OnRetryScheduled(fn func(run *models.JobRun, retryAt time.Time)) {
+	re.onRetry = fn
+}
+
 // Execute performs the work associate with a job run
 func (re *runExecutor) Execute(runID *models.ID) error {
 	logger.Debugw("runExecutor woke up", "runID", runID.String())
@@ -54,9 +118,13 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 		return errors.Wrapf(err, "error finding run %s", runID)
 	}
 
+	alreadyFinished := run.GetStatus().Finished()
 	validated := false
-	for taskIndex := range run.TaskRuns {
+	for taskIndex := 0; taskIndex < len(run.TaskRuns); taskIndex++ {
 		taskRun := &run.TaskRuns[taskIndex]
+		retrying := false
+		sleeping := false
+		concurrent := false
 		if !run.GetStatus().Runnable() {
 			logger.Debugw("Run execution blocked", run.ForLogger("task", taskRun.ID.String())...)
 			break
@@ -66,7 +134,16 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 			continue
 		}
 
-		if !meetsMinRequiredIncomingConfirmations(&run, taskRun, run.ObservedHeight) {
+		if run.Expired(time.Now()) {
+			logger.Warnw("Run exceeded its deadline", run.ForLogger("deadline", run.Deadline.Time)...)
+			run.Expire()
+
+		} else if err := re.checkExecutionTime(&run); err != nil {
+			logger.Warnw("Run exceeded its execution time budget", run.ForLogger("error", err)...)
+			taskRun.SetError(err)
+			run.SetError(err)
+
+		} else if !meetsMinRequiredIncomingConfirmations(&run, taskRun, run.ObservedHeight) {
 			logger.Debugw("Pausing run pending incoming confirmations",
 				run.ForLogger("required_height", taskRun.MinRequiredIncomingConfirmations)...,
 			)
@@ -81,22 +158,41 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 			taskRun.SetError(err)
 			run.SetError(err)
 
-		} else {
-			start := time.Now()
-
-			// NOTE: adapters may define and return the new job run status in here
-			result := re.executeTask(&run, *taskRun)
+		} else if retryAt := taskRun.RetryAt; retryAt.Valid && retryAt.Time.After(re.store.Clock.Now()) {
+			// Rather than hold a worker through the backoff, the run is left in
+			// progress and executed again once the retry is due. The retry time
+			// is persisted, so a run resumed after a restart still honours it.
+			logger.Debugw("Run waiting to retry task", run.ForLogger("task", taskRun.ID.String(), "retryAt", retryAt.Time)...)
+			if re.onRetry != nil {
+				re.onRetry(&run, retryAt.Time)
+			}
+			return nil
 
+		} else if taskRun.SleepUntil.Valid {
+			re.waitForSleep(taskRun)
+			result := models.NewRunOutputComplete(taskRun.Result.Data)
 			taskRun.ApplyOutput(result)
 			run.ApplyOutput(result)
 
-			elapsed := time.Since(start).Seconds()
+		} else if batch := concurrentBatch(&run, taskIndex, re.store.Clock.Now()); len(batch) > 1 {
+			re.executeConcurrently(&run, batch)
+			concurrent = true
 
-			logger.Debugw(fmt.Sprintf("Executed task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String(), "elapsed", elapsed)...)
+		} else {
+			start := time.Now()
+			span := startPerformSpan(&run, taskRun)
+
+			// NOTE: adapters may define and return the new job run status in here
+			result := re.executeTask(&run, taskRun)
+			retrying, sleeping = re.applyResult(&run, taskIndex, span, start, time.Now(), result)
 		}
 
 		validated = true
 
+		if run.GetStatus().Errored() {
+			run.DeadLetter(re.store.Clock.Now())
+		}
+
 		if err := re.store.ORM.SaveJobRun(&run); errors.Cause(err) == orm.ErrOptimisticUpdateConflict {
 			logger.Debugw("Optimistic update conflict while updating run", run.ForLogger()...)
 			return nil
@@ -105,6 +201,10 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 		}
 
 		re.statsPusher.PushNow()
+
+		if retrying || sleeping || concurrent {
+			taskIndex--
+		}
 	}
 
 	if run.GetStatus().Finished() {
@@ -114,9 +214,147 @@ func (re *runExecutor) Execute(runID *models.ID) error {
 			logger.Debugw("All tasks complete for run", run.ForLogger()...)
 		}
 	}
+	if !alreadyFinished && run.GetStatus().Completed() {
+		store.PromAddLinkEarned(run.JobSpecID, run.Payment)
+	}
+	if !alreadyFinished && run.GetStatus().Finished() {
+		tracing.RecordRun(&run)
+		if re.onFinished != nil {
+			re.onFinished(&run)
+		}
+	}
 	return nil
 }
 
+// applyResult records the outcome of performing a task, scheduling it to be
+// retried or woken up if that is what the outcome calls for.
+func (re *runExecutor) applyResult(run *models.JobRun, taskIndex int, span trace.Span, start, end time.Time, result models.RunOutput) (retrying, sleeping bool) {
+	taskRun := &run.TaskRuns[taskIndex]
+	taskRun.RecordAttempt(start, end, result.BytesTransferred())
+	endPerformSpan(span, taskRun, result)
+
+	if retryPolicy := taskRun.TaskSpec.Retry; result.HasError() && retryPolicy != nil && replaySafe(taskRun.TaskSpec) && retryPolicy.ShouldRetry(taskRun.Attempts, result.Error()) {
+		backoff := retryPolicy.BackoffFor(taskRun.Attempts)
+		logger.Warnw(fmt.Sprintf("Task %s failed, retrying", taskRun.TaskSpec.Type),
+			run.ForLogger("task", taskRun.ID.String(), "attempts", taskRun.Attempts, "backoff", backoff, "error", result.Error())...,
+		)
+		taskRun.ScheduleRetry(result.Error(), re.store.Clock.Now().Add(backoff))
+		retrying = true
+	} else if result.Status().PendingSleep() {
+		logger.Debugw("Task sleeping", run.ForLogger("task", taskRun.ID.String(), "until", result.SleepUntil())...)
+		taskRun.Sleep(result.Data(), result.SleepUntil())
+		sleeping = true
+	} else {
+		if skip := result.SkipTasks(); skip != 0 && !result.HasError() {
+			run.SkipTasks(taskIndex+1, skip, result.Data())
+		}
+		taskRun.ApplyOutput(result)
+		run.ApplyOutput(result)
+	}
+
+	elapsed := time.Since(start).Seconds()
+
+	logger.Debugw(fmt.Sprintf("Executed task %s", taskRun.TaskSpec.Type), run.ForLogger("task", taskRun.ID.String(), "elapsed", elapsed)...)
+	return retrying, sleeping
+}
+
+// concurrentBatch returns the indexes of the consecutive tasks, starting at
+// taskIndex, which can be performed at once. These are tasks which declare
+// their inputs, all of which have completed, and which are safe to perform
+// again if the node stops while they are in flight, so that bridges and
+// other tasks with side effects are still performed one at a time. A task
+// waiting to be retried ends the batch.
+func concurrentBatch(run *models.JobRun, taskIndex int, now time.Time) []int {
+	outputs := run.TaskOutputs()
+	var batch []int
+	for i := taskIndex; i < len(run.TaskRuns); i++ {
+		taskRun := &run.TaskRuns[i]
+		if taskRun.Status.Completed() {
+			continue
+		}
+		if taskRun.TaskSpec.Inputs == nil || taskRun.SleepUntil.Valid ||
+			(taskRun.RetryAt.Valid && taskRun.RetryAt.Time.After(now)) ||
+			!meetsMinRequiredIncomingConfirmations(run, taskRun, run.ObservedHeight) {
+			break
+		}
+		if !replaySafe(taskRun.TaskSpec) {
+			break
+		}
+		ready := true
+		for _, name := range *taskRun.TaskSpec.Inputs {
+			if _, ok := outputs[name]; !ok {
+				ready = false
+			}
+		}
+		if !ready {
+			break
+		}
+		batch = append(batch, i)
+	}
+	return batch
+}
+
+// replaySafe returns true if the task can be performed again without
+// repeating side effects outside the node. Only such tasks are retried or
+// performed concurrently; bridges never are.
+func replaySafe(taskSpec models.TaskSpec) bool {
+	adapter := adapters.FindNativeAdapterFor(taskSpec)
+	return adapter != nil && adapters.ReplaySafe(adapter)
+}
+
+// executeConcurrently performs the batch of tasks at once, so that
+// independent fetches from several providers take as long as the slowest of
+// them. The results are applied in the order of the tasks once all of them
+// have returned, up to the first which errors the run or leaves it pending.
+func (re *runExecutor) executeConcurrently(run *models.JobRun, batch []int) {
+	type outcome struct {
+		span       trace.Span
+		start, end time.Time
+		result     models.RunOutput
+	}
+	outcomes := make([]outcome, len(batch))
+
+	var wg sync.WaitGroup
+	wg.Add(len(batch))
+	for i, taskIndex := range batch {
+		go func(i int, taskRun *models.TaskRun) {
+			defer wg.Done()
+			start := time.Now()
+			span := startPerformSpan(run, taskRun)
+			result := re.executeTask(run, taskRun)
+			outcomes[i] = outcome{span, start, time.Now(), result}
+		}(i, &run.TaskRuns[taskIndex])
+	}
+	wg.Wait()
+
+	for i, taskIndex := range batch {
+		o := outcomes[i]
+		if !run.GetStatus().Runnable() {
+			o.span.End()
+			continue
+		}
+		re.applyResult(run, taskIndex, o.span, o.start, o.end, o.result)
+	}
+}
+
+func startPerformSpan(run *models.JobRun, taskRun *models.TaskRun) trace.Span {
+	_, span := tracing.Tracer().Start(tracing.TaskContext(context.Background(), run.ID, taskRun.ID), "task_run.perform")
+	return span
+}
+
+func endPerformSpan(span trace.Span, taskRun *models.TaskRun, result models.RunOutput) {
+	span.SetAttributes(
+		attribute.String("task.type", string(taskRun.TaskSpec.Type)),
+		attribute.Int64("task.attempt", int64(taskRun.Attempts)),
+		attribute.Int64("task.bytes_transferred", result.BytesTransferred()),
+		attribute.String("task.status", string(result.Status())),
+	)
+	if result.HasError() {
+		span.SetStatus(codes.Error, result.Error().Error())
+	}
+	span.End()
+}
+
 func validateOnMainChainOnce(validated bool, run *models.JobRun, taskRun *models.TaskRun, ethClient eth.Client) error {
 	if validated {
 		return nil
@@ -124,24 +362,43 @@ func validateOnMainChainOnce(validated bool, run *models.JobRun, taskRun *models
 	return validateOnMainChain(run, taskRun, ethClient)
 }
 
-func (re *runExecutor) executeTask(run *models.JobRun, taskRun models.TaskRun) models.RunOutput {
-	taskSpec := taskRun.TaskSpec
-
-	params, err := models.Merge(run.RunRequest.RequestParams, taskSpec.Params)
+// interpolateTemplates resolves the templates in the params of the job's task.
+// This is done before the params the run was requested with are merged in, so
+// that requesters cannot add templates of their own.
+func (re *runExecutor) interpolateTemplates(run *models.JobRun, params models.JSON, data models.JSON) (models.JSON, error) {
+	if !models.HasTemplates(params) {
+		return params, nil
+	}
+	job, err := re.store.ORM.FindJob(run.JobSpecID)
 	if err != nil {
-		return models.NewRunOutputError(err)
+		return models.JSON{}, errors.Wrap(err, "failed to load job for templates")
 	}
-	taskSpec.Params = params
-
-	adapter, err := adapters.For(taskSpec, re.store.Config, re.store.ORM)
+	templateContext, err := models.NewTemplateContext(job, *run, data)
 	if err != nil {
-		return models.NewRunOutputError(err)
+		return models.JSON{}, err
+	}
+	return models.InterpolateTemplates(params, templateContext)
+}
+
+// waitForSleep blocks until a sleeping task wakes up, which may already have
+// passed if the run was resumed after a restart.
+func (re *runExecutor) waitForSleep(taskRun *models.TaskRun) {
+	if duration := taskRun.SleepUntil.Time.Sub(re.store.Clock.Now()); duration > 0 {
+		<-re.store.Clock.After(duration)
 	}
+}
 
-	previousTaskRun := run.PreviousTaskRun()
+func (re *runExecutor) executeTask(run *models.JobRun, taskRun *models.TaskRun) models.RunOutput {
+	taskSpec := taskRun.TaskSpec
 
 	previousTaskInput := models.JSON{}
-	if previousTaskRun != nil {
+	if taskSpec.Inputs != nil {
+		input, err := run.InputData(*taskSpec.Inputs)
+		if err != nil {
+			return models.NewRunOutputError(err)
+		}
+		previousTaskInput = input
+	} else if previousTaskRun := run.PreviousTaskRun(); previousTaskRun != nil {
 		previousTaskInput = previousTaskRun.Result.Data
 	}
 
@@ -150,9 +407,174 @@ func (re *runExecutor) executeTask(run *models.JobRun, taskRun models.TaskRun) m
 		return models.NewRunOutputError(err)
 	}
 
+	// Variables are only resolved in the job's own params, so that a requester
+	// cannot read the output of other tasks through the request params.
+	params, err := models.InterpolateTaskVariables(taskSpec.Params, run.TaskOutputs())
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	params, err = re.interpolateTemplates(run, params, data)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	params, err = models.Merge(run.RunRequest.RequestParams, params)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	taskSpec.Params = params
+
+	chainStore, err := re.storeForChain(run)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+	adapter, err := adapters.For(taskSpec, chainStore.Config, chainStore.ORM)
+	if err != nil {
+		return models.NewRunOutputError(err)
+	}
+
+	if !adapters.ReplaySafe(adapter.BaseAdapter) {
+		if taskRun.Interrupted() {
+			return models.NewRunOutputError(fmt.Errorf(
+				"task %s was interrupted at %s and has not been performed again, as it may have side effects outside the node",
+				taskSpec.Type, utils.ISO8601UTC(taskRun.CheckpointedAt.Time)))
+		}
+		taskRun.Checkpoint(re.store.Clock.Now())
+		if err := re.store.ORM.SaveJobRun(run); err != nil {
+			return models.NewRunOutputError(errors.Wrap(err, "failed to checkpoint task run"))
+		}
+	}
+
+	timeout := time.Duration(0)
+	if taskSpec.Timeout != nil {
+		timeout = taskSpec.Timeout.Duration()
+	}
+	if budget := re.store.Config.JobRunMaxExecutionTime(); budget > 0 {
+		if remaining := budget - run.ExecutionTime(); timeout == 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+
 	input := *models.NewRunInput(run.ID, *taskRun.ID, data, taskRun.Status)
-	result := adapter.Perform(input, re.store)
+	if budget := re.store.Config.JobRunMaxDataSize(); budget > 0 {
+		remaining := budget - (run.DataSize() - int64(len(taskRun.Result.Data.Raw)))
+		if remaining <= 0 {
+			return models.NewRunOutputError(fmt.Errorf("run's data has used up its budget of %d bytes", budget))
+		}
+		input = input.WithDataLimit(remaining)
+	}
+	start := time.Now()
+	result := performWithTimeout(adapter, input, chainStore, timeout)
+	observeAdapterPerform(adapter.BaseAdapter, result, time.Since(start))
 	promAdapterCallsVec.WithLabelValues(run.JobSpecID.String(), string(adapter.TaskType()), string(result.Status())).Inc()
 
+	return re.checkDataSize(run, taskRun, result)
+}
+
+// observeAdapterPerform records the duration, result and bytes transferred of
+// an adapter's perform. Bridges are labelled with their name, so that a slow or
+// flaky external adapter can be told apart from the rest.
+func observeAdapterPerform(adapter adapters.BaseAdapter, result models.RunOutput, elapsed time.Duration) {
+	taskType := adapter.TaskType().String()
+	bridge := ""
+	if b, ok := adapter.(*adapters.Bridge); ok {
+		bridge = b.Name.String()
+	}
+
+	promAdapterPerformDuration.WithLabelValues(taskType, bridge).Observe(elapsed.Seconds())
+	outcome := "success"
+	if result.HasError() {
+		outcome = "error"
+	}
+	promAdapterPerformsVec.WithLabelValues(taskType, bridge, outcome).Inc()
+	if bytes := result.BytesTransferred(); bytes > 0 {
+		promAdapterBytesTransferred.WithLabelValues(taskType, bridge).Observe(float64(bytes))
+	}
+}
+
+// storeForChain returns the store through which the run's tasks are
+// performed, which is that of the chain its job is bound to.
+func (re *runExecutor) storeForChain(run *models.JobRun) (*store.Store, error) {
+	if run.ChainID == nil || run.ChainID.ToInt().Cmp(re.store.Config.ChainID()) == 0 {
+		return re.store, nil
+	}
+	chain, err := re.chainSet.Get(run.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return chain.Store, nil
+}
+
+// checkExecutionTime returns an error once the run's tasks have spent the
+// node's execution time budget for a single run.
+func (re *runExecutor) checkExecutionTime(run *models.JobRun) error {
+	budget := re.store.Config.JobRunMaxExecutionTime()
+	if budget <= 0 {
+		return nil
+	}
+	if spent := run.ExecutionTime(); spent >= budget {
+		return fmt.Errorf("run has spent %s performing tasks, exhausting its execution time budget of %s", spent, budget)
+	}
+	return nil
+}
+
+// checkDataSize errors the task instead of storing its output, if the output
+// would take the run over the node's data size budget for a single run.
+func (re *runExecutor) checkDataSize(run *models.JobRun, taskRun *models.TaskRun, result models.RunOutput) models.RunOutput {
+	budget := re.store.Config.JobRunMaxDataSize()
+	if budget <= 0 || result.HasError() {
+		return result
+	}
+	size := run.DataSize() - int64(len(taskRun.Result.Data.Raw)) + int64(len(result.Data().Raw))
+	if size > budget {
+		err := fmt.Errorf("output of task %s would bring the run's data to %d bytes, exceeding its budget of %d bytes", taskRun.TaskSpec.Type, size, budget)
+		return models.NewRunOutputError(err).WithBytesTransferred(result.BytesTransferred())
+	}
 	return result
 }
+
+// performWithTimeout performs the adapter, erroring the task if it has not
+// returned by the time the optional timeout elapses.
+//
+// Adapters cannot be cancelled, so one which times out is left performing in
+// the background until it returns, relying on its own limits such as the HTTP
+// client timeout. So that a hung adapter cannot pile up goroutines without
+// bound, tasks with a timeout are errored without being performed while
+// maxAbandonedPerforms are outstanding.
+func performWithTimeout(adapter adapters.BaseAdapter, input models.RunInput, store *store.Store, timeout time.Duration) models.RunOutput {
+	if timeout <= 0 {
+		return adapter.Perform(input, store)
+	}
+	if n := atomic.LoadInt32(&abandonedPerforms); n >= maxAbandonedPerforms {
+		return models.NewRunOutputError(fmt.Errorf("task %s not performed, as %d tasks which timed out are still performing", adapter.TaskType(), n))
+	}
+
+	var mu sync.Mutex
+	var finished, abandoned bool
+	chResult := make(chan models.RunOutput, 1)
+	go func() {
+		result := adapter.Perform(input, store)
+		mu.Lock()
+		defer mu.Unlock()
+		finished = true
+		if abandoned {
+			atomic.AddInt32(&abandonedPerforms, -1)
+			promAdapterPerformsAbandoned.Dec()
+		}
+		chResult <- result
+	}()
+
+	select {
+	case result := <-chResult:
+		return result
+	case <-time.After(timeout):
+		mu.Lock()
+		defer mu.Unlock()
+		if finished {
+			return <-chResult
+		}
+		abandoned = true
+		atomic.AddInt32(&abandonedPerforms, 1)
+		promAdapterPerformsAbandoned.Inc()
+		return models.NewRunOutputError(fmt.Errorf("task %s timed out after %s", adapter.TaskType(), timeout))
+	}
+}
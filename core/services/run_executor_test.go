@@ -2,8 +2,12 @@ package services_test
 
 import (
 	"fmt"
+	"io"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -29,7 +34,7 @@ func TestRunExecutor_Execute(t *testing.T) {
 	pusher := new(mocks.StatsPusher)
 	pusher.On("PushNow").Return(nil)
 
-	runExecutor := services.NewRunExecutor(store, pusher)
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
 
 	j := models.NewJob()
 	i := models.Initiator{Type: models.InitiatorWeb}
@@ -57,6 +62,39 @@ func TestRunExecutor_Execute(t *testing.T) {
 	assert.Equal(t, assets.NewLink(9117), actual)
 }
 
+func TestRunExecutor_Execute_OnRunFinished(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+	var finished []models.JobRun
+	runExecutor.OnRunFinished(func(run *models.JobRun) {
+		finished = append(finished, *run)
+	})
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop")}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+	require.Len(t, finished, 1)
+	assert.Equal(t, run.ID.String(), finished[0].ID.String())
+	assert.Equal(t, models.RunStatusCompleted, finished[0].GetStatus())
+
+	// Executing a run which had already finished does not finish it again
+	require.NoError(t, runExecutor.Execute(run.ID))
+	assert.Len(t, finished, 1)
+}
+
 func TestRunExecutor_Execute_PendingOutgoing(t *testing.T) {
 	t.Parallel()
 
@@ -66,7 +104,7 @@ func TestRunExecutor_Execute_PendingOutgoing(t *testing.T) {
 	pusher := new(mocks.StatsPusher)
 	pusher.On("PushNow").Return(nil)
 
-	runExecutor := services.NewRunExecutor(store, pusher)
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
 
 	j := models.NewJob()
 	i := models.Initiator{Type: models.InitiatorWeb}
@@ -95,6 +133,585 @@ func TestRunExecutor_Execute_PendingOutgoing(t *testing.T) {
 	assert.Nil(t, actual)
 }
 
+func TestRunExecutor_Execute_UnconfiguredChain(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop")}
+	j.ChainID = utils.NewBigI(100)
+	assert.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	run.ChainID = j.ChainID
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+	assert.Contains(t, run.Result.ErrorMessage.String, "chain 100 is not configured")
+	assert.Equal(t, big.NewInt(100), run.ChainID.ToInt())
+}
+
+func TestRunExecutor_Execute_InterruptedSideEffectingTask(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	j := models.NewJob()
+	i := models.Initiator{Type: models.InitiatorWeb}
+	j.Initiators = []models.Initiator{i}
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "noop"),
+		cltest.NewTask(t, "httppost", `{"post": "http://example.com"}`),
+		cltest.NewTask(t, "noop"),
+	}
+	assert.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	run.TaskRuns[0].ApplyOutput(models.NewRunOutputCompleteWithResult("done"))
+	run.TaskRuns[1].Checkpoint(time.Now())
+	require.NoError(t, store.CreateJobRun(&run))
+
+	err := runExecutor.Execute(run.ID)
+	require.NoError(t, err)
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+	assert.True(t, run.DeadLettered())
+	require.Len(t, run.TaskRuns, 3)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusErrored, run.TaskRuns[1].Status)
+	assert.Contains(t, run.TaskRuns[1].Result.ErrorMessage.String, "was interrupted")
+	assert.Equal(t, models.RunStatusUnstarted, run.TaskRuns[2].Status)
+}
+
+func TestRunExecutor_Execute_AdapterMetrics(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	mock, cleanup := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"data": {"result": "100"}}`)
+	defer cleanup()
+	_, bt := cltest.NewBridgeType(t, "meteredbridge", mock.URL)
+	require.NoError(t, store.CreateBridgeType(bt))
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "meteredbridge")}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	performs := services.PromAdapterPerformsVec.WithLabelValues("meteredbridge", "meteredbridge", "success")
+	assert.Equal(t, float64(1), testutil.ToFloat64(performs))
+	assert.Greater(t, testutil.CollectAndCount(services.PromAdapterPerformDuration), 0)
+	assert.Greater(t, testutil.CollectAndCount(services.PromAdapterBytesTransferred), 0)
+}
+
+func TestRunExecutor_Execute_ResultCollect(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	doubled := cltest.NewTask(t, "multiply", `{"times": 2}`)
+	doubled.Name = "doubled"
+	tripled := cltest.NewTask(t, "multiply", `{"times": 3}`)
+	tripled.Name = "tripled"
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{
+		doubled,
+		tripled,
+		cltest.NewTask(t, "resultcollect", `{"results": ["$(doubled)", "$(tripled)"]}`),
+		cltest.NewTask(t, "median"),
+	}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"result": "10"}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+	require.Len(t, run.TaskRuns, 4)
+	assert.JSONEq(t, `["20", "60"]`, run.TaskRuns[2].Result.Data.Get("result").Raw)
+	assert.Equal(t, "40", run.TaskRuns[3].Result.Data.Get("result").String())
+}
+
+func TestRunExecutor_Execute_ConcurrentTasks(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	// Each provider only answers once all three have been asked, so the run
+	// can only complete if they are fetched at the same time.
+	arrived := make(chan struct{}, 3)
+	allArrived := make(chan struct{})
+	go func() {
+		for i := 0; i < 3; i++ {
+			<-arrived
+		}
+		close(allArrived)
+	}()
+	provider := func(price string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			arrived <- struct{}{}
+			select {
+			case <-allArrived:
+				io.WriteString(w, price)
+			case <-time.After(5 * time.Second):
+				w.WriteHeader(http.StatusGatewayTimeout)
+			}
+		}))
+	}
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	for i, price := range []string{"100", "104", "102"} {
+		server := provider(price)
+		defer server.Close()
+		task := cltest.NewTask(t, "httpgetwithunrestrictednetworkaccess", fmt.Sprintf(`{"get": "%s"}`, server.URL))
+		task.Name = fmt.Sprintf("provider%d", i)
+		task.Inputs = &models.TaskInputs{}
+		j.Tasks = append(j.Tasks, task)
+	}
+	median := cltest.NewTask(t, "median")
+	median.Inputs = &models.TaskInputs{"provider0", "provider1", "provider2"}
+	j.Tasks = append(j.Tasks, median)
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+	require.Len(t, run.TaskRuns, 4)
+	assert.Equal(t, "102", run.TaskRuns[3].Result.Data.Get("result").String())
+	assert.Equal(t, "102", run.Result.Data.Get("result").String())
+}
+
+func TestRunExecutor_Execute_Retry(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+	var retries []time.Time
+	runExecutor.OnRetryScheduled(func(run *models.JobRun, retryAt time.Time) {
+		retries = append(retries, retryAt)
+	})
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, "100")
+	}))
+	defer server.Close()
+
+	task := cltest.NewTask(t, "httpgetwithunrestrictednetworkaccess", fmt.Sprintf(`{"get": "%s"}`, server.URL))
+	task.Retry = &models.RetryPolicy{MaxAttempts: 2, Backoff: models.MustMakeDuration(time.Hour)}
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{task}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	start := time.Now()
+	require.NoError(t, runExecutor.Execute(run.ID))
+	assert.WithinDuration(t, start, time.Now(), time.Minute, "expected the executor not to wait out the backoff")
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusInProgress, run.GetStatus())
+	assert.Equal(t, uint32(1), run.TaskRuns[0].Attempts)
+	require.True(t, run.TaskRuns[0].RetryAt.Valid)
+	assert.WithinDuration(t, start.Add(time.Hour), run.TaskRuns[0].RetryAt.Time, time.Minute)
+	require.Len(t, retries, 1)
+	assert.True(t, retries[0].Equal(run.TaskRuns[0].RetryAt.Time))
+
+	// Executing the run again before the retry is due leaves it waiting
+	require.NoError(t, runExecutor.Execute(run.ID))
+	assert.Equal(t, 1, requests)
+	require.Len(t, retries, 2)
+
+	run.TaskRuns[0].RetryAt.Time = time.Now().Add(-time.Second)
+	require.NoError(t, store.SaveJobRun(&run))
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err = store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+	assert.Equal(t, uint32(2), run.TaskRuns[0].Attempts)
+	assert.Equal(t, "100", run.Result.Data.Get("result").String())
+	assert.Len(t, retries, 2)
+}
+
+func TestRunExecutor_Execute_TaskTimeout(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	release := make(chan struct{})
+	returned := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		io.WriteString(w, "100")
+		close(returned)
+	}))
+	defer server.Close()
+
+	task := cltest.NewTask(t, "httpgetwithunrestrictednetworkaccess", fmt.Sprintf(`{"get": "%s"}`, server.URL))
+	timeout := models.MustMakeDuration(100 * time.Millisecond)
+	task.Timeout = &timeout
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{task}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+	assert.Contains(t, run.TaskRuns[0].Result.ErrorMessage.String, "timed out after 100ms")
+
+	// The abandoned request is left to finish in the background
+	close(release)
+	cltest.CallbackOrTimeout(t, "abandoned request returns", func() {
+		<-returned
+	})
+}
+
+func TestRunExecutor_Execute_Templates(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "multiply", `{"times": "{{ $.jobRun.params.factor }}"}`),
+		cltest.NewTask(t, "noop", `{"label": "{{ $.jobSpec.name }}/{{ $.jobRun.data.result }}"}`),
+	}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"result": "10", "factor": 3}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+	require.Len(t, run.TaskRuns, 2)
+	assert.Equal(t, "30", run.TaskRuns[0].Result.Data.Get("result").String())
+}
+
+func TestRunExecutor_Execute_TaskVariablesInRequestParams(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	base := cltest.NewTask(t, "multiply", `{"times": 2}`)
+	base.Name = "base"
+	j := models.NewJob()
+	j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+	j.Tasks = []models.TaskSpec{base, cltest.NewTask(t, "jsontransform")}
+	require.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"result": 10, "expression": "\"$(base)\""}`)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+	require.Len(t, run.TaskRuns, 2)
+	assert.Equal(t, "20", run.TaskRuns[0].Result.Data.Get("result").String())
+	assert.Equal(t, "$(base)", run.TaskRuns[1].Result.Data.Get("result").String())
+}
+
+func TestRunExecutor_Execute_ExceedsDataSizeBudget(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set("JOB_RUN_MAX_DATA_SIZE", 64)
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	j := models.NewJob()
+	i := models.Initiator{Type: models.InitiatorWeb}
+	j.Initiators = []models.Initiator{i}
+	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop")}
+	assert.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"result": "%s"}`, strings.Repeat("a", 100))
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+	require.Len(t, run.TaskRuns, 1)
+	assert.Equal(t, models.RunStatusErrored, run.TaskRuns[0].Status)
+	assert.Contains(t, run.TaskRuns[0].Result.ErrorMessage.String, "exceeding its budget of 64 bytes")
+}
+
+func TestRunExecutor_Execute_ExceedsExecutionTimeBudget(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set("JOB_RUN_MAX_EXECUTION_TIME", "1s")
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	j := models.NewJob()
+	i := models.Initiator{Type: models.InitiatorWeb}
+	j.Initiators = []models.Initiator{i}
+	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop"), cltest.NewTask(t, "noop")}
+	assert.NoError(t, store.CreateJob(&j))
+
+	run := cltest.NewJobRun(j)
+	start := time.Now()
+	run.TaskRuns[0].RecordAttempt(start, start.Add(2*time.Second), 0)
+	run.TaskRuns[0].ApplyOutput(models.NewRunOutputCompleteWithResult("done"))
+	require.NoError(t, store.CreateJobRun(&run))
+
+	require.NoError(t, runExecutor.Execute(run.ID))
+
+	run, err := store.FindJobRun(run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+	require.Len(t, run.TaskRuns, 2)
+	assert.Equal(t, models.RunStatusCompleted, run.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusErrored, run.TaskRuns[1].Status)
+	assert.Contains(t, run.TaskRuns[1].Result.ErrorMessage.String, "execution time budget of 1s")
+}
+
+func TestRunExecutor_Execute_Condition(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	execute := func(t *testing.T, tasks ...models.TaskSpec) models.JobRun {
+		j := models.NewJob()
+		j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+		j.Tasks = tasks
+		require.NoError(t, store.CreateJob(&j))
+
+		run := cltest.NewJobRun(j)
+		run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"result": "50"}`)
+		require.NoError(t, store.CreateJobRun(&run))
+		require.NoError(t, runExecutor.Execute(run.ID))
+
+		run, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		return run
+	}
+
+	t.Run("continues when the condition holds", func(t *testing.T) {
+		run := execute(t,
+			cltest.NewTask(t, "condition", `{"operator": "lt", "value": "100"}`),
+			cltest.NewTask(t, "multiply", `{"times": 2}`),
+		)
+		assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+		assert.Equal(t, "100", run.Result.Data.Get("result").String())
+		assert.Equal(t, uint32(1), run.TaskRuns[1].Attempts)
+	})
+
+	t.Run("ends the run when the condition does not hold", func(t *testing.T) {
+		run := execute(t,
+			cltest.NewTask(t, "condition", `{"operator": "gt", "value": "100"}`),
+			cltest.NewTask(t, "multiply", `{"times": 2}`),
+			cltest.NewTask(t, "multiply", `{"times": 10}`),
+		)
+		assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+		assert.Equal(t, "50", run.Result.Data.Get("result").String())
+		for _, tr := range run.TaskRuns[1:] {
+			assert.Equal(t, models.RunStatusCompleted, tr.Status)
+			assert.Equal(t, uint32(0), tr.Attempts)
+		}
+	})
+
+	t.Run("skips tasks when the condition does not hold", func(t *testing.T) {
+		run := execute(t,
+			cltest.NewTask(t, "condition", `{"operator": "gt", "value": "100", "else": "skip"}`),
+			cltest.NewTask(t, "multiply", `{"times": 2}`),
+			cltest.NewTask(t, "multiply", `{"times": 10}`),
+		)
+		assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+		assert.Equal(t, "500", run.Result.Data.Get("result").String())
+		assert.Equal(t, uint32(0), run.TaskRuns[1].Attempts)
+		assert.Equal(t, uint32(1), run.TaskRuns[2].Attempts)
+	})
+}
+
+func TestRunExecutor_Execute_Sleep(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	clock := cltest.NewTriggerClock(t)
+	store.Clock = clock
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
+
+	newRun := func(t *testing.T) models.JobRun {
+		j := models.NewJob()
+		j.Initiators = []models.Initiator{{Type: models.InitiatorWeb}}
+		j.Tasks = []models.TaskSpec{
+			cltest.NewTask(t, "sleep", `{"duration": "1h"}`),
+			cltest.NewTask(t, "multiply", `{"times": 2}`),
+		}
+		require.NoError(t, store.CreateJob(&j))
+
+		run := cltest.NewJobRun(j)
+		run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"result": "50"}`)
+		require.NoError(t, store.CreateJobRun(&run))
+		return run
+	}
+
+	t.Run("persists the wake up time while sleeping", func(t *testing.T) {
+		run := newRun(t)
+		start := time.Now()
+
+		done := make(chan struct{})
+		go func() {
+			assert.NoError(t, runExecutor.Execute(run.ID))
+			close(done)
+		}()
+
+		var sleeping models.TaskRun
+		require.Eventually(t, func() bool {
+			found, err := store.FindJobRun(run.ID)
+			require.NoError(t, err)
+			sleeping = found.TaskRuns[0]
+			return sleeping.Status.PendingSleep()
+		}, 10*time.Second, 10*time.Millisecond)
+		require.True(t, sleeping.SleepUntil.Valid)
+		assert.WithinDuration(t, start.Add(time.Hour), sleeping.SleepUntil.Time, time.Minute)
+
+		clock.Trigger()
+		<-done
+
+		run, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+		assert.Equal(t, "100", run.Result.Data.Get("result").String())
+	})
+
+	t.Run("resumes a sleep which ended while the node was stopped", func(t *testing.T) {
+		run := newRun(t)
+		run.TaskRuns[0].Sleep(cltest.JSONFromString(t, `{"result": "50"}`), time.Now().Add(-time.Minute))
+		require.NoError(t, store.SaveJobRun(&run))
+
+		require.NoError(t, runExecutor.Execute(run.ID))
+
+		run, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusCompleted, run.GetStatus())
+		assert.Equal(t, uint32(0), run.TaskRuns[0].Attempts, "expected the sleep not to start over")
+		assert.Equal(t, "100", run.Result.Data.Get("result").String())
+	})
+}
+
 func TestRunExecutor_Execute_RunNotFoundError(t *testing.T) {
 	t.Parallel()
 
@@ -104,7 +721,7 @@ func TestRunExecutor_Execute_RunNotFoundError(t *testing.T) {
 	pusher := new(mocks.StatsPusher)
 	pusher.On("PushNow").Return(nil)
 
-	runExecutor := services.NewRunExecutor(store, pusher)
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
 
 	err := runExecutor.Execute(models.NewID())
 	require.Error(t, err)
@@ -123,7 +740,7 @@ func TestRunExecutor_Execute_CancelActivelyRunningTask(t *testing.T) {
 	pusher := new(mocks.StatsPusher)
 	pusher.On("PushNow").Return(nil)
 
-	runExecutor := services.NewRunExecutor(store, pusher)
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
 
 	j := models.NewJob()
 	i := models.Initiator{Type: models.InitiatorWeb}
@@ -173,7 +790,7 @@ func TestRunExecutor_InitialTaskLacksConfirmations(t *testing.T) {
 	pusher := new(mocks.StatsPusher)
 	pusher.On("PushNow").Return(nil)
 
-	runExecutor := services.NewRunExecutor(store, pusher)
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
 
 	j := cltest.NewJobWithWebInitiator()
 	j.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop")}
@@ -204,7 +821,7 @@ func TestJobRunner_prioritizeSpecParamsOverRequestParams(t *testing.T) {
 	pusher := new(mocks.StatsPusher)
 	pusher.On("PushNow").Return(nil)
 
-	runExecutor := services.NewRunExecutor(store, pusher)
+	runExecutor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
 	requestBase := 2
 	requestParameter := 10
 	specParameter := 100
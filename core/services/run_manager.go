@@ -1,8 +1,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/jinzhu/gorm"
@@ -11,12 +13,17 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // RecurringScheduleJobError contains the field for the error message.
@@ -29,6 +36,32 @@ func (err RecurringScheduleJobError) Error() string {
 	return err.msg
 }
 
+// ErrMaintenance is returned when a run is requested while the node is in
+// maintenance mode.
+var ErrMaintenance = RecurringScheduleJobError{msg: "node is in maintenance mode and is not starting new runs"}
+
+// RunRateLimitedError is returned when a run is dropped because its
+// initiator last started a run less than its minIncomingInterval ago.
+type RunRateLimitedError struct {
+	msg string
+}
+
+// Error returns the error message for the run.
+func (err RunRateLimitedError) Error() string {
+	return err.msg
+}
+
+// RunInputError is returned when a run is rejected because its parameters do
+// not conform to the job's input schema.
+type RunInputError struct {
+	msg string
+}
+
+// Error returns the error message for the run.
+func (err RunInputError) Error() string {
+	return err.msg
+}
+
 //go:generate mockery --name RunManager --output ../internal/mocks/ --case=underscore
 
 // RunManager supplies methods for queueing, resuming and cancelling jobs in
@@ -47,10 +80,20 @@ type RunManager interface {
 		runID *models.ID,
 		input models.BridgeRunResult) error
 	Cancel(runID *models.ID) (*models.JobRun, error)
+	RetryDeadLetters(runIDs []*models.ID) ([]models.JobRun, error)
+	RetryRun(runID *models.ID) (*models.JobRun, error)
 
 	ResumeAllInProgress() error
-	ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error
-	ResumeAllPendingConnection() error
+	ResumeAllPendingNextBlock(chainID, currentBlockHeight *big.Int) error
+	ResumeAllPendingConnection(chainID *big.Int) error
+	ResumeAllPendingConcurrency() error
+	ResumePendingConcurrency(jobSpecID *models.ID) error
+	ResumeAt(run *models.JobRun, at time.Time)
+	ExpireAllPastDeadline() error
+
+	StartMaintenance()
+	StopMaintenance()
+	MaintenanceSince() (time.Time, bool)
 }
 
 // runManager implements RunManager
@@ -61,6 +104,20 @@ type runManager struct {
 	txManager   store.TxManager
 	config      orm.ConfigReader
 	clock       utils.AfterNower
+
+	// dequeueMutex keeps runs waiting on the concurrency limits of their
+	// jobs from being dequeued twice over
+	dequeueMutex sync.Mutex
+
+	// maintenanceSince is when the node entered maintenance mode, in which no
+	// new runs are started while those already started carry on
+	maintenanceSince time.Time
+	maintenanceMutex sync.RWMutex
+
+	// lastIncoming is when each initiator with a minIncomingInterval last
+	// started a run, keyed by initiator ID
+	lastIncoming      map[int64]time.Time
+	lastIncomingMutex sync.Mutex
 }
 
 func runCost(job *models.JobSpec, config orm.ConfigReader, adapters []*adapters.PipelineAdapter) *assets.Link {
@@ -119,11 +176,18 @@ func NewRun(
 	return &run, runAdapters
 }
 
-// ValidateRun ensures that a run's initial preconditions have been met
+// ValidateRun ensures that a run's initial preconditions have been met,
+// rejecting RunLog requests which underpay the job or whose fulfillment
+// parameters are inconsistent with the log.
 func ValidateRun(run *models.JobRun, contractCost *assets.Link) {
 
 	// payment is only present for runs triggered by runlogs
-	if run.Payment != nil && contractCost.Cmp(run.Payment) > 0 {
+	if run.Payment == nil {
+		return
+	}
+	store.PromObservePayment(run.JobSpecID, run.Payment, contractCost)
+
+	if contractCost.Cmp(run.Payment) > 0 {
 		logger.Debugw("Rejecting run with insufficient payment",
 			run.ForLogger("required_payment", contractCost.String())...)
 
@@ -132,9 +196,75 @@ func ValidateRun(run *models.JobRun, contractCost *assets.Link) {
 			run.JobSpecID,
 			run.Payment.Text(10),
 			contractCost.Text(10))
-		run.SetError(err)
+		run.Reject(models.RejectionInsufficientPayment, err)
 		return
 	}
+
+	dataPrefix := run.RunRequest.RequestParams.Get("dataPrefix")
+	if run.Initiator.Type != models.InitiatorRunLog || !dataPrefix.Exists() {
+		return
+	}
+	if rejection, err := validateOracleRequest(run, dataPrefix.String()); err != nil {
+		logger.Debugw("Rejecting run with invalid request", run.ForLogger("rejection", rejection, "error", err)...)
+		run.Reject(rejection, err)
+	}
+}
+
+func validateOracleRequest(run *models.JobRun, dataPrefix string) (models.Rejection, error) {
+	b, err := hexutil.Decode(dataPrefix)
+	if err != nil {
+		return models.RejectionMalformedRequest, errors.Wrapf(err, "rejecting job %s with undecodable dataPrefix", run.JobSpecID)
+	}
+	request, err := models.ParseOracleRequest(b)
+	if err != nil {
+		return models.RejectionMalformedRequest, errors.Wrapf(err, "rejecting job %s", run.JobSpecID)
+	}
+
+	switch {
+	case request.Payment.Cmp(run.Payment) != 0:
+		return models.RejectionPaymentMismatch, fmt.Errorf(
+			"rejecting job %s with fulfillment payment %s not matching the logged payment %s",
+			run.JobSpecID, request.Payment.Text(10), run.Payment.Text(10))
+	case run.RunRequest.RequestID != nil && request.RequestID != *run.RunRequest.RequestID:
+		return models.RejectionRequestIDMismatch, fmt.Errorf(
+			"rejecting job %s with fulfillment request ID %s not matching the logged request ID %s",
+			run.JobSpecID, request.RequestID.Hex(), run.RunRequest.RequestID.Hex())
+	case !request.Expiration.After(run.CreatedAt):
+		return models.RejectionExpiredRequest, fmt.Errorf(
+			"rejecting job %s with request which expired at %s",
+			run.JobSpecID, utils.ISO8601UTC(request.Expiration))
+	}
+	return "", nil
+}
+
+// validateRunInput checks the parameters of runs started by web, external,
+// webhook, kafka, mqtt and runlog initiators against the job's input schema.
+// The fields which the node adds to runlog parameters are left out, so that
+// schemas need only describe the request made on chain.
+func validateRunInput(job *models.JobSpec, initiator *models.Initiator, runRequest *models.RunRequest) error {
+	if job.InputSchema == nil {
+		return nil
+	}
+	input := runRequest.RequestParams
+	switch initiator.Type {
+	case models.InitiatorWeb, models.InitiatorExternal, models.InitiatorWebhook, models.InitiatorKafka, models.InitiatorMQTT:
+	case models.InitiatorRunLog:
+		for _, key := range []string{"address", "dataPrefix", "functionSelector"} {
+			if !input.Get(key).Exists() {
+				continue
+			}
+			var err error
+			if input, err = input.Delete(key); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil
+	}
+	if err := job.InputSchema.Validate(input); err != nil {
+		return RunInputError{msg: fmt.Sprintf("rejecting run of job %s: %v", job.ID, err)}
+	}
+	return nil
 }
 
 // NewRunManager returns a new job manager
@@ -146,12 +276,13 @@ func NewRunManager(
 	txManager store.TxManager,
 	clock utils.AfterNower) RunManager {
 	return &runManager{
-		orm:         orm,
-		statsPusher: statsPusher,
-		runQueue:    runQueue,
-		txManager:   txManager,
-		config:      config,
-		clock:       clock,
+		orm:          orm,
+		statsPusher:  statsPusher,
+		runQueue:     runQueue,
+		txManager:    txManager,
+		config:       config,
+		clock:        clock,
+		lastIncoming: make(map[int64]time.Time),
 	}
 }
 
@@ -181,8 +312,42 @@ func (rm *runManager) CreateErrored(
 	return &run, rm.orm.CreateJobRun(&run)
 }
 
+// debounce returns a RunRateLimitedError if the initiator started a run less
+// than its minIncomingInterval before now, and otherwise records now as the
+// time of its last run.
+func (rm *runManager) debounce(initiator *models.Initiator, now time.Time) error {
+	if initiator.MinIncomingInterval == nil || initiator.ID == 0 {
+		return nil
+	}
+	interval := initiator.MinIncomingInterval.Duration()
+
+	rm.lastIncomingMutex.Lock()
+	defer rm.lastIncomingMutex.Unlock()
+	if last, ok := rm.lastIncoming[initiator.ID]; ok && now.Before(last.Add(interval)) {
+		return RunRateLimitedError{
+			msg: fmt.Sprintf("Initiator %d of job %s last started a run at %v, less than its minIncomingInterval of %v ago", initiator.ID, initiator.JobSpecID, last, interval),
+		}
+	}
+	rm.lastIncoming[initiator.ID] = now
+	return nil
+}
+
 // Create immediately persists a JobRun and sends it to the RunQueue for
 // execution.
+// superseded returns true if the initiator belongs to an earlier version of
+// the job, whose cron schedule or subscription may still be running.
+func superseded(job models.JobSpec, initiator *models.Initiator) bool {
+	if initiator.ID == 0 {
+		return false
+	}
+	for _, initr := range job.Initiators {
+		if initr.ID == initiator.ID {
+			return false
+		}
+	}
+	return true
+}
+
 func (rm *runManager) Create(
 	jobSpecID *models.ID,
 	initiator *models.Initiator,
@@ -193,6 +358,11 @@ func (rm *runManager) Create(
 		"job", jobSpecID.String(),
 		"creation_height", creationHeight.String(),
 	)
+	triggeredAt := time.Now()
+
+	if _, ok := rm.MaintenanceSince(); ok {
+		return nil, ErrMaintenance
+	}
 
 	job, err := rm.orm.Unscoped().FindJob(jobSpecID)
 	if err != nil {
@@ -205,6 +375,18 @@ func (rm *runManager) Create(
 		}
 	}
 
+	if job.Paused() {
+		return nil, RecurringScheduleJobError{
+			msg: fmt.Sprintf("Trying to run paused job %s", job.ID),
+		}
+	}
+
+	if superseded(job, initiator) {
+		return nil, RecurringScheduleJobError{
+			msg: fmt.Sprintf("Initiator %d of job %s has been replaced by version %d of the job", initiator.ID, job.ID, job.Version),
+		}
+	}
+
 	now := rm.clock.Now()
 	if !job.Started(now) {
 		return nil, RecurringScheduleJobError{
@@ -222,31 +404,113 @@ func (rm *runManager) Create(
 		return nil, fmt.Errorf("invariant for job %s: no tasks to run in NewRun", job.ID)
 	}
 
+	if err := validateRunInput(&job, initiator, runRequest); err != nil {
+		logger.Debugw("Rejecting run with invalid input", "job", job.ID.String(), "error", err)
+		return nil, err
+	}
+
+	if err := rm.debounce(initiator, now); err != nil {
+		logger.Debugw("Dropping run", "job", job.ID.String(), "error", err)
+		return nil, err
+	}
+
 	run, adapters := NewRun(&job, initiator, creationHeight, runRequest, rm.config, rm.orm, now)
 	runCost := runCost(&job, rm.config, adapters)
 	ValidateRun(run, runCost)
+	if job.MaxConcurrentRuns > 0 && run.GetStatus().Runnable() {
+		run.SetStatus(models.RunStatusPendingConcurrency)
+	}
 
 	if err := rm.orm.CreateJobRun(run); err != nil {
 		return nil, errors.Wrap(err, "CreateJobRun failed")
 	}
 	rm.statsPusher.PushNow()
 
+	_, span := tracing.Tracer().Start(tracing.RunContext(context.Background(), run.ID), "job_run.create",
+		trace.WithTimestamp(triggeredAt),
+		trace.WithAttributes(
+			attribute.String("job.id", job.ID.String()),
+			attribute.String("run.initiator", string(initiator.Type)),
+			attribute.String("run.status", string(run.Status)),
+		),
+	)
+	span.End()
+	if run.GetStatus().Finished() {
+		tracing.RecordRun(run)
+	}
+
 	if run.GetStatus().Runnable() {
 		logger.Debugw(
 			fmt.Sprintf("Executing run originally initiated by %s", run.Initiator.Type),
 			run.ForLogger()...,
 		)
-		rm.runQueue.Run(run)
+		rm.queue(run)
+	} else if run.GetStatus().PendingConcurrency() {
+		if err := rm.dequeue(job.ID, run); err != nil {
+			logger.Errorw("Error dequeueing runs", run.ForLogger("error", err)...)
+		}
 	}
 	return run, nil
 }
 
-// ResumeAllPendingNextBlock wakes up all jobs that were sleeping because they
-// were waiting for the next block
-func (rm *runManager) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) error {
-	logger.Debugw("Resuming all runs pending next block", "currentBlockHeight", currentBlockHeight)
+// ResumeAllPendingConcurrency resumes the runs waiting for earlier runs of
+// their jobs to finish, as far as the concurrency limits of the jobs allow.
+func (rm *runManager) ResumeAllPendingConcurrency() error {
+	return rm.dequeue(nil, nil)
+}
+
+// ResumePendingConcurrency resumes the runs of the job waiting for earlier
+// runs to finish, as far as its concurrency limit allows. It is called as each
+// of the job's runs finishes.
+func (rm *runManager) ResumePendingConcurrency(jobSpecID *models.ID) error {
+	return rm.dequeue(jobSpecID, nil)
+}
+
+// dequeue resumes the runs of the job, or of every job if it is nil, which
+// the job's concurrency limit now allows. A run just created is resumed as it
+// is, rather than as it was read back from the database.
+func (rm *runManager) dequeue(jobSpecID *models.ID, created *models.JobRun) error {
+	rm.dequeueMutex.Lock()
+	defer rm.dequeueMutex.Unlock()
+
+	runs, err := rm.orm.DequeueJobRuns(jobSpecID)
+	if err != nil {
+		return err
+	}
+	for i := range runs {
+		run := &runs[i]
+		if created != nil && created.ID.String() == run.ID.String() {
+			created.SetStatus(models.RunStatusInProgress)
+			run = created
+		}
+		logger.Debugw("Resuming run waiting for earlier runs of its job", run.ForLogger()...)
+		rm.queue(run)
+	}
+	if len(runs) > 0 {
+		rm.statsPusher.PushNow()
+	}
+	return nil
+}
+
+// ResumeAt executes the run again at the given time, as when one of its tasks
+// is due to be retried. Runs still waiting when the node stops are resumed
+// with the other runs in progress when it next starts.
+func (rm *runManager) ResumeAt(run *models.JobRun, at time.Time) {
+	go func() {
+		if duration := at.Sub(rm.clock.Now()); duration > 0 {
+			<-rm.clock.After(duration)
+		}
+		rm.queue(run)
+	}()
+}
+
+// ResumeAllPendingNextBlock wakes up all jobs on the given chain that were
+// sleeping because they were waiting for the next block
+func (rm *runManager) ResumeAllPendingNextBlock(chainID, currentBlockHeight *big.Int) error {
+	logger.Debugw("Resuming all runs pending next block", "chainID", chainID, "currentBlockHeight", currentBlockHeight)
 
 	observedHeight := utils.NewBig(currentBlockHeight)
+	primaryChainID, runChainID := utils.NewBig(rm.config.ChainID()), utils.NewBig(chainID)
 	resumableRunStatuses := []models.RunStatus{
 		models.RunStatusPendingConnection,
 		models.RunStatusPendingOutgoingConfirmations,
@@ -258,7 +522,7 @@ func (rm *runManager) ResumeAllPendingNextBlock(currentBlockHeight *big.Int) err
 		models.RunStatusPendingOutgoingConfirmations,
 		models.RunStatusPendingIncomingConfirmations,
 	}
-	runIDs := []*models.ID{}
+	runs := []models.JobRun{}
 
 	err := rm.orm.Transaction(func(tx *gorm.DB) error {
 		updateTaskRunsQuery := `
@@ -273,9 +537,10 @@ UPDATE task_runs
    )
   FROM job_runs
  WHERE job_runs.status IN (?)
+   AND COALESCE(job_runs.chain_id, ?) = ?
    AND task_runs.job_run_id = job_runs.id
    AND task_runs.status IN (?);`
-		result := tx.Exec(updateTaskRunsQuery, models.RunStatusInProgress, observedHeight, observedHeight, resumableRunStatuses, resumableTaskStatuses)
+		result := tx.Exec(updateTaskRunsQuery, models.RunStatusInProgress, observedHeight, observedHeight, resumableRunStatuses, primaryChainID, runChainID, resumableTaskStatuses)
 		if result.Error != nil {
 			return result.Error
 		}
@@ -284,30 +549,30 @@ UPDATE task_runs
    UPDATE job_runs
       SET status = ?, observed_height = ?
     WHERE status IN (?)
-RETURNING id;`
-		result = tx.Raw(updateJobRunsQuery, models.RunStatusInProgress, observedHeight, resumableRunStatuses)
-		if result.Error != nil {
-			return result.Error
-		}
-		return result.Pluck("id", &runIDs).Error
+      AND COALESCE(chain_id, ?) = ?
+RETURNING id, job_spec_id, priority;`
+		return tx.Raw(updateJobRunsQuery, models.RunStatusInProgress, observedHeight, resumableRunStatuses, primaryChainID, runChainID).
+			Scan(&runs).Error
 	})
 
 	if err != nil {
 		return err
 	}
 
-	for _, runID := range runIDs {
-		// FIXME: this is safe, but probably better to change Run to just take an ID here
-		rm.runQueue.Run(&models.JobRun{ID: runID})
+	for i := range runs {
+		rm.queue(&runs[i])
 	}
 	rm.statsPusher.PushNow()
 	return nil
 }
 
-// ResumeAllPendingConnection wakes up all tasks that have gone to sleep because they
-// needed an ethereum client connection.
-func (rm *runManager) ResumeAllPendingConnection() error {
+// ResumeAllPendingConnection wakes up all tasks on the given chain that have
+// gone to sleep because they needed an ethereum client connection.
+func (rm *runManager) ResumeAllPendingConnection(chainID *big.Int) error {
 	return rm.orm.UnscopedJobRunsWithStatus(func(run *models.JobRun) {
+		if !rm.onChain(run, chainID) {
+			return
+		}
 		logger.Debugw("New connection resuming run", run.ForLogger()...)
 
 		currentTaskRun := run.NextTaskRun()
@@ -327,6 +592,15 @@ func (rm *runManager) ResumeAllPendingConnection() error {
 		models.RunStatusPendingConnection, models.RunStatusPendingOutgoingConfirmations)
 }
 
+// onChain returns true if the run is bound to the chain with the given ID,
+// runs without a chain ID being bound to the primary chain.
+func (rm *runManager) onChain(run *models.JobRun, chainID *big.Int) bool {
+	if run.ChainID == nil {
+		return rm.config.ChainID().Cmp(chainID) == 0
+	}
+	return run.ChainID.ToInt().Cmp(chainID) == 0
+}
+
 // ResumePendingBridgeTask wakes up a task that required a response from a bridge adapter.
 func (rm *runManager) ResumePendingBridge(
 	runID *models.ID,
@@ -348,6 +622,12 @@ func (rm *runManager) ResumePendingBridge(
 		return rm.updateWithError(&run, "Attempting to resume pending run with no remaining tasks %s", run.ID)
 	}
 
+	if !input.HasError() && !input.Status.PendingBridge() {
+		if err := rm.validateBridgeResponse(currentTaskRun, input.Data); err != nil {
+			return rm.updateWithError(&run, "Rejected bridge response for run %s: %v", run.ID, err)
+		}
+	}
+
 	data, err := models.Merge(run.RunRequest.RequestParams, input.Data)
 	if err != nil {
 		return rm.updateWithError(&run, "Error while merging onto RequestParams for run %s", run.ID)
@@ -357,7 +637,37 @@ func (rm *runManager) ResumePendingBridge(
 	currentTaskRun.ApplyBridgeRunResult(input)
 	run.ApplyBridgeRunResult(input)
 
-	return rm.saveAndResumeIfInProgress(&run)
+	_, span := tracing.Tracer().Start(tracing.TaskContext(context.Background(), run.ID, currentTaskRun.ID), "task_run.resume_bridge",
+		trace.WithAttributes(
+			attribute.String("task.type", string(currentTaskRun.TaskSpec.Type)),
+			attribute.String("task.status", string(currentTaskRun.Status)),
+		),
+	)
+	if input.HasError() {
+		span.SetStatus(codes.Error, input.GetError().Error())
+	}
+	span.End()
+
+	if err := rm.saveAndResumeIfInProgress(&run); err != nil {
+		return err
+	}
+	if run.GetStatus().Finished() {
+		tracing.RecordRun(&run)
+	}
+	return nil
+}
+
+// validateBridgeResponse checks the data an external adapter responded to a
+// pending task with against the task's response schema.
+func (rm *runManager) validateBridgeResponse(taskRun *models.TaskRun, data models.JSON) error {
+	adapter, err := adapters.For(taskRun.TaskSpec, rm.config, rm.orm)
+	if err != nil {
+		return err
+	}
+	if bridge, ok := adapter.BaseAdapter.(*adapters.Bridge); ok {
+		return bridge.ValidateResponse(data)
+	}
+	return nil
 }
 
 // ResumeAllInProgress queries the db for job runs that should be resumed
@@ -370,7 +680,23 @@ func (rm *runManager) ResumePendingBridge(
 // To recap: This must run before anything else writes job run status to the db,
 // ie. tries to run a job.
 func (rm *runManager) ResumeAllInProgress() error {
-	return rm.orm.UnscopedJobRunsWithStatus(rm.runQueue.Run, models.RunStatusInProgress, models.RunStatusPendingSleep)
+	return rm.orm.UnscopedJobRunsWithStatus(rm.queue, models.RunStatusInProgress, models.RunStatusPendingSleep)
+}
+
+// ExpireAllPastDeadline errors all unfinished runs that have exceeded their
+// job's deadline, so that runs stuck in pending states do not hang forever.
+func (rm *runManager) ExpireAllPastDeadline() error {
+	defer rm.statsPusher.PushNow()
+	return rm.orm.UnscopedJobRunsPastDeadline(func(run *models.JobRun) {
+		logger.Warnw("Expiring run past its deadline", run.ForLogger("deadline", run.Deadline.Time)...)
+		run.Expire()
+		run.DeadLetter(rm.clock.Now())
+		if err := rm.orm.SaveJobRun(run); err != nil {
+			logger.Errorw("Error saving expired run", run.ForLogger("error", err)...)
+			return
+		}
+		tracing.RecordRun(run)
+	}, rm.clock.Now())
 }
 
 // Cancel suspends a running task.
@@ -387,7 +713,14 @@ func (rm *runManager) Cancel(runID *models.ID) (*models.JobRun, error) {
 
 	run.Cancel()
 	defer rm.statsPusher.PushNow()
-	return &run, rm.orm.SaveJobRun(&run)
+	if err := rm.orm.SaveJobRun(&run); err != nil {
+		return &run, err
+	}
+	tracing.RecordRun(&run)
+	if err := rm.dequeue(run.JobSpecID, nil); err != nil {
+		logger.Errorw("Error dequeueing runs", run.ForLogger("error", err)...)
+	}
+	return &run, nil
 }
 
 func (rm *runManager) updateWithError(run *models.JobRun, msg string, args ...interface{}) error {
@@ -409,7 +742,130 @@ func (rm *runManager) saveAndResumeIfInProgress(run *models.JobRun) error {
 	}
 	rm.statsPusher.PushNow()
 	if run.GetStatus() == models.RunStatusInProgress {
-		rm.runQueue.Run(run)
+		rm.queue(run)
 	}
 	return nil
 }
+
+// queue hands the run to the run queue. A run the queue rejects because it is
+// full is errored, rather than being left in progress with nothing to execute
+// it until the node restarts. It is read back first, as some callers only
+// have the run's ID and job, and the errored run is copied back to run.
+func (rm *runManager) queue(run *models.JobRun) {
+	queueErr := rm.runQueue.Run(run)
+	if queueErr == nil {
+		return
+	}
+	rejected, err := rm.orm.Unscoped().FindJobRun(run.ID)
+	if err != nil {
+		logger.Errorw("Error finding run rejected by the run queue", run.ForLogger("error", err)...)
+		return
+	}
+	if rejected.GetStatus().Finished() {
+		return
+	}
+	if err := rm.updateWithError(&rejected, "Run %s could not be queued: %v", run.ID, queueErr); err != nil {
+		return
+	}
+	tracing.RecordRun(&rejected)
+	*run = rejected
+}
+
+// RetryDeadLetters takes runs out of the dead-letter queue and resumes each
+// from the task it failed on. Every run is checked before any is retried, so
+// if one of them cannot be retried none are.
+func (rm *runManager) RetryDeadLetters(runIDs []*models.ID) ([]models.JobRun, error) {
+	runs := make([]models.JobRun, 0, len(runIDs))
+	for _, runID := range runIDs {
+		run, err := rm.findDeadLetter(runID)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	for i := range runs {
+		logger.Debugw("Retrying dead lettered run", runs[i].ForLogger()...)
+		runs[i].ResetForRetry(rm.clock.Now())
+		if err := rm.saveAndResumeIfInProgress(&runs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return runs, nil
+}
+
+// findDeadLetter loads a dead-lettered run, returning an error if it is not in
+// the dead-letter queue or its job is paused.
+func (rm *runManager) findDeadLetter(runID *models.ID) (models.JobRun, error) {
+	run, err := rm.orm.Unscoped().FindJobRun(runID)
+	if err != nil {
+		return run, errors.Wrapf(err, "failed to find job run %s", runID)
+	}
+
+	if !run.DeadLettered() {
+		return run, fmt.Errorf("cannot retry run %s which is not in the dead-letter queue", run.ID)
+	}
+	job, err := rm.orm.Unscoped().FindJob(run.JobSpecID)
+	if err != nil {
+		return run, errors.Wrap(err, "failed to find job spec")
+	}
+	if job.Paused() {
+		return run, fmt.Errorf("cannot retry run %s of paused job %s", run.ID, job.ID)
+	}
+	return run, nil
+}
+
+// RetryRun resumes an errored run from the task it failed on, keeping the
+// results of the tasks before it, whether or not it is in the dead-letter
+// queue.
+func (rm *runManager) RetryRun(runID *models.ID) (*models.JobRun, error) {
+	run, err := rm.orm.FindJobRun(runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !run.GetStatus().Errored() {
+		return nil, fmt.Errorf("cannot retry run %s which has not errored", run.ID)
+	}
+	job, err := rm.orm.FindJob(run.JobSpecID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find job spec")
+	}
+	if job.Paused() {
+		return nil, fmt.Errorf("cannot retry run %s of paused job %s", run.ID, job.ID)
+	}
+
+	logger.Debugw("Retrying errored run", run.ForLogger()...)
+	run.ResetForRetry(rm.clock.Now())
+	return &run, rm.saveAndResumeIfInProgress(&run)
+}
+
+// StartMaintenance puts the node in maintenance mode, in which initiators do
+// not start new runs. The runs already started carry on, so that they can
+// drain before the database or Ethereum node is taken down.
+func (rm *runManager) StartMaintenance() {
+	rm.maintenanceMutex.Lock()
+	defer rm.maintenanceMutex.Unlock()
+	if rm.maintenanceSince.IsZero() {
+		logger.Info("Entering maintenance mode, no new runs will be started")
+		rm.maintenanceSince = rm.clock.Now()
+	}
+}
+
+// StopMaintenance takes the node out of maintenance mode.
+func (rm *runManager) StopMaintenance() {
+	rm.maintenanceMutex.Lock()
+	defer rm.maintenanceMutex.Unlock()
+	if !rm.maintenanceSince.IsZero() {
+		logger.Info("Leaving maintenance mode")
+		rm.maintenanceSince = time.Time{}
+	}
+}
+
+// MaintenanceSince returns when the node entered maintenance mode, and
+// whether it is in maintenance mode.
+func (rm *runManager) MaintenanceSince() (time.Time, bool) {
+	rm.maintenanceMutex.RLock()
+	defer rm.maintenanceMutex.RUnlock()
+	return rm.maintenanceSince, !rm.maintenanceSince.IsZero()
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -144,7 +145,7 @@ func TestRunManager_ResumeAllPendingNextBlock(t *testing.T) {
 		require.NoError(t, store.CreateJobRun(&run))
 
 		observedHeight := big.NewInt(1)
-		err := runManager.ResumeAllPendingNextBlock(observedHeight)
+		err := runManager.ResumeAllPendingNextBlock(store.Config.ChainID(), observedHeight)
 		require.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -172,7 +173,7 @@ func TestRunManager_ResumeAllPendingConnection(t *testing.T) {
 		run.SetStatus(models.RunStatusPendingConnection)
 		require.NoError(t, store.CreateJobRun(&run))
 
-		err := runManager.ResumeAllPendingConnection()
+		err := runManager.ResumeAllPendingConnection(store.Config.ChainID())
 		assert.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -189,7 +190,7 @@ func TestRunManager_ResumeAllPendingConnection(t *testing.T) {
 		run.TaskRuns = []models.TaskRun{models.TaskRun{ID: models.NewID(), TaskSpecID: job.Tasks[0].ID, Status: models.RunStatusUnstarted}}
 
 		require.NoError(t, store.CreateJobRun(&run))
-		err = runManager.ResumeAllPendingConnection()
+		err = runManager.ResumeAllPendingConnection(store.Config.ChainID())
 		assert.NoError(t, err)
 
 		run, err = store.FindJobRun(run.ID)
@@ -222,7 +223,7 @@ func TestRunManager_ResumeAllPendingConnection_NotEnoughConfirmations(t *testing
 	run.TaskRuns[0].Status = models.RunStatusPendingConnection
 	require.NoError(t, store.CreateJobRun(&run))
 
-	app.RunManager.ResumeAllPendingConnection()
+	app.RunManager.ResumeAllPendingConnection(store.Config.ChainID())
 
 	cltest.WaitForJobRunToPendIncomingConfirmations(t, store, run)
 }
@@ -254,6 +255,124 @@ func TestRunManager_Create(t *testing.T) {
 	assert.Equal(t, rr.RequestID, updatedJR.RunRequest.RequestID)
 }
 
+func TestRunManager_Create_RejectsInputNotMatchingSchema(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	store := app.Store
+	app.StartAndConnect()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "NoOp")}
+	job.InputSchema = &models.InputSchema{
+		Type:     models.InputSchemaObject,
+		Required: []string{"amount"},
+		Properties: map[string]*models.InputSchema{
+			"amount": {Type: models.InputSchemaInteger},
+		},
+	}
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	_, err := app.RunManager.Create(job.ID, &initiator, nil, models.NewRunRequest(cltest.JSONFromString(t, `{"amount": "ten"}`)))
+	require.Error(t, err)
+	assert.IsType(t, services.RunInputError{}, err)
+	assert.Contains(t, err.Error(), "input.amount must be of type integer, got string")
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 0)
+
+	jr, err := app.RunManager.Create(job.ID, &initiator, nil, models.NewRunRequest(cltest.JSONFromString(t, `{"amount": 10}`)))
+	require.NoError(t, err)
+	cltest.WaitForJobRunToComplete(t, store, *jr)
+}
+
+func TestRunManager_Create_MaxConcurrentRuns(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	store := app.Store
+	app.StartAndConnect()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "nooppendoutgoing")}
+	job.MaxConcurrentRuns = 1
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	first, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.NoError(t, err)
+	cltest.WaitForJobRunToPendOutgoingConfirmations(t, store, *first)
+
+	second, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConcurrency, second.GetStatus())
+	cltest.WaitForJobRunStatus(t, store, *second, models.RunStatusPendingConcurrency)
+
+	_, err = app.RunManager.Cancel(first.ID)
+	require.NoError(t, err)
+	cltest.WaitForJobRunToPendOutgoingConfirmations(t, store, *second)
+}
+
+func TestRunManager_Create_MinIncomingInterval(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	store := app.Store
+	app.StartAndConnect()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "NoOp")}
+	interval := models.MustMakeDuration(time.Hour)
+	job.Initiators[0].MinIncomingInterval = &interval
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	jr, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.NoError(t, err)
+	cltest.WaitForJobRunToComplete(t, store, *jr)
+
+	_, err = app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.Error(t, err)
+	assert.IsType(t, services.RunRateLimitedError{}, err)
+	assert.True(t, services.ExpectedRecurringScheduleJobError(err))
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, runs, 1)
+}
+
+func TestRunManager_Create_MaxConcurrentRuns_ResumedOnCompletion(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	store := app.Store
+	app.StartAndConnect()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{
+		cltest.NewTask(t, "sleep", `{"duration": "1s"}`),
+		cltest.NewTask(t, "noop"),
+	}
+	job.MaxConcurrentRuns = 1
+	require.NoError(t, store.CreateJob(&job))
+
+	initiator := job.Initiators[0]
+	first, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.NoError(t, err)
+	second, err := app.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, models.RunStatusPendingConcurrency, second.GetStatus())
+
+	cltest.WaitForJobRunToComplete(t, store, *first)
+	cltest.WaitForJobRunToComplete(t, store, *second)
+}
+
 func TestRunManager_Create_DoesNotSaveToTaskSpec(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication(t,
@@ -363,7 +482,7 @@ func TestRunManager_Create_fromRunLog_Happy(t *testing.T) {
 				BlockNumber: big.NewInt(3),
 			}, nil)
 
-			err = app.RunManager.ResumeAllPendingNextBlock(big.NewInt(2))
+			err = app.RunManager.ResumeAllPendingNextBlock(app.Store.Config.ChainID(), big.NewInt(2))
 			require.NoError(t, err)
 			run = cltest.WaitForJobRunStatus(t, app.Store, *jr, test.wantStatus)
 			assert.Equal(t, rr.RequestID, run.RunRequest.RequestID)
@@ -612,6 +731,48 @@ func TestRunManager_Create_fromRunLogPayments(t *testing.T) {
 	}
 }
 
+func TestRunManager_ErrorsRunsRejectedByFullRunQueue(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := new(mocks.StatsPusher)
+	pusher.On("PushNow").Return(nil)
+
+	runQueue := new(mocks.RunQueue)
+	runQueue.On("Run", mock.Anything).Return(services.ErrRunQueueFull)
+
+	runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
+
+	t.Run("created run", func(t *testing.T) {
+		job := cltest.NewJobWithWebInitiator()
+		require.NoError(t, store.CreateJob(&job))
+		initiator := job.Initiators[0]
+
+		run, err := runManager.Create(job.ID, &initiator, nil, models.NewRunRequest(models.JSON{}))
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+
+		found, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusErrored, found.GetStatus())
+		assert.Contains(t, found.Result.ErrorMessage.String, services.ErrRunQueueFull.Error())
+	})
+
+	t.Run("resumed run", func(t *testing.T) {
+		run := makeJobRunWithInitiator(t, store, cltest.NewJob())
+		run.SetStatus(models.RunStatusInProgress)
+		require.NoError(t, store.CreateJobRun(&run))
+
+		require.NoError(t, runManager.ResumeAllInProgress())
+
+		run, err := store.FindJobRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+		assert.Contains(t, run.Result.ErrorMessage.String, services.ErrRunQueueFull.Error())
+	})
+}
+
 func TestRunManager_Create_fromRunLog_ConnectToLaggingEthNode(t *testing.T) {
 	t.Parallel()
 
@@ -652,7 +813,7 @@ func TestRunManager_Create_fromRunLog_ConnectToLaggingEthNode(t *testing.T) {
 	require.NoError(t, err)
 	cltest.WaitForJobRunToPendIncomingConfirmations(t, app.Store, *jr)
 
-	err = app.RunManager.ResumeAllPendingNextBlock(pastCurrentHeight)
+	err = app.RunManager.ResumeAllPendingNextBlock(app.Store.Config.ChainID(), pastCurrentHeight)
 	require.NoError(t, err)
 
 	updatedJR := cltest.WaitForJobRunToPendIncomingConfirmations(t, app.Store, *jr)
@@ -691,7 +852,7 @@ func TestRunManager_ResumeConfirmingTasks(t *testing.T) {
 			runQueue.On("Run", mock.Anything).Return(nil)
 
 			runManager := services.NewRunManager(runQueue, store.Config, store.ORM, pusher, store.TxManager, store.Clock)
-			runManager.ResumeAllPendingNextBlock(big.NewInt(3821))
+			runManager.ResumeAllPendingNextBlock(store.Config.ChainID(), big.NewInt(3821))
 
 			runQueue.AssertExpectations(t)
 		})
@@ -863,11 +1024,57 @@ func TestRunManager_ValidateRun_PaymentBelowThreshold(t *testing.T) {
 	services.ValidateRun(run, contractCost)
 
 	assert.Equal(t, models.RunStatusErrored, run.GetStatus())
+	assert.Equal(t, models.RejectionInsufficientPayment, run.Rejection)
 
 	expectedErrorMsg := fmt.Sprintf("rejecting job %s with payment 1 below minimum threshold (2)", jobSpecID)
 	assert.Equal(t, expectedErrorMsg, run.Result.ErrorMessage.String)
 }
 
+func TestRunManager_ValidateRun_OracleRequest(t *testing.T) {
+	requestID := common.HexToHash("0x01")
+	dataPrefix := func(payment int64, id common.Hash, expiration time.Time) string {
+		return hexutil.Encode(utils.ConcatBytes(
+			id.Bytes(),
+			common.BigToHash(big.NewInt(payment)).Bytes(),
+			common.BytesToHash(cltest.NewAddress().Bytes()).Bytes(),
+			common.RightPadBytes([]byte{0x04, 0x2f, 0x2b, 0x65}, 32),
+			utils.EVMWordUint64(uint64(expiration.Unix())),
+		))
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name       string
+		dataPrefix string
+		rejection  models.Rejection
+	}{
+		{"valid", dataPrefix(2, requestID, now.Add(time.Minute)), ""},
+		{"payment mismatch", dataPrefix(3, requestID, now.Add(time.Minute)), models.RejectionPaymentMismatch},
+		{"request ID mismatch", dataPrefix(2, common.HexToHash("0x02"), now.Add(time.Minute)), models.RejectionRequestIDMismatch},
+		{"expired", dataPrefix(2, requestID, now.Add(-time.Minute)), models.RejectionExpiredRequest},
+		{"malformed", "0x0102", models.RejectionMalformedRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := cltest.JSONFromString(t, `{"dataPrefix":%q}`, test.dataPrefix)
+			run := &models.JobRun{
+				ID:         models.NewID(),
+				JobSpecID:  cltest.NewJob().ID,
+				CreatedAt:  now,
+				Payment:    assets.NewLink(2),
+				Initiator:  models.Initiator{Type: models.InitiatorRunLog},
+				RunRequest: models.RunRequest{RequestID: &requestID, RequestParams: params},
+			}
+
+			services.ValidateRun(run, assets.NewLink(1))
+
+			assert.Equal(t, test.rejection, run.Rejection)
+			assert.Equal(t, test.rejection != "", run.GetStatus().Errored())
+		})
+	}
+}
+
 func TestRunManager_NewRun(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
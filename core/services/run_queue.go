@@ -1,16 +1,22 @@
 package services
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+var runQueueLogger = logger.Module(logger.ModuleRunQueue)
+
 var (
 	numberRunsQueued = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "run_queue_runs_queued",
@@ -20,15 +26,31 @@ var (
 		Name: "run_queue_queue_size",
 		Help: "The size of the run queue",
 	})
+	numberRunQueueActive = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "run_queue_active_workers",
+		Help: "The number of runs currently being executed",
+	})
+	numberRunQueuePending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "run_queue_pending",
+		Help: "The number of runs waiting for a free worker",
+	})
+	numberRunsRejected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_queue_runs_rejected_total",
+		Help: "The total number of runs rejected because the run queue was full",
+	})
 )
 
+// ErrRunQueueFull is returned for a run which cannot be queued because
+// RUN_QUEUE_MAX_PENDING runs are already waiting for a worker.
+var ErrRunQueueFull = errors.New("run queue is full")
+
 //go:generate mockery --name RunQueue --output ../internal/mocks/ --case=underscore
 
 // RunQueue safely handles coordinating job runs.
 type RunQueue interface {
 	Start() error
 	Stop()
-	Run(*models.JobRun)
+	Run(*models.JobRun) error
 
 	WorkerCount() int
 }
@@ -39,26 +61,60 @@ type runQueue struct {
 	workersWg     sync.WaitGroup
 	stopRequested bool
 
+	// pending holds the runs waiting for a free worker, with the time they
+	// were queued. active and activePerJob count the runs being executed.
+	pending      []pendingRun
+	active       uint
+	activePerJob map[string]uint
+
+	maxPending       uint
+	maxWorkers       uint
+	maxWorkersPerJob uint
+	priorityAging    time.Duration
+
 	runExecutor RunExecutor
 }
 
 // NewRunQueue initializes a RunQueue.
-func NewRunQueue(runExecutor RunExecutor) RunQueue {
+func NewRunQueue(runExecutor RunExecutor, config orm.ConfigReader) RunQueue {
 	return &runQueue{
-		workers:     make(map[string]int),
-		runExecutor: runExecutor,
+		workers:          make(map[string]int),
+		activePerJob:     make(map[string]uint),
+		maxPending:       config.RunQueueMaxPending(),
+		maxWorkers:       config.RunQueueMaxWorkers(),
+		maxWorkersPerJob: config.RunQueueMaxWorkersPerJob(),
+		priorityAging:    config.RunQueuePriorityAging(),
+		runExecutor:      runExecutor,
 	}
 }
 
+type pendingRun struct {
+	run      *models.JobRun
+	queuedAt time.Time
+}
+
+// rank is the run's priority class, raised by one class for each aging
+// interval it has spent waiting for a worker.
+func (pr pendingRun) rank(now time.Time, aging time.Duration) int {
+	rank := pr.run.Priority.Rank()
+	if aging > 0 {
+		rank += int(now.Sub(pr.queuedAt) / aging)
+	}
+	return rank
+}
+
 // Start prepares the job runner for accepting runs to execute.
 func (rq *runQueue) Start() error {
 	return nil
 }
 
-// Stop closes all open worker channels.
+// Stop closes all open worker channels. Runs still waiting for a worker are
+// left in the database, to be resumed when the node next starts.
 func (rq *runQueue) Stop() {
 	rq.workersMutex.Lock()
 	rq.stopRequested = true
+	rq.pending = nil
+	numberRunQueuePending.Set(0)
 	rq.workersMutex.Unlock()
 	rq.workersWg.Wait()
 }
@@ -88,27 +144,90 @@ func (rq *runQueue) decrementQueue(runID string) bool {
 	return isEmpty
 }
 
-// Run tells the job runner to start executing a job
-func (rq *runQueue) Run(run *models.JobRun) {
+// Run tells the job runner to start executing a job. It returns
+// ErrRunQueueFull if the run is rejected because too many runs are already
+// waiting for a worker, in which case the run is left as it is and must be
+// dealt with by the caller.
+func (rq *runQueue) Run(run *models.JobRun) error {
 	rq.workersMutex.Lock()
 	if rq.stopRequested {
 		rq.workersMutex.Unlock()
-		return
+		return nil
 	}
 	rq.workersMutex.Unlock()
 
 	runID := run.ID.String()
 	if !rq.incrementQueue(runID) {
-		return
+		return nil
+	}
+
+	rq.workersMutex.Lock()
+	defer rq.workersMutex.Unlock()
+
+	if rq.maxPending > 0 && uint(len(rq.pending)) >= rq.maxPending {
+		numberRunsRejected.Inc()
+		delete(rq.workers, runID)
+		numberRunQueueWorkers.Set(float64(len(rq.workers)))
+		runQueueLogger.Warnw("Run queue is full, rejecting run", "runID", runID, "pending", len(rq.pending))
+		return ErrRunQueueFull
+	}
+
+	rq.pending = append(rq.pending, pendingRun{run: run, queuedAt: time.Now()})
+	rq.dispatch()
+	return nil
+}
+
+// dispatch starts a worker for each pending run that fits within the global
+// and per job concurrency limits, highest priority first and otherwise in
+// queue order. It must be called with workersMutex held.
+func (rq *runQueue) dispatch() {
+	now := time.Now()
+	sort.SliceStable(rq.pending, func(i, j int) bool {
+		return rq.pending[i].rank(now, rq.priorityAging) > rq.pending[j].rank(now, rq.priorityAging)
+	})
+
+	remaining := rq.pending[:0]
+	for _, pr := range rq.pending {
+		if rq.stopRequested || !rq.hasCapacityFor(pr.run) {
+			remaining = append(remaining, pr)
+			continue
+		}
+		rq.startWorker(pr.run)
+	}
+	for i := len(remaining); i < len(rq.pending); i++ {
+		rq.pending[i] = pendingRun{}
 	}
+	rq.pending = remaining
+	numberRunQueuePending.Set(float64(len(rq.pending)))
+}
+
+func (rq *runQueue) hasCapacityFor(run *models.JobRun) bool {
+	if rq.maxWorkers > 0 && rq.active >= rq.maxWorkers {
+		return false
+	}
+	if rq.maxWorkersPerJob > 0 && run.JobSpecID != nil {
+		return rq.activePerJob[run.JobSpecID.String()] < rq.maxWorkersPerJob
+	}
+	return true
+}
 
+// startWorker must be called with workersMutex held.
+func (rq *runQueue) startWorker(run *models.JobRun) {
+	rq.active++
+	if run.JobSpecID != nil {
+		rq.activePerJob[run.JobSpecID.String()]++
+	}
+	numberRunQueueActive.Set(float64(rq.active))
+
+	runID := run.ID.String()
 	rq.workersWg.Add(1)
 	go func() {
 		defer rq.workersWg.Done()
+		defer rq.finishWorker(run)
 
 		for {
 			if err := rq.runExecutor.Execute(run.ID); err != nil {
-				logger.Errorw(fmt.Sprint("Error executing run ", runID), "error", err)
+				runQueueLogger.Errorw(fmt.Sprint("Error executing run ", runID), "error", err)
 			}
 
 			if rq.decrementQueue(runID) {
@@ -118,6 +237,22 @@ func (rq *runQueue) Run(run *models.JobRun) {
 	}()
 }
 
+func (rq *runQueue) finishWorker(run *models.JobRun) {
+	rq.workersMutex.Lock()
+	defer rq.workersMutex.Unlock()
+
+	rq.active--
+	if run.JobSpecID != nil {
+		jobID := run.JobSpecID.String()
+		rq.activePerJob[jobID]--
+		if rq.activePerJob[jobID] == 0 {
+			delete(rq.activePerJob, jobID)
+		}
+	}
+	numberRunQueueActive.Set(float64(rq.active))
+	rq.dispatch()
+}
+
 // WorkerCount returns the number of workers currently processing a job run
 func (rq *runQueue) WorkerCount() int {
 	rq.workersMutex.RLock()
@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"sync/atomic"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -9,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -17,7 +19,7 @@ func TestRunQueue(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	runExecutor := new(mocks.RunExecutor)
-	runQueue := services.NewRunQueue(runExecutor)
+	runQueue := services.NewRunQueue(runExecutor, cltest.NewTestConfig(t))
 
 	executeJobChannel := make(chan struct{})
 
@@ -52,7 +54,7 @@ func TestRunQueue_OneWorkerPerRun(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	runExecutor := new(mocks.RunExecutor)
-	runQueue := services.NewRunQueue(runExecutor)
+	runQueue := services.NewRunQueue(runExecutor, cltest.NewTestConfig(t))
 
 	executeJobChannel := make(chan struct{})
 
@@ -89,7 +91,7 @@ func TestRunQueue_OneWorkerForSameRunTriggeredMultipleTimes(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	runExecutor := new(mocks.RunExecutor)
-	runQueue := services.NewRunQueue(runExecutor)
+	runQueue := services.NewRunQueue(runExecutor, cltest.NewTestConfig(t))
 
 	executeJobChannel := make(chan struct{})
 
@@ -125,3 +127,140 @@ func TestRunQueue_OneWorkerForSameRunTriggeredMultipleTimes(t *testing.T) {
 		return runQueue.WorkerCount()
 	}).Should(gomega.Equal(0))
 }
+
+func TestRunQueue_MaxWorkersPerJob(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("RUN_QUEUE_MAX_WORKERS_PER_JOB", 1)
+
+	runExecutor := new(mocks.RunExecutor)
+	runQueue := services.NewRunQueue(runExecutor, config)
+
+	executeJobChannel := make(chan struct{})
+	var executions int32
+
+	runQueue.Start()
+	defer runQueue.Stop()
+
+	runExecutor.On("Execute", mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) {
+			atomic.AddInt32(&executions, 1)
+			executeJobChannel <- struct{}{}
+		})
+
+	jobID := models.NewID()
+	runQueue.Run(&models.JobRun{ID: models.NewID(), JobSpecID: jobID})
+	runQueue.Run(&models.JobRun{ID: models.NewID(), JobSpecID: jobID})
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(2))
+
+	g.Eventually(func() int32 {
+		return atomic.LoadInt32(&executions)
+	}).Should(gomega.Equal(int32(1)))
+	g.Consistently(func() int32 {
+		return atomic.LoadInt32(&executions)
+	}).Should(gomega.Equal(int32(1)))
+
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		<-executeJobChannel
+		<-executeJobChannel
+	})
+
+	runExecutor.AssertNumberOfCalls(t, "Execute", 2)
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(0))
+}
+
+func TestRunQueue_DispatchesByPriority(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestConfig(t)
+	config.Set("RUN_QUEUE_MAX_WORKERS", 1)
+	config.Set("RUN_QUEUE_PRIORITY_AGING", "1h")
+
+	runExecutor := new(mocks.RunExecutor)
+	runQueue := services.NewRunQueue(runExecutor, config)
+
+	executeJobChannel := make(chan *models.ID)
+
+	runQueue.Start()
+	defer runQueue.Stop()
+
+	runExecutor.On("Execute", mock.Anything).
+		Return(nil, nil).
+		Run(func(args mock.Arguments) {
+			executeJobChannel <- args.Get(0).(*models.ID)
+		})
+
+	blocking := &models.JobRun{ID: models.NewID(), Priority: models.RunPriorityNormal}
+	low := &models.JobRun{ID: models.NewID(), Priority: models.RunPriorityLow}
+	high := &models.JobRun{ID: models.NewID(), Priority: models.RunPriorityHigh}
+
+	runQueue.Run(blocking)
+	runQueue.Run(low)
+	runQueue.Run(high)
+
+	var executed []*models.ID
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		for i := 0; i < 3; i++ {
+			executed = append(executed, <-executeJobChannel)
+		}
+	})
+
+	assert.Equal(t, []*models.ID{blocking.ID, high.ID, low.ID}, executed)
+}
+
+func TestRunQueue_RejectsRunsWhenFull(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	config := cltest.NewTestConfig(t)
+	config.Set("RUN_QUEUE_MAX_WORKERS", 1)
+	config.Set("RUN_QUEUE_MAX_PENDING", 1)
+
+	runExecutor := new(mocks.RunExecutor)
+	runQueue := services.NewRunQueue(runExecutor, config)
+
+	executeJobChannel := make(chan struct{})
+	var executions int32
+
+	runQueue.Start()
+	defer runQueue.Stop()
+
+	runExecutor.On("Execute", mock.Anything).
+		Return(nil, nil).
+		Run(func(mock.Arguments) {
+			atomic.AddInt32(&executions, 1)
+			executeJobChannel <- struct{}{}
+		})
+
+	runQueue.Run(&models.JobRun{ID: models.NewID()})
+	g.Eventually(func() int32 {
+		return atomic.LoadInt32(&executions)
+	}).Should(gomega.Equal(int32(1)))
+
+	assert.NoError(t, runQueue.Run(&models.JobRun{ID: models.NewID()}))
+	assert.Equal(t, services.ErrRunQueueFull, runQueue.Run(&models.JobRun{ID: models.NewID()}))
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(2))
+
+	cltest.CallbackOrTimeout(t, "Execute", func() {
+		<-executeJobChannel
+		<-executeJobChannel
+	})
+
+	runExecutor.AssertNumberOfCalls(t, "Execute", 2)
+
+	g.Eventually(func() int {
+		return runQueue.WorkerCount()
+	}).Should(gomega.Equal(0))
+}
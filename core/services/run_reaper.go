@@ -0,0 +1,107 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type (
+	// RunReaper periodically deletes, or archives, the finished runs of each
+	// job which are older or more numerous than its retention allows.
+	RunReaper interface {
+		Start() error
+		Stop() error
+		Reap()
+	}
+
+	runReaper struct {
+		store    *store.Store
+		interval time.Duration
+
+		utils.StartStopOnce
+		chStop chan struct{}
+		chDone chan struct{}
+	}
+
+	// NullRunReaper does not reap anything, for when JOB_RUN_REAPER_INTERVAL
+	// is 0
+	NullRunReaper struct{}
+)
+
+// NewRunReaper returns a RunReaper pruning the runs of store on every
+// interval.
+func NewRunReaper(store *store.Store, interval time.Duration) RunReaper {
+	return &runReaper{
+		store:    store,
+		interval: interval,
+		chStop:   make(chan struct{}),
+		chDone:   make(chan struct{}),
+	}
+}
+
+// Start begins reaping runs.
+func (rr *runReaper) Start() error {
+	return rr.StartOnce("RunReaper", func() error {
+		go rr.run()
+		return nil
+	})
+}
+
+// Stop stops reaping runs, waiting for a reaping in progress to finish.
+func (rr *runReaper) Stop() error {
+	return rr.StopOnce("RunReaper", func() error {
+		close(rr.chStop)
+		<-rr.chDone
+		return nil
+	})
+}
+
+func (rr *runReaper) run() {
+	defer close(rr.chDone)
+
+	ticker := time.NewTicker(rr.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-rr.chStop:
+			return
+		case <-ticker.C:
+			rr.Reap()
+		}
+	}
+}
+
+// Reap prunes the finished runs of each job once.
+func (rr *runReaper) Reap() {
+	jobs, err := rr.store.JobRunRetentions()
+	if err != nil {
+		logger.Errorw("RunReaper: error getting jobs", "error", err)
+		return
+	}
+
+	config := rr.store.Config
+	now := rr.store.Clock.Now()
+	for _, job := range jobs {
+		maxAge, maxRuns := job.RunRetention.Limits(config.JobRunRetentionPeriod(), config.JobRunRetentionCount())
+		var finishedBefore time.Time
+		if maxAge > 0 {
+			finishedBefore = now.Add(-maxAge)
+		}
+
+		count, err := rr.store.PruneJobRuns(job.ID, finishedBefore, maxRuns, config.JobRunRetentionArchive())
+		if err != nil {
+			logger.Errorw(fmt.Sprintf("RunReaper: error pruning runs of job %s", job.ID), "error", err, "job", job.ID.String())
+		} else if count > 0 {
+			logger.Infow(fmt.Sprintf("RunReaper: pruned %d runs of job %s", count, job.ID), "job", job.ID.String(), "count", count)
+		}
+	}
+}
+
+func (*NullRunReaper) Start() error { return nil }
+func (*NullRunReaper) Stop() error  { return nil }
+func (*NullRunReaper) Reap()        {}
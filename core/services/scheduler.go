@@ -93,6 +93,17 @@ func (s *Scheduler) AddJob(job models.JobSpec) {
 	s.addJob(&job)
 }
 
+// RemoveJob stops scheduling runs of the job, until it is added again.
+func (s *Scheduler) RemoveJob(ID *models.ID) {
+	s.startedMutex.RLock()
+	defer s.startedMutex.RUnlock()
+	if !s.started {
+		return
+	}
+	s.Recurring.RemoveJob(ID)
+	s.OneTime.RemoveJob(ID)
+}
+
 // Recurring is used for runs that need to execute on a schedule,
 // and is configured with cron.
 // Instances of Recurring must be initialized using NewRecurring().
@@ -100,12 +111,16 @@ type Recurring struct {
 	Cron       Cron
 	Clock      utils.Nower
 	runManager RunManager
+
+	entriesMutex sync.Mutex
+	entries      map[string][]cron.EntryID
 }
 
 // NewRecurring create a new instance of Recurring, ready to use.
 func NewRecurring(runManager RunManager) *Recurring {
 	return &Recurring{
 		runManager: runManager,
+		entries:    make(map[string][]cron.EntryID),
 	}
 }
 
@@ -125,32 +140,53 @@ func (r *Recurring) Stop() {
 }
 
 // AddJob looks for "cron" initiators, adds them to cron's schedule
-// for execution when specified.
+// for execution when specified. An initiator with several schedules is added
+// once for each of them.
 func (r *Recurring) AddJob(job models.JobSpec) {
+	r.entriesMutex.Lock()
+	defer r.entriesMutex.Unlock()
 	for _, initr := range job.InitiatorsFor(models.InitiatorCron) {
-		_, err := r.Cron.AddFunc(string(initr.Schedule), func() {
-			now := time.Now()
-			if !job.Started(now) || job.Ended(now) {
-				return
-			}
+		initr := initr
+		for _, schedule := range initr.CronSchedules() {
+			id, err := r.Cron.AddFunc(string(schedule), func() {
+				now := time.Now()
+				if !job.Started(now) || job.Ended(now) {
+					return
+				}
 
-			_, err := r.runManager.Create(job.ID, &initr, nil, &models.RunRequest{})
-			if err != nil && !ExpectedRecurringScheduleJobError(err) {
-				logger.Errorw(err.Error())
+				_, err := r.runManager.Create(job.ID, &initr, nil, &models.RunRequest{})
+				if err != nil && !ExpectedRecurringScheduleJobError(err) {
+					logger.Errorw(err.Error())
+				}
+			})
+			if err != nil {
+				logger.Error(err)
+				continue
 			}
-		})
-		if err != nil {
-			logger.Error(err)
+			r.entries[job.ID.String()] = append(r.entries[job.ID.String()], id)
 		}
 	}
 }
 
+// RemoveJob removes the job's "cron" initiators from cron's schedule.
+func (r *Recurring) RemoveJob(ID *models.ID) {
+	r.entriesMutex.Lock()
+	defer r.entriesMutex.Unlock()
+	for _, id := range r.entries[ID.String()] {
+		r.Cron.Remove(id)
+	}
+	delete(r.entries, ID.String())
+}
+
 // OneTime represents runs that are to be executed only once.
 type OneTime struct {
 	Store      *store.Store
 	Clock      utils.Afterer
 	RunManager RunManager
 	done       chan struct{}
+
+	removedMutex sync.Mutex
+	removed      map[string]chan struct{}
 }
 
 // Start allocates a channel for the "done" field with an empty struct.
@@ -159,16 +195,52 @@ func (ot *OneTime) Start() error {
 	return nil
 }
 
-// AddJob runs the job at the time specified for the "runat" initiator.
+// AddJob runs the job at the time specified for the "runat" initiator, or
+// every interval from it for recurring initiators. Initiators which have
+// already run are skipped, so that they do not run again after a restart.
 func (ot *OneTime) AddJob(job models.JobSpec) {
+	removed := ot.removedChannel(job.ID)
 	for _, initiator := range job.InitiatorsFor(models.InitiatorRunAt) {
 		if !initiator.Time.Valid {
 			logger.Errorf("RunJobAt: JobSpec %s must have initiator with valid run at time: %v", job.ID, initiator)
 			continue
 		}
+		if initiator.Ran {
+			continue
+		}
+
+		if initiator.Recurring() {
+			go ot.runJobEvery(initiator, job, removed)
+		} else {
+			go ot.runJobAt(initiator, job, removed)
+		}
+	}
+}
+
+// RemoveJob stops waiting to run the job at the times of its "runat"
+// initiators.
+func (ot *OneTime) RemoveJob(ID *models.ID) {
+	ot.removedMutex.Lock()
+	defer ot.removedMutex.Unlock()
+	if removed, ok := ot.removed[ID.String()]; ok {
+		close(removed)
+		delete(ot.removed, ID.String())
+	}
+}
 
-		go ot.RunJobAt(initiator, job)
+// removedChannel returns the channel closed when the job is removed.
+func (ot *OneTime) removedChannel(ID *models.ID) chan struct{} {
+	ot.removedMutex.Lock()
+	defer ot.removedMutex.Unlock()
+	if ot.removed == nil {
+		ot.removed = make(map[string]chan struct{})
+	}
+	removed, ok := ot.removed[ID.String()]
+	if !ok {
+		removed = make(chan struct{})
+		ot.removed[ID.String()] = removed
 	}
+	return removed
 }
 
 // Stop closes the "done" field's channel.
@@ -179,8 +251,13 @@ func (ot *OneTime) Stop() {
 // RunJobAt wait until the Stop() function has been called on the run
 // or the specified time for the run is after the present time.
 func (ot *OneTime) RunJobAt(initiator models.Initiator, job models.JobSpec) {
+	ot.runJobAt(initiator, job, nil)
+}
+
+func (ot *OneTime) runJobAt(initiator models.Initiator, job models.JobSpec, removed <-chan struct{}) {
 	select {
 	case <-ot.done:
+	case <-removed:
 	case <-ot.Clock.After(utils.DurationFromNow(initiator.Time.Time)):
 		now := time.Now()
 		if !job.Started(now) || job.Ended(now) {
@@ -199,9 +276,52 @@ func (ot *OneTime) RunJobAt(initiator models.Initiator, job models.JobSpec) {
 	}
 }
 
+// runJobEvery runs the job at each of the times of a recurring runat
+// initiator, recording the last it ran at so that it resumes from there after
+// a restart. Once its until time has passed the initiator is marked as ran.
+func (ot *OneTime) runJobEvery(initiator models.Initiator, job models.JobSpec, removed <-chan struct{}) {
+	for {
+		next, ok := initiator.NextRunAt()
+		if !ok {
+			if err := ot.Store.MarkRan(initiator, true); err != nil {
+				logger.Error(err.Error())
+			}
+			return
+		}
+
+		select {
+		case <-ot.done:
+			return
+		case <-removed:
+			return
+		case <-ot.Clock.After(utils.DurationFromNow(next)):
+		}
+
+		now := time.Now()
+		if job.Ended(now) {
+			return
+		}
+		if job.Started(now) {
+			_, err := ot.RunManager.Create(job.ID, &initiator, nil, &models.RunRequest{})
+			if err != nil && !ExpectedRecurringScheduleJobError(err) {
+				logger.Error(err.Error())
+			}
+		}
+
+		lastRanAt := initiator.RunAtOccurrence(now)
+		if lastRanAt.Before(next) {
+			lastRanAt = next
+		}
+		if err := ot.Store.MarkRanAt(initiator, lastRanAt); err != nil {
+			logger.Error(err.Error())
+		}
+		initiator.LastRanAt = models.NewAnyTime(lastRanAt)
+	}
+}
+
 func ExpectedRecurringScheduleJobError(err error) bool {
 	switch errors.Cause(err).(type) {
-	case RecurringScheduleJobError:
+	case RecurringScheduleJobError, RunRateLimitedError:
 		return true
 	default:
 		return false
@@ -212,4 +332,5 @@ type Cron interface {
 	Start()
 	Stop() context.Context
 	AddFunc(string, func()) (cron.EntryID, error)
+	Remove(cron.EntryID)
 }
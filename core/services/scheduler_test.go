@@ -7,7 +7,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 
+	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -82,6 +84,44 @@ func TestRecurring_AddJob(t *testing.T) {
 	runManager.AssertExpectations(t)
 }
 
+func TestRecurring_RemoveJob(t *testing.T) {
+	runManager := new(mocks.RunManager)
+
+	r := services.NewRecurring(runManager)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+
+	job := cltest.NewJobWithSchedule("* * * * *")
+	other := cltest.NewJobWithSchedule("* * * * *")
+	r.AddJob(job)
+	r.AddJob(other)
+	require.Len(t, cron.Entries, 2)
+
+	r.RemoveJob(job.ID)
+	require.Len(t, cron.Entries, 1)
+
+	r.RemoveJob(other.ID)
+	assert.Len(t, cron.Entries, 0)
+
+	runManager.AssertExpectations(t)
+}
+
+func TestRecurring_AddJob_MultipleSchedules(t *testing.T) {
+	runManager := new(mocks.RunManager)
+
+	r := services.NewRecurring(runManager)
+	cron := cltest.NewMockCron()
+	r.Cron = cron
+
+	job := cltest.NewJobWithSchedule("CRON_TZ=UTC */15 9-17 * * 1-5")
+	job.Initiators[0].Schedules = models.Crons{"CRON_TZ=UTC 0 2 * * *"}
+	r.AddJob(job)
+	require.Len(t, cron.Entries, 2)
+
+	r.RemoveJob(job.ID)
+	assert.Len(t, cron.Entries, 0)
+}
+
 func TestRecurring_AddJob_PastEnd(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -176,6 +216,60 @@ func TestOneTime_AddJob(t *testing.T) {
 	runManager.AssertExpectations(t)
 }
 
+func TestOneTime_AddJob_Recurring(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	executeJobChannel := make(chan struct{})
+	runManager := new(mocks.RunManager)
+	runManager.On("Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Times(3).
+		Run(func(mock.Arguments) {
+			executeJobChannel <- struct{}{}
+		})
+
+	clock := cltest.NewTriggerClock(t)
+
+	ot := services.OneTime{
+		Clock:      clock,
+		Store:      store,
+		RunManager: runManager,
+	}
+	require.NoError(t, ot.Start())
+
+	// The first two times have passed, and are made up for with one run.
+	now := time.Now().Truncate(time.Second)
+	every := models.MustMakeDuration(time.Hour)
+	j := cltest.NewJobWithRunAtInitiator(now.Add(-90 * time.Minute))
+	j.Initiators[0].Every = &every
+	j.Initiators[0].Until = models.NewAnyTime(now.Add(2 * time.Hour))
+	require.NoError(t, store.CreateJob(&j))
+
+	ot.AddJob(j)
+
+	for i := 0; i < 3; i++ {
+		clock.Trigger()
+		cltest.CallbackOrTimeout(t, "Create", func() {
+			<-executeJobChannel
+		}, 3*time.Second)
+	}
+
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		job, err := store.FindJob(j.ID)
+		require.NoError(t, err)
+		return job.Initiators[0].Ran
+	}).Should(gomega.BeTrue())
+
+	job, err := store.FindJob(j.ID)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(90*time.Minute).Unix(), job.Initiators[0].LastRanAt.Time.Unix())
+
+	ot.Stop()
+
+	runManager.AssertExpectations(t)
+}
+
 func TestOneTime_AddJob_PastEnd(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
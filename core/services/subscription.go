@@ -172,7 +172,7 @@ func runJob(runManager RunManager, le models.LogRequest) {
 	}
 
 	_, err = runManager.Create(jobSpecID, &initiator, le.BlockNumber(), &rr)
-	if err != nil {
+	if err != nil && !ExpectedRecurringScheduleJobError(err) {
 		logger.Errorw(err.Error(), le.ForLogger()...)
 	}
 }
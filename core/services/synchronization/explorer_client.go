@@ -20,6 +20,9 @@ var (
 	// ErrReceiveTimeout is returned when no message is received after a
 	// specified duration in Receive
 	ErrReceiveTimeout = errors.New("timeout waiting for message")
+	// ErrSendTimeout is returned when a message could not be written within
+	// the write deadline in SendAndWait
+	ErrSendTimeout = errors.New("timeout writing message")
 )
 
 type ConnectionStatus string
@@ -41,6 +44,7 @@ type ExplorerClient interface {
 	Start() error
 	Close() error
 	Send([]byte)
+	SendAndWait([]byte) error
 	Receive(...time.Duration) ([]byte, error)
 }
 
@@ -51,13 +55,14 @@ func (NoopExplorerClient) Status() ConnectionStatus                 { return Con
 func (NoopExplorerClient) Start() error                             { return nil }
 func (NoopExplorerClient) Close() error                             { return nil }
 func (NoopExplorerClient) Send([]byte)                              {}
+func (NoopExplorerClient) SendAndWait([]byte) error                 { return nil }
 func (NoopExplorerClient) Receive(...time.Duration) ([]byte, error) { return nil, nil }
 
 type explorerClient struct {
 	boot      *sync.Mutex
 	conn      *websocket.Conn
 	cancel    context.CancelFunc
-	send      chan []byte
+	send      chan outgoingMessage
 	receive   chan []byte
 	sleeper   utils.Sleeper
 	started   bool
@@ -77,7 +82,7 @@ type explorerClient struct {
 func NewExplorerClient(url *url.URL, accessKey, secret string) ExplorerClient {
 	return &explorerClient{
 		url:       url,
-		send:      make(chan []byte),
+		send:      make(chan outgoingMessage),
 		receive:   make(chan []byte),
 		boot:      &sync.Mutex{},
 		sleeper:   utils.NewBackoffSleeper(),
@@ -125,7 +130,28 @@ func (ec *explorerClient) Start() error {
 // holds it in a small buffer until connection, throwing away messages
 // once buffer is full.
 func (ec *explorerClient) Send(data []byte) {
-	ec.send <- data
+	ec.send <- outgoingMessage{data: data}
+}
+
+// outgoingMessage is a message for the write pump, with a channel to be told
+// whether it was written if the sender is waiting on that.
+type outgoingMessage struct {
+	data    []byte
+	written chan error
+}
+
+// SendAndWait sends data across the websocket, blocking the caller until it
+// has been written to the connection. An error is returned if the write
+// failed, or if the websocket did not take the message within the write
+// deadline.
+func (ec *explorerClient) SendAndWait(data []byte) error {
+	written := make(chan error, 1)
+	select {
+	case ec.send <- outgoingMessage{data: data, written: written}:
+	case <-time.After(writeWait):
+		return ErrSendTimeout
+	}
+	return <-written
 }
 
 // Receive blocks the caller while waiting for a response from the server,
@@ -225,7 +251,10 @@ func (ec *explorerClient) writePump(ctx context.Context) {
 				ec.wrapConnErrorIf(ec.conn.WriteMessage(websocket.CloseMessage, []byte{}))
 			}
 
-			err := ec.writeMessage(message)
+			err := ec.writeMessage(message.data)
+			if message.written != nil {
+				message.written <- err
+			}
 			if err != nil {
 				logger.Error("websocketStatsPusher: ", err)
 				return
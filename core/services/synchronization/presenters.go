@@ -51,16 +51,21 @@ func (p SyncJobRunPresenter) MarshalJSON() ([]byte, error) {
 }
 
 func (p SyncJobRunPresenter) initiator() syncInitiatorPresenter {
-	var eip *models.EIP55Address
+	var eip, oracle *models.EIP55Address
 	if p.RunRequest.Requester != nil {
 		coerced := models.EIP55Address(p.RunRequest.Requester.Hex())
 		eip = &coerced
 	}
+	if p.RunRequest.Oracle != nil {
+		coerced := models.EIP55Address(p.RunRequest.Oracle.Hex())
+		oracle = &coerced
+	}
 	return syncInitiatorPresenter{
 		Type:      p.Initiator.Type,
 		RequestID: p.RunRequest.RequestID,
 		TxHash:    p.RunRequest.TxHash,
 		Requester: eip,
+		Oracle:    oracle,
 	}
 }
 
@@ -138,6 +143,7 @@ type syncInitiatorPresenter struct {
 	RequestID *common.Hash         `json:"requestId,omitempty"`
 	TxHash    *common.Hash         `json:"txHash,omitempty"`
 	Requester *models.EIP55Address `json:"requester,omitempty"`
+	Oracle    *models.EIP55Address `json:"oracle,omitempty"`
 }
 
 type syncTaskRunPresenter struct {
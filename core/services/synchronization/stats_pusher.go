@@ -24,6 +24,18 @@ var (
 		Name: "stats_pusher_events_sent",
 		Help: "The number of events pushed up to explorer",
 	})
+	numberEventsDelivered = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "stats_pusher_events_delivered",
+		Help: "The number of events acknowledged by explorer, or written to it in the case of telemetry",
+	})
+	numberDeliveryFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "stats_pusher_delivery_failures",
+		Help: "The number of failed attempts to push events up to explorer",
+	})
+	numberEventsPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "stats_pusher_events_pending",
+		Help: "The number of events waiting to be pushed up to explorer",
+	})
 
 	gormCallbacksMutex *sync.RWMutex
 )
@@ -35,8 +47,9 @@ func init() {
 //go:generate mockery --name StatsPusher --output ../../internal/mocks/ --case=underscore
 
 // StatsPusher polls for events and pushes them via a WebSocketClient. Events
-// are consumed by the Explorer. Currently there is only one event type: an
-// encoding of a JobRun.
+// are consumed by the Explorer, and are either an encoding of a JobRun or a
+// telemetry log. Events are kept in the sync_events table until they have
+// been delivered, so that they survive restarts and explorer outages.
 type StatsPusher interface {
 	Start() error
 	Close() error
@@ -194,11 +207,19 @@ func (sp *statsPusher) pusherLoop(parentCtx context.Context) error {
 func (sp *statsPusher) pushEvents() error {
 	gormCallbacksMutex.RLock()
 	defer gormCallbacksMutex.RUnlock()
+	defer sp.updatePendingCount()
+
+	if sp.ExplorerClient.Status() != ConnectionStatusConnected {
+		numberDeliveryFailures.Inc()
+		return errors.New("pushEvents: explorer is not connected")
+	}
+
 	err := sp.ORM.AllSyncEvents(func(event models.SyncEvent) error {
 		return sp.syncEvent(event)
 	})
 
 	if err != nil {
+		numberDeliveryFailures.Inc()
 		return errors.Wrap(err, "pushEvents#AllSyncEvents failed")
 	}
 
@@ -206,7 +227,26 @@ func (sp *statsPusher) pushEvents() error {
 	return nil
 }
 
+func (sp *statsPusher) updatePendingCount() {
+	count, err := sp.ORM.CountOf(&models.SyncEvent{})
+	if err != nil {
+		logger.Warnw("Unable to count pending sync events", "error", err)
+		return
+	}
+	numberEventsPending.Set(float64(count))
+}
+
 func (sp *statsPusher) syncEvent(event models.SyncEvent) error {
+	if event.Telemetry {
+		// The explorer does not acknowledge telemetry, so it is delivered once
+		// it has been written to the connection.
+		if err := sp.ExplorerClient.SendAndWait([]byte(event.Body)); err != nil {
+			return errors.Wrap(err, "syncEvent#ExplorerClient.SendAndWait failed")
+		}
+		numberEventsSent.Inc()
+		return sp.deleteEvent(event)
+	}
+
 	sp.ExplorerClient.Send([]byte(event.Body))
 	numberEventsSent.Inc()
 
@@ -225,13 +265,17 @@ func (sp *statsPusher) syncEvent(event models.SyncEvent) error {
 		return errors.New("event not created")
 	}
 
-	err = sp.ORM.RawDB(func(db *gorm.DB) error {
+	return sp.deleteEvent(event)
+}
+
+func (sp *statsPusher) deleteEvent(event models.SyncEvent) error {
+	err := sp.ORM.RawDB(func(db *gorm.DB) error {
 		return db.Delete(event).Error
 	})
 	if err != nil {
 		return errors.Wrap(err, "syncEvent#DB.Delete failed")
 	}
-
+	numberEventsDelivered.Inc()
 	return nil
 }
 
@@ -123,6 +123,44 @@ func TestStatsPusher_BadSyncLeavesEvent(t *testing.T) {
 	cltest.AssertSyncEventCountStays(t, store.ORM, 1)
 }
 
+func TestStatsPusher_TelemetryDeletedOnceWritten(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	wsserver, wscleanup := cltest.NewEventWebSocketServer(t)
+	defer wscleanup()
+
+	explorerClient := synchronization.NewExplorerClient(wsserver.URL, "", "")
+	err := explorerClient.Start()
+	require.NoError(t, err)
+
+	pusher := synchronization.NewStatsPusher(store.ORM, explorerClient)
+	pusher.Start()
+	defer pusher.Close()
+
+	require.NoError(t, store.ORM.CreateSyncEvent(&models.SyncEvent{Body: "telemetry", Telemetry: true}))
+	pusher.PushNow()
+
+	cltest.CallbackOrTimeout(t, "ws server receives telemetry", func() {
+		<-wsserver.Received
+	})
+	cltest.WaitForSyncEventCount(t, store.ORM, 0)
+}
+
+func TestStatsPusher_DisconnectedLeavesEvent(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	pusher := synchronization.NewStatsPusher(store.ORM, synchronization.NoopExplorerClient{})
+	pusher.Start()
+	defer pusher.Close()
+
+	require.NoError(t, store.ORM.CreateSyncEvent(&models.SyncEvent{Body: "telemetry", Telemetry: true}))
+	pusher.PushNow()
+
+	cltest.AssertSyncEventCountStays(t, store.ORM, 1)
+}
+
 func lenSyncEvents(t *testing.T, orm *orm.ORM) int {
 	count, err := orm.CountOf(&models.SyncEvent{})
 	require.NoError(t, err)
@@ -1,18 +1,70 @@
 package telemetry
 
-import "github.com/smartcontractkit/chainlink/core/services/synchronization"
+import (
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+)
+
+const (
+	// flushInterval is how long telemetry logs are collected before being
+	// queued in the database together.
+	flushInterval = time.Second
+	// maxBufferedLogs is how many logs are collected between flushes before
+	// further logs are dropped.
+	maxBufferedLogs = 1000
+	// maxQueuedLogs is how many logs are kept queued in the database while the
+	// explorer is unreachable. Older logs are discarded beyond it.
+	maxQueuedLogs = 10000
+)
 
 type Agent struct {
-	explorerClient synchronization.ExplorerClient
+	orm         *orm.ORM
+	statsPusher synchronization.StatsPusher
+
+	mu       sync.Mutex
+	buffered []string
+	dropped  int
 }
 
-// NewAgent returns a Agent which is just a thin wrapper over
-// the explorerClient for now
-func NewAgent(explorerClient synchronization.ExplorerClient) *Agent {
-	return &Agent{explorerClient}
+// NewAgent returns a Agent which queues telemetry logs as sync events, to be
+// pushed to the explorer by the stats pusher. Logs are written to the
+// database in batches, so those sent in the last flushInterval before the
+// node stops are lost.
+func NewAgent(orm *orm.ORM, statsPusher synchronization.StatsPusher) *Agent {
+	return &Agent{orm: orm, statsPusher: statsPusher}
 }
 
 // SendLog sends a telemetry log to the explorer
 func (t *Agent) SendLog(log []byte) {
-	t.explorerClient.Send(log)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.buffered) >= maxBufferedLogs {
+		t.dropped++
+		return
+	}
+	if len(t.buffered) == 0 {
+		time.AfterFunc(flushInterval, t.flush)
+	}
+	t.buffered = append(t.buffered, string(log))
+}
+
+func (t *Agent) flush() {
+	t.mu.Lock()
+	logs, dropped := t.buffered, t.dropped
+	t.buffered, t.dropped = nil, 0
+	t.mu.Unlock()
+
+	if dropped > 0 {
+		logger.Warnw("Dropped telemetry logs sent faster than they could be queued", "dropped", dropped)
+	}
+	if err := t.orm.CreateTelemetrySyncEvents(logs, maxQueuedLogs); err != nil {
+		logger.Errorw("Unable to queue telemetry logs", "error", err, "count", len(logs))
+		return
+	}
+	t.statsPusher.PushNow()
 }
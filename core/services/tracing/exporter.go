@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the spans recorded by the node.
+const instrumentationName = "github.com/smartcontractkit/chainlink"
+
+// Tracer returns the tracer the node records its spans with. Until an
+// exporter is started, spans started with it are not recorded.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Exporter sends the spans recorded by the node to a tracing backend.
+type Exporter interface {
+	Start() error
+	Stop() error
+}
+
+// NoopExporter leaves tracing disabled.
+type NoopExporter struct{}
+
+// Start does nothing
+func (*NoopExporter) Start() error { return nil }
+
+// Stop does nothing
+func (*NoopExporter) Stop() error { return nil }
+
+// otlpTimeout is how long the exporter waits for the collector to accept a
+// batch of spans, including retries.
+const otlpTimeout = 10 * time.Second
+
+// OTLPExporter sends spans to an OpenTelemetry collector over OTLP/HTTP. Once
+// started, its tracer provider is the global one, which batches spans and
+// retries failed requests. Spans which end while its queue is full are
+// dropped rather than slowing down the node.
+type OTLPExporter struct {
+	provider *sdktrace.TracerProvider
+	utils.StartStopOnce
+}
+
+// NewOTLPExporter returns an exporter posting to the given traces endpoint,
+// for example http://localhost:4318/v1/traces, which records the given
+// fraction of runs.
+func NewOTLPExporter(endpoint *url.URL, ratio float64) *OTLPExporter {
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(endpoint.Host),
+		otlptracehttp.WithURLPath(endpoint.Path),
+		otlptracehttp.WithTimeout(otlpTimeout),
+	}
+	if endpoint.Scheme != "https" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	return &OTLPExporter{
+		provider: newTracerProvider(ratio, sdktrace.WithBatcher(otlptracehttp.NewUnstarted(opts...))),
+	}
+}
+
+// newTracerProvider returns a tracer provider which records the given
+// fraction of traces, deciding by trace ID so that every span of a run gets
+// the same answer.
+func newTracerProvider(ratio float64, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	attributes := []attribute.KeyValue{semconv.ServiceNameKey.String("chainlink")}
+	if hostname, err := os.Hostname(); err == nil {
+		attributes = append(attributes, semconv.HostNameKey.String(hostname))
+	}
+	return sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, attributes...)),
+		sdktrace.WithIDGenerator(runIDGenerator{}),
+		sdktrace.WithSampler(sdktrace.TraceIDRatioBased(ratio)),
+	}, opts...)...)
+}
+
+// Start makes the exporter's tracer provider the global one.
+func (e *OTLPExporter) Start() error {
+	return e.StartOnce("OTLPExporter", func() error {
+		otel.SetTracerProvider(e.provider)
+		return nil
+	})
+}
+
+// Stop sends any spans still queued and stops the exporter.
+func (e *OTLPExporter) Stop() error {
+	return e.StopOnce("OTLPExporter", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), otlpTimeout)
+		defer cancel()
+		return e.provider.Shutdown(ctx)
+	})
+}
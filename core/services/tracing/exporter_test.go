@@ -0,0 +1,63 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestOTLPExporter_PostsToEndpoint(t *testing.T) {
+	chRequests := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chRequests <- r
+	}))
+	defer server.Close()
+
+	endpoint, err := url.Parse(server.URL + "/v1/traces")
+	require.NoError(t, err)
+	exporter := tracing.NewOTLPExporter(endpoint, 1)
+	require.NoError(t, exporter.Start())
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	_, span := tracing.Tracer().Start(tracing.RunContext(context.Background(), models.NewID()), "job_run.create")
+	span.End()
+	require.NoError(t, exporter.Stop())
+
+	select {
+	case r := <-chRequests:
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for spans to be exported")
+	}
+}
+
+func TestTracerProvider_SamplesWholeRuns(t *testing.T) {
+	useSpanRecorder(t, 0.5)
+
+	var sampled, unsampled int
+	for i := 0; i < 100; i++ {
+		runID := models.NewID()
+		run := startSpan(tracing.RunContext(context.Background(), runID))
+		task := startSpan(tracing.TaskContext(context.Background(), runID, models.NewID()))
+		require.Equal(t, run.IsSampled(), task.IsSampled())
+		if run.IsSampled() {
+			sampled++
+		} else {
+			unsampled++
+		}
+	}
+	assert.NotZero(t, sampled)
+	assert.NotZero(t, unsampled)
+}
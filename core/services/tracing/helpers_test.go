@@ -0,0 +1,9 @@
+package tracing
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func ExportedNewTracerProvider(ratio float64, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	return newTracerProvider(ratio, opts...)
+}
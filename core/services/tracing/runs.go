@@ -0,0 +1,136 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The spans of a job run and its tasks are given IDs derived from the IDs of
+// the run and task runs, rather than random ones. This lets spans recorded
+// at different times, possibly across restarts of the node, be joined into a
+// single trace without persisting any tracing state: the run's ID is its
+// trace ID, and every span recorded for the run is a descendant of the
+// run's root span.
+
+// RunContext returns ctx with the root span of a job run as its parent, so
+// that spans started in it join the run's trace.
+func RunContext(ctx context.Context, runID *models.ID) context.Context {
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: runTraceID(runID),
+		SpanID:  deriveSpanID("job_run", runID),
+		Remote:  true,
+	}))
+}
+
+// TaskContext returns ctx with the span of a task run as its parent, so that
+// spans started in it join the trace of the task's job run.
+func TaskContext(ctx context.Context, runID *models.ID, taskRunID *models.ID) context.Context {
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: runTraceID(runID),
+		SpanID:  deriveSpanID("task_run", taskRunID),
+		Remote:  true,
+	}))
+}
+
+func runTraceID(runID *models.ID) (traceID trace.TraceID) {
+	copy(traceID[:], runID.Bytes())
+	return traceID
+}
+
+func deriveSpanID(kind string, id *models.ID) (spanID trace.SpanID) {
+	sum := sha256.Sum256(append([]byte(kind+":"), id.Bytes()...))
+	copy(spanID[:], sum[:])
+	return spanID
+}
+
+type derivedSpanKey struct{}
+
+// derivedSpan names the run or task run whose span is being started.
+type derivedSpan struct {
+	kind  string
+	runID *models.ID
+	id    *models.ID
+}
+
+// runIDGenerator gives the span started in a context carrying a derivedSpan
+// the IDs derived for it, and random IDs to every other span.
+type runIDGenerator struct{}
+
+func (runIDGenerator) NewIDs(ctx context.Context) (traceID trace.TraceID, spanID trace.SpanID) {
+	if span, ok := ctx.Value(derivedSpanKey{}).(derivedSpan); ok {
+		return runTraceID(span.runID), deriveSpanID(span.kind, span.id)
+	}
+	_, _ = rand.Read(traceID[:])
+	_, _ = rand.Read(spanID[:])
+	return traceID, spanID
+}
+
+func (runIDGenerator) NewSpanID(ctx context.Context, _ trace.TraceID) (spanID trace.SpanID) {
+	if span, ok := ctx.Value(derivedSpanKey{}).(derivedSpan); ok {
+		return deriveSpanID(span.kind, span.id)
+	}
+	_, _ = rand.Read(spanID[:])
+	return spanID
+}
+
+// RecordRun records the root span of a finished job run, covering its whole
+// life from creation, and a span for each of its tasks which was started.
+func RecordRun(run *models.JobRun) {
+	end := time.Now()
+	if run.FinishedAt.Valid {
+		end = run.FinishedAt.Time
+	}
+
+	ctx := context.WithValue(context.Background(), derivedSpanKey{}, derivedSpan{"job_run", run.ID, run.ID})
+	ctx, root := Tracer().Start(ctx, "job_run",
+		trace.WithTimestamp(run.CreatedAt),
+		trace.WithAttributes(
+			attribute.String("job.id", run.JobSpecID.String()),
+			attribute.String("run.id", run.ID.String()),
+			attribute.String("run.initiator", string(run.Initiator.Type)),
+			attribute.String("run.status", string(run.Status)),
+		),
+	)
+	if !root.IsRecording() {
+		return
+	}
+	if run.Result.ErrorMessage.Valid {
+		root.SetStatus(codes.Error, run.Result.ErrorMessage.String)
+	}
+
+	for i := range run.TaskRuns {
+		tr := &run.TaskRuns[i]
+		if !tr.StartedAt.Valid {
+			continue
+		}
+		taskEnd := end
+		if tr.FinishedAt.Valid {
+			taskEnd = tr.FinishedAt.Time
+		}
+		taskCtx := context.WithValue(ctx, derivedSpanKey{}, derivedSpan{"task_run", run.ID, tr.ID})
+		_, span := Tracer().Start(taskCtx, "task_run "+string(tr.TaskSpec.Type),
+			trace.WithTimestamp(tr.StartedAt.Time),
+			trace.WithAttributes(
+				attribute.String("task.id", tr.ID.String()),
+				attribute.String("task.type", string(tr.TaskSpec.Type)),
+				attribute.Int64("task.attempts", int64(tr.Attempts)),
+				attribute.Int64("task.bytes_transferred", tr.BytesTransferred),
+				attribute.String("task.status", string(tr.Status)),
+			),
+		)
+		if tr.Result.ErrorMessage.Valid {
+			span.SetStatus(codes.Error, tr.Result.ErrorMessage.String)
+		}
+		span.End(trace.WithTimestamp(taskEnd))
+	}
+
+	root.End(trace.WithTimestamp(end))
+}
@@ -0,0 +1,123 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/guregu/null.v3"
+)
+
+func useSpanRecorder(t *testing.T, ratio float64) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(tracing.ExportedNewTracerProvider(ratio, sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+	return recorder
+}
+
+func startSpan(ctx context.Context) trace.SpanContext {
+	_, span := tracing.Tracer().Start(ctx, "test")
+	defer span.End()
+	return span.SpanContext()
+}
+
+func TestRunContext_Deterministic(t *testing.T) {
+	useSpanRecorder(t, 1)
+	runID := models.NewID()
+	taskRunID := models.NewID()
+
+	root := trace.SpanContextFromContext(tracing.RunContext(context.Background(), runID))
+	traceID := root.TraceID()
+	assert.Equal(t, runID.Bytes(), traceID[:])
+	assert.True(t, root.IsValid())
+	assert.Equal(t, root, trace.SpanContextFromContext(tracing.RunContext(context.Background(), runID)))
+
+	task := trace.SpanContextFromContext(tracing.TaskContext(context.Background(), runID, taskRunID))
+	assert.Equal(t, root.TraceID(), task.TraceID())
+	assert.NotEqual(t, root.SpanID(), task.SpanID())
+	assert.Equal(t, task, trace.SpanContextFromContext(tracing.TaskContext(context.Background(), runID, taskRunID)))
+
+	child := startSpan(tracing.TaskContext(context.Background(), runID, taskRunID))
+	assert.Equal(t, root.TraceID(), child.TraceID())
+	assert.NotEqual(t, task.SpanID(), child.SpanID())
+}
+
+func TestRecordRun(t *testing.T) {
+	recorder := useSpanRecorder(t, 1)
+
+	created := time.Now().Add(-time.Minute)
+	run := models.JobRun{
+		ID:         models.NewID(),
+		JobSpecID:  models.NewID(),
+		Status:     models.RunStatusErrored,
+		CreatedAt:  created,
+		FinishedAt: null.TimeFrom(created.Add(30 * time.Second)),
+		Initiator:  models.Initiator{Type: models.InitiatorWeb},
+		Result:     models.RunResult{ErrorMessage: null.StringFrom("bridge failed")},
+		TaskRuns: []models.TaskRun{
+			{
+				ID:         models.NewID(),
+				Status:     models.RunStatusCompleted,
+				TaskSpec:   models.TaskSpec{Type: models.MustNewTaskType("httpget")},
+				Attempts:   1,
+				StartedAt:  null.TimeFrom(created.Add(time.Second)),
+				FinishedAt: null.TimeFrom(created.Add(2 * time.Second)),
+			},
+			{
+				ID:       models.NewID(),
+				Status:   models.RunStatusUnstarted,
+				TaskSpec: models.TaskSpec{Type: models.MustNewTaskType("ethtx")},
+			},
+		},
+	}
+	tracing.RecordRun(&run)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	task, root := spans[0], spans[1]
+
+	runContext := trace.SpanContextFromContext(tracing.RunContext(context.Background(), run.ID))
+	assert.Equal(t, "job_run", root.Name())
+	assert.Equal(t, runContext.TraceID(), root.SpanContext().TraceID())
+	assert.Equal(t, runContext.SpanID(), root.SpanContext().SpanID())
+	assert.False(t, root.Parent().IsValid())
+	assert.Equal(t, created, root.StartTime())
+	assert.Equal(t, run.FinishedAt.Time, root.EndTime())
+	assert.Contains(t, root.Attributes(), attribute.String("run.initiator", "web"))
+	assert.Equal(t, codes.Error, root.Status().Code)
+	assert.Equal(t, "bridge failed", root.Status().Description)
+	assert.Contains(t, root.Resource().Attributes(), attribute.String("service.name", "chainlink"))
+
+	taskContext := trace.SpanContextFromContext(tracing.TaskContext(context.Background(), run.ID, run.TaskRuns[0].ID))
+	assert.Equal(t, "task_run httpget", task.Name())
+	assert.Equal(t, taskContext.TraceID(), task.SpanContext().TraceID())
+	assert.Equal(t, taskContext.SpanID(), task.SpanContext().SpanID())
+	assert.Equal(t, root.SpanContext().SpanID(), task.Parent().SpanID())
+	assert.Equal(t, run.TaskRuns[0].StartedAt.Time, task.StartTime())
+	assert.Equal(t, run.TaskRuns[0].FinishedAt.Time, task.EndTime())
+	assert.Equal(t, codes.Unset, task.Status().Code)
+}
+
+func TestRecordRun_Unsampled(t *testing.T) {
+	recorder := useSpanRecorder(t, 0)
+
+	run := models.JobRun{ID: models.NewID(), JobSpecID: models.NewID(), CreatedAt: time.Now()}
+	tracing.RecordRun(&run)
+	assert.Empty(t, recorder.Ended())
+}
+
+func TestRecordRun_Disabled(t *testing.T) {
+	run := models.JobRun{ID: models.NewID(), JobSpecID: models.NewID(), CreatedAt: time.Now()}
+	assert.NotPanics(t, func() { tracing.RecordRun(&run) })
+}
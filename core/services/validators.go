@@ -1,14 +1,16 @@
 package services
 
 import (
-	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/mail"
 	"net/url"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -17,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/models/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/tidwall/gjson"
@@ -33,6 +36,9 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 	if len(j.Initiators) < 1 || len(j.Tasks) < 1 {
 		fe.Add("Must have at least one Initiator and one Task")
 	}
+	if !j.Priority.Valid() {
+		fe.Add(fmt.Sprintf("Priority must be one of %q, %q or %q", models.RunPriorityHigh, models.RunPriorityNormal, models.RunPriorityLow))
+	}
 	for _, i := range j.Initiators {
 		if err := ValidateInitiator(i, j, store); err != nil {
 			fe.Merge(err)
@@ -43,6 +49,92 @@ func ValidateJob(j models.JobSpec, store *store.Store) error {
 			fe.Merge(err)
 		}
 	}
+	if err := validateTaskVariables(j.Tasks); err != nil {
+		fe.Merge(err)
+	}
+	if err := validateChain(j, store); err != nil {
+		fe.Merge(err)
+	}
+	if err := validateFailureNotifications(j.FailureNotifications, store); err != nil {
+		fe.Merge(err)
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+// validateFailureNotifications checks that a job notifying of its failures
+// has somewhere to send them. Emails are sent through the node's alert SMTP
+// server, so can only be declared when one is configured.
+func validateFailureNotifications(fn *models.FailureNotifications, store *store.Store) error {
+	if fn == nil {
+		return nil
+	}
+	fe := models.NewJSONAPIErrors()
+	if fn.WebhookURL == nil && len(fn.Email) == 0 {
+		fe.Add("Failure notifications must have a webhookUrl or email recipients")
+	}
+	if fn.WebhookURL != nil && fn.WebhookURL.Scheme != "http" && fn.WebhookURL.Scheme != "https" {
+		fe.Add("Failure notification webhookUrl must be an http or https URL")
+	}
+	for _, address := range fn.Email {
+		if _, err := mail.ParseAddress(address); err != nil {
+			fe.Add(fmt.Sprintf("Failure notification email %q is not a valid address", address))
+		}
+	}
+	if len(fn.Email) > 0 && store.Config.AlertEmailSMTPAddress() == "" {
+		fe.Add("Failure notification emails require ALERT_EMAIL_SMTP_ADDRESS to be set")
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+// validateChain checks that a job is bound to a chain the node serves.
+func validateChain(j models.JobSpec, store *store.Store) error {
+	if j.ChainID == nil || j.ChainID.ToInt().Cmp(store.Config.ChainID()) == 0 {
+		return nil
+	}
+
+	for _, chain := range store.Config.EVMChains() {
+		if chain.ChainID.ToInt().Cmp(j.ChainID.ToInt()) == 0 {
+			return nil
+		}
+	}
+	fe := models.NewJSONAPIErrors()
+	fe.Add(fmt.Sprintf("Chain %s is not configured on this node", j.ChainID))
+	return fe.CoerceEmptyToNil()
+}
+
+// validateTaskVariables checks that task names are unique, that tasks only
+// reference or take as inputs the output of named tasks which run before
+// them, and that their templates refer to the run, the job or permitted
+// environment variables.
+func validateTaskVariables(tasks []models.TaskSpec) error {
+	fe := models.NewJSONAPIErrors()
+	named := make(map[string]bool)
+	for i, task := range tasks {
+		if err := models.ValidateTemplates(task.Params); err != nil {
+			fe.Add(fmt.Sprintf("Task %d: %v", i, err))
+		}
+		for _, name := range models.TaskVariableReferences(task.Params) {
+			if !named[name] {
+				fe.Add(fmt.Sprintf("Task %d references $(%s), which is not the name of an earlier task", i, name))
+			}
+		}
+		if task.Inputs != nil {
+			for _, name := range *task.Inputs {
+				if !named[name] {
+					fe.Add(fmt.Sprintf("Task %d takes the input %s, which is not the name of an earlier task", i, name))
+				}
+			}
+		}
+		if task.Name == "" {
+			continue
+		}
+		if !models.ValidTaskName(task.Name) {
+			fe.Add(fmt.Sprintf("Task name %q may only contain letters, numbers, underscores and hyphens", task.Name))
+		} else if named[task.Name] {
+			fe.Add(fmt.Sprintf("Task name %q is used more than once", task.Name))
+		}
+		named[task.Name] = true
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -76,6 +168,12 @@ func ValidateBridgeType(bt *models.BridgeTypeRequest, store *store.Store) error
 		bt.MinimumContractPayment.Cmp(assets.NewLink(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if _, err := utils.ParseProxyURL(bt.Proxy); err != nil {
+		fe.Add(err.Error())
+	}
+	if bt.CircuitBreakerThreshold == 0 && !bt.CircuitBreakerCooldown.IsInstant() {
+		fe.Add("CircuitBreakerCooldown requires a CircuitBreakerThreshold")
+	}
 	ts := models.TaskSpec{Type: bt.Name}
 	if a := adapters.FindNativeAdapterFor(ts); a != nil {
 		fe.Add(fmt.Sprintf("Bridge Type %v is a native adapter", bt.Name))
@@ -108,6 +206,10 @@ func ValidateExternalInitiator(
 
 // ValidateInitiator checks the Initiator for any application logic errors.
 func ValidateInitiator(i models.Initiator, j models.JobSpec, store *store.Store) error {
+	if i.MinIncomingInterval != nil && i.MinIncomingInterval.Duration() <= 0 {
+		return models.NewJSONAPIErrorsWith("minIncomingInterval must be positive")
+	}
+
 	switch strings.ToLower(i.Type) {
 	case models.InitiatorRunAt:
 		return validateRunAtInitiator(i, j)
@@ -124,9 +226,21 @@ func ValidateInitiator(i models.Initiator, j models.JobSpec, store *store.Store)
 	case models.InitiatorWeb:
 		return nil
 	case models.InitiatorEthLog:
-		return nil
+		return validateEthLogInitiator(i)
 	case models.InitiatorRandomnessLog:
 		return validateRandomnessLogInitiator(i, j)
+	case models.InitiatorBalanceThreshold:
+		return validateBalanceThresholdInitiator(i)
+	case models.InitiatorWebhook:
+		return validateWebhookInitiator(i)
+	case models.InitiatorKafka:
+		return validateKafkaInitiator(i)
+	case models.InitiatorMQTT:
+		return validateMQTTInitiator(i)
+	case models.InitiatorBlockInterval:
+		return validateBlockIntervalInitiator(i)
+	case models.InitiatorGasPrice:
+		return validateGasPriceInitiator(i)
 	default:
 		return models.NewJSONAPIErrorsWith(fmt.Sprintf("type %v does not exist", i.Type))
 	}
@@ -157,6 +271,15 @@ func validateFluxMonitor(i models.Initiator, j models.JobSpec, store *store.Stor
 			"means that the value can change by up to 0.01 units " +
 			"before a new report is made")
 	}
+	if i.ReleaseThreshold < 0 || i.AbsoluteReleaseThreshold < 0 {
+		fe.Add("releaseThreshold and absoluteReleaseThreshold must not be negative")
+	}
+	if i.Threshold > 0 && i.ReleaseThreshold >= i.Threshold {
+		fe.Add("releaseThreshold must be less than threshold")
+	}
+	if i.AbsoluteReleaseThreshold > 0 && i.AbsoluteReleaseThreshold >= i.AbsoluteThreshold {
+		fe.Add("absoluteReleaseThreshold must be less than absoluteThreshold")
+	}
 
 	if i.PollTimer.Disabled && i.IdleTimer.Disabled {
 		fe.Add("must enable pollTimer, idleTimer, or both")
@@ -192,38 +315,68 @@ func validateFluxMonitor(i models.Initiator, j models.JobSpec, store *store.Stor
 		fe.Add(err.Error())
 	}
 
+	switch i.Aggregation.Strategy {
+	case "", models.AggregationMedian, models.AggregationMean:
+		if i.Aggregation.TrimPercent != 0 {
+			fe.Add("aggregation trimPercent is only used by the trimmedmean strategy")
+		}
+	case models.AggregationTrimmedMean:
+		if i.Aggregation.TrimPercent <= 0 || i.Aggregation.TrimPercent >= 50 {
+			fe.Add("aggregation trimPercent must be greater than 0 and less than 50")
+		}
+	default:
+		fe.Add(fmt.Sprintf("aggregation strategy must be one of %s, %s or %s", models.AggregationMedian, models.AggregationMean, models.AggregationTrimmedMean))
+	}
+	if i.Aggregation.MaxDeviationPercent < 0 {
+		fe.Add("aggregation maxDeviationPercent must not be negative")
+	}
+	if i.Aggregation.MaxMADs < 0 {
+		fe.Add("aggregation maxMADs must not be negative")
+	}
+	if feeds, err := models.ParseFeeds(i.Feeds); err == nil && int(i.Aggregation.MinAnswers) > len(feeds) {
+		fe.Add("aggregation minAnswers must not be more than the number of feeds")
+	}
+
 	return fe.CoerceEmptyToNil()
 }
 
 func validateFeeds(feeds models.Feeds, store *store.Store) error {
-	var feedsData []interface{}
-	if err := json.Unmarshal(feeds.Bytes(), &feedsData); err != nil {
-		return errors.New("invalid json for feeds parameter")
+	parsed, err := models.ParseFeeds(feeds)
+	if err != nil {
+		return errors.Wrap(err, "invalid json for feeds parameter")
 	}
-	if len(feedsData) == 0 {
+	if len(parsed) == 0 {
 		return errors.New("feeds field is empty")
 	}
 
 	var bridgeNames []string
-	for _, entry := range feedsData {
-		switch feed := entry.(type) {
-		case string:
-			if _, err := url.ParseRequestURI(feed); err != nil {
+	for _, feed := range parsed {
+		switch {
+		case feed.URL != "" && feed.Bridge != "":
+			return errors.New("feed must have either a url or a bridge, not both")
+		case feed.URL != "":
+			if _, err := url.ParseRequestURI(feed.URL); err != nil {
 				return err
 			}
-		case map[string]interface{}: // named feed - ex: {"bridge": "bridgeName"}
-			bridgeName := feed["bridge"]
-			bridgeNameString, ok := bridgeName.(string)
-			if bridgeName == nil {
-				return errors.New("Feeds object missing bridge key")
-			} else if len(feed) != 1 {
-				return errors.New("Unsupported keys in feed JSON")
-			} else if !ok {
-				return errors.New("Unsupported bridge name type in feed JSON")
-			}
-			bridgeNames = append(bridgeNames, bridgeNameString)
+		case feed.Bridge != "":
+			bridgeNames = append(bridgeNames, feed.Bridge)
 		default:
-			return errors.New("Unknown feed type")
+			return errors.New("feed must have a url or a bridge")
+		}
+
+		if method := feed.RequestMethod(); method != http.MethodPost && method != http.MethodGet {
+			return fmt.Errorf("feed method must be %s or %s", http.MethodPost, http.MethodGet)
+		}
+		if feed.Body != nil {
+			if _, err := feed.Body.AsMap(); err != nil {
+				return errors.New("feed body must be a JSON object")
+			}
+		}
+		if feed.Timeout != nil && feed.Timeout.Duration() <= 0 {
+			return errors.New("feed timeout must be positive")
+		}
+		if feed.Weight < 0 {
+			return errors.New("feed weight must not be negative")
 		}
 	}
 	if _, err := store.ORM.FindBridgesByNames(bridgeNames); err != nil {
@@ -264,6 +417,15 @@ func validateRunLogInitiator(i models.Initiator, j models.JobSpec, s *store.Stor
 	if ethTxCount > 1 {
 		fe.Add("Cannot RunLog initiated jobs cannot have more than one EthTx Task")
 	}
+	oracles := map[common.Address]bool{i.Address: true}
+	for _, address := range i.Addresses {
+		if address == utils.ZeroAddress {
+			fe.Add("RunLog addresses must not include the zero address")
+		} else if oracles[address] {
+			fe.Add(fmt.Sprintf("RunLog address %s is listed more than once", address.Hex()))
+		}
+		oracles[address] = true
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -276,14 +438,33 @@ func validateRunAtInitiator(i models.Initiator, j models.JobSpec) error {
 	} else if j.EndAt.Valid && i.Time.Time.Unix() > j.EndAt.Time.Unix() {
 		fe.Add("RunAt time must be before job's EndAt")
 	}
+	if i.Every != nil && i.Every.Duration() < time.Second {
+		fe.Add("RunAt every must be at least 1s")
+	}
+	if i.Until.Valid {
+		if i.Every == nil {
+			fe.Add("RunAt until requires every to be set")
+		} else if i.Time.Valid && i.Until.Time.Before(i.Time.Time) {
+			fe.Add("RunAt until must be after its time")
+		}
+	}
 	return fe.CoerceEmptyToNil()
 }
 
 func validateCronInitiator(i models.Initiator) error {
-	if i.Schedule == "" {
+	schedules := i.CronSchedules()
+	if len(schedules) == 0 {
 		return models.NewJSONAPIErrorsWith("Schedule must have a cron")
 	}
-	return nil
+	fe := models.NewJSONAPIErrors()
+	seen := make(map[models.Cron]bool)
+	for _, schedule := range schedules {
+		if seen[schedule] {
+			fe.Add(fmt.Sprintf("Schedule %s is given more than once", schedule))
+		}
+		seen[schedule] = true
+	}
+	return fe.CoerceEmptyToNil()
 }
 
 func validateExternalInitiator(i models.Initiator) error {
@@ -293,6 +474,61 @@ func validateExternalInitiator(i models.Initiator) error {
 	return nil
 }
 
+// minWebhookSecretLength is the shortest secret a webhook initiator may be
+// given, so that its signatures cannot feasibly be forged.
+const minWebhookSecretLength = 16
+
+func validateWebhookInitiator(i models.Initiator) error {
+	if len(i.Secret) < minWebhookSecretLength {
+		return models.NewJSONAPIErrorsWith(fmt.Sprintf("Webhook must have a secret of at least %d characters", minWebhookSecretLength))
+	}
+	return nil
+}
+
+func validateKafkaInitiator(i models.Initiator) error {
+	if i.Kafka == nil {
+		return models.NewJSONAPIErrorsWith("Kafka must have a kafka config")
+	}
+	fe := models.NewJSONAPIErrors()
+	if len(i.Kafka.Brokers) == 0 {
+		fe.Add("Kafka must have at least one broker")
+	}
+	if i.Kafka.Topic == "" {
+		fe.Add("Kafka must have a topic")
+	}
+	if i.Kafka.GroupID == "" {
+		fe.Add("Kafka must have a groupId")
+	}
+	if i.Kafka.SASL != nil && i.Kafka.SASL.Username == "" {
+		fe.Add("Kafka SASL must have a username")
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+func validateMQTTInitiator(i models.Initiator) error {
+	if i.MQTT == nil {
+		return models.NewJSONAPIErrorsWith("MQTT must have an mqtt config")
+	}
+	fe := models.NewJSONAPIErrors()
+	broker, err := url.Parse(i.MQTT.Broker)
+	if err != nil || broker.Host == "" {
+		fe.Add("MQTT must have a broker URL")
+	} else {
+		switch broker.Scheme {
+		case "tcp", "mqtt", "ssl", "tls", "mqtts", "ws", "wss":
+		default:
+			fe.Add(fmt.Sprintf("MQTT broker scheme %s is not supported", broker.Scheme))
+		}
+	}
+	if i.MQTT.Topic == "" {
+		fe.Add("MQTT must have a topic")
+	}
+	if i.MQTT.QoS > 2 {
+		fe.Add("MQTT qos must be 0, 1 or 2")
+	}
+	return fe.CoerceEmptyToNil()
+}
+
 func validateServiceAgreementInitiator(i models.Initiator, j models.JobSpec) error {
 	fe := models.NewJSONAPIErrors()
 	if len(j.Initiators) != 1 {
@@ -312,6 +548,58 @@ func validateRandomnessLogInitiator(i models.Initiator, j models.JobSpec) error
 	return fe.CoerceEmptyToNil()
 }
 
+func validateBalanceThresholdInitiator(i models.Initiator) error {
+	fe := models.NewJSONAPIErrors()
+	if i.Address == utils.ZeroAddress {
+		fe.Add("balance threshold must specify the address to watch")
+	}
+	if i.BalanceThreshold == nil {
+		fe.Add("balance threshold must specify a balanceThreshold")
+	} else if i.BalanceThreshold.ToInt().Sign() < 0 {
+		fe.Add("balance threshold balanceThreshold must not be negative")
+	}
+	switch strings.ToLower(i.Crossing) {
+	case "", models.BalanceCrossingBelow, models.BalanceCrossingAbove:
+	default:
+		fe.Add(fmt.Sprintf("balance threshold crossing must be %q or %q", models.BalanceCrossingBelow, models.BalanceCrossingAbove))
+	}
+	return fe.CoerceEmptyToNil()
+}
+
+func validateEthLogInitiator(i models.Initiator) error {
+	if i.EventABI == nil || i.EventABI.Anonymous || len(i.Topics) == 0 || len(i.Topics[0]) == 0 {
+		return nil
+	}
+	for _, topic := range i.Topics[0] {
+		if topic == i.EventABI.ID {
+			return nil
+		}
+	}
+	return models.NewJSONAPIErrorsWith(fmt.Sprintf("ethlog first topic must match the eventAbi, whose signature %s has topic %s", i.EventABI.Sig, i.EventABI.ID.Hex()))
+}
+
+func validateBlockIntervalInitiator(i models.Initiator) error {
+	if i.BlockInterval == 0 {
+		return models.NewJSONAPIErrorsWith("block interval must specify a blockInterval of at least 1")
+	}
+	return nil
+}
+
+func validateGasPriceInitiator(i models.Initiator) error {
+	fe := models.NewJSONAPIErrors()
+	if i.GasPriceThreshold == nil {
+		fe.Add("gas price must specify a gasPriceThreshold")
+	} else if i.GasPriceThreshold.ToInt().Sign() <= 0 {
+		fe.Add("gas price gasPriceThreshold must be positive")
+	}
+	switch strings.ToLower(i.Crossing) {
+	case "", models.BalanceCrossingBelow, models.BalanceCrossingAbove:
+	default:
+		fe.Add(fmt.Sprintf("gas price crossing must be %q or %q", models.BalanceCrossingBelow, models.BalanceCrossingAbove))
+	}
+	return fe.CoerceEmptyToNil()
+}
+
 func validateTask(task models.TaskSpec, store *store.Store) error {
 	adapter, err := adapters.For(task, store.Config, store.ORM)
 	if err != nil {
@@ -322,6 +610,54 @@ func validateTask(task models.TaskSpec, store *store.Store) error {
 			return errors.New("Sleep Adapter is not implemented yet")
 		}
 	}
+	if transform, ok := adapter.BaseAdapter.(*adapters.JSONTransform); ok && transform.Expression != "" {
+		if err := transform.Validate(); err != nil {
+			return err
+		}
+	}
+	if graphQL, ok := adapter.BaseAdapter.(*adapters.GraphQL); ok {
+		if err := graphQL.Validate(); err != nil {
+			return err
+		}
+	}
+	if random, ok := adapter.BaseAdapter.(*adapters.Random); ok {
+		if err := validateRandomTask(random, task, store); err != nil {
+			return err
+		}
+	}
+	if task.Retry != nil {
+		if !adapters.ReplaySafe(adapter.BaseAdapter) {
+			return fmt.Errorf("%s tasks may have side effects outside the node, so cannot be given a retry policy", task.Type)
+		}
+		for _, class := range task.Retry.RetryOn {
+			switch strings.ToLower(class) {
+			case models.RetryOnAny, models.RetryOnTimeout, models.RetryOnConnection, models.RetryOnServerError:
+			default:
+				return fmt.Errorf("unknown retryOn error class %q", class)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRandomTask checks that a random task's public key is one of the
+// node's VRF keys, rather than waiting for the first request to fail. Keys
+// supplied by the run's request parameters or by another task's output are
+// only known when the task is performed.
+func validateRandomTask(random *adapters.Random, task models.TaskSpec, store *store.Store) error {
+	if random.PublicKey == "" || len(models.TaskVariableReferences(task.Params)) > 0 {
+		return nil
+	}
+	key, err := vrfkey.NewPublicKeyFromHex(random.PublicKey)
+	if err != nil {
+		return errors.Wrapf(err, "random task has invalid publicKey %s", random.PublicKey)
+	}
+	found, err := store.VRFKeyStore.HasKey(key)
+	if err != nil {
+		return errors.Wrap(err, "while looking up random task's publicKey")
+	} else if !found {
+		return fmt.Errorf("random task's publicKey %s is not a VRF key of this node", key)
+	}
 	return nil
 }
 
@@ -395,9 +731,22 @@ func ValidatedOracleSpec(tomlString string) (spec offchainreporting.OracleSpec,
 	if spec.IsBootstrapPeer {
 		return validatedBootstrapSpec(m, spec)
 	}
+	for _, k := range oracleRequiredKeys {
+		if !m.IsDefined(k) {
+			err = multierr.Append(err, errors.Errorf("missing required key for oracle: %s", k))
+		}
+	}
 	return
 }
 
+// oracleRequiredKeys are the keys an oracle which takes part in rounds must
+// define, on top of those it shares with bootstrap peers.
+var oracleRequiredKeys = []string{
+	`keyBundleID`,
+	`transmitterAddress`,
+	`observationSource`,
+}
+
 var bootstrapKeys = map[string]struct{}{
 	`type`:                                   struct{}{},
 	`schemaVersion`:                          struct{}{},
@@ -9,8 +9,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/adapters"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/models/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -75,6 +77,11 @@ func TestValidateJob(t *testing.T) {
 			cltest.MustReadFile(t, "testdata/runlog_2_ethlogs_job.json"),
 			models.NewJSONAPIErrorsWith("Cannot RunLog initiated jobs cannot have more than one EthTx Task"),
 		},
+		{
+			"task variable without a matching task name",
+			cltest.MustReadFile(t, "testdata/unknown_task_variable_job.json"),
+			models.NewJSONAPIErrorsWith("Task 2 references $(fetchB), which is not the name of an earlier task"),
+		},
 	}
 
 	store, cleanup := cltest.NewStore(t)
@@ -104,6 +111,196 @@ func TestValidateJob_RejectsSleepAdapterWhenExperimentalAdaptersAreDisabled(t *t
 	assert.Error(t, services.ValidateJob(sleepingJob, store))
 }
 
+func TestValidateJob_JSONTransformExpression(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "jsontransform", `{"expression": ".data | {price: .last}"}`)}
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "jsontransform", `{"expression": ".data | {price: "}`)}
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_TaskRetryPolicy(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	task := cltest.NewTask(t, "httpget", `{"get": "https://example.com/price"}`)
+	task.Retry = &models.RetryPolicy{MaxAttempts: 3}
+	job.Tasks = []models.TaskSpec{task}
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	task = cltest.NewTask(t, "httppost", `{"post": "https://example.com/orders"}`)
+	task.Retry = &models.RetryPolicy{MaxAttempts: 3}
+	job.Tasks = []models.TaskSpec{task}
+	err := services.ValidateJob(job, store)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be given a retry policy")
+}
+
+func TestValidateJob_GraphQLQuery(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "graphql", `{"url": "https://example.com/graphql", "query": "{ ticker { price } }"}`)}
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "graphql", `{"url": "https://example.com/graphql", "query": "mutation { reset }"}`)}
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_Templates(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "httpgetwithunrestrictednetworkaccess",
+		`{"get": "https://example.com/price?sym={{ $.jobRun.params.symbol }}&key={{ $.env.JOB_API_KEY }}"}`)}
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop", `{"value": "{{ $.run.id }}"}`)}
+	assert.Error(t, services.ValidateJob(job, store))
+
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop", `{"value": "{{ $.env.DATABASE_URL }}"}`)}
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_TaskInputs(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	fetch := cltest.NewTask(t, "httpgetwithunrestrictednetworkaccess", `{"get": "https://example.com/price"}`)
+	fetch.Name = "fetchA"
+	fetch.Inputs = &models.TaskInputs{}
+	median := cltest.NewTask(t, "median")
+	median.Inputs = &models.TaskInputs{"fetchA", "fetchB"}
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{fetch, median}
+	assert.Equal(t,
+		models.NewJSONAPIErrorsWith("Task 1 takes the input fetchB, which is not the name of an earlier task"),
+		services.ValidateJob(job, store))
+
+	median.Inputs = &models.TaskInputs{"fetchA"}
+	job.Tasks = []models.TaskSpec{fetch, median}
+	assert.NoError(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_RandomTaskPublicKey(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	key := vrfkey.CreateKey()
+	store.VRFKeyStore.StoreInMemoryXXXTestingOnly(key)
+	unknownKey := vrfkey.CreateKey()
+
+	tests := []struct {
+		name      string
+		publicKey string
+		wantError bool
+	}{
+		{"known key", key.PublicKey.String(), false},
+		{"unknown key", unknownKey.PublicKey.String(), true},
+		{"malformed key", "0xdeadbeef", true},
+		{"key from task variable", "$(fetchKey)", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job := cltest.NewJobWithWebInitiator()
+			job.Tasks = []models.TaskSpec{
+				{Type: adapters.TaskTypeNoOp, Name: "fetchKey"},
+				{
+					Type:   adapters.TaskTypeRandom,
+					Params: cltest.JSONFromString(t, fmt.Sprintf(`{"publicKey": %q}`, test.publicKey)),
+				},
+			}
+			err := services.ValidateJob(job, store)
+			if test.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateJob_ChainID(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	store.Config.Set("EVM_CHAINS", `[{"chainId": "100", "ethUrl": "wss://xdai.example.com"}]`)
+
+	job := cltest.NewJobWithWebInitiator()
+	job.ChainID = utils.NewBig(store.Config.ChainID())
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.ChainID = utils.NewBigI(100)
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.ChainID = utils.NewBigI(137)
+	assert.Error(t, services.ValidateJob(job, store))
+
+	logJob := cltest.NewJobWithLogInitiator()
+	logJob.ChainID = utils.NewBigI(100)
+	assert.NoError(t, services.ValidateJob(logJob, store))
+}
+
+func TestValidateJob_CronSchedules(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithSchedule("")
+	job.Tasks = []models.TaskSpec{cltest.NewTask(t, "noop")}
+	assert.Error(t, services.ValidateJob(job, store))
+
+	job.Initiators[0].Schedules = models.Crons{"CRON_TZ=UTC */15 9-17 * * 1-5", "CRON_TZ=UTC 0 2 * * *"}
+	assert.NoError(t, services.ValidateJob(job, store))
+
+	job.Initiators[0].Schedule = "CRON_TZ=UTC 0 2 * * *"
+	assert.Error(t, services.ValidateJob(job, store))
+}
+
+func TestValidateJob_FailureNotifications(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	webhookURL := cltest.WebURL(t, "https://alerts.example.com/hook")
+	ftpURL := cltest.WebURL(t, "ftp://alerts.example.com")
+	tests := []struct {
+		name          string
+		notifications models.FailureNotifications
+		smtpAddress   string
+		wantErr       bool
+	}{
+		{"webhook", models.FailureNotifications{WebhookURL: &webhookURL, ConsecutiveErrors: 3}, "", false},
+		{"email", models.FailureNotifications{Email: []string{"ops@example.com"}}, "smtp.example.com:587", false},
+		{"nowhere", models.FailureNotifications{ConsecutiveErrors: 3}, "", true},
+		{"webhook scheme", models.FailureNotifications{WebhookURL: &ftpURL}, "", true},
+		{"invalid email", models.FailureNotifications{Email: []string{"ops"}}, "smtp.example.com:587", true},
+		{"email without smtp", models.FailureNotifications{Email: []string{"ops@example.com"}}, "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store.Config.Set("ALERT_EMAIL_SMTP_ADDRESS", test.smtpAddress)
+			job := cltest.NewJobWithWebInitiator()
+			notifications := test.notifications
+			job.FailureNotifications = &notifications
+			err := services.ValidateJob(job, store)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateBridgeType(t *testing.T) {
 	t.Parallel()
 
@@ -172,6 +369,45 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid proxy",
+			models.BridgeTypeRequest{
+				Name:  "adapterwithproxy",
+				URL:   cltest.WebURL(t, "https://denergy.eth"),
+				Proxy: "socks5://egress:1080",
+			},
+			nil,
+		},
+		{
+			"invalid proxy scheme",
+			models.BridgeTypeRequest{
+				Name:  "adapterwithproxy",
+				URL:   cltest.WebURL(t, "https://denergy.eth"),
+				Proxy: "ftp://egress:21",
+			},
+			models.NewJSONAPIErrorsWith(`invalid proxy "ftp://egress:21": scheme must be http, https or socks5`),
+		},
+		{
+			"circuit breaker",
+			models.BridgeTypeRequest{
+				Name:                    "adapterwithbreaker",
+				URL:                     cltest.WebURL(t, "https://denergy.eth"),
+				Timeout:                 models.MustMakeDuration(5 * time.Second),
+				Retries:                 null.Uint32From(0),
+				CircuitBreakerThreshold: 3,
+				CircuitBreakerCooldown:  models.MustMakeDuration(time.Minute),
+			},
+			nil,
+		},
+		{
+			"circuit breaker cooldown without threshold",
+			models.BridgeTypeRequest{
+				Name:                   "adapterwithbreaker",
+				URL:                    cltest.WebURL(t, "https://denergy.eth"),
+				CircuitBreakerCooldown: models.MustMakeDuration(time.Minute),
+			},
+			models.NewJSONAPIErrorsWith("CircuitBreakerCooldown requires a CircuitBreakerThreshold"),
+		},
 		{
 			"existing core adapter",
 			models.BridgeTypeRequest{
@@ -277,16 +513,50 @@ func TestValidateInitiator(t *testing.T) {
 	}{
 		{"web", `{"type":"web"}`, false},
 		{"ethlog", `{"type":"ethlog"}`, false},
+		{"ethlog w/ eventAbi", `{"type":"ethlog","params":{"eventAbi":{"name":"Transfer","inputs":[{"name":"value","type":"uint256"}]}}}`, false},
+		{"ethlog w/ eventAbi and its topic", `{"type":"ethlog","params":{"topics":[["0x248dd4076d0a389d795107efafd558ce7f31ae37b441ccb9a599c60868f480d5"]],"eventAbi":{"name":"Transfer","inputs":[{"name":"value","type":"uint256"}]}}}`, false},
+		{"ethlog w/ eventAbi and other topic", `{"type":"ethlog","params":{"topics":[["0x4a1eb0e8df314cb894024a38991cff0f00000000000000000000000000000000"]],"eventAbi":{"name":"Transfer","inputs":[{"name":"value","type":"uint256"}]}}}`, true},
 		{"external", `{"type":"external","params":{"name":"bitcoin"}}`, false},
 		{"runlog", `{"type":"runlog"}`, false},
+		{"runlog w/ addresses", `{"type":"runlog","params":{"address":"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42","addresses":["0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"]}}`, false},
+		{"runlog w/ repeated address", `{"type":"runlog","params":{"address":"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42","addresses":["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]}}`, true},
+		{"runlog w/ zero address", `{"type":"runlog","params":{"addresses":["0x0000000000000000000000000000000000000000"]}}`, true},
 		{"runat", fmt.Sprintf(`{"type":"runat","params": {"time":"%v"}}`, utils.ISO8601UTC(startAt)), false},
 		{"runat w/o time", `{"type":"runat"}`, true},
 		{"runat w time before start at", fmt.Sprintf(`{"type":"runat","params": {"time":"%v"}}`, startAt.Add(-1*time.Second).Unix()), true},
 		{"runat w time after end at", fmt.Sprintf(`{"type":"runat","params": {"time":"%v"}}`, endAt.Add(time.Second).Unix()), true},
+		{"runat every", fmt.Sprintf(`{"type":"runat","params": {"time":"%v","every":"1h","until":"%v"}}`, utils.ISO8601UTC(startAt), utils.ISO8601UTC(endAt)), false},
+		{"runat every under 1s", fmt.Sprintf(`{"type":"runat","params": {"time":"%v","every":"500ms"}}`, utils.ISO8601UTC(startAt)), true},
+		{"runat until w/o every", fmt.Sprintf(`{"type":"runat","params": {"time":"%v","until":"%v"}}`, utils.ISO8601UTC(startAt), utils.ISO8601UTC(endAt)), true},
+		{"runat until before time", fmt.Sprintf(`{"type":"runat","params": {"time":"%v","every":"1h","until":"%v"}}`, utils.ISO8601UTC(endAt), utils.ISO8601UTC(startAt)), true},
 		{"cron standard", `{"type":"cron","params": {"schedule":"CRON_TZ=UTC * * * * *"}}`, false},
 		{"cron with 6 fields", `{"type":"cron","params": {"schedule":"CRON_TZ=UTC * * * * * *"}}`, false},
 		{"cron w/o schedule", `{"type":"cron"}`, true},
 		{"external w/o name", `{"type":"external"}`, true},
+		{"balancethreshold", `{"type":"balancethreshold","params":{"address":"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42","balanceThreshold":"1000000000000000000"}}`, false},
+		{"balancethreshold above", `{"type":"balancethreshold","params":{"address":"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42","balanceThreshold":"1","crossing":"above"}}`, false},
+		{"balancethreshold w/o address", `{"type":"balancethreshold","params":{"balanceThreshold":"1"}}`, true},
+		{"balancethreshold w/o threshold", `{"type":"balancethreshold","params":{"address":"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"}}`, true},
+		{"balancethreshold w bad crossing", `{"type":"balancethreshold","params":{"address":"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42","balanceThreshold":"1","crossing":"sideways"}}`, true},
+		{"webhook", `{"type":"webhook","params":{"secret":"0123456789abcdef"}}`, false},
+		{"webhook w short secret", `{"type":"webhook","params":{"secret":"s3cret"}}`, true},
+		{"kafka", `{"type":"kafka","params":{"kafka":{"brokers":["localhost:9092"],"topic":"prices","groupId":"chainlink","tls":true,"sasl":{"username":"node","password":"s3cret"}}}}`, false},
+		{"kafka w/o topic", `{"type":"kafka","params":{"kafka":{"brokers":["localhost:9092"],"groupId":"chainlink"}}}`, true},
+		{"kafka w/o config", `{"type":"kafka"}`, true},
+		{"mqtt", `{"type":"mqtt","params":{"mqtt":{"broker":"ssl://broker.example.com:8883","topic":"sensors/+/temperature","qos":1,"username":"node","password":"s3cret"}}}`, false},
+		{"mqtt w/o topic", `{"type":"mqtt","params":{"mqtt":{"broker":"tcp://localhost:1883"}}}`, true},
+		{"mqtt w/ bad qos", `{"type":"mqtt","params":{"mqtt":{"broker":"tcp://localhost:1883","topic":"prices","qos":3}}}`, true},
+		{"mqtt w/ unsupported scheme", `{"type":"mqtt","params":{"mqtt":{"broker":"http://localhost:1883","topic":"prices"}}}`, true},
+		{"mqtt w/o config", `{"type":"mqtt"}`, true},
+		{"blockinterval", `{"type":"blockinterval","params":{"blockInterval":100}}`, false},
+		{"blockinterval w/o interval", `{"type":"blockinterval"}`, true},
+		{"gasprice", `{"type":"gasprice","params":{"gasPriceThreshold":"100000000000"}}`, false},
+		{"gasprice below", `{"type":"gasprice","params":{"gasPriceThreshold":"20000000000","crossing":"below"}}`, false},
+		{"gasprice w/o threshold", `{"type":"gasprice"}`, true},
+		{"gasprice w/ zero threshold", `{"type":"gasprice","params":{"gasPriceThreshold":"0"}}`, true},
+		{"gasprice w/ bad crossing", `{"type":"gasprice","params":{"gasPriceThreshold":"1","crossing":"sideways"}}`, true},
+		{"external w/ minIncomingInterval", `{"type":"external","params":{"name":"bitcoin","minIncomingInterval":"10s"}}`, false},
+		{"ethlog w/ zero minIncomingInterval", `{"type":"ethlog","params":{"minIncomingInterval":"0s"}}`, true},
 		{"non-existent initiator", `{"type":"doesntExist"}`, true},
 	}
 
@@ -394,6 +664,14 @@ func TestValidateInitiator_FluxMonitorHappy(t *testing.T) {
 	require.NoError(t, json.Unmarshal([]byte(validInitiator), &initr))
 	err := services.ValidateInitiator(initr, job, store)
 	require.NoError(t, err)
+
+	initr.Aggregation = models.AggregationConfig{Strategy: models.AggregationTrimmedMean, TrimPercent: 20, MinAnswers: 3}
+	require.NoError(t, services.ValidateInitiator(initr, job, store))
+
+	initr.ReleaseThreshold = 0.25
+	initr.AbsoluteThreshold = 1
+	initr.AbsoluteReleaseThreshold = 0.5
+	require.NoError(t, services.ValidateInitiator(initr, job, store))
 }
 
 func TestValidateInitiator_FluxMonitorErrors(t *testing.T) {
@@ -415,6 +693,15 @@ func TestValidateInitiator_FluxMonitorErrors(t *testing.T) {
 		{"pollTimer enabled, but no period specified", cltest.MustJSONDel(t, validInitiator, "params.pollTimer.period")},
 		{"period must be equal or greater than 15s", cltest.MustJSONSet(t, validInitiator, "params.pollTimer.period", "1s")},
 		{"idleTimer.duration must be >= than pollTimer.period", cltest.MustJSONSet(t, validInitiator, "params.idleTimer.duration", "30s")},
+		{"aggregation strategy", cltest.MustJSONSet(t, validInitiator, "params.aggregation.strategy", "mode")},
+		{"aggregation trimPercent", cltest.MustJSONSet(t, validInitiator, "params.aggregation", map[string]interface{}{"strategy": "trimmedmean", "trimPercent": 50})},
+		{"aggregation trimPercent", cltest.MustJSONSet(t, validInitiator, "params.aggregation", map[string]interface{}{"strategy": "mean", "trimPercent": 10})},
+		{"aggregation maxDeviationPercent", cltest.MustJSONSet(t, validInitiator, "params.aggregation.maxDeviationPercent", -1)},
+		{"aggregation maxMADs", cltest.MustJSONSet(t, validInitiator, "params.aggregation.maxMADs", -3)},
+		{"aggregation minAnswers", cltest.MustJSONSet(t, validInitiator, "params.aggregation.minAnswers", 4)},
+		{"must not be negative", cltest.MustJSONSet(t, validInitiator, "params.releaseThreshold", -0.1)},
+		{"releaseThreshold must be less than threshold", cltest.MustJSONSet(t, validInitiator, "params.releaseThreshold", 0.5)},
+		{"absoluteReleaseThreshold must be less than absoluteThreshold", cltest.MustJSONSet(t, validInitiator, "params.absoluteReleaseThreshold", 0.01)},
 	}
 	for _, test := range tests {
 		t.Run("bad "+test.Field, func(t *testing.T) {
@@ -445,6 +732,12 @@ func TestValidateInitiator_FeedsHappy(t *testing.T) {
 	initr.Feeds = cltest.JSONFromString(t, `["https://lambda.staging.devnet.tools/bnc/call", {"bridge": "testbridge"}]`)
 	err := services.ValidateInitiator(initr, job, store)
 	require.NoError(t, err)
+
+	initr.Feeds = cltest.JSONFromString(t, `[
+		{"url": "https://lambda.staging.devnet.tools/bnc/call", "method": "GET", "headers": {"X-Api-Key": "s3cret"}, "timeout": "5s"},
+		{"bridge": "testbridge", "body": {"data": {"coin": "ETH", "market": "USD"}}, "weight": 2}
+	]`)
+	require.NoError(t, services.ValidateInitiator(initr, job, store))
 }
 
 func TestValidateInitiator_FeedsErrors(t *testing.T) {
@@ -473,6 +766,12 @@ func TestValidateInitiator_FeedsErrors(t *testing.T) {
 		{"missing bridge", `[{"bridgeName": "doesnotexist"}]`},
 		{"unsupported bridge properties", `[{"bridge": "testbridge", "foo": "bar"}]`},
 		{"invalid entry", `["http://example.com", {"bridge": "testbridge"}, 1]`},
+		{"url and bridge", `[{"url": "http://example.com", "bridge": "testbridge"}]`},
+		{"neither url nor bridge", `[{"method": "GET"}]`},
+		{"unsupported method", `[{"url": "http://example.com", "method": "PUT"}]`},
+		{"non-object body", `[{"url": "http://example.com", "body": [1, 2]}]`},
+		{"negative timeout", `[{"url": "http://example.com", "timeout": "-1s"}]`},
+		{"negative weight", `[{"url": "http://example.com", "weight": -1}]`},
 	}
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
@@ -550,6 +849,24 @@ observationSource = """
 		require.True(t, s.IsBootstrapPeer)
 	})
 
+	t.Run("raises error for oracle missing required keys", func(t *testing.T) {
+		toml := `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pPeerID          = "12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq"
+p2pBootstrapPeers  = []
+isBootstrapPeer    = false
+observationTimeout = "10s"
+blockchainTimeout  = "20s"
+contractConfigTrackerSubscribeInterval = "2m"
+contractConfigTrackerPollInterval = "1m"
+contractConfigConfirmations = 3
+`
+		_, err := services.ValidatedOracleSpec(toml)
+		require.EqualError(t, err, "missing required key for oracle: keyBundleID; missing required key for oracle: transmitterAddress; missing required key for oracle: observationSource")
+	})
+
 	t.Run("raises error for bootstrap peer with extra keys", func(t *testing.T) {
 		toml := `
 type               = "offchainreporting"
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/jinzhu/gorm"
+)
+
+// HTTPCache keeps the responses of HTTP tasks which set a cacheTTL, so that
+// jobs polling rate limited APIs can reuse a recent answer instead of asking
+// again.
+type HTTPCache interface {
+	// Get returns the response cached under key, if it has not expired
+	Get(key string) ([]byte, bool)
+	// Set caches body under key for ttl
+	Set(key string, body []byte, ttl time.Duration)
+}
+
+// NewHTTPCache returns the HTTPCache for the HTTP_CACHE_BACKEND backend
+func NewHTTPCache(backend string, db *gorm.DB) HTTPCache {
+	if backend == orm.HTTPCacheBackendDatabase {
+		return &dbHTTPCache{db: db}
+	}
+	return NewMemoryHTTPCache()
+}
+
+type httpCacheEntry struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+type memoryHTTPCache struct {
+	entries map[string]httpCacheEntry
+	mutex   sync.Mutex
+}
+
+// NewMemoryHTTPCache returns an HTTPCache which is lost when the node stops
+func NewMemoryHTTPCache() HTTPCache {
+	return &memoryHTTPCache{entries: make(map[string]httpCacheEntry)}
+}
+
+func (c *memoryHTTPCache) Get(key string) ([]byte, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *memoryHTTPCache) Set(key string, body []byte, ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Expired entries of tasks which no longer run would otherwise never
+	// be removed
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = httpCacheEntry{body: body, expiresAt: now.Add(ttl)}
+}
+
+type dbHTTPCache struct {
+	db *gorm.DB
+}
+
+func (c *dbHTTPCache) Get(key string) ([]byte, bool) {
+	var body []byte
+	err := c.db.Raw(`SELECT body FROM http_cache_entries WHERE key = ? AND expires_at > NOW()`, key).Row().Scan(&body)
+	if err == sql.ErrNoRows {
+		return nil, false
+	} else if err != nil {
+		logger.Errorw("HTTPCache: error reading cached response", "error", err)
+		return nil, false
+	}
+	return body, true
+}
+
+func (c *dbHTTPCache) Set(key string, body []byte, ttl time.Duration) {
+	err := c.db.Exec(`
+		INSERT INTO http_cache_entries (key, body, expires_at) VALUES (?, ?, NOW() + ? * interval '1 millisecond')
+		ON CONFLICT (key) DO UPDATE SET body = EXCLUDED.body, expires_at = EXCLUDED.expires_at
+	`, key, body, ttl.Milliseconds()).Error
+	if err != nil {
+		logger.Errorw("HTTPCache: error caching response", "error", err)
+		return
+	}
+	if err := c.db.Exec(`DELETE FROM http_cache_entries WHERE expires_at <= NOW()`).Error; err != nil {
+		logger.Errorw("HTTPCache: error removing expired responses", "error", err)
+	}
+}
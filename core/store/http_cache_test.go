@@ -0,0 +1,41 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	strpkg "github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPCache(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	for _, backend := range []string{orm.HTTPCacheBackendMemory, orm.HTTPCacheBackendDatabase} {
+		cache := strpkg.NewHTTPCache(backend, store.DB)
+		t.Run(backend, func(t *testing.T) {
+			_, ok := cache.Get("a")
+			assert.False(t, ok)
+
+			cache.Set("a", []byte("first"), time.Hour)
+			body, ok := cache.Get("a")
+			assert.True(t, ok)
+			assert.Equal(t, "first", string(body))
+
+			cache.Set("a", []byte("second"), time.Hour)
+			body, ok = cache.Get("a")
+			assert.True(t, ok)
+			assert.Equal(t, "second", string(body))
+
+			cache.Set("b", []byte("expired"), -time.Second)
+			_, ok = cache.Get("b")
+			assert.False(t, ok)
+		})
+	}
+}
@@ -30,6 +30,7 @@ type KeyStoreInterface interface {
 	Unlock(phrase string) error
 	NewAccount(passphrase string) (accounts.Account, error)
 	SignHash(hash common.Hash) (models.Signature, error)
+	SignHashWithAccount(account accounts.Account, hash common.Hash) (models.Signature, error)
 	Import(keyJSON []byte, passphrase, newPassphrase string) (accounts.Account, error)
 	Export(a accounts.Account, passphrase, newPassphrase string) ([]byte, error)
 	GetAccounts() []accounts.Account
@@ -114,6 +115,22 @@ func (ks *KeyStore) SignHash(hash common.Hash) (models.Signature, error) {
 	return signature, nil
 }
 
+// SignHashWithAccount is SignHash with the given account's private key.
+func (ks *KeyStore) SignHashWithAccount(account accounts.Account, hash common.Hash) (models.Signature, error) {
+	prefixedMessageBytes, err := utils.Keccak256(append([]byte(EthereumMessageHashPrefix), hash.Bytes()...))
+	if err != nil {
+		return models.Signature{}, err
+	}
+
+	output, err := ks.KeyStore.SignHash(account, prefixedMessageBytes)
+	if err != nil {
+		return models.Signature{}, err
+	}
+	var signature models.Signature
+	signature.SetBytes(output)
+	return signature, nil
+}
+
 // unsafeSignHash signs a precomputed digest, using the first account's private
 // key
 // NOTE: Do not use this method to sign arbitrary message hashes, it may be an
@@ -82,6 +82,53 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1604003825"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1604437959"
 	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1604674426"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605083734"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605115281"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605201829"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605288471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605374871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605461271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605547671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605634071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605806871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605893271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1605979671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606066071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606152471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606238871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606325271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606411671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606498071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606584471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606670871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606757271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606843671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1606930071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607016471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607102871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607189271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607275671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607362071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607448471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607534871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607621271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607707671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607794071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607880471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1607966871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608053271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608139671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608226071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608312471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608398871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608485271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608571671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608658071"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608744471"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608830871"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1608917271"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1609003671"
+	"github.com/smartcontractkit/chainlink/core/store/migrations/migration1609090071"
 	gormigrate "gopkg.in/gormigrate.v1"
 )
 
@@ -399,6 +446,194 @@ func init() {
 			ID:      "1604674426",
 			Migrate: migration1604674426.Migrate,
 		},
+		{
+			ID:      "1605083734",
+			Migrate: migration1605083734.Migrate,
+		},
+		{
+			ID:      "1605115281",
+			Migrate: migration1605115281.Migrate,
+		},
+		{
+			ID:      "1605201829",
+			Migrate: migration1605201829.Migrate,
+		},
+		{
+			ID:      "1605288471",
+			Migrate: migration1605288471.Migrate,
+		},
+		{
+			ID:      "1605374871",
+			Migrate: migration1605374871.Migrate,
+		},
+		{
+			ID:      "1605461271",
+			Migrate: migration1605461271.Migrate,
+		},
+		{
+			ID:      "1605547671",
+			Migrate: migration1605547671.Migrate,
+		},
+		{
+			ID:      "1605634071",
+			Migrate: migration1605634071.Migrate,
+		},
+		{
+			ID:      "1605806871",
+			Migrate: migration1605806871.Migrate,
+		},
+		{
+			ID:      "1605893271",
+			Migrate: migration1605893271.Migrate,
+		},
+		{
+			ID:      "1605979671",
+			Migrate: migration1605979671.Migrate,
+		},
+		{
+			ID:      "1606066071",
+			Migrate: migration1606066071.Migrate,
+		},
+		{
+			ID:      "1606152471",
+			Migrate: migration1606152471.Migrate,
+		},
+		{
+			ID:      "1606238871",
+			Migrate: migration1606238871.Migrate,
+		},
+		{
+			ID:      "1606325271",
+			Migrate: migration1606325271.Migrate,
+		},
+		{
+			ID:      "1606411671",
+			Migrate: migration1606411671.Migrate,
+		},
+		{
+			ID:      "1606498071",
+			Migrate: migration1606498071.Migrate,
+		},
+		{
+			ID:      "1606584471",
+			Migrate: migration1606584471.Migrate,
+		},
+		{
+			ID:      "1606670871",
+			Migrate: migration1606670871.Migrate,
+		},
+		{
+			ID:      "1606757271",
+			Migrate: migration1606757271.Migrate,
+		},
+		{
+			ID:      "1606843671",
+			Migrate: migration1606843671.Migrate,
+		},
+		{
+			ID:      "1606930071",
+			Migrate: migration1606930071.Migrate,
+		},
+		{
+			ID:      "1607016471",
+			Migrate: migration1607016471.Migrate,
+		},
+		{
+			ID:      "1607102871",
+			Migrate: migration1607102871.Migrate,
+		},
+		{
+			ID:      "1607189271",
+			Migrate: migration1607189271.Migrate,
+		},
+		{
+			ID:      "1607275671",
+			Migrate: migration1607275671.Migrate,
+		},
+		{
+			ID:      "1607362071",
+			Migrate: migration1607362071.Migrate,
+		},
+		{
+			ID:      "1607448471",
+			Migrate: migration1607448471.Migrate,
+		},
+		{
+			ID:      "1607534871",
+			Migrate: migration1607534871.Migrate,
+		},
+		{
+			ID:      "1607621271",
+			Migrate: migration1607621271.Migrate,
+		},
+		{
+			ID:      "1607707671",
+			Migrate: migration1607707671.Migrate,
+		},
+		{
+			ID:      "1607794071",
+			Migrate: migration1607794071.Migrate,
+		},
+		{
+			ID:      "1607880471",
+			Migrate: migration1607880471.Migrate,
+		},
+		{
+			ID:      "1607966871",
+			Migrate: migration1607966871.Migrate,
+		},
+		{
+			ID:      "1608053271",
+			Migrate: migration1608053271.Migrate,
+		},
+		{
+			ID:      "1608139671",
+			Migrate: migration1608139671.Migrate,
+		},
+		{
+			ID:      "1608226071",
+			Migrate: migration1608226071.Migrate,
+		},
+		{
+			ID:      "1608312471",
+			Migrate: migration1608312471.Migrate,
+		},
+		{
+			ID:      "1608398871",
+			Migrate: migration1608398871.Migrate,
+		},
+		{
+			ID:      "1608485271",
+			Migrate: migration1608485271.Migrate,
+		},
+		{
+			ID:      "1608571671",
+			Migrate: migration1608571671.Migrate,
+		},
+		{
+			ID:      "1608658071",
+			Migrate: migration1608658071.Migrate,
+		},
+		{
+			ID:      "1608744471",
+			Migrate: migration1608744471.Migrate,
+		},
+		{
+			ID:      "1608830871",
+			Migrate: migration1608830871.Migrate,
+		},
+		{
+			ID:      "1608917271",
+			Migrate: migration1608917271.Migrate,
+		},
+		{
+			ID:      "1609003671",
+			Migrate: migration1609003671.Migrate,
+		},
+		{
+			ID:      "1609090071",
+			Migrate: migration1609090071.Migrate,
+		},
 	}
 }
 
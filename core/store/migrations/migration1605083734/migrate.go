@@ -0,0 +1,13 @@
+package migration1605083734
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the balancethreshold initiator params to initiators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators
+		ADD COLUMN token_address bytea,
+		ADD COLUMN balance_threshold varchar(255),
+		ADD COLUMN crossing text;
+    `).Error
+}
@@ -0,0 +1,13 @@
+package migration1605115281
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds task timeouts, job deadlines and the deadline of each run.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE task_specs ADD COLUMN timeout bigint;
+		ALTER TABLE job_specs ADD COLUMN deadline bigint;
+		ALTER TABLE job_runs ADD COLUMN deadline timestamptz;
+		CREATE INDEX idx_job_runs_deadline ON job_runs (deadline) WHERE deadline IS NOT NULL;
+    `).Error
+}
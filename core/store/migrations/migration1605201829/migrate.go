@@ -0,0 +1,14 @@
+package migration1605201829
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds task retry policies, and tracks the attempts made at each task
+// run so that retries survive a restart.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE task_specs ADD COLUMN retry jsonb;
+		ALTER TABLE task_runs
+		ADD COLUMN attempts integer NOT NULL DEFAULT 0,
+		ADD COLUMN retry_at timestamptz;
+    `).Error
+}
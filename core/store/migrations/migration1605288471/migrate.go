@@ -0,0 +1,11 @@
+package migration1605288471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the priority class of jobs and their runs.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN priority text NOT NULL DEFAULT '';
+		ALTER TABLE job_runs ADD COLUMN priority text NOT NULL DEFAULT '';
+    `).Error
+}
@@ -0,0 +1,10 @@
+package migration1605374871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the name of task specs, used to reference their output from later tasks.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE task_specs ADD COLUMN name text NOT NULL DEFAULT '';
+    `).Error
+}
@@ -0,0 +1,11 @@
+package migration1605461271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the time at which a run failed permanently and was moved into the dead-letter queue.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_runs ADD COLUMN dead_lettered_at timestamptz;
+		CREATE INDEX idx_job_runs_dead_lettered_at ON job_runs (dead_lettered_at) WHERE dead_lettered_at IS NOT NULL;
+    `).Error
+}
@@ -0,0 +1,10 @@
+package migration1605547671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the checkpoint recorded before performing a task with side effects, used to detect tasks interrupted by the node stopping.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE task_runs ADD COLUMN checkpointed_at timestamptz;
+    `).Error
+}
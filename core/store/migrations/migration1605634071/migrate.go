@@ -0,0 +1,10 @@
+package migration1605634071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the reason a run was rejected before any of its tasks were performed.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_runs ADD COLUMN rejection text NOT NULL DEFAULT '';
+    `).Error
+}
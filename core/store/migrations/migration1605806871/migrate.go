@@ -0,0 +1,14 @@
+package migration1605806871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds timing and resource accounting columns to task_runs.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE task_runs
+		ADD COLUMN started_at timestamptz,
+		ADD COLUMN finished_at timestamptz,
+		ADD COLUMN duration bigint NOT NULL DEFAULT 0,
+		ADD COLUMN bytes_transferred bigint NOT NULL DEFAULT 0;
+    `).Error
+}
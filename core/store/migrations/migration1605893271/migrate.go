@@ -0,0 +1,10 @@
+package migration1605893271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the schema which run parameters are checked against to job_specs.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN input_schema jsonb;
+    `).Error
+}
@@ -0,0 +1,11 @@
+package migration1605979671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate binds job specs and their runs to an optional EVM chain ID
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN chain_id numeric(78,0);
+		ALTER TABLE job_runs ADD COLUMN chain_id numeric(78,0);
+    `).Error
+}
@@ -0,0 +1,10 @@
+package migration1606066071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate flags the sync events which carry telemetry, which the explorer does not acknowledge.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE sync_events ADD COLUMN telemetry boolean NOT NULL DEFAULT false;
+    `).Error
+}
@@ -0,0 +1,10 @@
+package migration1606152471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds a gas price to eth_txes, which overrides ETH_GAS_PRICE_DEFAULT for the first attempt of the transaction.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE eth_txes ADD COLUMN gas_price numeric(78,0);
+    `).Error
+}
@@ -0,0 +1,10 @@
+package migration1606238871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate flags eth_txes that an operator abandoned, replacing them with an empty self-transfer at the same nonce.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE eth_txes ADD COLUMN abandoned boolean NOT NULL DEFAULT false;
+    `).Error
+}
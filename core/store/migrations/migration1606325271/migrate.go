@@ -0,0 +1,11 @@
+package migration1606325271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the per-job gas price ceiling of eth_txes, and a flag set while a transaction is held at its ceiling
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE eth_txes ADD COLUMN max_gas_price numeric(78,0);
+		ALTER TABLE eth_txes ADD COLUMN gas_price_capped boolean NOT NULL DEFAULT false;
+    `).Error
+}
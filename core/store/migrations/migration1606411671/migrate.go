@@ -0,0 +1,13 @@
+package migration1606411671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds idempotency keys to eth_txes and run_requests, so that retried API calls return the record created by the first call
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE eth_txes ADD COLUMN idempotency_key text;
+		CREATE UNIQUE INDEX idx_eth_txes_idempotency_key ON eth_txes (idempotency_key) WHERE idempotency_key IS NOT NULL;
+		ALTER TABLE run_requests ADD COLUMN idempotency_key text;
+		CREATE UNIQUE INDEX idx_run_requests_idempotency_key ON run_requests (idempotency_key) WHERE idempotency_key IS NOT NULL;
+    `).Error
+}
@@ -0,0 +1,11 @@
+package migration1606498071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds a priority to eth_txes, by which the EthBroadcaster orders unstarted transactions
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE eth_txes ADD COLUMN priority integer NOT NULL DEFAULT 0;
+		CREATE INDEX idx_eth_txes_unstarted_priority ON eth_txes (from_address, priority DESC, created_at) WHERE state = 'unstarted'::eth_txes_state;
+    `).Error
+}
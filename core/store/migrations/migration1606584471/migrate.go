@@ -0,0 +1,35 @@
+package migration1606584471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds a trigger that notifies listeners whenever an eth_tx is created or changes state
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE OR REPLACE FUNCTION notifyEthTxStateChange() RETURNS TRIGGER AS $_$
+		DECLARE
+			previous_state text;
+		BEGIN
+			IF TG_OP = 'UPDATE' THEN
+				previous_state := OLD.state::text;
+			END IF;
+			PERFORM pg_notify('eth_tx_state_changed'::text, json_build_object(
+				'id', NEW.id,
+				'state', NEW.state::text,
+				'previousState', previous_state,
+				'fromAddress', '0x' || encode(NEW.from_address, 'hex'),
+				'nonce', NEW.nonce,
+				'hash', (SELECT '0x' || encode(hash, 'hex') FROM eth_tx_attempts WHERE eth_tx_id = NEW.id ORDER BY gas_price DESC LIMIT 1)
+			)::text);
+			RETURN NULL;
+		END
+		$_$ LANGUAGE 'plpgsql';
+
+		CREATE TRIGGER notify_eth_tx_state_change_on_insert
+			AFTER INSERT ON eth_txes
+			FOR EACH ROW EXECUTE PROCEDURE notifyEthTxStateChange();
+
+		CREATE TRIGGER notify_eth_tx_state_change_on_update
+			AFTER UPDATE OF state ON eth_txes
+			FOR EACH ROW WHEN (OLD.state IS DISTINCT FROM NEW.state) EXECUTE PROCEDURE notifyEthTxStateChange();
+    `).Error
+}
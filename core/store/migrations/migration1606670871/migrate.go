@@ -0,0 +1,17 @@
+package migration1606670871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate creates the table in which HTTP task responses are cached when
+// HTTP_CACHE_BACKEND is database
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE TABLE http_cache_entries (
+			key text PRIMARY KEY,
+			body bytea NOT NULL,
+			expires_at timestamptz NOT NULL
+		);
+
+		CREATE INDEX idx_http_cache_entries_expires_at ON http_cache_entries (expires_at);
+    `).Error
+}
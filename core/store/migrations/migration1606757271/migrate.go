@@ -0,0 +1,11 @@
+package migration1606757271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the name of the client certificate bridges present to
+// external adapters which require mutual TLS
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE bridge_types ADD COLUMN client_certificate text NOT NULL DEFAULT '';
+    `).Error
+}
@@ -0,0 +1,11 @@
+package migration1606843671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the proxy bridges send their requests to external adapters
+// through
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE bridge_types ADD COLUMN proxy text NOT NULL DEFAULT '';
+    `).Error
+}
@@ -0,0 +1,11 @@
+package migration1606930071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate records when sleeping task runs wake up, so that sleeps outlast a
+// restart of the node.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE task_runs ADD COLUMN sleep_until timestamptz;
+    `).Error
+}
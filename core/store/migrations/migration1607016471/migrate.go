@@ -0,0 +1,11 @@
+package migration1607016471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the JSON schema which the responses of a bridge's external
+// adapter are checked against
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE bridge_types ADD COLUMN response_schema jsonb;
+    `).Error
+}
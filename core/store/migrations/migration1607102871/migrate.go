@@ -0,0 +1,13 @@
+package migration1607102871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the timeout, retries and circuit breaker policy of bridges
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE bridge_types ADD COLUMN timeout bigint NOT NULL DEFAULT 0;
+		ALTER TABLE bridge_types ADD COLUMN retries bigint;
+		ALTER TABLE bridge_types ADD COLUMN circuit_breaker_threshold bigint NOT NULL DEFAULT 0;
+		ALTER TABLE bridge_types ADD COLUMN circuit_breaker_cooldown bigint NOT NULL DEFAULT 0;
+    `).Error
+}
@@ -0,0 +1,10 @@
+package migration1607189271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the inputs tasks declare in place of the task before them
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE task_specs ADD COLUMN inputs jsonb;
+    `).Error
+}
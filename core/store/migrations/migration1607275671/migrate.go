@@ -0,0 +1,22 @@
+package migration1607275671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds job spec versions. The initiators and tasks replaced by a new
+// version are marked as superseded, and kept for the runs which refer to them.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN version integer NOT NULL DEFAULT 1;
+		ALTER TABLE initiators ADD COLUMN superseded_at timestamptz;
+		ALTER TABLE task_specs ADD COLUMN superseded_at timestamptz;
+
+		CREATE TABLE job_spec_versions (
+			id BIGSERIAL PRIMARY KEY,
+			job_spec_id uuid NOT NULL REFERENCES job_specs (id) ON DELETE CASCADE,
+			version integer NOT NULL,
+			spec jsonb NOT NULL,
+			created_at timestamptz NOT NULL,
+			UNIQUE (job_spec_id, version)
+		);
+    `).Error
+}
@@ -0,0 +1,10 @@
+package migration1607362071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the time at which a job was paused, if it is paused.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN paused_at timestamptz;
+    `).Error
+}
@@ -0,0 +1,11 @@
+package migration1607448471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the limits a job may set on how long, and how many of, its
+// finished runs are kept.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN run_retention jsonb;
+    `).Error
+}
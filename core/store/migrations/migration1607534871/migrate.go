@@ -0,0 +1,29 @@
+package migration1607534871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the limit a job may set on its unfinished runs, and the
+// pending_concurrency status of the runs waiting for it. The run_status type
+// is recreated with the new status, as values cannot be added to an enum
+// within a transaction.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN max_concurrent_runs bigint NOT NULL DEFAULT 0;
+
+		ALTER TYPE run_status RENAME TO run_status_old;
+		CREATE TYPE run_status AS ENUM ('unstarted', 'in_progress', 'pending_incoming_confirmations', 'pending_outgoing_confirmations', 'pending_connection', 'pending_bridge', 'pending_sleep', 'pending_concurrency', 'errored', 'completed', 'cancelled');
+
+		DROP INDEX idx_job_runs_status;
+		DROP INDEX idx_task_runs_status;
+		ALTER TABLE job_runs ALTER COLUMN status DROP DEFAULT;
+		ALTER TABLE task_runs ALTER COLUMN status DROP DEFAULT;
+		ALTER TABLE job_runs ALTER COLUMN status TYPE run_status USING status::text::run_status;
+		ALTER TABLE task_runs ALTER COLUMN status TYPE run_status USING status::text::run_status;
+		DROP TYPE run_status_old;
+
+		CREATE INDEX idx_job_runs_status ON job_runs(status) WHERE status != 'completed'::run_status;
+		CREATE INDEX idx_task_runs_status ON task_runs(status) WHERE status != 'completed'::run_status;
+		ALTER TABLE job_runs ALTER COLUMN status SET DEFAULT 'unstarted';
+		ALTER TABLE task_runs ALTER COLUMN status SET DEFAULT 'unstarted';
+	`).Error
+}
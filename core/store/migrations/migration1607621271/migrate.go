@@ -0,0 +1,10 @@
+package migration1607621271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds where a job's operator is notified of its runs erroring.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE job_specs ADD COLUMN failure_notifications jsonb;
+	`).Error
+}
@@ -0,0 +1,10 @@
+package migration1607707671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the further schedules a cron initiator may run on.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN schedules text;
+	`).Error
+}
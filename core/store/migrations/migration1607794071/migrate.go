@@ -0,0 +1,13 @@
+package migration1607794071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the interval and end time of recurring runat initiators, and
+// the time they last ran at, from which they resume after a restart.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN every bigint;
+		ALTER TABLE initiators ADD COLUMN until timestamptz;
+		ALTER TABLE initiators ADD COLUMN last_ran_at timestamptz;
+	`).Error
+}
@@ -0,0 +1,11 @@
+package migration1607880471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the secret with which the requests to webhook initiators are
+// signed.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN secret text;
+	`).Error
+}
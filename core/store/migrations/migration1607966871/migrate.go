@@ -0,0 +1,10 @@
+package migration1607966871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the topic and connection settings of kafka initiators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN kafka jsonb;
+	`).Error
+}
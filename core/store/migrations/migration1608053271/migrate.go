@@ -0,0 +1,10 @@
+package migration1608053271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the topic and connection settings of mqtt initiators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN mqtt jsonb;
+	`).Error
+}
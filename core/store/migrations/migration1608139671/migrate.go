@@ -0,0 +1,11 @@
+package migration1608139671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the number of blocks between the runs of blockinterval
+// initiators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN block_interval bigint;
+	`).Error
+}
@@ -0,0 +1,11 @@
+package migration1608226071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the gas price which gasprice initiators watch for the node's
+// gas price to cross.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN gas_price_threshold varchar(255);
+	`).Error
+}
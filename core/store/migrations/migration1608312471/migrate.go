@@ -0,0 +1,10 @@
+package migration1608312471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the ABI of the event which ethlog initiators decode.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN event_abi text;
+	`).Error
+}
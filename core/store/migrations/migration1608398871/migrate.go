@@ -0,0 +1,12 @@
+package migration1608398871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the further oracle contracts watched by runlog initiators, and
+// the oracle whose log requested each run.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN addresses text;
+		ALTER TABLE run_requests ADD COLUMN oracle bytea;
+	`).Error
+}
@@ -0,0 +1,11 @@
+package migration1608485271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the minimum interval between the runs of an initiator, within
+// which further runs it triggers are dropped.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN min_incoming_interval bigint;
+	`).Error
+}
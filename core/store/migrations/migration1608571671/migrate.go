@@ -0,0 +1,11 @@
+package migration1608571671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the strategy with which a fluxmonitor initiator aggregates the
+// answers of its feeds.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN aggregation jsonb;
+	`).Error
+}
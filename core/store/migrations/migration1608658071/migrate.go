@@ -0,0 +1,11 @@
+package migration1608658071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the drumbeat schedule on which fluxmonitor initiators submit
+// answers regardless of deviation.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN drumbeat_schedule text;
+	`).Error
+}
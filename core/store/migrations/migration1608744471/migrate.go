@@ -0,0 +1,11 @@
+package migration1608744471
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the Flags contract whose flags pause a fluxmonitor initiator,
+// in place of the node's FLAGS_CONTRACT_ADDRESS.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN flags_contract_address bytea;
+	`).Error
+}
@@ -0,0 +1,12 @@
+package migration1608830871
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the release thresholds which give a fluxmonitor initiator
+// hysteresis around its deviation thresholds.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN release_threshold float;
+		ALTER TABLE initiators ADD COLUMN absolute_release_threshold float;
+	`).Error
+}
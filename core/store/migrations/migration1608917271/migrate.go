@@ -0,0 +1,25 @@
+package migration1608917271
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the simulate param of fluxmonitor initiators, and the table
+// in which simulated initiators record the answers they would have submitted.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN simulate boolean NOT NULL DEFAULT false;
+
+		CREATE TABLE flux_monitor_simulated_submissions (
+			id BIGSERIAL PRIMARY KEY,
+			job_spec_id uuid NOT NULL REFERENCES job_specs (id) ON DELETE CASCADE,
+			initiator_id bigint NOT NULL,
+			aggregator bytea NOT NULL,
+			round_id integer NOT NULL,
+			answer numeric NOT NULL,
+			latest_answer numeric,
+			rejected_feeds text[],
+			created_at timestamptz NOT NULL,
+			UNIQUE (initiator_id, round_id)
+		);
+		CREATE INDEX idx_flux_monitor_simulated_submissions_job_spec_id ON flux_monitor_simulated_submissions (job_spec_id, created_at);
+	`).Error
+}
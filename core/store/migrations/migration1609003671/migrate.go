@@ -0,0 +1,10 @@
+package migration1609003671
+
+import "github.com/jinzhu/gorm"
+
+// Migrate adds the skipSupermajorityRounds param of fluxmonitor initiators.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE initiators ADD COLUMN skip_supermajority_rounds boolean NOT NULL DEFAULT false;
+	`).Error
+}
@@ -0,0 +1,13 @@
+package migration1609090071
+
+import "github.com/jinzhu/gorm"
+
+// Migrate records the chain of each key and head, which is NULL for the
+// primary chain.
+func Migrate(tx *gorm.DB) error {
+	return tx.Exec(`
+		ALTER TABLE keys ADD COLUMN evm_chain_id numeric(78,0);
+		ALTER TABLE heads ADD COLUMN evm_chain_id numeric(78,0);
+		CREATE INDEX idx_heads_evm_chain_id_number ON heads (evm_chain_id, number);
+	`).Error
+}
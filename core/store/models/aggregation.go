@@ -0,0 +1,66 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// AggregationMedian submits the median of the answers of a fluxmonitor
+	// initiator's feeds, or the mean of the middle two if there is an even
+	// number of them.
+	AggregationMedian = "median"
+	// AggregationMean submits the mean of the answers.
+	AggregationMean = "mean"
+	// AggregationTrimmedMean submits the mean of the answers left once the
+	// given percentage of them has been dropped from each end.
+	AggregationTrimmedMean = "trimmedmean"
+)
+
+// AggregationConfig is how a fluxmonitor initiator combines the answers of
+// its feeds into the answer it submits.
+type AggregationConfig struct {
+	// Strategy is one of the Aggregation* constants, defaulting to median.
+	Strategy string `json:"strategy,omitempty"`
+	// TrimPercent is the percentage of the answers, rounded down, which a
+	// trimmed mean drops from each of the lowest and the highest.
+	TrimPercent float64 `json:"trimPercent,omitempty"`
+	// MaxDeviationPercent, when set, discards each answer which differs from
+	// the median of the answers by more than that percentage of the median,
+	// before the rest are aggregated.
+	MaxDeviationPercent float64 `json:"maxDeviationPercent,omitempty"`
+	// MaxMADs, when set, discards each answer which differs from the median
+	// by more than that many median absolute deviations of the answers.
+	MaxMADs float64 `json:"maxMADs,omitempty"`
+	// MinAnswers, when set, is how many feeds must answer a poll for an
+	// answer to be submitted. With fewer, the poll is skipped and the
+	// operator alerted, rather than submitting the aggregate of the few.
+	MinAnswers uint32 `json:"minAnswers,omitempty"`
+}
+
+// WithDefaults returns the config with its strategy defaulted to median.
+func (ac AggregationConfig) WithDefaults() AggregationConfig {
+	if ac.Strategy == "" {
+		ac.Strategy = AggregationMedian
+	}
+	return ac
+}
+
+// Value is defined so that we can store AggregationConfig as JSONB.
+func (ac AggregationConfig) Value() (driver.Value, error) {
+	return json.Marshal(ac)
+}
+
+// Scan is defined so that we can read AggregationConfig as JSONB.
+func (ac *AggregationConfig) Scan(value interface{}) error {
+	if value == nil {
+		*ac = AggregationConfig{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal AggregationConfig JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, ac)
+}
@@ -6,15 +6,23 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
 type BridgeTypeRequest struct {
-	Name                   TaskType     `json:"name"`
-	URL                    WebURL       `json:"url"`
-	Confirmations          uint32       `json:"confirmations"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
+	Name                    TaskType      `json:"name"`
+	URL                     WebURL        `json:"url"`
+	Confirmations           uint32        `json:"confirmations"`
+	MinimumContractPayment  *assets.Link  `json:"minimumContractPayment"`
+	ClientCertificate       string        `json:"clientCertificate,omitempty"`
+	Proxy                   string        `json:"proxy,omitempty"`
+	ResponseSchema          *InputSchema  `json:"responseSchema,omitempty"`
+	Timeout                 Duration      `json:"timeout"`
+	Retries                 clnull.Uint32 `json:"retries"`
+	CircuitBreakerThreshold uint32        `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  Duration      `json:"circuitBreakerCooldown"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -36,12 +44,19 @@ func (bt *BridgeTypeRequest) SetID(value string) error {
 
 // BridgeTypeAuthentication is the record returned in response to a request to create a BridgeType
 type BridgeTypeAuthentication struct {
-	Name                   TaskType     `json:"name"`
-	URL                    WebURL       `json:"url"`
-	Confirmations          uint32       `json:"confirmations"`
-	IncomingToken          string       `json:"incomingToken"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
+	Name                    TaskType      `json:"name"`
+	URL                     WebURL        `json:"url"`
+	Confirmations           uint32        `json:"confirmations"`
+	IncomingToken           string        `json:"incomingToken"`
+	OutgoingToken           string        `json:"outgoingToken"`
+	MinimumContractPayment  *assets.Link  `json:"minimumContractPayment"`
+	ClientCertificate       string        `json:"clientCertificate,omitempty"`
+	Proxy                   string        `json:"proxy,omitempty"`
+	ResponseSchema          *InputSchema  `json:"responseSchema,omitempty"`
+	Timeout                 Duration      `json:"timeout"`
+	Retries                 clnull.Uint32 `json:"retries"`
+	CircuitBreakerThreshold uint32        `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  Duration      `json:"circuitBreakerCooldown"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -71,8 +86,24 @@ type BridgeType struct {
 	Salt                   string       `json:"-"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment" gorm:"type:varchar(255)"`
-	CreatedAt              time.Time    `json:"-"`
-	UpdatedAt              time.Time    `json:"-"`
+	ClientCertificate      string       `json:"clientCertificate"`
+	Proxy                  string       `json:"proxy"`
+	// ResponseSchema, when present, is checked against the data returned by
+	// the external adapter
+	ResponseSchema *InputSchema `json:"responseSchema,omitempty" gorm:"type:jsonb"`
+	// Timeout, when not zero, is used for requests to the external adapter in
+	// place of the default HTTP timeout
+	Timeout Duration `json:"timeout"`
+	// Retries, when set, is the number of times a failed request to the
+	// external adapter is retried, in place of the default
+	Retries clnull.Uint32 `json:"retries"`
+	// CircuitBreakerThreshold, when not zero, is the number of consecutive
+	// failed requests after which the bridge is skipped for the
+	// CircuitBreakerCooldown, failing its tasks without a request being sent
+	CircuitBreakerThreshold uint32    `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  Duration  `json:"circuitBreakerCooldown"`
+	CreatedAt               time.Time `json:"-"`
+	UpdatedAt               time.Time `json:"-"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -106,20 +137,34 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	}
 
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
+			Name:                    btr.Name,
+			URL:                     btr.URL,
+			Confirmations:           btr.Confirmations,
+			IncomingToken:           incomingToken,
+			OutgoingToken:           outgoingToken,
+			MinimumContractPayment:  btr.MinimumContractPayment,
+			ClientCertificate:       btr.ClientCertificate,
+			Proxy:                   btr.Proxy,
+			ResponseSchema:          btr.ResponseSchema,
+			Timeout:                 btr.Timeout,
+			Retries:                 btr.Retries,
+			CircuitBreakerThreshold: btr.CircuitBreakerThreshold,
+			CircuitBreakerCooldown:  btr.CircuitBreakerCooldown,
 		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
+			Name:                    btr.Name,
+			URL:                     btr.URL,
+			Confirmations:           btr.Confirmations,
+			IncomingTokenHash:       hash,
+			Salt:                    salt,
+			OutgoingToken:           outgoingToken,
+			MinimumContractPayment:  btr.MinimumContractPayment,
+			ClientCertificate:       btr.ClientCertificate,
+			Proxy:                   btr.Proxy,
+			ResponseSchema:          btr.ResponseSchema,
+			Timeout:                 btr.Timeout,
+			Retries:                 btr.Retries,
+			CircuitBreakerThreshold: btr.CircuitBreakerThreshold,
+			CircuitBreakerCooldown:  btr.CircuitBreakerCooldown,
 		}, nil
 }
 
@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -63,3 +64,17 @@ func (r *RunStatusCollection) Scan(value interface{}) error {
 	*r = collection
 	return nil
 }
+
+// DeadLetterRunRequest selects the runs in the dead-letter queue to be retried
+// or discarded.
+type DeadLetterRunRequest struct {
+	RunIDs []*ID `json:"runIds"`
+}
+
+// ValidateDeadLetterRunRequest checks that at least one run is selected.
+func ValidateDeadLetterRunRequest(request *DeadLetterRunRequest) error {
+	if len(request.RunIDs) == 0 {
+		return errors.New("must select at least one run by its ID in runIds")
+	}
+	return nil
+}
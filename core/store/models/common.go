@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
@@ -48,6 +49,10 @@ const (
 	RunStatusPendingBridge = RunStatus("pending_bridge")
 	// RunStatusPendingSleep is used for when a run is waiting on a sleep function to finish.
 	RunStatusPendingSleep = RunStatus("pending_sleep")
+	// RunStatusPendingConcurrency is used for when a run is waiting for earlier
+	// runs of its job to finish, as the job has as many unfinished runs as it
+	// allows.
+	RunStatusPendingConcurrency = RunStatus("pending_concurrency")
 	// RunStatusPendingOutgoingConfirmations is used for when a run is waiting for outgoing block confirmations
 	// e.g. we have sent a transaction using ethtx and are now waiting for it to be N blocks deep
 	RunStatusPendingOutgoingConfirmations = RunStatus("pending_outgoing_confirmations")
@@ -84,6 +89,11 @@ func (s RunStatus) PendingSleep() bool {
 	return s == RunStatusPendingSleep
 }
 
+// PendingConcurrency returns true if the status is pending_concurrency.
+func (s RunStatus) PendingConcurrency() bool {
+	return s == RunStatusPendingConcurrency
+}
+
 // PendingOutgoingConfirmations returns true if the status is pending_incoming_confirmations.
 func (s RunStatus) PendingOutgoingConfirmations() bool {
 	return s == RunStatusPendingOutgoingConfirmations
@@ -106,7 +116,7 @@ func (s RunStatus) Errored() bool {
 
 // Pending returns true if the status is pending external or confirmations.
 func (s RunStatus) Pending() bool {
-	return s.PendingBridge() || s.PendingIncomingConfirmations() || s.PendingOutgoingConfirmations() || s.PendingSleep() || s.PendingConnection()
+	return s.PendingBridge() || s.PendingIncomingConfirmations() || s.PendingOutgoingConfirmations() || s.PendingSleep() || s.PendingConnection() || s.PendingConcurrency()
 }
 
 // Finished returns true if the status is final and can't be changed.
@@ -477,6 +487,38 @@ func (c Cron) String() string {
 	return string(c)
 }
 
+// Crons are the further schedules of a cron initiator, on each of which it
+// starts a run.
+type Crons []Cron
+
+// Scan coerces the value returned from the data store to the proper data
+// in this instance.
+func (c *Crons) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to Crons", value, value)
+	}
+}
+
+// Value returns this instance serialized for database storage.
+func (c Crons) Value() (driver.Value, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	j, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(j), nil
+}
+
 // Duration is a non-negative time duration.
 type Duration struct{ d time.Duration }
 
@@ -602,6 +644,31 @@ type SendEtherRequest struct {
 	Amount             assets.Eth     `json:"amount"`
 }
 
+// RebroadcastRequest represents a request to re-sign and re-send the
+// unconfirmed transactions of the node's keys, starting at BeginningNonce. If
+// Address is set only the transactions of that key are rebroadcast.
+type RebroadcastRequest struct {
+	BeginningNonce uint            `json:"beginningNonce"`
+	GasPrice       *utils.Big      `json:"gasPrice"`
+	GasLimit       uint64          `json:"gasLimit,omitempty"`
+	Address        *common.Address `json:"address,omitempty"`
+}
+
+// RepairNonceGapsRequest represents a request to repair the nonce gaps of the
+// node's keys. If Address is set only the nonces of that key are repaired.
+type RepairNonceGapsRequest struct {
+	Address *common.Address `json:"address,omitempty"`
+}
+
+// UpdateFluxMonitorRequest represents a request to change the feeds, or the
+// deviation thresholds, of the fluxmonitor initiators of a job while it runs.
+// Only the fields given are changed.
+type UpdateFluxMonitorRequest struct {
+	Feeds             *Feeds   `json:"feeds,omitempty"`
+	Threshold         *float32 `json:"threshold,omitempty"`
+	AbsoluteThreshold *float32 `json:"absoluteThreshold,omitempty"`
+}
+
 // CreateKeyRequest represents a request to add an ethereum key.
 type CreateKeyRequest struct {
 	CurrentPassword string `json:"current_password"`
@@ -396,3 +396,31 @@ func TestCron_UnmarshalJSON_Invalid(t *testing.T) {
 		})
 	}
 }
+
+func TestCrons_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var params models.InitiatorParams
+	err := json.Unmarshal([]byte(`{"schedule": "CRON_TZ=UTC */15 9-17 * * 1-5", "schedules": ["CRON_TZ=UTC 0 2 * * *"]}`), &params)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Cron{"CRON_TZ=UTC */15 9-17 * * 1-5", "CRON_TZ=UTC 0 2 * * *"}, params.CronSchedules())
+
+	err = json.Unmarshal([]byte(`{"schedules": ["CRON_TZ=UTC 0 2 * * *", "0 2 * * *"]}`), &params)
+	assert.Error(t, err)
+}
+
+func TestCrons_ScanValue(t *testing.T) {
+	t.Parallel()
+
+	crons := models.Crons{"CRON_TZ=UTC 0 2 * * *", "CRON_TZ=UTC 0 14 * * *"}
+	value, err := crons.Value()
+	require.NoError(t, err)
+
+	var scanned models.Crons
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, crons, scanned)
+
+	value, err = models.Crons{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
@@ -52,10 +52,16 @@ type EthTx struct {
 	EncodedPayload []byte
 	Value          assets.Eth
 	GasLimit       uint64
+	GasPrice       *utils.Big
+	MaxGasPrice    *utils.Big
 	Error          *string
 	BroadcastAt    *time.Time
 	CreatedAt      time.Time
 	State          EthTxState
+	Abandoned      bool
+	GasPriceCapped bool
+	IdempotencyKey *string
+	Priority       int32
 	EthTxAttempts  []EthTxAttempt `gorm:"association_autoupdate:false;association_autocreate:false"`
 }
 
@@ -232,6 +238,9 @@ type Head struct {
 	Parent     *Head
 	Timestamp  time.Time
 	CreatedAt  time.Time
+	// EVMChainID is the secondary chain of the head, or nil for the primary
+	// chain.
+	EVMChainID *utils.Big `gorm:"column:evm_chain_id"`
 }
 
 // NewHead returns a Head instance.
@@ -0,0 +1,181 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// EventABI is the ABI of the event whose logs an ethlog initiator decodes
+// into the params of its runs. It is written as the event's entry in the
+// contract's JSON ABI, e.g.
+//
+//  {"name": "Transfer", "inputs": [
+//    {"name": "from", "type": "address", "indexed": true},
+//    {"name": "to", "type": "address", "indexed": true},
+//    {"name": "value", "type": "uint256"}
+//  ]}
+type EventABI struct {
+	abi.Event
+	raw json.RawMessage
+}
+
+// ParseEventABI parses the JSON ABI entry of an event. The entry's type may
+// be left out.
+func ParseEventABI(b []byte) (EventABI, error) {
+	var entry map[string]interface{}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return EventABI{}, errors.Wrap(err, "event ABI must be a JSON object")
+	}
+	if t, ok := entry["type"]; !ok {
+		entry["type"] = "event"
+	} else if t != "event" {
+		return EventABI{}, fmt.Errorf("event ABI has type %v, not event", t)
+	}
+	if name, _ := entry["name"].(string); name == "" {
+		return EventABI{}, errors.New("event ABI must have a name")
+	}
+	entries, err := json.Marshal([]interface{}{entry})
+	if err != nil {
+		return EventABI{}, err
+	}
+	parsed, err := abi.JSON(bytes.NewReader(entries))
+	if err != nil {
+		return EventABI{}, errors.Wrap(err, "invalid event ABI")
+	}
+	for _, event := range parsed.Events {
+		return EventABI{Event: event, raw: append(json.RawMessage(nil), b...)}, nil
+	}
+	return EventABI{}, errors.New("invalid event ABI")
+}
+
+// MarshalJSON returns the event's ABI as it was written.
+func (e EventABI) MarshalJSON() ([]byte, error) {
+	if e.raw == nil {
+		return []byte("null"), nil
+	}
+	return e.raw, nil
+}
+
+// UnmarshalJSON parses the JSON ABI entry of an event.
+func (e *EventABI) UnmarshalJSON(b []byte) error {
+	parsed, err := ParseEventABI(b)
+	if err != nil {
+		return err
+	}
+	*e = parsed
+	return nil
+}
+
+// Value returns the event's ABI for storage in the database.
+func (e EventABI) Value() (driver.Value, error) {
+	return string(e.raw), nil
+}
+
+// Scan reads the event's ABI from the database.
+func (e *EventABI) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		return e.UnmarshalJSON([]byte(v))
+	case []byte:
+		return e.UnmarshalJSON(v)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to EventABI", value, value)
+	}
+}
+
+// Decode returns the arguments of the event emitted by the log, by name.
+// Numbers are given as decimal strings, so that those too large for a JSON
+// number keep their precision, and addresses, hashes and bytes as hex. An
+// indexed string, bytes or array argument is given as the hash of its value,
+// which is all that the log holds.
+func (e EventABI) Decode(log Log) (JSON, error) {
+	topics := log.Topics
+	if !e.Anonymous {
+		if len(topics) == 0 || topics[0] != e.ID {
+			return JSON{}, fmt.Errorf("log is not a %s event", e.Sig)
+		}
+		topics = topics[1:]
+	}
+
+	var indexed abi.Arguments
+	for _, input := range e.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	args := make(map[string]interface{})
+	if err := abi.ParseTopicsIntoMap(args, indexed, topics); err != nil {
+		return JSON{}, errors.Wrapf(err, "unable to decode the topics of %s event", e.Sig)
+	}
+	if err := e.Inputs.NonIndexed().UnpackIntoMap(args, log.Data); err != nil {
+		return JSON{}, errors.Wrapf(err, "unable to decode the data of %s event", e.Sig)
+	}
+
+	for name, value := range args {
+		args[name] = abiValueToJSON(reflect.ValueOf(value))
+	}
+	b, err := json.Marshal(args)
+	if err != nil {
+		return JSON{}, err
+	}
+	return ParseJSON(b)
+}
+
+var (
+	bigIntType  = reflect.TypeOf(&big.Int{})
+	addressType = reflect.TypeOf(common.Address{})
+	hashType    = reflect.TypeOf(common.Hash{})
+)
+
+// abiValueToJSON converts a value decoded by the abi package to one which
+// marshals to JSON as described by EventABI.Decode.
+func abiValueToJSON(v reflect.Value) interface{} {
+	switch v.Type() {
+	case bigIntType:
+		return v.Interface().(*big.Int).String()
+	case addressType:
+		return v.Interface().(common.Address).Hex()
+	case hashType:
+		return v.Interface().(common.Hash).Hex()
+	}
+
+	switch v.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprint(v.Int())
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(v.Uint())
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		out := make([]interface{}, v.Len())
+		for i := range out {
+			out[i] = abiValueToJSON(v.Index(i))
+		}
+		return out
+	case reflect.Struct:
+		out := make(map[string]interface{})
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			out[name] = abiValueToJSON(v.Field(i))
+		}
+		return out
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,99 @@
+package models_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transferEventABI = `{"name": "Transfer", "inputs": [
+	{"name": "from", "type": "address", "indexed": true},
+	{"name": "to", "type": "address", "indexed": true},
+	{"name": "value", "type": "uint256"},
+	{"name": "memo", "type": "bytes"}
+]}`
+
+func transferLog(t *testing.T, from, to common.Address, value *big.Int, memo []byte) models.Log {
+	t.Helper()
+	data := append(common.LeftPadBytes(value.Bytes(), 32), common.LeftPadBytes([]byte{0x40}, 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(memo))).Bytes(), 32)...)
+	data = append(data, common.RightPadBytes(memo, (len(memo)+31)/32*32)...)
+	return models.Log{
+		Topics: []common.Hash{
+			utils.MustHash("Transfer(address,address,uint256,bytes)"),
+			from.Hash(),
+			to.Hash(),
+		},
+		Data: data,
+	}
+}
+
+func TestParseEventABI(t *testing.T) {
+	t.Parallel()
+
+	event, err := models.ParseEventABI([]byte(transferEventABI))
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer", event.RawName)
+	assert.Equal(t, utils.MustHash("Transfer(address,address,uint256,bytes)"), event.ID)
+
+	b, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.JSONEq(t, transferEventABI, string(b))
+
+	_, err = models.ParseEventABI([]byte(`{"inputs": []}`))
+	assert.Error(t, err)
+	_, err = models.ParseEventABI([]byte(`{"name": "transfer", "type": "function", "inputs": []}`))
+	assert.Error(t, err)
+	_, err = models.ParseEventABI([]byte(`{"name": "Transfer", "inputs": [{"name": "value", "type": "decimal"}]}`))
+	assert.Error(t, err)
+}
+
+func TestEventABI_Decode(t *testing.T) {
+	t.Parallel()
+
+	event, err := models.ParseEventABI([]byte(transferEventABI))
+	require.NoError(t, err)
+
+	from := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	to := common.HexToAddress("0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+	value, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	log := transferLog(t, from, to, value, []byte("rent"))
+
+	args, err := event.Decode(log)
+	require.NoError(t, err)
+	assert.Equal(t, from.Hex(), args.Get("from").String())
+	assert.Equal(t, to.Hex(), args.Get("to").String())
+	assert.Equal(t, "123456789012345678901234567890", args.Get("value").String())
+	assert.Equal(t, "0x72656e74", args.Get("memo").String())
+
+	log.Topics[0] = utils.MustHash("Approval(address,address,uint256)")
+	_, err = event.Decode(log)
+	assert.Error(t, err)
+}
+
+func TestEthLogEvent_JSON_EventABI(t *testing.T) {
+	t.Parallel()
+
+	event, err := models.ParseEventABI([]byte(transferEventABI))
+	require.NoError(t, err)
+	initr := models.Initiator{
+		Type:            models.InitiatorEthLog,
+		InitiatorParams: models.InitiatorParams{EventABI: &event},
+	}
+	log := transferLog(t, common.Address{}, common.Address{}, big.NewInt(7), nil)
+
+	le := models.InitiatorLogEvent{Initiator: initr, Log: log}.LogRequest()
+	rr, err := le.RunRequest()
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer", rr.RequestParams.Get("event").String())
+	assert.Equal(t, "7", rr.RequestParams.Get("args.value").String())
+	assert.Equal(t, "0x", rr.RequestParams.Get("args.memo").String())
+	assert.True(t, rr.RequestParams.Get("topics").Exists())
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// FailureNotifications are where the operator of a job is told when its runs
+// error, by a JSON post to a webhook and by email through the node's alert
+// SMTP server.
+type FailureNotifications struct {
+	WebhookURL *WebURL  `json:"webhookUrl,omitempty"`
+	Email      []string `json:"email,omitempty"`
+	// ConsecutiveErrors, when greater than one, is how many runs of the job
+	// must error in a row before a notification is sent. It is sent once each
+	// time the job reaches that many, rather than for every errored run.
+	ConsecutiveErrors uint32 `json:"consecutiveErrors,omitempty"`
+}
+
+// ShouldNotify returns true if a run erroring as the given number of
+// consecutive errored runs of the job is to be notified.
+func (fn *FailureNotifications) ShouldNotify(consecutive int) bool {
+	if fn == nil {
+		return false
+	}
+	if fn.ConsecutiveErrors <= 1 {
+		return true
+	}
+	return consecutive == int(fn.ConsecutiveErrors)
+}
+
+// Value is defined so that we can store FailureNotifications as JSONB.
+func (fn FailureNotifications) Value() (driver.Value, error) {
+	return json.Marshal(fn)
+}
+
+// Scan is defined so that we can read FailureNotifications as JSONB.
+func (fn *FailureNotifications) Scan(value interface{}) error {
+	if value == nil {
+		*fn = FailureNotifications{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal FailureNotifications JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, fn)
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Feed is an entry of the feeds of a fluxmonitor initiator. It is either the
+// URL of an external adapter, given as a string, or an object naming the URL
+// or a bridge along with how the feed is requested. Without those settings,
+// the initiator's requestData is posted to the feed with the node's default
+// HTTP timeout.
+type Feed struct {
+	URL string `json:"url,omitempty"`
+	// Bridge names a bridge whose URL is requested, with the bridge's
+	// outgoing token as a bearer token, as the bridge adapter does.
+	Bridge string `json:"bridge,omitempty"`
+	// Method is POST, by default, or GET, which sends no body.
+	Method string `json:"method,omitempty"`
+	// Headers are set on each request to the feed, such as its API key.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body, when set, is posted to the feed in place of the requestData.
+	Body *JSON `json:"body,omitempty"`
+	// Timeout replaces the node's default HTTP timeout for the feed.
+	Timeout *Duration `json:"timeout,omitempty"`
+	// Weight is how much the feed's answer counts in the aggregate relative
+	// to those of the other feeds. Unset, it is 1.
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// AggregationWeight returns the weight of the feed's answer in the aggregate.
+func (f Feed) AggregationWeight() float64 {
+	if f.Weight == 0 {
+		return 1
+	}
+	return f.Weight
+}
+
+// RequestMethod returns the HTTP method of the feed's requests.
+func (f Feed) RequestMethod() string {
+	if f.Method == "" {
+		return http.MethodPost
+	}
+	return strings.ToUpper(f.Method)
+}
+
+// UnmarshalJSON parses a feed given either as a URL string or as an object,
+// rejecting objects with keys it does not know.
+func (f *Feed) UnmarshalJSON(input []byte) error {
+	var url string
+	if err := json.Unmarshal(input, &url); err == nil {
+		*f = Feed{URL: url}
+		return nil
+	}
+
+	type feed Feed
+	var decoded feed
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&decoded); err != nil {
+		return errors.Wrap(err, "feed must be a URL or an object with a url or bridge")
+	}
+	*f = Feed(decoded)
+	return nil
+}
+
+// MarshalJSON returns a feed with only a URL as that URL string, as it is
+// usually given, and any other as an object.
+func (f Feed) MarshalJSON() ([]byte, error) {
+	if f.Bridge == "" && f.Method == "" && len(f.Headers) == 0 && f.Body == nil && f.Timeout == nil && f.Weight == 0 {
+		return json.Marshal(f.URL)
+	}
+	type feed Feed
+	return json.Marshal(feed(f))
+}
+
+// ParseFeeds returns each entry of the feeds parameter of a fluxmonitor
+// initiator.
+func ParseFeeds(feeds Feeds) ([]Feed, error) {
+	var parsed []Feed
+	if err := json.Unmarshal(feeds.Bytes(), &parsed); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
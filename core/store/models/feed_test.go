@@ -0,0 +1,49 @@
+package models_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFeeds(t *testing.T) {
+	t.Parallel()
+
+	feeds, err := models.ParseJSON([]byte(`[
+		"https://example.com/price",
+		{"bridge": "coinmarketcap"},
+		{"url": "https://example.com/auth", "method": "get", "headers": {"X-Api-Key": "s3cret"}, "timeout": "5s", "weight": 2.5}
+	]`))
+	require.NoError(t, err)
+
+	parsed, err := models.ParseFeeds(feeds)
+	require.NoError(t, err)
+	require.Len(t, parsed, 3)
+	assert.Equal(t, models.Feed{URL: "https://example.com/price"}, parsed[0])
+	assert.Equal(t, http.MethodPost, parsed[0].RequestMethod())
+	assert.Equal(t, models.Feed{Bridge: "coinmarketcap"}, parsed[1])
+	assert.Equal(t, "https://example.com/auth", parsed[2].URL)
+	assert.Equal(t, http.MethodGet, parsed[2].RequestMethod())
+	assert.Equal(t, map[string]string{"X-Api-Key": "s3cret"}, parsed[2].Headers)
+	require.NotNil(t, parsed[2].Timeout)
+	assert.Equal(t, 5*time.Second, parsed[2].Timeout.Duration())
+	assert.Equal(t, float64(1), parsed[0].AggregationWeight())
+	assert.Equal(t, 2.5, parsed[2].AggregationWeight())
+
+	b, err := json.Marshal(parsed[:2])
+	require.NoError(t, err)
+	assert.JSONEq(t, `["https://example.com/price", {"bridge": "coinmarketcap"}]`, string(b))
+
+	for _, invalid := range []string{`[1]`, `[{"bridgeName": "coinmarketcap"}]`, `[{"bridge": 1}]`, `[{"url": "https://example.com/price", "weight": "heavy"}]`} {
+		feeds, err := models.ParseJSON([]byte(invalid))
+		require.NoError(t, err)
+		_, err = models.ParseFeeds(feeds)
+		assert.Error(t, err, invalid)
+	}
+}
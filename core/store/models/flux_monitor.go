@@ -1,7 +1,12 @@
 package models
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
 )
 
 type FluxMonitorRoundStats struct {
@@ -12,3 +17,38 @@ type FluxMonitorRoundStats struct {
 	NumNewRoundLogs uint64         `gorm:"not null;default 0"`
 	NumSubmissions  uint64         `gorm:"not null;default 0"`
 }
+
+// FluxMonitorSimulatedSubmission is an answer which a simulated fluxmonitor
+// initiator would have submitted to a round of its aggregator, alongside the
+// aggregator's latest answer at the time.
+type FluxMonitorSimulatedSubmission struct {
+	ID            int64            `json:"-" gorm:"primary_key"`
+	JobSpecID     *ID              `json:"jobSpecId"`
+	InitiatorID   int64            `json:"initiatorId"`
+	Aggregator    common.Address   `json:"aggregator"`
+	RoundID       uint32           `json:"roundId"`
+	Answer        decimal.Decimal  `json:"answer" gorm:"type:numeric"`
+	LatestAnswer  *decimal.Decimal `json:"latestAnswer" gorm:"type:numeric"`
+	RejectedFeeds pq.StringArray   `json:"rejectedFeeds,omitempty" gorm:"type:text[]"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (s FluxMonitorSimulatedSubmission) GetID() string {
+	return strconv.FormatInt(s.ID, 10)
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (s FluxMonitorSimulatedSubmission) GetName() string {
+	return "fluxMonitorSimulatedSubmissions"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (s *FluxMonitorSimulatedSubmission) SetID(value string) error {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	s.ID = id
+	return nil
+}
@@ -0,0 +1,237 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+// Types which may be given as the type of an InputSchema.
+const (
+	InputSchemaObject  = "object"
+	InputSchemaArray   = "array"
+	InputSchemaString  = "string"
+	InputSchemaNumber  = "number"
+	InputSchemaInteger = "integer"
+	InputSchemaBoolean = "boolean"
+	InputSchemaNull    = "null"
+)
+
+// InputSchema describes the run parameters a job accepts, in a subset of JSON
+// Schema. The keywords supported are type, properties, required,
+// additionalProperties, items, enum, minimum, maximum, minLength, maxLength
+// and pattern. Any other keyword, other than the annotations $schema, title
+// and description, is refused rather than silently ignored.
+type InputSchema struct {
+	Schema               string                  `json:"$schema,omitempty"`
+	Title                string                  `json:"title,omitempty"`
+	Description          string                  `json:"description,omitempty"`
+	Type                 string                  `json:"type,omitempty"`
+	Properties           map[string]*InputSchema `json:"properties,omitempty"`
+	Required             []string                `json:"required,omitempty"`
+	AdditionalProperties *bool                   `json:"additionalProperties,omitempty"`
+	Items                *InputSchema            `json:"items,omitempty"`
+	Enum                 []interface{}           `json:"enum,omitempty"`
+	Minimum              *float64                `json:"minimum,omitempty"`
+	Maximum              *float64                `json:"maximum,omitempty"`
+	MinLength            *int                    `json:"minLength,omitempty"`
+	MaxLength            *int                    `json:"maxLength,omitempty"`
+	Pattern              string                  `json:"pattern,omitempty"`
+}
+
+// UnmarshalJSON parses the schema, returning an error if it uses keywords
+// which are not supported or is otherwise invalid.
+func (s *InputSchema) UnmarshalJSON(input []byte) error {
+	type plain InputSchema
+	var p plain
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&p); err != nil {
+		return errors.Wrap(err, "invalid input schema")
+	}
+	*s = InputSchema(p)
+	return s.check()
+}
+
+func (s InputSchema) check() error {
+	switch s.Type {
+	case "", InputSchemaObject, InputSchemaArray, InputSchemaString, InputSchemaNumber,
+		InputSchemaInteger, InputSchemaBoolean, InputSchemaNull:
+	default:
+		return fmt.Errorf("invalid input schema: unknown type %q", s.Type)
+	}
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			return errors.Wrap(err, "invalid input schema pattern")
+		}
+	}
+	if s.Minimum != nil && s.Maximum != nil && *s.Minimum > *s.Maximum {
+		return errors.New("invalid input schema: minimum is greater than maximum")
+	}
+	if s.MinLength != nil && s.MaxLength != nil && *s.MinLength > *s.MaxLength {
+		return errors.New("invalid input schema: minLength is greater than maxLength")
+	}
+	return nil
+}
+
+// Validate returns an error describing the first way in which the input does
+// not conform to the schema, if any.
+func (s InputSchema) Validate(input JSON) error {
+	return s.ValidateNamed("input", input)
+}
+
+// ValidateNamed is Validate for a value other than run parameters, which is
+// referred to by name in the error.
+func (s InputSchema) ValidateNamed(name string, input JSON) error {
+	value := input.Result
+	if input.Raw == "" {
+		value = gjson.Parse("{}")
+	}
+	return s.validate(name, value)
+}
+
+func (s InputSchema) validate(path string, value gjson.Result) error {
+	if s.Type != "" && !inputSchemaTypeMatches(s.Type, value) {
+		return fmt.Errorf("%s must be of type %s, got %s", path, s.Type, inputSchemaTypeOf(value))
+	}
+	if len(s.Enum) > 0 && !s.enumContains(value) {
+		return fmt.Errorf("%s must be one of the values enumerated by the input schema, got %s", path, value.Raw)
+	}
+
+	switch {
+	case value.Type == gjson.Number:
+		if s.Minimum != nil && value.Num < *s.Minimum {
+			return fmt.Errorf("%s must be at least %v, got %v", path, *s.Minimum, value.Num)
+		}
+		if s.Maximum != nil && value.Num > *s.Maximum {
+			return fmt.Errorf("%s must be at most %v, got %v", path, *s.Maximum, value.Num)
+		}
+	case value.Type == gjson.String:
+		length := utf8.RuneCountInString(value.Str)
+		if s.MinLength != nil && length < *s.MinLength {
+			return fmt.Errorf("%s must be at least %d characters long", path, *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return fmt.Errorf("%s must be at most %d characters long", path, *s.MaxLength)
+		}
+		if s.Pattern != "" && !regexp.MustCompile(s.Pattern).MatchString(value.Str) {
+			return fmt.Errorf("%s must match the pattern %s", path, s.Pattern)
+		}
+	case value.IsObject():
+		return s.validateObject(path, value)
+	case value.IsArray():
+		if s.Items == nil {
+			return nil
+		}
+		for i, item := range value.Array() {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s InputSchema) validateObject(path string, value gjson.Result) error {
+	fields := value.Map()
+	for _, name := range s.Required {
+		if _, ok := fields[name]; !ok {
+			return fmt.Errorf("%s is missing the required field %s", path, name)
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s has the field %s, which is not allowed by the input schema", path, name)
+			}
+			continue
+		}
+		if property == nil {
+			continue
+		}
+		if err := property.validate(path+"."+name, fields[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s InputSchema) enumContains(value gjson.Result) bool {
+	var actual interface{}
+	if err := json.Unmarshal([]byte(value.Raw), &actual); err != nil {
+		return false
+	}
+	for _, allowed := range s.Enum {
+		if reflect.DeepEqual(allowed, actual) {
+			return true
+		}
+	}
+	return false
+}
+
+func inputSchemaTypeMatches(t string, value gjson.Result) bool {
+	switch t {
+	case InputSchemaInteger:
+		return value.Type == gjson.Number && value.Num == math.Trunc(value.Num)
+	default:
+		return inputSchemaTypeOf(value) == t
+	}
+}
+
+func inputSchemaTypeOf(value gjson.Result) string {
+	switch {
+	case value.IsObject():
+		return InputSchemaObject
+	case value.IsArray():
+		return InputSchemaArray
+	}
+	switch value.Type {
+	case gjson.String:
+		return InputSchemaString
+	case gjson.Number:
+		return InputSchemaNumber
+	case gjson.True, gjson.False:
+		return InputSchemaBoolean
+	default:
+		return InputSchemaNull
+	}
+}
+
+// Value is defined so that we can store InputSchema as JSONB, because of an
+// error with GORM where it has trouble with nested structs as JSONB.
+func (s InputSchema) Value() (driver.Value, error) {
+	j, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Scan is defined so that we can read InputSchema as JSONB.
+func (s *InputSchema) Scan(value interface{}) error {
+	if value == nil {
+		*s = InputSchema{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal InputSchema JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, s)
+}
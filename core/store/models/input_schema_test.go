@@ -0,0 +1,97 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInputSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	var schema models.InputSchema
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"type": "object",
+		"required": ["symbol", "amount"],
+		"additionalProperties": false,
+		"properties": {
+			"symbol": {"type": "string", "pattern": "^[A-Z]+$", "maxLength": 5},
+			"amount": {"type": "integer", "minimum": 1},
+			"side": {"enum": ["buy", "sell"]},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`), &schema))
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"valid", `{"symbol": "ETH", "amount": 2, "side": "buy", "tags": ["a"]}`, ""},
+		{"empty", ``, "input is missing the required field symbol"},
+		{"not an object", `[]`, "input must be of type object, got array"},
+		{"missing field", `{"symbol": "ETH"}`, "input is missing the required field amount"},
+		{"wrong type", `{"symbol": "ETH", "amount": "2"}`, "input.amount must be of type integer, got string"},
+		{"not an integer", `{"symbol": "ETH", "amount": 2.5}`, "input.amount must be of type integer, got number"},
+		{"below minimum", `{"symbol": "ETH", "amount": 0}`, "input.amount must be at least 1, got 0"},
+		{"pattern", `{"symbol": "eth", "amount": 2}`, "input.symbol must match the pattern ^[A-Z]+$"},
+		{"too long", `{"symbol": "ETHEREUM", "amount": 2}`, "input.symbol must be at most 5 characters long"},
+		{"enum", `{"symbol": "ETH", "amount": 2, "side": "hold"}`, `input.side must be one of the values enumerated by the input schema, got "hold"`},
+		{"items", `{"symbol": "ETH", "amount": 2, "tags": ["a", 1]}`, "input.tags[1] must be of type string, got number"},
+		{"additional field", `{"symbol": "ETH", "amount": 2, "extra": true}`, "input has the field extra, which is not allowed by the input schema"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := models.JSON{}
+			if test.input != "" {
+				input = cltest.JSONFromString(t, test.input)
+			}
+			err := schema.Validate(input)
+			if test.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestInputSchema_ValidateNamed(t *testing.T) {
+	t.Parallel()
+
+	var schema models.InputSchema
+	require.NoError(t, json.Unmarshal([]byte(`{"type": "object", "required": ["price"], "properties": {"price": {"type": "number"}}}`), &schema))
+
+	assert.NoError(t, schema.ValidateNamed("response", cltest.JSONFromString(t, `{"price": 1.5}`)))
+	assert.EqualError(t, schema.ValidateNamed("response", cltest.JSONFromString(t, `{"price": "1.5"}`)),
+		"response.price must be of type number, got string")
+}
+
+func TestInputSchema_UnmarshalJSON_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		schema string
+	}{
+		{"unsupported keyword", `{"type": "object", "oneOf": []}`},
+		{"unknown type", `{"type": "decimal"}`},
+		{"nested unknown type", `{"properties": {"a": {"type": "decimal"}}}`},
+		{"bad pattern", `{"type": "string", "pattern": "("}`},
+		{"minimum above maximum", `{"type": "number", "minimum": 2, "maximum": 1}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var schema models.InputSchema
+			assert.Error(t, json.Unmarshal([]byte(test.schema), &schema))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+// JobBundle is a job spec packaged with the bridges and external initiators
+// it refers to, so that the job can be exported from one node and imported
+// into another. The credentials of the bridges and external initiators are
+// left out, as the importing node issues its own. So are the secrets of
+// webhook initiators and the passwords of kafka and mqtt initiators, which
+// must be filled in before the bundle is imported.
+type JobBundle struct {
+	Job                JobSpecRequest             `json:"job"`
+	Bridges            []BridgeTypeRequest        `json:"bridges"`
+	ExternalInitiators []ExternalInitiatorRequest `json:"externalInitiators"`
+}
+
+// NewJobBundle packages the job with the bridges and external initiators
+// given.
+func NewJobBundle(job JobSpec, bridges []BridgeType, externalInitiators []ExternalInitiator) (JobBundle, error) {
+	initiators := make([]Initiator, len(job.Initiators))
+	for i, initr := range job.Initiators {
+		initiators[i] = initr.WithoutCredentials()
+	}
+	job.Initiators = initiators
+	b, err := json.Marshal(job)
+	if err != nil {
+		return JobBundle{}, err
+	}
+	bundle := JobBundle{
+		Bridges:            make([]BridgeTypeRequest, len(bridges)),
+		ExternalInitiators: make([]ExternalInitiatorRequest, len(externalInitiators)),
+	}
+	if err := json.Unmarshal(b, &bundle.Job); err != nil {
+		return JobBundle{}, err
+	}
+	for i, bt := range bridges {
+		bundle.Bridges[i] = BridgeTypeRequest{
+			Name:                    bt.Name,
+			URL:                     bt.URL,
+			Confirmations:           bt.Confirmations,
+			MinimumContractPayment:  bt.MinimumContractPayment,
+			ClientCertificate:       bt.ClientCertificate,
+			Proxy:                   bt.Proxy,
+			ResponseSchema:          bt.ResponseSchema,
+			Timeout:                 bt.Timeout,
+			Retries:                 bt.Retries,
+			CircuitBreakerThreshold: bt.CircuitBreakerThreshold,
+			CircuitBreakerCooldown:  bt.CircuitBreakerCooldown,
+		}
+	}
+	for i, ei := range externalInitiators {
+		bundle.ExternalInitiators[i] = ExternalInitiatorRequest{Name: ei.Name, URL: ei.URL}
+	}
+	return bundle, nil
+}
@@ -0,0 +1,54 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJobBundle_LeavesOutCredentials(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators = []models.Initiator{
+		{Type: models.InitiatorWebhook, InitiatorParams: models.InitiatorParams{
+			Secret: "webhook-secret-0123456789",
+		}},
+		{Type: models.InitiatorKafka, InitiatorParams: models.InitiatorParams{
+			Kafka: &models.KafkaConfig{
+				Brokers: []string{"broker.example.com:9092"},
+				Topic:   "prices",
+				SASL:    &models.KafkaSASL{Username: "node", Password: "kafka-password"},
+			},
+		}},
+		{Type: models.InitiatorMQTT, InitiatorParams: models.InitiatorParams{
+			MQTT: &models.MQTTConfig{
+				Broker:   "tcp://broker.example.com:1883",
+				Topic:    "prices",
+				Username: "node",
+				Password: "mqtt-password",
+			},
+		}},
+	}
+
+	bundle, err := models.NewJobBundle(job, nil, nil)
+	require.NoError(t, err)
+	b, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(b), "webhook-secret-0123456789")
+	assert.NotContains(t, string(b), "kafka-password")
+	assert.NotContains(t, string(b), "mqtt-password")
+	require.Len(t, bundle.Job.Initiators, 3)
+	assert.Equal(t, "node", bundle.Job.Initiators[1].Kafka.SASL.Username)
+	assert.Equal(t, "node", bundle.Job.Initiators[2].MQTT.Username)
+
+	// The job itself keeps its credentials
+	assert.Equal(t, "kafka-password", job.Initiators[1].Kafka.SASL.Password)
+	assert.Equal(t, "mqtt-password", job.Initiators[2].MQTT.Password)
+}
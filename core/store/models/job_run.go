@@ -44,8 +44,35 @@ type JobRun struct {
 	ObservedHeight *utils.Big   `json:"observedHeight"`
 	DeletedAt      null.Time    `json:"-"`
 	Payment        *assets.Link `json:"payment,omitempty"`
+	Deadline       null.Time    `json:"deadline"`
+	Priority       RunPriority  `json:"priority,omitempty"`
+	DeadLetteredAt null.Time    `json:"deadLetteredAt"`
+	Rejection      Rejection    `json:"rejection,omitempty"`
+	ChainID        *utils.Big   `json:"chainId,omitempty"`
 }
 
+// Rejection classifies why a run was rejected before any of its tasks were
+// performed.
+type Rejection string
+
+const (
+	// RejectionInsufficientPayment is a request paying less than the job's
+	// minimum payment.
+	RejectionInsufficientPayment = Rejection("insufficient_payment")
+	// RejectionPaymentMismatch is a request whose fulfillment parameters do
+	// not match the payment of the log.
+	RejectionPaymentMismatch = Rejection("payment_mismatch")
+	// RejectionRequestIDMismatch is a request whose fulfillment parameters do
+	// not match the request ID of the log.
+	RejectionRequestIDMismatch = Rejection("request_id_mismatch")
+	// RejectionMalformedRequest is a request whose fulfillment parameters
+	// could not be decoded.
+	RejectionMalformedRequest = Rejection("malformed_request")
+	// RejectionExpiredRequest is a request which expired before it was
+	// received, so the requester may already have cancelled it.
+	RejectionExpiredRequest = Rejection("expired_request")
+)
+
 // MakeJobRun returns a new JobRun copy
 func MakeJobRun(job *JobSpec, now time.Time, initiator *Initiator, currentHeight *big.Int, runRequest *RunRequest) JobRun {
 	run := JobRun{
@@ -58,6 +85,11 @@ func MakeJobRun(job *JobSpec, now time.Time, initiator *Initiator, currentHeight
 		TaskRuns:    make([]TaskRun, len(job.Tasks)),
 		RunRequest:  *runRequest,
 		Payment:     runRequest.Payment,
+		Priority:    job.Priority,
+		ChainID:     job.ChainID,
+	}
+	if job.Deadline != nil && !job.Deadline.IsInstant() {
+		run.Deadline = null.TimeFrom(now.Add(job.Deadline.Duration()))
 	}
 	if currentHeight != nil {
 		run.CreationHeight = utils.NewBig(currentHeight)
@@ -174,6 +206,51 @@ func (jr *JobRun) PreviousTaskRun() *TaskRun {
 	return nil
 }
 
+// TaskOutputs returns the output data of each completed task run whose task
+// spec is named, keyed by that name.
+func (jr *JobRun) TaskOutputs() map[string]JSON {
+	outputs := make(map[string]JSON)
+	for _, tr := range jr.TaskRuns {
+		if tr.TaskSpec.Name != "" && tr.Status.Completed() {
+			outputs[tr.TaskSpec.Name] = tr.Result.Data
+		}
+	}
+	return outputs
+}
+
+// ExecutionTime returns the total time spent performing the run's tasks.
+func (jr *JobRun) ExecutionTime() time.Duration {
+	var total time.Duration
+	for _, tr := range jr.TaskRuns {
+		total += tr.Duration.Duration()
+	}
+	return total
+}
+
+// DataSize returns the number of bytes of output data held by the run's
+// tasks.
+func (jr *JobRun) DataSize() int64 {
+	var total int64
+	for _, tr := range jr.TaskRuns {
+		total += int64(len(tr.Result.Data.Raw))
+	}
+	return total
+}
+
+// SkipTasks completes up to count unstarted tasks from the index from
+// without performing them, or all of the remaining ones if count is negative.
+// Each passes data on to the task following it.
+func (jr *JobRun) SkipTasks(from, count int, data JSON) {
+	for i := from; i < len(jr.TaskRuns) && (count < 0 || i < from+count); i++ {
+		tr := &jr.TaskRuns[i]
+		if tr.Status != RunStatusUnstarted {
+			continue
+		}
+		tr.Result.Data = data
+		tr.Status = RunStatusCompleted
+	}
+}
+
 // TasksRemain returns true if there are unfinished tasks left for this job run
 func (jr *JobRun) TasksRemain() bool {
 	_, runnable := jr.NextTaskRunIndex()
@@ -186,6 +263,71 @@ func (jr *JobRun) SetError(err error) {
 	jr.SetStatus(RunStatusErrored)
 }
 
+// Reject errors the run before any of its tasks are performed, recording the
+// reason alongside the error.
+func (jr *JobRun) Reject(rejection Rejection, err error) {
+	jr.Rejection = rejection
+	jr.SetError(err)
+}
+
+// Expired returns true if the run has a deadline which has passed.
+func (jr *JobRun) Expired(now time.Time) bool {
+	return jr.Deadline.Valid && !now.Before(jr.Deadline.Time)
+}
+
+// Expire errors the run, along with its current task run, for having
+// exceeded its deadline.
+func (jr *JobRun) Expire() {
+	err := fmt.Errorf("run expired: exceeded deadline of %s", utils.ISO8601UTC(jr.Deadline.Time))
+	if currentTaskRun := jr.NextTaskRun(); currentTaskRun != nil {
+		currentTaskRun.SetError(err)
+	}
+	jr.SetError(err)
+}
+
+// DeadLetter records that the run failed permanently, moving it into the
+// dead-letter queue to be inspected, retried or discarded by the operator.
+func (jr *JobRun) DeadLetter(now time.Time) {
+	jr.DeadLetteredAt = null.TimeFrom(now)
+}
+
+// DeadLettered returns true if the run is in the dead-letter queue.
+func (jr *JobRun) DeadLettered() bool {
+	return jr.DeadLetteredAt.Valid
+}
+
+// ResetForRetry takes the run out of the dead-letter queue, resetting the
+// task it failed on and all those after it so that the run resumes from the
+// failed task. A deadline is extended by the time the run had originally.
+func (jr *JobRun) ResetForRetry(now time.Time) {
+	failed := false
+	for i := range jr.TaskRuns {
+		tr := &jr.TaskRuns[i]
+		if tr.Status.Errored() {
+			failed = true
+		}
+		if failed {
+			tr.Status = RunStatusUnstarted
+			tr.Result.ErrorMessage = null.String{}
+			tr.Attempts = 0
+			tr.RetryAt = null.Time{}
+			tr.SleepUntil = null.Time{}
+			tr.CheckpointedAt = null.Time{}
+			tr.StartedAt = null.Time{}
+			tr.FinishedAt = null.Time{}
+			tr.Duration = Duration{}
+			tr.BytesTransferred = 0
+		}
+	}
+	if jr.Deadline.Valid {
+		jr.Deadline = null.TimeFrom(now.Add(jr.Deadline.Time.Sub(jr.CreatedAt)))
+	}
+	jr.Result.ErrorMessage = null.String{}
+	jr.FinishedAt = null.Time{}
+	jr.DeadLetteredAt = null.Time{}
+	jr.SetStatus(RunStatusInProgress)
+}
+
 // Cancel sets this run as cancelled, it should no longer be processed.
 func (jr *JobRun) Cancel() {
 	currentTaskRun := jr.NextTaskRun()
@@ -229,6 +371,11 @@ type RunRequest struct {
 	CreatedAt     time.Time
 	Payment       *assets.Link
 	RequestParams JSON `gorm:"default: '{}';not null"`
+	// IdempotencyKey is set by web initiated runs whose request carried an
+	// Idempotency-Key header
+	IdempotencyKey *string
+	// Oracle is the contract whose log requested a runlog initiated run
+	Oracle *common.Address
 }
 
 // NewRunRequest returns a new RunRequest instance.
@@ -248,6 +395,14 @@ type TaskRun struct {
 	TaskSpecID                       int64         `json:"-"`
 	MinRequiredIncomingConfirmations clnull.Uint32 `json:"minimumConfirmations" gorm:"column:minimum_confirmations"`
 	ObservedIncomingConfirmations    clnull.Uint32 `json:"confirmations" gorm:"column:confirmations"`
+	Attempts                         uint32        `json:"attempts"`
+	RetryAt                          null.Time     `json:"retryAt"`
+	SleepUntil                       null.Time     `json:"sleepUntil"`
+	CheckpointedAt                   null.Time     `json:"checkpointedAt"`
+	StartedAt                        null.Time     `json:"startedAt"`
+	FinishedAt                       null.Time     `json:"finishedAt"`
+	Duration                         Duration      `json:"duration"`
+	BytesTransferred                 int64         `json:"bytesTransferred"`
 	CreatedAt                        time.Time     `json:"-"`
 	UpdatedAt                        time.Time     `json:"-"`
 }
@@ -261,6 +416,53 @@ func (tr TaskRun) String() string {
 func (tr *TaskRun) SetError(err error) {
 	tr.Result.ErrorMessage = null.StringFrom(err.Error())
 	tr.Status = RunStatusErrored
+	tr.CheckpointedAt = null.Time{}
+}
+
+// Checkpoint records that the task is about to be performed. It is set before
+// performing tasks with side effects outside the node, and cleared once their
+// outcome is recorded, so that a task interrupted by the node stopping can be
+// told apart from one which never started.
+func (tr *TaskRun) Checkpoint(now time.Time) {
+	tr.CheckpointedAt = null.TimeFrom(now)
+}
+
+// Interrupted returns true if the task began to be performed but its outcome
+// was never recorded.
+func (tr *TaskRun) Interrupted() bool {
+	return tr.CheckpointedAt.Valid
+}
+
+// RecordAttempt accounts for an attempt at performing the task which ran from
+// start to end. StartedAt is the start of the first attempt and FinishedAt the
+// end of the latest, while Duration and BytesTransferred are totalled across
+// attempts, leaving out time spent waiting to retry.
+func (tr *TaskRun) RecordAttempt(start, end time.Time, bytesTransferred int64) {
+	tr.Attempts++
+	if !tr.StartedAt.Valid {
+		tr.StartedAt = null.TimeFrom(start)
+	}
+	tr.FinishedAt = null.TimeFrom(end)
+	tr.Duration = Duration{d: tr.Duration.d + end.Sub(start)}
+	tr.BytesTransferred += bytesTransferred
+}
+
+// ScheduleRetry records the error of a failed attempt at the task, leaving it
+// in progress to be performed again at the given time. Its checkpoint is kept,
+// so that a task with side effects is never retried as if it had not begun.
+func (tr *TaskRun) ScheduleRetry(err error, retryAt time.Time) {
+	tr.Result.ErrorMessage = null.StringFrom(err.Error())
+	tr.Status = RunStatusInProgress
+	tr.RetryAt = null.TimeFrom(retryAt)
+}
+
+// Sleep records that the task is sleeping until the given time, after which
+// it completes with data. The time is persisted so that a sleep outlasts a
+// restart of the node.
+func (tr *TaskRun) Sleep(data JSON, until time.Time) {
+	tr.Result.Data = data
+	tr.Status = RunStatusPendingSleep
+	tr.SleepUntil = null.TimeFrom(until)
 }
 
 // ApplyBridgeRunResult updates the TaskRun's Result and Status
@@ -270,6 +472,9 @@ func (tr *TaskRun) ApplyBridgeRunResult(result BridgeRunResult) {
 	}
 	tr.Result.Data = result.Data
 	tr.Status = result.Status
+	if tr.Status.Finished() {
+		tr.FinishedAt = null.TimeFrom(time.Now())
+	}
 }
 
 // ApplyOutput updates the TaskRun's Result and Status
@@ -279,7 +484,10 @@ func (tr *TaskRun) ApplyOutput(result RunOutput) {
 		return
 	}
 	tr.Result.Data = result.Data()
+	tr.Result.ErrorMessage = null.String{}
 	tr.Status = result.Status()
+	tr.RetryAt = null.Time{}
+	tr.CheckpointedAt = null.Time{}
 }
 
 // RunResult keeps track of the outcome of a TaskRun or JobRun. It stores the
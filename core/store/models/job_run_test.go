@@ -5,6 +5,7 @@ import (
 	"errors"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -209,3 +210,85 @@ func TestJobRun_ApplyOutput_ErrorSetsFinishedAt(t *testing.T) {
 	jobRun.ApplyOutput(result)
 	assert.True(t, jobRun.FinishedAt.Valid)
 }
+
+func TestJobRun_Expire(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	deadline := models.MustMakeDuration(time.Minute)
+	job.Deadline = &deadline
+	now := time.Now()
+	initr := job.Initiators[0]
+	jobRun := models.MakeJobRun(&job, now, &initr, nil, &models.RunRequest{})
+
+	require.True(t, jobRun.Deadline.Valid)
+	assert.Equal(t, now.Add(time.Minute), jobRun.Deadline.Time)
+	assert.False(t, jobRun.Expired(now))
+	assert.True(t, jobRun.Expired(now.Add(time.Minute)))
+
+	jobRun.Expire()
+	assert.True(t, jobRun.GetStatus().Errored())
+	assert.True(t, jobRun.FinishedAt.Valid)
+	assert.Contains(t, jobRun.Result.ErrorMessage.String, "run expired")
+	assert.True(t, jobRun.TaskRuns[0].Status.Errored())
+}
+
+func TestJobRun_Expired_NoDeadline(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	initr := job.Initiators[0]
+	jobRun := models.MakeJobRun(&job, time.Now(), &initr, nil, &models.RunRequest{})
+
+	assert.False(t, jobRun.Deadline.Valid)
+	assert.False(t, jobRun.Expired(time.Now().Add(24*time.Hour)))
+}
+
+func TestJobRun_ResetForRetry(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: "noop"}, {Type: "noop"}, {Type: "noop"}}
+	deadline := models.MustMakeDuration(time.Minute)
+	job.Deadline = &deadline
+	createdAt := time.Now()
+	initr := job.Initiators[0]
+	jobRun := models.MakeJobRun(&job, createdAt, &initr, nil, &models.RunRequest{})
+
+	jobRun.TaskRuns[0].ApplyOutput(models.NewRunOutputCompleteWithResult("first"))
+	jobRun.TaskRuns[1].Attempts = 3
+	jobRun.TaskRuns[1].SetError(errors.New("failed"))
+	jobRun.SetError(errors.New("failed"))
+	jobRun.DeadLetter(createdAt)
+	require.True(t, jobRun.DeadLettered())
+
+	now := createdAt.Add(time.Hour)
+	jobRun.ResetForRetry(now)
+
+	assert.False(t, jobRun.DeadLettered())
+	assert.Equal(t, models.RunStatusInProgress, jobRun.GetStatus())
+	assert.False(t, jobRun.Result.ErrorMessage.Valid)
+	assert.False(t, jobRun.FinishedAt.Valid)
+	assert.Equal(t, now.Add(time.Minute), jobRun.Deadline.Time)
+
+	assert.Equal(t, models.RunStatusCompleted, jobRun.TaskRuns[0].Status)
+	assert.Equal(t, models.RunStatusUnstarted, jobRun.TaskRuns[1].Status)
+	assert.Equal(t, uint32(0), jobRun.TaskRuns[1].Attempts)
+	assert.False(t, jobRun.TaskRuns[1].Result.ErrorMessage.Valid)
+	assert.Equal(t, models.RunStatusUnstarted, jobRun.TaskRuns[2].Status)
+}
+
+func TestTaskRun_RecordAttempt(t *testing.T) {
+	t.Parallel()
+
+	var tr models.TaskRun
+	start := time.Now()
+	tr.RecordAttempt(start, start.Add(2*time.Second), 100)
+	tr.RecordAttempt(start.Add(time.Minute), start.Add(time.Minute+3*time.Second), 50)
+
+	assert.Equal(t, uint32(2), tr.Attempts)
+	assert.Equal(t, start, tr.StartedAt.Time)
+	assert.Equal(t, start.Add(time.Minute+3*time.Second), tr.FinishedAt.Time)
+	assert.Equal(t, 5*time.Second, tr.Duration.Duration())
+	assert.Equal(t, int64(150), tr.BytesTransferred)
+}
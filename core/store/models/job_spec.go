@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"regexp"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/BurntSushi/toml"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	null "gopkg.in/guregu/null.v3"
@@ -19,12 +21,45 @@ import (
 
 // JobSpecRequest represents a schema for the incoming job spec request as used by the API.
 type JobSpecRequest struct {
-	Name       string             `json:"name"`
-	Initiators []InitiatorRequest `json:"initiators"`
-	Tasks      []TaskSpecRequest  `json:"tasks"`
-	StartAt    null.Time          `json:"startAt"`
-	EndAt      null.Time          `json:"endAt"`
-	MinPayment *assets.Link       `json:"minPayment,omitempty"`
+	Name                 string                `json:"name"`
+	Initiators           []InitiatorRequest    `json:"initiators"`
+	Tasks                []TaskSpecRequest     `json:"tasks"`
+	StartAt              null.Time             `json:"startAt"`
+	EndAt                null.Time             `json:"endAt"`
+	MinPayment           *assets.Link          `json:"minPayment,omitempty"`
+	Deadline             *Duration             `json:"deadline,omitempty"`
+	Priority             RunPriority           `json:"priority,omitempty"`
+	InputSchema          *InputSchema          `json:"inputSchema,omitempty"`
+	ChainID              *utils.Big            `json:"chainId,omitempty"`
+	RunRetention         *RunRetention         `json:"runRetention,omitempty"`
+	MaxConcurrentRuns    uint32                `json:"maxConcurrentRuns,omitempty"`
+	FailureNotifications *FailureNotifications `json:"failureNotifications,omitempty"`
+}
+
+// NewJobSpecRequestFromTOML parses a job spec request written in TOML, with
+// the same fields as in JSON and its initiators and tasks as arrays of tables:
+//
+//  name = "prices"
+//  [[initiators]]
+//  type = "web"
+//  [[tasks]]
+//  type = "httpget"
+//  [tasks.params]
+//  get = "https://example.com/price"
+//
+// The TOML is converted to JSON and decoded as such, so that a spec is read
+// and stored the same way whichever format it was written in.
+func NewJobSpecRequestFromTOML(b []byte) (JobSpecRequest, error) {
+	var tree map[string]interface{}
+	if err := toml.Unmarshal(b, &tree); err != nil {
+		return JobSpecRequest{}, errors.Wrap(err, "invalid TOML job spec")
+	}
+	js, err := json.Marshal(tree)
+	if err != nil {
+		return JobSpecRequest{}, err
+	}
+	var jsr JobSpecRequest
+	return jsr, json.Unmarshal(js, &jsr)
 }
 
 // InitiatorRequest represents a schema for incoming initiator requests as used by the API.
@@ -36,8 +71,34 @@ type InitiatorRequest struct {
 // TaskSpecRequest represents a schema for incoming TaskSpec requests as used by the API.
 type TaskSpecRequest struct {
 	Type                             TaskType      `json:"type"`
+	Name                             string        `json:"name,omitempty"`
 	MinRequiredIncomingConfirmations clnull.Uint32 `json:"confirmations"`
 	Params                           JSON          `json:"params"`
+	Timeout                          *Duration     `json:"timeout,omitempty"`
+	Retry                            *RetryPolicy  `json:"retry,omitempty"`
+	Retries                          *uint32       `json:"retries,omitempty"`
+	RetryBackoff                     *Duration     `json:"retryBackoff,omitempty"`
+	Inputs                           *TaskInputs   `json:"inputs,omitempty"`
+}
+
+// RetryPolicy returns the policy the task is retried with: its retry policy,
+// with retries, the number of times the task is performed again after the
+// first, and retryBackoff taking the place of its maxAttempts and backoff.
+func (t TaskSpecRequest) RetryPolicy() *RetryPolicy {
+	if t.Retries == nil && t.RetryBackoff == nil {
+		return t.Retry
+	}
+	policy := RetryPolicy{}
+	if t.Retry != nil {
+		policy = *t.Retry
+	}
+	if t.Retries != nil {
+		policy.MaxAttempts = *t.Retries + 1
+	}
+	if t.RetryBackoff != nil {
+		policy.Backoff = *t.RetryBackoff
+	}
+	return &policy
 }
 
 // JobSpec is the definition for all the work to be carried out by the node
@@ -55,6 +116,35 @@ type JobSpec struct {
 	DeletedAt  null.Time      `json:"-" gorm:"index"`
 	UpdatedAt  time.Time      `json:"-"`
 	Errors     []JobSpecError `json:"-" gorm:"foreignkey:JobSpecID;association_autoupdate:false;association_autocreate:false"`
+	// Deadline is the maximum amount of time a run of this job may take
+	// before it is errored, including any time spent in pending states.
+	Deadline *Duration   `json:"deadline,omitempty"`
+	Priority RunPriority `json:"priority,omitempty"`
+	// InputSchema, when present, is checked against the parameters of runs
+	// started by web, external, webhook, kafka, mqtt and runlog initiators
+	// before they are created.
+	InputSchema *InputSchema `json:"inputSchema,omitempty" gorm:"type:jsonb"`
+	// ChainID binds the job to one of the chains configured in EVM_CHAINS.
+	// Jobs without a chain ID run on the node's primary chain.
+	ChainID *utils.Big `json:"chainId,omitempty"`
+	// Version counts the versions of the job, starting at 1 and incremented
+	// each time the job is updated in place.
+	Version int32 `json:"version,omitempty"`
+	// PausedAt is when the job was paused. A paused job keeps its runs, but
+	// its initiators do not start new ones until it is resumed.
+	PausedAt null.Time `json:"pausedAt"`
+	// RunRetention overrides the node's limits on how long, and how many of,
+	// the job's finished runs are kept.
+	RunRetention *RunRetention `json:"runRetention,omitempty" gorm:"type:jsonb"`
+	// MaxConcurrentRuns, when not zero, is how many of the job's runs may be
+	// unfinished at once. Runs started beyond it wait in the
+	// pending_concurrency status until earlier runs finish. Unlike
+	// RUN_QUEUE_MAX_WORKERS_PER_JOB, which only limits how many of a job's
+	// runs are executed at once, it also counts runs paused waiting for a
+	// bridge or confirmations.
+	MaxConcurrentRuns uint32 `json:"maxConcurrentRuns,omitempty"`
+	// FailureNotifications, when present, are sent as the job's runs error.
+	FailureNotifications *FailureNotifications `json:"failureNotifications,omitempty" gorm:"type:jsonb"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -80,6 +170,7 @@ func NewJob() JobSpec {
 		ID:        id,
 		Name:      fmt.Sprintf("Job%s", id),
 		CreatedAt: time.Now(),
+		Version:   1,
 	}
 }
 
@@ -98,14 +189,25 @@ func NewJobFromRequest(jsr JobSpecRequest) JobSpec {
 		jobSpec.Tasks = append(jobSpec.Tasks, TaskSpec{
 			JobSpecID:                        jobSpec.ID,
 			Type:                             task.Type,
+			Name:                             task.Name,
 			MinRequiredIncomingConfirmations: task.MinRequiredIncomingConfirmations,
 			Params:                           task.Params,
+			Timeout:                          task.Timeout,
+			Retry:                            task.RetryPolicy(),
+			Inputs:                           task.Inputs,
 		})
 	}
 
 	jobSpec.EndAt = jsr.EndAt
 	jobSpec.StartAt = jsr.StartAt
 	jobSpec.MinPayment = jsr.MinPayment
+	jobSpec.Deadline = jsr.Deadline
+	jobSpec.Priority = jsr.Priority
+	jobSpec.InputSchema = jsr.InputSchema
+	jobSpec.ChainID = jsr.ChainID
+	jobSpec.RunRetention = jsr.RunRetention
+	jobSpec.MaxConcurrentRuns = jsr.MaxConcurrentRuns
+	jobSpec.FailureNotifications = jsr.FailureNotifications
 	return jobSpec
 }
 
@@ -114,6 +216,11 @@ func (j JobSpec) Archived() bool {
 	return j.DeletedAt.Valid
 }
 
+// Paused returns true if the job spec has been paused
+func (j JobSpec) Paused() bool {
+	return j.PausedAt.Valid
+}
+
 // InitiatorsFor returns an array of Initiators for the given list of
 // Initiator types.
 func (j JobSpec) InitiatorsFor(types ...string) []Initiator {
@@ -168,6 +275,40 @@ func (j JobSpec) Started(t time.Time) bool {
 	return t.After(j.StartAt.Time) || t.Equal(j.StartAt.Time)
 }
 
+// RunPriority is the class of a job's runs, which decides the order in which
+// runs waiting for a free worker are executed.
+type RunPriority string
+
+// Classes of RunPriority, from most to least urgent.
+const (
+	RunPriorityHigh   = RunPriority("high")
+	RunPriorityNormal = RunPriority("normal")
+	RunPriorityLow    = RunPriority("low")
+)
+
+// Rank orders priorities, higher ranks being more urgent. An unset priority
+// ranks as normal.
+func (p RunPriority) Rank() int {
+	switch p {
+	case RunPriorityHigh:
+		return 2
+	case RunPriorityLow:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Valid returns true if the priority is unset or one of the known classes.
+func (p RunPriority) Valid() bool {
+	switch p {
+	case "", RunPriorityHigh, RunPriorityNormal, RunPriorityLow:
+		return true
+	default:
+		return false
+	}
+}
+
 // Types of Initiators (see Initiator struct just below.)
 const (
 	// InitiatorRunLog for tasks in a job to watch an ethereum address
@@ -191,6 +332,33 @@ const (
 	InitiatorFluxMonitor = "fluxmonitor"
 	// InitiatorRandomnessLog for tasks from a VRF specific contract
 	InitiatorRandomnessLog = "randomnesslog"
+	// InitiatorBalanceThreshold for tasks in a job to be run when the ETH or
+	// token balance of an address crosses a threshold.
+	InitiatorBalanceThreshold = "balancethreshold"
+	// InitiatorWebhook for tasks in a job to be run by requests to its
+	// webhook, signed with a secret shared with the sender.
+	InitiatorWebhook = "webhook"
+	// InitiatorKafka for tasks in a job to be run for each message published
+	// to a Kafka topic.
+	InitiatorKafka = "kafka"
+	// InitiatorMQTT for tasks in a job to be run for each message published
+	// to an MQTT topic.
+	InitiatorMQTT = "mqtt"
+	// InitiatorBlockInterval for tasks in a job to be run every given number
+	// of blocks.
+	InitiatorBlockInterval = "blockinterval"
+	// InitiatorGasPrice for tasks in a job to be run when the node's gas
+	// price crosses a threshold.
+	InitiatorGasPrice = "gasprice"
+)
+
+// Directions in which a balance or gas price must cross its threshold to
+// trigger a balancethreshold or gasprice initiator.
+const (
+	// BalanceCrossingBelow triggers when the value drops below the threshold.
+	BalanceCrossingBelow = "below"
+	// BalanceCrossingAbove triggers when the value rises above the threshold.
+	BalanceCrossingAbove = "above"
 )
 
 // Initiator could be thought of as a trigger, defines how a Job can be
@@ -213,6 +381,7 @@ type Initiator struct {
 // Initiators may require.
 type InitiatorParams struct {
 	Schedule   Cron              `json:"schedule,omitempty"`
+	Schedules  Crons             `json:"schedules,omitempty" gorm:"type:text"`
 	Time       AnyTime           `json:"time,omitempty"`
 	Ran        bool              `json:"ran,omitempty"`
 	Address    common.Address    `json:"address,omitempty" gorm:"index"`
@@ -222,6 +391,14 @@ type InitiatorParams struct {
 	FromBlock  *utils.Big        `json:"fromBlock,omitempty" gorm:"type:varchar(255)"`
 	ToBlock    *utils.Big        `json:"toBlock,omitempty" gorm:"type:varchar(255)"`
 	Topics     Topics            `json:"topics,omitempty"`
+	// EventABI is the event whose logs an ethlog initiator decodes into the
+	// params of its runs. Its signature is used as the first topic when the
+	// initiator has no topics.
+	EventABI *EventABI `json:"eventAbi,omitempty" gorm:"type:text"`
+	// Addresses are further oracle contracts whose requests a runlog
+	// initiator runs, alongside those of Address, such as the old and new
+	// oracle while requesters migrate from one to the other.
+	Addresses AddressCollection `json:"addresses,omitempty" gorm:"type:text"`
 
 	RequestData JSON    `json:"requestData,omitempty" gorm:"type:text"`
 	Feeds       Feeds   `json:"feeds,omitempty" gorm:"type:text"`
@@ -233,6 +410,112 @@ type InitiatorParams struct {
 	AbsoluteThreshold float32         `json:"absoluteThreshold" gorm:"type:float;not null"`
 	PollTimer         PollTimerConfig `json:"pollTimer,omitempty" gorm:"type:jsonb"`
 	IdleTimer         IdleTimerConfig `json:"idleTimer,omitempty" gorm:"type:jsonb"`
+	// Aggregation is how a fluxmonitor initiator combines the answers of its
+	// feeds, by default taking their median.
+	Aggregation AggregationConfig `json:"aggregation,omitempty" gorm:"type:jsonb"`
+	// DrumbeatSchedule, when set, is a cron schedule on which a fluxmonitor
+	// initiator submits an answer whether or not it has deviated, for
+	// aggregators which expect answers at fixed times.
+	DrumbeatSchedule Cron `json:"drumbeatSchedule,omitempty"`
+	// FlagsContractAddress, when set, is the Flags contract which pauses a
+	// fluxmonitor initiator while the flag of its aggregator is raised, in
+	// place of the node's FLAGS_CONTRACT_ADDRESS.
+	FlagsContractAddress common.Address `json:"flagsContractAddress,omitempty"`
+	// ReleaseThreshold and AbsoluteReleaseThreshold, when either is set, give
+	// a fluxmonitor initiator hysteresis: once it has submitted because its
+	// answer deviated, it does not submit on deviation again until a poll
+	// finds the answer back within these thresholds of the latest answer.
+	ReleaseThreshold         float32 `json:"releaseThreshold,omitempty"`
+	AbsoluteReleaseThreshold float32 `json:"absoluteReleaseThreshold,omitempty"`
+	// Simulate, when set, has a fluxmonitor initiator record the answers it
+	// would submit, rather than starting runs to submit them, so that new
+	// feeds and thresholds can be tried out against the live aggregator.
+	Simulate bool `json:"simulate,omitempty" gorm:"not null"`
+	// SkipSupermajorityRounds, when set, has a fluxmonitor initiator not
+	// submit to rounds which more than two thirds of the aggregator's oracles
+	// have already submitted to, saving the gas of answers which would not
+	// change the round's outcome.
+	SkipSupermajorityRounds bool `json:"skipSupermajorityRounds,omitempty" gorm:"not null"`
+
+	// TokenAddress is the ERC20 contract whose balance of Address is watched
+	// by a balancethreshold initiator. The ETH balance is watched when unset.
+	TokenAddress     common.Address `json:"tokenAddress,omitempty"`
+	BalanceThreshold *utils.Big     `json:"balanceThreshold,omitempty" gorm:"type:varchar(255)"`
+	// Crossing is one of the BalanceCrossing* constants, defaulting to below
+	// for balancethreshold initiators and to above for gasprice ones.
+	Crossing string `json:"crossing,omitempty"`
+	// GasPriceThreshold is the gas price in wei which a gasprice initiator
+	// watches the node's gas price, as set by the gas updater, cross.
+	GasPriceThreshold *utils.Big `json:"gasPriceThreshold,omitempty" gorm:"type:varchar(255)"`
+
+	// Every, when set, makes a runat initiator recurring, starting a run at
+	// its time and every interval after it, until its Until time if set.
+	Every     *Duration `json:"every,omitempty"`
+	Until     AnyTime   `json:"until,omitempty"`
+	LastRanAt AnyTime   `json:"-"`
+
+	// Secret is the key with which the requests to a webhook initiator are
+	// signed. It is left out of the initiator's params when jobs, their runs
+	// and their versions are presented by the API.
+	Secret string `json:"secret,omitempty"`
+
+	// Kafka is the topic consumed by a kafka initiator.
+	Kafka *KafkaConfig `json:"kafka,omitempty" gorm:"type:jsonb"`
+	// MQTT is the topic subscribed to by an mqtt initiator.
+	MQTT *MQTTConfig `json:"mqtt,omitempty" gorm:"column:mqtt;type:jsonb"`
+
+	// BlockInterval is the number of blocks between the runs of a
+	// blockinterval initiator, which runs on each block whose number is a
+	// multiple of it.
+	BlockInterval uint32 `json:"blockInterval,omitempty"`
+
+	// MinIncomingInterval, when set, is the least time between the runs of
+	// the initiator. Runs it triggers sooner than that after its last run are
+	// dropped, so that a noisy log or external initiator cannot flood the
+	// node with runs of the job.
+	MinIncomingInterval *Duration `json:"minIncomingInterval,omitempty"`
+}
+
+// CronSchedules returns each schedule of a cron initiator: its schedule,
+// if set, followed by its list of schedules.
+func (i InitiatorParams) CronSchedules() []Cron {
+	var schedules []Cron
+	if i.Schedule != "" {
+		schedules = append(schedules, i.Schedule)
+	}
+	return append(schedules, i.Schedules...)
+}
+
+// Recurring returns true if a runat initiator starts a run every interval
+// from its time, rather than once.
+func (i InitiatorParams) Recurring() bool {
+	return i.Every != nil && !i.Every.IsInstant()
+}
+
+// NextRunAt returns when a recurring runat initiator is next due to start a
+// run: its time if it has not run, or else its first time after the last
+// one it ran at. It returns false once that would be after its until time.
+func (i InitiatorParams) NextRunAt() (time.Time, bool) {
+	next := i.Time.Time
+	if i.LastRanAt.Valid {
+		next = i.RunAtOccurrence(i.LastRanAt.Time).Add(i.Every.Duration())
+	}
+	if i.Until.Valid && next.After(i.Until.Time) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// RunAtOccurrence returns the latest of the times a recurring runat initiator
+// is due at which is no later than t, or its first time if t is before it.
+// Recording it as when the initiator last ran means that the times missed
+// while the node was down are made up for with a single run.
+func (i InitiatorParams) RunAtOccurrence(t time.Time) time.Time {
+	if !t.After(i.Time.Time) {
+		return i.Time.Time
+	}
+	every := i.Every.Duration()
+	return i.Time.Time.Add(t.Sub(i.Time.Time) / every * every)
 }
 
 type PollTimerConfig struct {
@@ -342,6 +625,22 @@ func NewInitiatorFromRequest(
 	return ret
 }
 
+// WithoutCredentials returns the initiator with the secret of a webhook
+// initiator and the passwords of kafka and mqtt initiators left out, for
+// showing it outside the node.
+func (i Initiator) WithoutCredentials() Initiator {
+	i.Secret = ""
+	if i.Kafka != nil {
+		kafka := i.Kafka.WithoutPassword()
+		i.Kafka = &kafka
+	}
+	if i.MQTT != nil {
+		mqtt := i.MQTT.WithoutPassword()
+		i.MQTT = &mqtt
+	}
+	return i
+}
+
 // IsLogInitiated Returns true if triggered by event logs.
 func (i Initiator) IsLogInitiated() bool {
 	for _, logType := range LogBasedChainlinkJobInitiators {
@@ -352,8 +651,59 @@ func (i Initiator) IsLogInitiated() bool {
 	return false
 }
 
+// BlockIntervalDue returns true if a blockinterval initiator is due to run on
+// the block with the given number, given the number of the block it last
+// saw. Heads which the node skipped over are made up for with a single run,
+// and when no block has been seen, only a block on the interval is due.
+func (i Initiator) BlockIntervalDue(last *int64, number int64) bool {
+	if i.BlockInterval == 0 {
+		return false
+	}
+	interval := int64(i.BlockInterval)
+	if last == nil {
+		return number%interval == 0
+	}
+	return number/interval > *last/interval
+}
+
+// BalanceCrossed returns true if the given balance is on the triggering side
+// of a balancethreshold initiator's threshold.
+func (i Initiator) BalanceCrossed(balance *big.Int) bool {
+	if i.BalanceThreshold == nil || balance == nil {
+		return false
+	}
+	cmp := balance.Cmp(i.BalanceThreshold.ToInt())
+	if strings.ToLower(i.Crossing) == BalanceCrossingAbove {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// GasPriceCrossed returns true if the given gas price is on the triggering
+// side of a gasprice initiator's threshold.
+func (i Initiator) GasPriceCrossed(gasPrice *big.Int) bool {
+	if i.GasPriceThreshold == nil || gasPrice == nil {
+		return false
+	}
+	cmp := gasPrice.Cmp(i.GasPriceThreshold.ToInt())
+	if i.GasPriceCrossing() == BalanceCrossingBelow {
+		return cmp < 0
+	}
+	return cmp > 0
+}
+
+// GasPriceCrossing returns the direction in which the gas price must cross a
+// gasprice initiator's threshold to trigger it.
+func (i Initiator) GasPriceCrossing() string {
+	if strings.ToLower(i.Crossing) == BalanceCrossingBelow {
+		return BalanceCrossingBelow
+	}
+	return BalanceCrossingAbove
+}
+
 // Feeds holds the json of the feeds parameter in the job spec. It is an array of
-// URL strings and/or objects containing the names of bridges
+// URL strings and/or objects containing the URLs or the names of bridges, as
+// parsed by ParseFeeds
 type Feeds = JSON
 
 // TaskSpec is the definition of work to be carried out. The
@@ -363,8 +713,12 @@ type TaskSpec struct {
 	ID                               int64         `gorm:"primary_key"`
 	JobSpecID                        *ID           `json:"-"`
 	Type                             TaskType      `json:"type" gorm:"index;not null"`
+	Name                             string        `json:"name,omitempty"`
 	MinRequiredIncomingConfirmations clnull.Uint32 `json:"confirmations" gorm:"column:confirmations"`
 	Params                           JSON          `json:"params" gorm:"type:text"`
+	Timeout                          *Duration     `json:"timeout,omitempty"`
+	Retry                            *RetryPolicy  `json:"retry,omitempty" gorm:"type:jsonb"`
+	Inputs                           *TaskInputs   `json:"inputs,omitempty" gorm:"type:jsonb"`
 	CreatedAt                        time.Time
 	UpdatedAt                        time.Time
 	DeletedAt                        *time.Time
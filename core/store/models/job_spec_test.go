@@ -1,6 +1,7 @@
 package models_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 	"time"
@@ -188,6 +189,127 @@ func TestNewJobFromRequest(t *testing.T) {
 	assert.Equal(t, assets.NewLink(5), fetched2.MinPayment)
 }
 
+func TestNewJobFromRequest_TaskInputs(t *testing.T) {
+	t.Parallel()
+
+	var jsr models.JobSpecRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"initiators": [{"type": "web"}],
+		"tasks": [
+			{"type": "httpget", "name": "a", "inputs": [], "params": {"get": "https://a.example.com"}},
+			{"type": "median", "inputs": ["a"]},
+			{"type": "noop"}
+		]
+	}`), &jsr))
+
+	js := models.NewJobFromRequest(jsr)
+	require.Len(t, js.Tasks, 3)
+	require.NotNil(t, js.Tasks[0].Inputs)
+	assert.Empty(t, *js.Tasks[0].Inputs)
+	assert.Equal(t, &models.TaskInputs{"a"}, js.Tasks[1].Inputs)
+	assert.Nil(t, js.Tasks[2].Inputs)
+}
+
+func TestNewJobFromRequest_Retries(t *testing.T) {
+	t.Parallel()
+
+	var jsr models.JobSpecRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"initiators": [{"type": "web"}],
+		"tasks": [
+			{"type": "httpget", "timeout": "5s", "retries": 2, "retryBackoff": "1s"},
+			{"type": "httpget", "retries": 3, "retry": {"maxAttempts": 10, "retryOn": ["any"]}},
+			{"type": "httpget", "retry": {"maxAttempts": 2}},
+			{"type": "noop"}
+		]
+	}`), &jsr))
+
+	js := models.NewJobFromRequest(jsr)
+	require.Len(t, js.Tasks, 4)
+	assert.Equal(t, models.MustMakeDuration(5*time.Second), *js.Tasks[0].Timeout)
+	assert.Equal(t, &models.RetryPolicy{MaxAttempts: 3, Backoff: models.MustMakeDuration(time.Second)}, js.Tasks[0].Retry)
+	assert.Equal(t, &models.RetryPolicy{MaxAttempts: 4, RetryOn: []string{"any"}}, js.Tasks[1].Retry)
+	assert.Equal(t, &models.RetryPolicy{MaxAttempts: 2}, js.Tasks[2].Retry)
+	assert.Nil(t, js.Tasks[3].Retry)
+}
+
+func TestNewJobFromRequest_RunRetention(t *testing.T) {
+	t.Parallel()
+
+	var jsr models.JobSpecRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"initiators": [{"type": "web"}],
+		"tasks": [{"type": "noop"}],
+		"runRetention": {"maxRuns": 100}
+	}`), &jsr))
+
+	js := models.NewJobFromRequest(jsr)
+	require.NotNil(t, js.RunRetention)
+	maxAge, maxRuns := js.RunRetention.Limits(24*time.Hour, 10)
+	assert.Equal(t, 24*time.Hour, maxAge)
+	assert.Equal(t, uint32(100), maxRuns)
+
+	var unset *models.RunRetention
+	maxAge, maxRuns = unset.Limits(24*time.Hour, 10)
+	assert.Equal(t, 24*time.Hour, maxAge)
+	assert.Equal(t, uint32(10), maxRuns)
+}
+
+func TestNewJobSpecRequestFromTOML(t *testing.T) {
+	t.Parallel()
+
+	fromTOML, err := models.NewJobSpecRequestFromTOML([]byte(`
+name = "prices"
+minPayment = "1000000000000000000"
+startAt = 2020-12-01T00:00:00Z
+
+[[initiators]]
+type = "cron"
+[initiators.params]
+schedule = "CRON_TZ=UTC 0 * * * *"
+
+[[tasks]]
+type = "HTTPGet"
+name = "fetch"
+timeout = "10s"
+[tasks.params]
+get = "https://example.com/price"
+
+[[tasks]]
+type = "multiply"
+[tasks.params]
+times = 100
+`))
+	require.NoError(t, err)
+
+	var fromJSON models.JobSpecRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "prices",
+		"minPayment": "1000000000000000000",
+		"startAt": "2020-12-01T00:00:00Z",
+		"initiators": [{"type": "cron", "params": {"schedule": "CRON_TZ=UTC 0 * * * *"}}],
+		"tasks": [
+			{"type": "httpget", "name": "fetch", "timeout": "10s", "params": {"get": "https://example.com/price"}},
+			{"type": "multiply", "params": {"times": 100}}
+		]
+	}`), &fromJSON))
+
+	assert.Equal(t, fromJSON.Name, fromTOML.Name)
+	assert.Equal(t, fromJSON.MinPayment, fromTOML.MinPayment)
+	assert.True(t, fromJSON.StartAt.Time.Equal(fromTOML.StartAt.Time))
+	assert.Equal(t, fromJSON.Initiators, fromTOML.Initiators)
+	require.Len(t, fromTOML.Tasks, 2)
+	for i := range fromJSON.Tasks {
+		assert.Equal(t, fromJSON.Tasks[i].Type, fromTOML.Tasks[i].Type)
+		assert.Equal(t, fromJSON.Tasks[i].Name, fromTOML.Tasks[i].Name)
+		assert.Equal(t, fromJSON.Tasks[i].Timeout, fromTOML.Tasks[i].Timeout)
+		assert.JSONEq(t, fromJSON.Tasks[i].Params.String(), fromTOML.Tasks[i].Params.String())
+	}
+
+	_, err = models.NewJobSpecRequestFromTOML([]byte(`[[tasks]`))
+	assert.Error(t, err)
+}
+
 func TestJobSpec_Save(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -261,6 +383,107 @@ func TestJobEnded(t *testing.T) {
 	}
 }
 
+func TestInitiator_BalanceCrossed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		crossing  string
+		threshold *utils.Big
+		balance   *big.Int
+		want      bool
+	}{
+		{"no threshold", "", nil, big.NewInt(1), false},
+		{"no balance", "", utils.NewBigI(10), nil, false},
+		{"default below, under", "", utils.NewBigI(10), big.NewInt(9), true},
+		{"below, equal", models.BalanceCrossingBelow, utils.NewBigI(10), big.NewInt(10), false},
+		{"below, over", models.BalanceCrossingBelow, utils.NewBigI(10), big.NewInt(11), false},
+		{"above, under", models.BalanceCrossingAbove, utils.NewBigI(10), big.NewInt(9), false},
+		{"above, equal", models.BalanceCrossingAbove, utils.NewBigI(10), big.NewInt(10), false},
+		{"above, over", models.BalanceCrossingAbove, utils.NewBigI(10), big.NewInt(11), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initr := models.Initiator{
+				Type: models.InitiatorBalanceThreshold,
+				InitiatorParams: models.InitiatorParams{
+					BalanceThreshold: test.threshold,
+					Crossing:         test.crossing,
+				},
+			}
+			assert.Equal(t, test.want, initr.BalanceCrossed(test.balance))
+		})
+	}
+}
+
+func TestInitiator_GasPriceCrossed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		crossing  string
+		threshold *utils.Big
+		gasPrice  *big.Int
+		want      bool
+	}{
+		{"no threshold", "", nil, big.NewInt(1), false},
+		{"no gas price", "", utils.NewBigI(10), nil, false},
+		{"default above, over", "", utils.NewBigI(10), big.NewInt(11), true},
+		{"default above, equal", "", utils.NewBigI(10), big.NewInt(10), false},
+		{"above, under", models.BalanceCrossingAbove, utils.NewBigI(10), big.NewInt(9), false},
+		{"below, under", models.BalanceCrossingBelow, utils.NewBigI(10), big.NewInt(9), true},
+		{"below, over", models.BalanceCrossingBelow, utils.NewBigI(10), big.NewInt(11), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initr := models.Initiator{
+				Type: models.InitiatorGasPrice,
+				InitiatorParams: models.InitiatorParams{
+					GasPriceThreshold: test.threshold,
+					Crossing:          test.crossing,
+				},
+			}
+			assert.Equal(t, test.want, initr.GasPriceCrossed(test.gasPrice))
+		})
+	}
+}
+
+func TestInitiator_BlockIntervalDue(t *testing.T) {
+	t.Parallel()
+
+	last := func(n int64) *int64 { return &n }
+	tests := []struct {
+		name     string
+		interval uint32
+		last     *int64
+		number   int64
+		want     bool
+	}{
+		{"no interval", 0, last(99), 100, false},
+		{"first head, on interval", 100, nil, 300, true},
+		{"first head, off interval", 100, nil, 301, false},
+		{"next head, on interval", 100, last(299), 300, true},
+		{"next head, off interval", 100, last(300), 301, false},
+		{"skipped interval head", 100, last(298), 302, true},
+		{"same head again", 100, last(300), 300, false},
+		{"reorg below interval", 100, last(301), 299, false},
+		{"reorg back onto interval", 100, last(299), 300, true},
+		{"every block", 1, last(41), 42, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initr := models.Initiator{
+				Type:            models.InitiatorBlockInterval,
+				InitiatorParams: models.InitiatorParams{BlockInterval: test.interval},
+			}
+			assert.Equal(t, test.want, initr.BlockIntervalDue(test.last, test.number))
+		})
+	}
+}
+
 func TestJobSpec_Started(t *testing.T) {
 	t.Parallel()
 
@@ -288,6 +511,36 @@ func TestJobSpec_Started(t *testing.T) {
 	}
 }
 
+func TestInitiatorParams_NextRunAt(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	every := models.MustMakeDuration(time.Hour)
+	params := models.InitiatorParams{
+		Time:  models.NewAnyTime(start),
+		Every: &every,
+		Until: models.NewAnyTime(start.Add(3 * time.Hour)),
+	}
+	require.True(t, params.Recurring())
+
+	next, ok := params.NextRunAt()
+	require.True(t, ok)
+	assert.Equal(t, start, next)
+
+	params.LastRanAt = models.NewAnyTime(params.RunAtOccurrence(start.Add(150 * time.Minute)))
+	assert.Equal(t, start.Add(2*time.Hour), params.LastRanAt.Time)
+	next, ok = params.NextRunAt()
+	require.True(t, ok)
+	assert.Equal(t, start.Add(3*time.Hour), next)
+
+	params.LastRanAt = models.NewAnyTime(next)
+	_, ok = params.NextRunAt()
+	assert.False(t, ok)
+
+	params.Every = nil
+	assert.False(t, params.Recurring())
+}
+
 func TestNewTaskType(t *testing.T) {
 	t.Parallel()
 
@@ -315,3 +568,15 @@ func TestNewTaskType(t *testing.T) {
 		})
 	}
 }
+
+func TestRunPriority_Rank(t *testing.T) {
+	t.Parallel()
+
+	assert.Greater(t, models.RunPriorityHigh.Rank(), models.RunPriorityNormal.Rank())
+	assert.Greater(t, models.RunPriorityNormal.Rank(), models.RunPriorityLow.Rank())
+	assert.Equal(t, models.RunPriorityNormal.Rank(), models.RunPriority("").Rank())
+
+	assert.True(t, models.RunPriority("").Valid())
+	assert.True(t, models.RunPriorityLow.Valid())
+	assert.False(t, models.RunPriority("urgent").Valid())
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// JobSpecVersion is a snapshot of a job spec as it was at one of its
+// versions, kept so that the changes made to a job can be reviewed and an
+// earlier version restored.
+type JobSpecVersion struct {
+	ID        int64     `json:"-" gorm:"primary_key"`
+	JobSpecID *ID       `json:"jobSpecId"`
+	Version   int32     `json:"version"`
+	Spec      JSON      `json:"spec" gorm:"type:jsonb"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewJobSpecVersion takes a snapshot of the job spec at its current version.
+func NewJobSpecVersion(job JobSpec) (JobSpecVersion, error) {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return JobSpecVersion{}, err
+	}
+	spec, err := ParseJSON(b)
+	if err != nil {
+		return JobSpecVersion{}, err
+	}
+	return JobSpecVersion{
+		JobSpecID: job.ID,
+		Version:   job.Version,
+		Spec:      spec,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// WithoutCredentials returns the version with the credentials of the job's
+// initiators left out of its spec, for showing it outside the node.
+func (v JobSpecVersion) WithoutCredentials() (JobSpecVersion, error) {
+	var job JobSpec
+	if err := json.Unmarshal(v.Spec.Bytes(), &job); err != nil {
+		return v, err
+	}
+	for i, initiator := range job.Initiators {
+		job.Initiators[i] = initiator.WithoutCredentials()
+	}
+	b, err := json.Marshal(job)
+	if err != nil {
+		return v, err
+	}
+	v.Spec, err = ParseJSON(b)
+	return v, err
+}
+
+// JobSpecRequest returns the request which would create the job spec as it
+// was at this version.
+func (v JobSpecVersion) JobSpecRequest() (JobSpecRequest, error) {
+	var jsr JobSpecRequest
+	return jsr, json.Unmarshal(v.Spec.Bytes(), &jsr)
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (v JobSpecVersion) GetID() string {
+	return strconv.FormatInt(int64(v.Version), 10)
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (v JobSpecVersion) GetName() string {
+	return "specVersions"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (v *JobSpecVersion) SetID(value string) error {
+	version, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return err
+	}
+	v.Version = int32(version)
+	return nil
+}
@@ -0,0 +1,30 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobSpecVersion_WithoutCredentials(t *testing.T) {
+	t.Parallel()
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators[0].Type = models.InitiatorWebhook
+	job.Initiators[0].Secret = "webhook-secret-0123456789"
+	version, err := models.NewJobSpecVersion(job)
+	require.NoError(t, err)
+
+	presented, err := version.WithoutCredentials()
+	require.NoError(t, err)
+	assert.NotContains(t, presented.Spec.String(), "webhook-secret-0123456789")
+	assert.Equal(t, version.Version, presented.Version)
+	assert.Equal(t, models.InitiatorWebhook, presented.Spec.Get("initiators.0.type").String())
+
+	// The stored version keeps the secret, so that it can be restored
+	assert.Contains(t, version.Spec.String(), "webhook-secret-0123456789")
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// KafkaConfig is the topic a kafka initiator consumes, and how it connects to
+// the brokers.
+type KafkaConfig struct {
+	Brokers []string `json:"brokers"`
+	Topic   string   `json:"topic"`
+	// GroupID is the consumer group the initiator commits its offsets to, so
+	// that after a restart it resumes after the last message it consumed,
+	// and nodes sharing the group split the topic's messages between them.
+	GroupID string `json:"groupId"`
+	// TLS connects to the brokers over TLS, verifying them against the
+	// system's root certificates.
+	TLS  bool       `json:"tls,omitempty"`
+	SASL *KafkaSASL `json:"sasl,omitempty"`
+}
+
+// KafkaSASL are the credentials a kafka initiator authenticates to the
+// brokers with, using SASL/PLAIN.
+type KafkaSASL struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+// WithoutPassword returns the config with the SASL password left out, for
+// showing it outside the node.
+func (kc KafkaConfig) WithoutPassword() KafkaConfig {
+	if kc.SASL != nil {
+		kc.SASL = &KafkaSASL{Username: kc.SASL.Username}
+	}
+	return kc
+}
+
+// Value is defined so that we can store KafkaConfig as JSONB.
+func (kc KafkaConfig) Value() (driver.Value, error) {
+	return json.Marshal(kc)
+}
+
+// Scan is defined so that we can read KafkaConfig as JSONB.
+func (kc *KafkaConfig) Scan(value interface{}) error {
+	if value == nil {
+		*kc = KafkaConfig{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal KafkaConfig JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, kc)
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"time"
 
@@ -33,6 +34,9 @@ type Key struct {
 	// IsFunding marks the address as being used for rescuing the  node and the pending transactions
 	// Only one key can be IsFunding=true at a time.
 	IsFunding bool
+	// EVMChainID is the secondary chain the key sends transactions on, as
+	// configured in EVM_CHAINS, or nil for the primary chain.
+	EVMChainID *utils.Big `json:"-" gorm:"column:evm_chain_id"`
 }
 
 // NewKeyFromFile creates an instance in memory from a key file on disk.
@@ -51,6 +55,26 @@ func NewKeyFromFile(path string) (Key, error) {
 	return Key{Address: address, JSON: JSON{Result: js}}, nil
 }
 
+// NewRemoteKey creates an instance in memory for an account whose key is held
+// by a remote signer. Its JSON has the address, but no encrypted key.
+func NewRemoteKey(address common.Address) (Key, error) {
+	eip55, err := NewEIP55Address(address.Hex())
+	if err != nil {
+		return Key{}, err
+	}
+	js, err := ParseJSON([]byte(fmt.Sprintf(`{"address": "%x", "remote": true}`, address.Bytes())))
+	if err != nil {
+		return Key{}, err
+	}
+	return Key{Address: eip55, JSON: js}, nil
+}
+
+// IsRemote returns true if the key is held by a remote signer rather than
+// the node's keystore.
+func (k Key) IsRemote() bool {
+	return k.JSON.Get("remote").Bool()
+}
+
 // WriteToDisk writes this key to disk at the passed path.
 func (k *Key) WriteToDisk(path string) error {
 	return utils.WriteFileWithMaxPerms(path, []byte(k.JSON.String()), 0600)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -96,6 +97,9 @@ func JobSpecIDTopics(jsID *ID) []common.Hash {
 func FilterQueryFactory(i Initiator, from *big.Int, addresses ...common.Address) (q ethereum.FilterQuery, err error) {
 	q.FromBlock = from
 	filterAddresses := append([]common.Address{i.Address}, addresses...)
+	if i.Type == InitiatorRunLog {
+		filterAddresses = append(filterAddresses, i.Addresses...)
+	}
 	q.Addresses = utils.WithoutZeroAddresses(filterAddresses)
 
 	switch {
@@ -116,6 +120,9 @@ func FilterQueryFactory(i Initiator, from *big.Int, addresses ...common.Address)
 		// [][]common.Hash) clarifies their type for reflect.DeepEqual
 		q.Topics = make([][]common.Hash, len(i.Topics))
 		copy(q.Topics, i.Topics)
+		if len(q.Topics) == 0 && i.EventABI != nil && !i.EventABI.Anonymous {
+			q.Topics = [][]common.Hash{{i.EventABI.ID}}
+		}
 	case initiationRequiresJobSpecID(i.Type):
 		q.Topics = [][]common.Hash{
 			TopicsForInitiatorsWhichRequireJobSpecIDTopic[i.Type],
@@ -252,6 +259,35 @@ type EthLogEvent struct {
 	InitiatorLogEvent
 }
 
+// JSON returns the eth log as JSON. If the initiator has an event ABI, the
+// log's decoded arguments are added under "args", and the event's name under
+// "event".
+func (le EthLogEvent) JSON() (JSON, error) {
+	out, err := le.InitiatorLogEvent.JSON()
+	if err != nil || le.Initiator.EventABI == nil {
+		return out, err
+	}
+	args, err := le.Initiator.EventABI.Decode(le.Log)
+	if err != nil {
+		return out, err
+	}
+	return out.MultiAdd(KV{
+		"event": le.Initiator.EventABI.RawName,
+		"args":  args,
+	})
+}
+
+// RunRequest returns a run request instance with the transaction hash and
+// the log, decoded if the initiator has an event ABI.
+func (le EthLogEvent) RunRequest() (RunRequest, error) {
+	requestParams, err := le.JSON()
+	if err != nil {
+		return RunRequest{}, err
+	}
+	return RunRequest{BlockHash: &le.Log.BlockHash, TxHash: &le.Log.TxHash,
+		RequestParams: requestParams}, nil
+}
+
 // RunLogEvent provides functionality specific to a log event emitted
 // for a run log initiator.
 type RunLogEvent struct {
@@ -348,6 +384,7 @@ func (le RunLogEvent) RunRequest() (RunRequest, error) {
 		TxHash:        &le.Log.TxHash,
 		BlockHash:     &le.Log.BlockHash,
 		Requester:     &requester,
+		Oracle:        &le.Log.Address,
 		Payment:       payment,
 		RequestParams: requestParams,
 	}, nil
@@ -439,6 +476,46 @@ func (parseRunLog20190207withoutIndexes) parseRequestID(log Log) (common.Hash, e
 	return common.BytesToHash(requestIDBytes), nil
 }
 
+// OracleRequest holds the fulfillment parameters of a RunLog request, as
+// encoded in the dataPrefix which is passed back to the oracle contract when
+// the request is fulfilled.
+type OracleRequest struct {
+	RequestID          common.Hash
+	Payment            *assets.Link
+	CallbackAddress    common.Address
+	CallbackFunctionID FunctionSelector
+	Expiration         time.Time
+}
+
+// ParseOracleRequest decodes the dataPrefix of a RunLog request.
+func ParseOracleRequest(dataPrefix []byte) (OracleRequest, error) {
+	data := UntrustedBytes(dataPrefix)
+	if len(data) != idSize+paymentSize+callbackAddrSize+callbackFuncSize+expirationSize {
+		return OracleRequest{}, fmt.Errorf("malformed dataPrefix of %d bytes", len(data))
+	}
+
+	var start int
+	next := func(size int) []byte {
+		word, _ := data.SafeByteSlice(start, start+size)
+		start += size
+		return word
+	}
+
+	requestID := common.BytesToHash(next(idSize))
+	payment := (*assets.Link)(new(big.Int).SetBytes(next(paymentSize)))
+	callbackAddress := common.BytesToAddress(next(callbackAddrSize))
+	callbackFunctionID := BytesToFunctionSelector(next(callbackFuncSize))
+	expiration := utils.EVMBytesToUint64(next(expirationSize))
+
+	return OracleRequest{
+		RequestID:          requestID,
+		Payment:            payment,
+		CallbackAddress:    callbackAddress,
+		CallbackFunctionID: callbackFunctionID,
+		Expiration:         time.Unix(int64(expiration), 0),
+	}, nil
+}
+
 func bytesToHex(data []byte) string {
 	return utils.AddHexPrefix(hex.EncodeToString(data))
 }
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -12,6 +13,7 @@ import (
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
@@ -62,6 +64,23 @@ func TestParseRunLog(t *testing.T) {
 	}
 }
 
+func TestParseOracleRequest(t *testing.T) {
+	t.Parallel()
+
+	dataPrefix := hexutil.MustDecode("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f80000000000000000000000000000000000000000000000000de0b6b3a76400010000000000000000000000009fbda871d559710256a2502a2517b794b482db40042f2b6500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000005c4a7338")
+
+	request, err := models.ParseOracleRequest(dataPrefix)
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8"), request.RequestID)
+	assert.Equal(t, "1000000000000000001", request.Payment.Text(10))
+	assert.Equal(t, common.HexToAddress("0x9fbda871d559710256a2502a2517b794b482db40"), request.CallbackAddress)
+	assert.Equal(t, models.HexToFunctionSelector("0x042f2b65"), request.CallbackFunctionID)
+	assert.Equal(t, time.Unix(0x5c4a7338, 0), request.Expiration)
+
+	_, err = models.ParseOracleRequest(dataPrefix[:64])
+	assert.Error(t, err)
+}
+
 func TestEthLogEvent_JSON(t *testing.T) {
 	t.Parallel()
 
@@ -267,6 +286,22 @@ func TestFilterQueryFactory_InitiatorEthLog(t *testing.T) {
 		}
 		assert.Equal(t, want, filter)
 	}
+
+	// Without topics, the signature of the event ABI is the first topic
+	{
+		event, err := models.ParseEventABI([]byte(`{"name": "Transfer", "inputs": [{"name": "value", "type": "uint256"}]}`))
+		require.NoError(t, err)
+		i := models.Initiator{
+			Type: models.InitiatorEthLog,
+			InitiatorParams: models.InitiatorParams{
+				Address:  common.HexToAddress("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef"),
+				EventABI: &event,
+			},
+		}
+		filter, err := models.FilterQueryFactory(i, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, [][]common.Hash{{utils.MustHash("Transfer(uint256)")}}, filter.Topics)
+	}
 }
 
 func TestFilterQueryFactory_InitiatorRunLog(t *testing.T) {
@@ -294,6 +329,15 @@ func TestFilterQueryFactory_InitiatorRunLog(t *testing.T) {
 		},
 	}
 	assert.Equal(t, want, filter)
+
+	// With further oracle addresses
+	oldOracle := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	newOracle := common.HexToAddress("0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+	i.Address = oldOracle
+	i.Addresses = models.AddressCollection{newOracle}
+	filter, err = models.FilterQueryFactory(i, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{oldOracle, newOracle}, filter.Addresses)
 }
 
 func TestRunLogEvent_ContractPayment(t *testing.T) {
@@ -364,6 +408,7 @@ func TestRunLogEvent_RunRequest(t *testing.T) {
 		wantTxHash    string
 		wantBlockHash string
 		wantRequester common.Address
+		wantOracle    common.Address
 	}{
 		{
 			name:          "20190207 without indexes",
@@ -372,6 +417,7 @@ func TestRunLogEvent_RunRequest(t *testing.T) {
 			wantTxHash:    "0x04250548cd0b5d03b3bf1331aa83f32b35879440db31a6008d151260a5f3cc76",
 			wantBlockHash: "0x000c0d01ce8bd7100b73b1609ababc020e7f51dac75186bb799277c6b4b71e1c",
 			wantRequester: common.HexToAddress("0x9FBDa871d559710256a2502A2517b794B482Db40"),
+			wantOracle:    common.HexToAddress("0xf25186b5081ff5ce73482ad761db0eb0d25abfbf"),
 		},
 	}
 
@@ -385,6 +431,7 @@ func TestRunLogEvent_RunRequest(t *testing.T) {
 			assert.Equal(t, test.wantTxHash, rr.TxHash.Hex())
 			assert.Equal(t, test.wantBlockHash, rr.BlockHash.Hex())
 			assert.Equal(t, &test.wantRequester, rr.Requester)
+			assert.Equal(t, &test.wantOracle, rr.Oracle)
 		})
 	}
 }
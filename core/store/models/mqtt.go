@@ -0,0 +1,58 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MQTTConfig is the topic an mqtt initiator subscribes to, and how it
+// connects to the broker and reconnects when the connection is lost.
+type MQTTConfig struct {
+	// Broker is the URL of the broker, such as tcp://broker.example.com:1883,
+	// or ssl://broker.example.com:8883 to connect over TLS.
+	Broker string `json:"broker"`
+	// Topic may include the + and # wildcards.
+	Topic string `json:"topic"`
+	// QoS is the quality of service, 0, 1 or 2, that the initiator
+	// subscribes with. Messages received with QoS 1 or 2 are only
+	// acknowledged once their run has been created.
+	QoS byte `json:"qos,omitempty"`
+	// ClientID defaults to one derived from the job and initiator, so that it
+	// stays the same across restarts.
+	ClientID string `json:"clientId,omitempty"`
+	// CleanSession discards the subscription and any messages queued for it
+	// when the initiator disconnects. It is false by default, so that the
+	// broker keeps messages published with QoS 1 or 2 while the node is down.
+	CleanSession bool   `json:"cleanSession,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+	// MaxReconnectInterval caps the backoff between attempts to reconnect to
+	// the broker, which otherwise rises to 10 minutes.
+	MaxReconnectInterval *Duration `json:"maxReconnectInterval,omitempty"`
+}
+
+// WithoutPassword returns the config with the password left out, for showing
+// it outside the node.
+func (mc MQTTConfig) WithoutPassword() MQTTConfig {
+	mc.Password = ""
+	return mc
+}
+
+// Value is defined so that we can store MQTTConfig as JSONB.
+func (mc MQTTConfig) Value() (driver.Value, error) {
+	return json.Marshal(mc)
+}
+
+// Scan is defined so that we can read MQTTConfig as JSONB.
+func (mc *MQTTConfig) Scan(value interface{}) error {
+	if value == nil {
+		*mc = MQTTConfig{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal MQTTConfig JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, mc)
+}
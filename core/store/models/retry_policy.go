@@ -0,0 +1,158 @@
+package models
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+)
+
+// Classes of errors which a RetryPolicy may retry on.
+const (
+	// RetryOnAny retries on every error.
+	RetryOnAny = "any"
+	// RetryOnTimeout retries when a request or task timed out.
+	RetryOnTimeout = "timeout"
+	// RetryOnConnection retries when a connection could not be made, or was
+	// dropped.
+	RetryOnConnection = "connection"
+	// RetryOnServerError retries when a remote server responded with a 5xx.
+	RetryOnServerError = "server_error"
+)
+
+// DefaultRetryOn are the error classes retried when a RetryPolicy does not
+// specify any, all of which are usually transient.
+var DefaultRetryOn = []string{RetryOnTimeout, RetryOnConnection, RetryOnServerError}
+
+// RetryPolicy describes how the run executor retries a task which errored,
+// before giving up and erroring the run.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times the task may be performed,
+	// including the first. Zero or one disables retrying.
+	MaxAttempts uint32 `json:"maxAttempts,omitempty"`
+	// Backoff is the delay before the first retry, doubling for each
+	// subsequent retry.
+	Backoff Duration `json:"backoff,omitempty"`
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff Duration `json:"maxBackoff,omitempty"`
+	// RetryOn lists the error classes to retry on, defaulting to
+	// DefaultRetryOn.
+	RetryOn []string `json:"retryOn,omitempty"`
+}
+
+// Enabled returns true if the policy allows any retries.
+func (rp RetryPolicy) Enabled() bool {
+	return rp.MaxAttempts > 1
+}
+
+// ShouldRetry returns true if a task which has been performed the given number
+// of times, and last failed with err, should be performed again.
+func (rp RetryPolicy) ShouldRetry(attempts uint32, err error) bool {
+	if !rp.Enabled() || attempts >= rp.MaxAttempts || err == nil {
+		return false
+	}
+	retryOn := rp.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = DefaultRetryOn
+	}
+	for _, class := range retryOn {
+		if ErrorIsOfClass(err, class) {
+			return true
+		}
+	}
+	return false
+}
+
+// BackoffFor returns the delay before performing the task again, after it has
+// been performed the given number of times.
+func (rp RetryPolicy) BackoffFor(attempts uint32) time.Duration {
+	if rp.Backoff.IsInstant() {
+		return 0
+	}
+	max := rp.MaxBackoff.Duration()
+	if max == 0 {
+		max = time.Duration(1<<63 - 1)
+	}
+	bb := backoff.Backoff{
+		Min:    rp.Backoff.Duration(),
+		Max:    max,
+		Factor: 2,
+	}
+	if attempts == 0 {
+		return bb.ForAttempt(0)
+	}
+	return bb.ForAttempt(float64(attempts - 1))
+}
+
+// Value is defined so that we can store RetryPolicy as JSONB, because of an
+// error with GORM where it has trouble with nested structs as JSONB.
+func (rp RetryPolicy) Value() (driver.Value, error) {
+	j, err := json.Marshal(rp)
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Scan is defined so that we can read RetryPolicy as JSONB.
+func (rp *RetryPolicy) Scan(value interface{}) error {
+	if value == nil {
+		*rp = RetryPolicy{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal RetryPolicy JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, rp)
+}
+
+// ErrorIsOfClass returns true if the error falls into the given retryable
+// error class. Many adapters flatten their errors into strings, so the
+// message is inspected when the error's type is not conclusive.
+func ErrorIsOfClass(err error, class string) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	switch strings.ToLower(class) {
+	case RetryOnAny:
+		return true
+	case RetryOnTimeout:
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return true
+		}
+		return errors.Is(err, context.DeadlineExceeded) ||
+			strings.Contains(msg, "timeout") ||
+			strings.Contains(msg, "timed out") ||
+			strings.Contains(msg, "deadline exceeded")
+	case RetryOnConnection:
+		var opErr *net.OpError
+		if errors.As(err, &opErr) {
+			return true
+		}
+		return errors.Is(err, io.ErrUnexpectedEOF) ||
+			strings.Contains(msg, "connection refused") ||
+			strings.Contains(msg, "connection reset") ||
+			strings.Contains(msg, "no such host") ||
+			strings.HasSuffix(msg, "eof")
+	case RetryOnServerError:
+		var serverErr *utils.RemoteServerError
+		if errors.As(err, &serverErr) {
+			return true
+		}
+		return strings.Contains(msg, "remote server error")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,82 @@
+package models_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	timeoutErr := fmt.Errorf("wrapped: %w", context.DeadlineExceeded)
+	connErr := errors.New("dial tcp 127.0.0.1:8080: connect: connection refused")
+	serverErr := errors.New("remote server error: 503")
+	otherErr := errors.New("invalid json")
+
+	tests := []struct {
+		name     string
+		policy   models.RetryPolicy
+		attempts uint32
+		err      error
+		want     bool
+	}{
+		{"disabled", models.RetryPolicy{}, 1, timeoutErr, false},
+		{"single attempt", models.RetryPolicy{MaxAttempts: 1}, 1, timeoutErr, false},
+		{"no error", models.RetryPolicy{MaxAttempts: 3}, 1, nil, false},
+		{"default timeout", models.RetryPolicy{MaxAttempts: 3}, 1, timeoutErr, true},
+		{"default connection", models.RetryPolicy{MaxAttempts: 3}, 2, connErr, true},
+		{"default server error", models.RetryPolicy{MaxAttempts: 3}, 1, serverErr, true},
+		{"default other", models.RetryPolicy{MaxAttempts: 3}, 1, otherErr, false},
+		{"attempts exhausted", models.RetryPolicy{MaxAttempts: 3}, 3, timeoutErr, false},
+		{"any", models.RetryPolicy{MaxAttempts: 3, RetryOn: []string{models.RetryOnAny}}, 1, otherErr, true},
+		{"restricted classes", models.RetryPolicy{MaxAttempts: 3, RetryOn: []string{models.RetryOnServerError}}, 1, timeoutErr, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.policy.ShouldRetry(test.attempts, test.err))
+		})
+	}
+}
+
+func TestRetryPolicy_BackoffFor(t *testing.T) {
+	t.Parallel()
+
+	policy := models.RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     models.MustMakeDuration(time.Second),
+		MaxBackoff:  models.MustMakeDuration(3 * time.Second),
+	}
+	assert.Equal(t, time.Second, policy.BackoffFor(1))
+	assert.Equal(t, 2*time.Second, policy.BackoffFor(2))
+	assert.Equal(t, 3*time.Second, policy.BackoffFor(3))
+	assert.Equal(t, 3*time.Second, policy.BackoffFor(4))
+
+	assert.Equal(t, time.Duration(0), models.RetryPolicy{MaxAttempts: 5}.BackoffFor(1))
+}
+
+func TestRetryPolicy_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	var policy models.RetryPolicy
+	require.NoError(t, json.Unmarshal([]byte(`{"maxAttempts":3,"backoff":"1s","retryOn":["timeout"]}`), &policy))
+
+	value, err := policy.Value()
+	require.NoError(t, err)
+
+	var scanned models.RetryPolicy
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, policy, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, models.RetryPolicy{}, scanned)
+}
@@ -12,6 +12,7 @@ type RunInput struct {
 	taskRunID ID
 	data      JSON
 	status    RunStatus
+	dataLimit int64
 }
 
 // NewRunInput creates a new RunInput with arbitrary data
@@ -72,6 +73,19 @@ func (ri RunInput) TaskRunID() ID {
 	return ri.taskRunID
 }
 
+// DataLimit returns the most bytes of data the task's output may hold, as
+// left by the run's data size budget, or 0 if there is no limit.
+func (ri RunInput) DataLimit() int64 {
+	return ri.dataLimit
+}
+
+// WithDataLimit returns a copy of the input whose task's output may hold at
+// most limit bytes of data.
+func (ri RunInput) WithDataLimit(limit int64) RunInput {
+	ri.dataLimit = limit
+	return ri
+}
+
 func (ri RunInput) CloneWithData(data JSON) RunInput {
 	ri.data = data
 	return ri
@@ -2,15 +2,19 @@ package models
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tidwall/gjson"
 )
 
 // RunOutput represents the result of performing a Task
 type RunOutput struct {
-	data   JSON
-	status RunStatus
-	err    error
+	data             JSON
+	status           RunStatus
+	err              error
+	bytesTransferred int64
+	skipTasks        int
+	sleepUntil       time.Time
 }
 
 // NewRunOutputError returns a new RunOutput with an error
@@ -37,6 +41,13 @@ func NewRunOutputComplete(data JSON) RunOutput {
 	return RunOutput{status: RunStatusCompleted, data: data}
 }
 
+// NewRunOutputCompleteSkippingTasks returns a new RunOutput that is complete
+// and skips the given number of tasks following it, or all of them if tasks
+// is negative. The skipped tasks pass the data on unchanged.
+func NewRunOutputCompleteSkippingTasks(data JSON, tasks int) RunOutput {
+	return RunOutput{status: RunStatusCompleted, data: data, skipTasks: tasks}
+}
+
 // NewRunOutputPendingOutgoingConfirmationsWithData returns a new RunOutput that
 // indicates the task is pending outgoing confirmations but also has some data that
 // needs to be fed in on next invocation
@@ -63,6 +74,12 @@ func NewRunOutputInProgress(data JSON) RunOutput {
 	return RunOutput{status: RunStatusInProgress, data: data}
 }
 
+// NewRunOutputPendingSleep returns a new RunOutput that indicates the task
+// is sleeping until the given time, after which it completes with the data
+func NewRunOutputPendingSleep(data JSON, until time.Time) RunOutput {
+	return RunOutput{status: RunStatusPendingSleep, data: data, sleepUntil: until}
+}
+
 // NewRunOutputPendingBridge returns a new RunOutput that indicates the
 // task is still in progress
 func NewRunOutputPendingBridge() RunOutput {
@@ -98,3 +115,27 @@ func (ro RunOutput) Data() JSON {
 func (ro RunOutput) Status() RunStatus {
 	return ro.status
 }
+
+// WithBytesTransferred returns a copy of the RunOutput recording the number of
+// bytes sent and received by the adapter while performing the task.
+func (ro RunOutput) WithBytesTransferred(n int64) RunOutput {
+	ro.bytesTransferred = n
+	return ro
+}
+
+// SkipTasks returns the number of tasks following this one to skip, which is
+// negative when all of them are to be skipped.
+func (ro RunOutput) SkipTasks() int {
+	return ro.skipTasks
+}
+
+// SleepUntil returns the time a sleeping task wakes up.
+func (ro RunOutput) SleepUntil() time.Time {
+	return ro.sleepUntil
+}
+
+// BytesTransferred returns the number of bytes sent and received by the
+// adapter, for adapters which make requests over the network.
+func (ro RunOutput) BytesTransferred() int64 {
+	return ro.bytesTransferred
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RunRetention limits how long, and how many of, the finished runs of a job
+// are kept before the run reaper deletes or archives them. Each limit a job
+// sets overrides the node's, and zero means no limit.
+type RunRetention struct {
+	// MaxAge is how long after finishing a run is kept.
+	MaxAge *Duration `json:"maxAge,omitempty"`
+	// MaxRuns is how many of the most recently finished runs are kept.
+	MaxRuns *uint32 `json:"maxRuns,omitempty"`
+}
+
+// Limits returns the age and number of runs kept, taking the limits the
+// retention does not set from the node's.
+func (rr *RunRetention) Limits(defaultMaxAge time.Duration, defaultMaxRuns uint32) (time.Duration, uint32) {
+	maxAge, maxRuns := defaultMaxAge, defaultMaxRuns
+	if rr == nil {
+		return maxAge, maxRuns
+	}
+	if rr.MaxAge != nil {
+		maxAge = rr.MaxAge.Duration()
+	}
+	if rr.MaxRuns != nil {
+		maxRuns = *rr.MaxRuns
+	}
+	return maxAge, maxRuns
+}
+
+// Value is defined so that we can store RunRetention as JSONB.
+func (rr RunRetention) Value() (driver.Value, error) {
+	return json.Marshal(rr)
+}
+
+// Scan is defined so that we can read RunRetention as JSONB.
+func (rr *RunRetention) Scan(value interface{}) error {
+	if value == nil {
+		*rr = RunRetention{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal RunRetention JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, rr)
+}
@@ -3,10 +3,13 @@ package models
 import "time"
 
 // SyncEvent represents an event sourcing style event, which is used to sync
-// data upstream with another service
+// data upstream with another service. Telemetry events are not acknowledged
+// by the explorer, and are considered delivered once written to the
+// connection.
 type SyncEvent struct {
 	ID        int64 `gorm:"primary_key"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Body      string
+	Telemetry bool
 }
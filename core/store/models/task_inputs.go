@@ -0,0 +1,74 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tidwall/gjson"
+)
+
+// TaskInputs are the names of the earlier tasks whose output a task takes in
+// place of the output of the task before it. A task with inputs, even an
+// empty list of them, does not depend on the tasks before it otherwise, so
+// the tasks of a job form a graph rather than a chain. Consecutive tasks whose
+// inputs have completed are performed concurrently, but only if they are native
+// adapters without side effects outside the node; bridges, httppost and
+// signedwebhook tasks are always performed one at a time.
+type TaskInputs []string
+
+// Value is defined so that we can store TaskInputs as JSONB.
+func (ti TaskInputs) Value() (driver.Value, error) {
+	if ti == nil {
+		ti = TaskInputs{}
+	}
+	return json.Marshal([]string(ti))
+}
+
+// Scan is defined so that we can read TaskInputs as JSONB.
+func (ti *TaskInputs) Scan(value interface{}) error {
+	if value == nil {
+		*ti = TaskInputs{}
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal TaskInputs JSONB value: %v", value)
+	}
+	return json.Unmarshal(b, ti)
+}
+
+// InputData returns the data input to a task taking the outputs of the named
+// tasks: the output of its one input, or the results of several as an array
+// in the order they are named. A task without inputs is given nothing but the
+// params the run was requested with, which are merged into its input data.
+func (jr *JobRun) InputData(inputs TaskInputs) (JSON, error) {
+	outputs := jr.TaskOutputs()
+	if len(inputs) == 1 {
+		output, ok := outputs[inputs[0]]
+		if !ok {
+			return JSON{}, fmt.Errorf("input %s has not completed", inputs[0])
+		}
+		return output, nil
+	}
+
+	results := make([]json.RawMessage, len(inputs))
+	for i, name := range inputs {
+		output, ok := outputs[name]
+		if !ok {
+			return JSON{}, fmt.Errorf("input %s has not completed", name)
+		}
+		results[i] = rawResult(output.Get("result"))
+	}
+	if len(results) == 0 {
+		return JSON{}, nil
+	}
+	return JSON{}.Add("result", results)
+}
+
+func rawResult(result gjson.Result) json.RawMessage {
+	if !result.Exists() {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(result.Raw)
+}
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// TemplateEnvPrefix is the prefix of the environment variables which task
+// params may reference, so that a job spec cannot read the node's secrets.
+const TemplateEnvPrefix = "JOB_"
+
+// templateRegexp matches a template in task params, such as
+// {{ $.jobRun.params.symbol }}, capturing its root and the path within it.
+var templateRegexp = regexp.MustCompile(`\{\{\s*\$\.([a-zA-Z0-9_-]+)((?:\.[a-zA-Z0-9_-]+)*)\s*\}\}`)
+
+// HasTemplates returns true if the string values of params contain templates.
+func HasTemplates(params JSON) bool {
+	return templateRegexp.MatchString(params.String())
+}
+
+// ValidateTemplates checks that each template in params refers to the run,
+// its job or a permitted environment variable.
+func ValidateTemplates(params JSON) error {
+	for _, match := range templateRegexp.FindAllStringSubmatch(params.String(), -1) {
+		switch match[1] {
+		case "jobRun", "jobSpec":
+		case "env":
+			name := strings.TrimPrefix(match[2], ".")
+			if !strings.HasPrefix(name, TemplateEnvPrefix) || strings.Contains(name, ".") {
+				return fmt.Errorf("template %s may only refer to environment variables beginning with %s", match[0], TemplateEnvPrefix)
+			}
+		default:
+			return fmt.Errorf("template %s must refer to $.jobRun, $.jobSpec or $.env", match[0])
+		}
+	}
+	return nil
+}
+
+// NewTemplateContext returns the values templates are resolved from: the run
+// as $.jobRun, with the params it was requested with, the data input to the
+// task and the provenance of the request, and its job as $.jobSpec. The
+// request fields are null for runs whose initiator did not record them.
+func NewTemplateContext(job JobSpec, run JobRun, data JSON) (JSON, error) {
+	rr := run.RunRequest
+	b, err := json.Marshal(map[string]interface{}{
+		"jobRun": map[string]interface{}{
+			"id":        run.ID,
+			"params":    rr.RequestParams,
+			"data":      data,
+			"createdAt": run.CreatedAt.UTC().Format(time.RFC3339),
+			"request": map[string]interface{}{
+				"requestId":   rr.RequestID,
+				"requester":   rr.Requester,
+				"txHash":      rr.TxHash,
+				"blockHash":   rr.BlockHash,
+				"blockNumber": run.CreationHeight,
+				"payment":     rr.Payment,
+			},
+		},
+		"jobSpec": map[string]interface{}{
+			"id":        job.ID,
+			"name":      job.Name,
+			"createdAt": job.CreatedAt.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return JSON{}, err
+	}
+	return ParseJSON(b)
+}
+
+// InterpolateTemplates replaces each template in the string values of params
+// with the value it refers to in the context, or the environment variable
+// for $.env. As with task variables, a string consisting only of a template
+// takes on the value and its JSON type, whereas a template embedded in a
+// longer string is substituted as text.
+func InterpolateTemplates(params JSON, context JSON) (JSON, error) {
+	if err := ValidateTemplates(params); err != nil {
+		return JSON{}, err
+	}
+	return interpolate(params, templateRegexp, func(match []string) (gjson.Result, error) {
+		if match[1] == "env" {
+			value, ok := os.LookupEnv(strings.TrimPrefix(match[2], "."))
+			if !ok {
+				return gjson.Result{}, fmt.Errorf("template %s refers to an environment variable which is not set", match[0])
+			}
+			b, err := json.Marshal(value)
+			if err != nil {
+				return gjson.Result{}, err
+			}
+			return gjson.Result{Type: gjson.String, Str: value, Raw: string(b)}, nil
+		}
+		value := context.Get(match[1] + match[2])
+		if !value.Exists() {
+			return gjson.Result{}, fmt.Errorf("template %s not found", match[0])
+		}
+		return value, nil
+	})
+}
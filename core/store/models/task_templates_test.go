@@ -0,0 +1,89 @@
+package models_test
+
+import (
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolateTemplates(t *testing.T) {
+	os.Setenv("JOB_TEMPLATE_TEST_KEY", "s3cret")
+	defer os.Unsetenv("JOB_TEMPLATE_TEST_KEY")
+
+	job := models.NewJob()
+	job.Name = "prices"
+	run := cltest.NewJobRun(job)
+	run.CreatedAt = time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)
+	run.RunRequest.RequestParams = cltest.JSONFromString(t, `{"symbol": "ETH", "amount": 2.5}`)
+	requester := common.HexToAddress("0x9FBDa871d559710256a2502A2517b794B482Db40")
+	txHash := common.HexToHash("0xb7862c896a6ba2711bccc0410184e46d793ea83b3e05470f1d359ea276d16bb5")
+	run.RunRequest.Requester = &requester
+	run.RunRequest.TxHash = &txHash
+	run.RunRequest.Payment = assets.NewLink(1000000000000000000)
+	run.CreationHeight = utils.NewBig(big.NewInt(11000000))
+	context, err := models.NewTemplateContext(job, run, cltest.JSONFromString(t, `{"result": "100"}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params string
+		want   string
+	}{
+		{"no templates", `{"times": 100}`, `{"times": 100}`},
+		{"embedded", `{"get": "https://api.example.com/price?sym={{ $.jobRun.params.symbol }}"}`, `{"get": "https://api.example.com/price?sym=ETH"}`},
+		{"keeps type", `{"times": "{{$.jobRun.params.amount}}"}`, `{"times": 2.5}`},
+		{"run data", `{"value": "{{ $.jobRun.data.result }}"}`, `{"value": "100"}`},
+		{"job", `{"label": "{{ $.jobSpec.name }} {{ $.jobRun.createdAt }}"}`, `{"label": "prices 2020-12-01T00:00:00Z"}`},
+		{"run ID", `{"id": "{{ $.jobRun.id }}"}`, `{"id": "` + run.ID.String() + `"}`},
+		{"environment", `{"headers": {"X-Key": ["{{ $.env.JOB_TEMPLATE_TEST_KEY }}"]}}`, `{"headers": {"X-Key": ["s3cret"]}}`},
+		{"request", `{"source": "{{ $.jobRun.request.requester }}@{{ $.jobRun.request.blockNumber }}", "tx": "{{ $.jobRun.request.txHash }}", "paid": "{{ $.jobRun.request.payment }}"}`,
+			`{"source": "0x9fbda871d559710256a2502a2517b794b482db40@11000000", "tx": "0xb7862c896a6ba2711bccc0410184e46d793ea83b3e05470f1d359ea276d16bb5", "paid": "1000000000000000000"}`},
+		{"missing request field", `{"id": "{{ $.jobRun.request.requestId }}"}`, `{"id": null}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := models.InterpolateTemplates(cltest.JSONFromString(t, test.params), context)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, result.String())
+		})
+	}
+}
+
+func TestInterpolateTemplates_Errors(t *testing.T) {
+	t.Parallel()
+
+	job := models.NewJob()
+	run := cltest.NewJobRun(job)
+	context, err := models.NewTemplateContext(job, run, models.JSON{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		params  string
+		wantErr string
+	}{
+		{"missing", `{"a": "{{ $.jobRun.params.symbol }}"}`, "template {{ $.jobRun.params.symbol }} not found"},
+		{"unknown root", `{"a": "{{ $.run.id }}"}`, "template {{ $.run.id }} must refer to $.jobRun, $.jobSpec or $.env"},
+		{"secret", `{"a": "{{ $.env.DATABASE_URL }}"}`, "template {{ $.env.DATABASE_URL }} may only refer to environment variables beginning with JOB_"},
+		{"unset", `{"a": "{{ $.env.JOB_TEMPLATE_UNSET }}"}`, "template {{ $.env.JOB_TEMPLATE_UNSET }} refers to an environment variable which is not set"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := models.InterpolateTemplates(cltest.JSONFromString(t, test.params), context)
+			assert.EqualError(t, err, test.wantErr)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+var (
+	taskNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+	// taskVariableRegexp matches a reference to the output of a named task,
+	// either its result, as in $(fetchA), or a path within its output data,
+	// as in $(fetchA.data.price).
+	taskVariableRegexp = regexp.MustCompile(`\$\(([a-zA-Z0-9_-]+)((?:\.[a-zA-Z0-9_-]+)*)\)`)
+)
+
+// ValidTaskName returns true if the name can be referenced as a task variable.
+func ValidTaskName(name string) bool {
+	return taskNameRegexp.MatchString(name)
+}
+
+// TaskVariableReferences returns the names of the tasks whose output is
+// referenced by the params.
+func TaskVariableReferences(params JSON) []string {
+	var names []string
+	for _, match := range taskVariableRegexp.FindAllStringSubmatch(params.String(), -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// InterpolateTaskVariables replaces each task variable in the string values of
+// params with the output of the task it references. A string consisting only
+// of a variable takes on the referenced value and its JSON type, whereas a
+// variable embedded in a longer string is substituted as text.
+func InterpolateTaskVariables(params JSON, outputs map[string]JSON) (JSON, error) {
+	return interpolate(params, taskVariableRegexp, func(match []string) (gjson.Result, error) {
+		return lookupTaskVariable(match[1], match[2], outputs)
+	})
+}
+
+// variableLookup returns the value of the variable matched, given the
+// submatches of its pattern.
+type variableLookup func(match []string) (gjson.Result, error)
+
+// interpolate replaces the variables matching pattern in the string values of
+// params with the values lookup returns for them.
+func interpolate(params JSON, pattern *regexp.Regexp, lookup variableLookup) (JSON, error) {
+	if !pattern.MatchString(params.String()) {
+		return params, nil
+	}
+	raw, err := interpolateValue(params.String(), params.Result, "", pattern, lookup)
+	if err != nil {
+		return JSON{}, err
+	}
+	return ParseJSON([]byte(raw))
+}
+
+func interpolateValue(raw string, value gjson.Result, path string, pattern *regexp.Regexp, lookup variableLookup) (string, error) {
+	var err error
+	switch {
+	case value.IsObject():
+		value.ForEach(func(key, child gjson.Result) bool {
+			raw, err = interpolateValue(raw, child, joinTaskVariablePath(path, escapeTaskVariablePath(key.String())), pattern, lookup)
+			return err == nil
+		})
+	case value.IsArray():
+		index := 0
+		value.ForEach(func(_, child gjson.Result) bool {
+			raw, err = interpolateValue(raw, child, joinTaskVariablePath(path, strconv.Itoa(index)), pattern, lookup)
+			index++
+			return err == nil
+		})
+	case value.Type == gjson.String && path != "":
+		if !pattern.MatchString(value.Str) {
+			return raw, nil
+		}
+		var replacement string
+		if replacement, err = resolveVariables(value.Str, pattern, lookup); err == nil {
+			raw, err = sjson.SetRaw(raw, path, replacement)
+		}
+	}
+	return raw, err
+}
+
+// resolveVariables returns the raw JSON to replace the string s with.
+func resolveVariables(s string, pattern *regexp.Regexp, lookup variableLookup) (string, error) {
+	if match := pattern.FindStringSubmatch(s); match[0] == s {
+		value, err := lookup(match)
+		if err != nil {
+			return "", err
+		}
+		return value.Raw, nil
+	}
+
+	var err error
+	replaced := pattern.ReplaceAllStringFunc(s, func(variable string) string {
+		value, lookupErr := lookup(pattern.FindStringSubmatch(variable))
+		if lookupErr != nil {
+			err = lookupErr
+			return variable
+		}
+		return value.String()
+	})
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(replaced)
+	return string(b), err
+}
+
+func lookupTaskVariable(name, path string, outputs map[string]JSON) (gjson.Result, error) {
+	output, ok := outputs[name]
+	if !ok {
+		return gjson.Result{}, fmt.Errorf("task variable $(%s%s) refers to a task which has not completed", name, path)
+	}
+	key := "result"
+	if path != "" {
+		key = strings.TrimPrefix(path, ".")
+	}
+	value := output.Get(key)
+	if !value.Exists() {
+		return gjson.Result{}, fmt.Errorf("task variable $(%s%s) not found in the output of task %s", name, path, name)
+	}
+	return value, nil
+}
+
+func joinTaskVariablePath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func escapeTaskVariablePath(key string) string {
+	replacer := strings.NewReplacer(".", `\.`, "*", `\*`, "?", `\?`)
+	return replacer.Replace(key)
+}
@@ -0,0 +1,62 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolateTaskVariables(t *testing.T) {
+	t.Parallel()
+
+	outputs := map[string]models.JSON{
+		"fetchA": cltest.JSONFromString(t, `{"result":"100.5","data":{"price":100.5}}`),
+		"fetchB": cltest.JSONFromString(t, `{"result":2}`),
+	}
+
+	tests := []struct {
+		name   string
+		params string
+		want   string
+	}{
+		{"no variables", `{"times":100}`, `{"times":100}`},
+		{"result", `{"a":"$(fetchA)"}`, `{"a":"100.5"}`},
+		{"path keeps type", `{"a":"$(fetchA.data.price)"}`, `{"a":100.5}`},
+		{"embedded", `{"q":"a=$(fetchA)&b=$(fetchB)"}`, `{"q":"a=100.5&b=2"}`},
+		{"nested", `{"body":{"values":["$(fetchB)",3]}}`, `{"body":{"values":[2,3]}}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := cltest.JSONFromString(t, test.params)
+			result, err := models.InterpolateTaskVariables(params, outputs)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, result.String())
+		})
+	}
+}
+
+func TestInterpolateTaskVariables_Errors(t *testing.T) {
+	t.Parallel()
+
+	outputs := map[string]models.JSON{
+		"fetchA": cltest.JSONFromString(t, `{"result":"100"}`),
+	}
+
+	_, err := models.InterpolateTaskVariables(cltest.JSONFromString(t, `{"a":"$(fetchB)"}`), outputs)
+	assert.EqualError(t, err, "task variable $(fetchB) refers to a task which has not completed")
+
+	_, err = models.InterpolateTaskVariables(cltest.JSONFromString(t, `{"a":"x$(fetchA.missing)"}`), outputs)
+	assert.EqualError(t, err, "task variable $(fetchA.missing) not found in the output of task fetchA")
+}
+
+func TestTaskVariableReferences(t *testing.T) {
+	t.Parallel()
+
+	params := cltest.JSONFromString(t, `{"a":"$(fetchA)","b":{"c":"$(fetchB.data.x)/$(fetchC)"}}`)
+	assert.ElementsMatch(t, []string{"fetchA", "fetchB", "fetchC"}, models.TaskVariableReferences(params))
+}
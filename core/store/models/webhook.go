@@ -0,0 +1,40 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// WebhookTimestampTolerance is how far the timestamp of a webhook request
+// may be from the node's clock. Older requests are rejected, so a captured
+// request can only be replayed within it.
+const WebhookTimestampTolerance = 5 * time.Minute
+
+// WebhookSignature returns the signature of a webhook request sent at the
+// given unix time: the hex encoded HMAC-SHA256 of the timestamp, a ".", and
+// the body, keyed by the initiator's secret, prefixed by the name of the
+// hash as in "sha256=...".
+func WebhookSignature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature returns true if the signature was made over the
+// timestamp and body with the secret of the webhook initiator, and the
+// timestamp is within WebhookTimestampTolerance of now.
+func (i Initiator) VerifyWebhookSignature(timestamp int64, body []byte, signature string, now time.Time) bool {
+	if i.Type != InitiatorWebhook || i.Secret == "" {
+		return false
+	}
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > WebhookTimestampTolerance || age < -WebhookTimestampTolerance {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(WebhookSignature(i.Secret, timestamp, body)))
+}
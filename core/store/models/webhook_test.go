@@ -0,0 +1,39 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitiator_VerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"symbol": "ETH"}`)
+	now := time.Now()
+	timestamp := now.Unix()
+	initiator := models.Initiator{
+		Type:            models.InitiatorWebhook,
+		InitiatorParams: models.InitiatorParams{Secret: "0123456789abcdef"},
+	}
+	signature := models.WebhookSignature("0123456789abcdef", timestamp, body)
+	assert.Equal(t, "sha256=", signature[:7])
+
+	assert.True(t, initiator.VerifyWebhookSignature(timestamp, body, signature, now))
+	assert.False(t, initiator.VerifyWebhookSignature(timestamp, []byte(`{"symbol": "BTC"}`), signature, now))
+	assert.False(t, initiator.VerifyWebhookSignature(timestamp+1, body, signature, now))
+	assert.False(t, initiator.VerifyWebhookSignature(timestamp, body, models.WebhookSignature("fedcba9876543210", timestamp, body), now))
+	assert.False(t, initiator.VerifyWebhookSignature(timestamp, body, "", now))
+
+	t.Run("rejects timestamps outside the tolerance", func(t *testing.T) {
+		assert.True(t, initiator.VerifyWebhookSignature(timestamp, body, signature, now.Add(models.WebhookTimestampTolerance-time.Second)))
+		assert.False(t, initiator.VerifyWebhookSignature(timestamp, body, signature, now.Add(models.WebhookTimestampTolerance+time.Second)))
+		assert.False(t, initiator.VerifyWebhookSignature(timestamp, body, signature, now.Add(-models.WebhookTimestampTolerance-time.Second)))
+	})
+
+	initiator.Secret = ""
+	assert.False(t, initiator.VerifyWebhookSignature(timestamp, body, models.WebhookSignature("", timestamp, body), now))
+}
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -33,6 +34,24 @@ import (
 // this permission grants read / write accccess to file owners only
 const readWritePerms = os.FileMode(0600)
 
+const (
+	// KeySelectionRoundRobin sends each transaction from the least recently
+	// used key
+	KeySelectionRoundRobin = "round_robin"
+	// KeySelectionLeastPending sends each transaction from the key with the
+	// fewest transactions waiting to be confirmed
+	KeySelectionLeastPending = "least_pending"
+)
+
+const (
+	// HTTPCacheBackendMemory keeps cached HTTP responses in memory, so that
+	// they are lost on restart
+	HTTPCacheBackendMemory = "memory"
+	// HTTPCacheBackendDatabase keeps cached HTTP responses in the database,
+	// where they survive restarts
+	HTTPCacheBackendDatabase = "database"
+)
+
 // Config holds parameters used by the application which can be overridden by
 // setting environment variables.
 //
@@ -44,6 +63,7 @@ type Config struct {
 	runtimeStore    *ORM
 	Dialect         DialectName
 	AdvisoryLockID  int64
+	evmChain        *EVMChain
 }
 
 var configFileNotFoundError = reflect.TypeOf(viper.ConfigFileNotFoundError{})
@@ -109,6 +129,43 @@ func (c *Config) Validate() error {
 	if c.FeatureOffchainReporting() && c.P2PListenPort() == 0 {
 		return errors.New("OCR_LISTEN_PORT must be set to a non-zero value if FEATURE_OFFCHAIN_REPORTING is enabled")
 	}
+
+	if forwarder := c.EthForwarderAddress(); forwarder != "" && !common.IsHexAddress(forwarder) {
+		return errors.Errorf("ETH_FORWARDER_ADDRESS of %s is not a valid address", forwarder)
+	}
+
+	switch c.EthKeySelection() {
+	case KeySelectionRoundRobin, KeySelectionLeastPending:
+	default:
+		return errors.Errorf("ETH_KEY_SELECTION must be one of %s or %s, got %s", KeySelectionRoundRobin, KeySelectionLeastPending, c.EthKeySelection())
+	}
+
+	switch c.HTTPCacheBackend() {
+	case HTTPCacheBackendMemory, HTTPCacheBackendDatabase:
+	default:
+		return errors.Errorf("HTTP_CACHE_BACKEND must be one of %s or %s, got %s", HTTPCacheBackendMemory, HTTPCacheBackendDatabase, c.HTTPCacheBackend())
+	}
+
+	if c.HTTPRateLimit() < 0 {
+		return errors.Errorf("HTTP_RATE_LIMIT must not be negative, got %v", c.HTTPRateLimit())
+	}
+
+	if c.EthBalanceTopUpFundingAddress() != (common.Address{}) {
+		if !c.EnableBulletproofTxManager() {
+			return errors.New("ETH_BALANCE_TOP_UP_FUNDING_ADDRESS requires ENABLE_BULLETPROOF_TX_MANAGER")
+		}
+		if c.AlertMinEthBalanceWei().Sign() <= 0 || c.EthBalanceTopUpAmountWei().Sign() <= 0 {
+			return errors.New("ETH_BALANCE_TOP_UP_FUNDING_ADDRESS requires ALERT_MIN_ETH_BALANCE_WEI and ETH_BALANCE_TOP_UP_AMOUNT_WEI to be set")
+		}
+	}
+
+	chains, err := parseEVMChains(c.viper.GetString(EnvVarName("EVMChains")))
+	if err != nil {
+		return errors.Wrap(err, "invalid EVM_CHAINS")
+	}
+	if err := validateEVMChains(c.ChainID(), chains); err != nil {
+		return errors.Wrap(err, "invalid EVM_CHAINS")
+	}
 	return nil
 }
 
@@ -148,6 +205,121 @@ func (c Config) GetDatabaseDialectConfiguredOrDefault() DialectName {
 	return c.Dialect
 }
 
+// AlertCheckInterval is how often the alerter evaluates its conditions.
+func (c Config) AlertCheckInterval() time.Duration {
+	return c.viper.GetDuration(EnvVarName("AlertCheckInterval"))
+}
+
+// AlertEmailFrom is the sender address of alert emails.
+func (c Config) AlertEmailFrom() string {
+	return c.viper.GetString(EnvVarName("AlertEmailFrom"))
+}
+
+// AlertEmailSMTPAddress is the host:port of the SMTP server alert emails are
+// sent through. Email alerts are disabled if it is not set.
+func (c Config) AlertEmailSMTPAddress() string {
+	return c.viper.GetString(EnvVarName("AlertEmailSMTPAddress"))
+}
+
+// AlertEmailSMTPPassword is the password used to authenticate with the SMTP
+// server.
+func (c Config) AlertEmailSMTPPassword() string {
+	return c.viper.GetString(EnvVarName("AlertEmailSMTPPassword"))
+}
+
+// AlertEmailSMTPUsername is the username used to authenticate with the SMTP
+// server. No authentication is attempted if it is not set.
+func (c Config) AlertEmailSMTPUsername() string {
+	return c.viper.GetString(EnvVarName("AlertEmailSMTPUsername"))
+}
+
+// AlertEmailTo is the comma separated list of addresses alert emails are sent
+// to.
+func (c Config) AlertEmailTo() []string {
+	var to []string
+	for _, address := range strings.Split(c.viper.GetString(EnvVarName("AlertEmailTo")), ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			to = append(to, address)
+		}
+	}
+	return to
+}
+
+// AlertMinEthBalanceWei is the ETH balance, in Wei, below which a sending key
+// raises an alert. Zero disables the condition.
+func (c Config) AlertMinEthBalanceWei() *big.Int {
+	return c.getWithFallback("AlertMinEthBalanceWei", parseBigInt).(*big.Int)
+}
+
+// AlertPagerDutyRoutingKey is the integration key of the PagerDuty service
+// alerts are sent to. PagerDuty alerts are disabled if it is not set.
+func (c Config) AlertPagerDutyRoutingKey() string {
+	return c.viper.GetString(EnvVarName("AlertPagerDutyRoutingKey"))
+}
+
+// AlertRunErrorRate is the fraction of runs finished within
+// AlertRunErrorRateWindow which may error before an alert is raised. Zero
+// disables the condition.
+func (c Config) AlertRunErrorRate() float64 {
+	return c.viper.GetFloat64(EnvVarName("AlertRunErrorRate"))
+}
+
+// AlertRunErrorRateMinRuns is the number of runs which must have finished
+// within AlertRunErrorRateWindow before the error rate is considered, so that
+// a single failure on a quiet node does not raise an alert.
+func (c Config) AlertRunErrorRateMinRuns() uint {
+	return c.viper.GetUint(EnvVarName("AlertRunErrorRateMinRuns"))
+}
+
+// AlertRunErrorRateWindow is the period over which the run error rate is
+// measured.
+func (c Config) AlertRunErrorRateWindow() time.Duration {
+	return c.viper.GetDuration(EnvVarName("AlertRunErrorRateWindow"))
+}
+
+// AlertSlackWebhookURL is the Slack incoming webhook alerts are posted to, or
+// nil if Slack alerts are disabled.
+func (c Config) AlertSlackWebhookURL() *url.URL {
+	rval := c.getWithFallback("AlertSlackWebhookURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: AlertSlackWebhookURL returned as type %T", rval)
+		return nil
+	}
+}
+
+// AlertStaleHeadThreshold is how long the node may go without seeing a new
+// head before an alert is raised. Zero disables the condition.
+func (c Config) AlertStaleHeadThreshold() time.Duration {
+	return c.viper.GetDuration(EnvVarName("AlertStaleHeadThreshold"))
+}
+
+// AlertStuckTxThreshold is how long a transaction may remain unconfirmed
+// after it was first broadcast before an alert is raised. Zero disables the
+// condition.
+func (c Config) AlertStuckTxThreshold() time.Duration {
+	return c.viper.GetDuration(EnvVarName("AlertStuckTxThreshold"))
+}
+
+// AlertWebhookURL is a URL to which each alert is posted as JSON, for
+// integrations other than Slack, PagerDuty and email.
+func (c Config) AlertWebhookURL() *url.URL {
+	rval := c.getWithFallback("AlertWebhookURL", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: AlertWebhookURL returned as type %T", rval)
+		return nil
+	}
+}
+
 // AllowOrigins returns the CORS hosts used by the frontend.
 func (c Config) AllowOrigins() string {
 	return c.viper.GetString(EnvVarName("AllowOrigins"))
@@ -288,6 +460,25 @@ func (c Config) EthBalanceMonitorBlockDelay() uint16 {
 	return c.getWithFallback("EthBalanceMonitorBlockDelay", parseUint16).(uint16)
 }
 
+// EthBalanceTopUpAmountWei is the amount sent from the funding key to a
+// sending key whose balance has dropped below ALERT_MIN_ETH_BALANCE_WEI.
+func (c Config) EthBalanceTopUpAmountWei() *big.Int {
+	return c.getWithFallback("EthBalanceTopUpAmountWei", parseBigInt).(*big.Int)
+}
+
+// EthBalanceTopUpFundingAddress is the node's key from which the balance
+// monitor tops up the other sending keys. The zero address disables top-ups.
+func (c Config) EthBalanceTopUpFundingAddress() common.Address {
+	if c.viper.GetString(EnvVarName("EthBalanceTopUpFundingAddress")) == "" {
+		return common.Address{}
+	}
+	address, ok := c.getWithFallback("EthBalanceTopUpFundingAddress", parseAddress).(*common.Address)
+	if !ok {
+		return common.Address{}
+	}
+	return *address
+}
+
 // EthGasBumpThreshold is the number of blocks to wait for confirmations before bumping gas again
 func (c Config) EthGasBumpThreshold() uint64 {
 	return c.viper.GetUint64(EnvVarName("EthGasBumpThreshold"))
@@ -305,11 +496,82 @@ func (c Config) EthGasBumpPercent() uint16 {
 	return c.getWithFallback("EthGasBumpPercent", parseUint16).(uint16)
 }
 
+// EthGasBumpReplacementPercent is the percentage over the rejected attempt's gas
+// price at which a new attempt is made straight away when the eth node rejects a
+// gas bump as "replacement transaction underpriced". If 0, the rejected attempt
+// is kept and bumped again once ETH_GAS_BUMP_THRESHOLD blocks have passed.
+func (c Config) EthGasBumpReplacementPercent() uint16 {
+	return c.getWithFallback("EthGasBumpReplacementPercent", parseUint16).(uint16)
+}
+
 // EthGasBumpWei is the minimum fixed amount of wei by which gas is bumped on each transaction attempt
 func (c Config) EthGasBumpWei() *big.Int {
 	return c.getWithFallback("EthGasBumpWei", parseBigInt).(*big.Int)
 }
 
+// EthForwarderAddress is the address of a forwarder contract which the node
+// sends ethtx transactions through, or the empty string if transactions are
+// sent directly. Consumer contracts then only need to trust the forwarder,
+// which lets the node's keys be rotated without redeploying them.
+func (c Config) EthForwarderAddress() string {
+	return c.viper.GetString(EnvVarName("EthForwarderAddress"))
+}
+
+// EthKeySelection is the strategy used to pick the key which sends an ethtx
+// task's transaction, out of the keys the task may use. It is either
+// KeySelectionRoundRobin or KeySelectionLeastPending.
+func (c Config) EthKeySelection() string {
+	return c.viper.GetString(EnvVarName("EthKeySelection"))
+}
+
+// EthRemoteSignerURL is the URL of an external signing service which holds
+// the node's accounts and signs their transactions, for operators who keep
+// their keys in a separate signing enclave. grpc:// and grpcs:// URLs are
+// called with the TxSigner gRPC service, others with eth_accounts and
+// eth_signTransaction over JSON-RPC. If empty, transactions are signed with
+// the keys in the node's keystore.
+func (c Config) EthRemoteSignerURL() string {
+	return c.viper.GetString(EnvVarName("EthRemoteSignerURL"))
+}
+
+// EthReceiptFetchBatchSize is the number of eth_getTransactionReceipt calls
+// which the EthConfirmer sends in each JSON-RPC batch request. If 0, receipts
+// are fetched with one request per transaction attempt.
+func (c Config) EthReceiptFetchBatchSize() uint32 {
+	return c.viper.GetUint32(EnvVarName("EthReceiptFetchBatchSize"))
+}
+
+// EthNonceGapCheckInterval is how often the nonce of each sending key is
+// compared with its pending nonce on chain, to detect gaps such as those left
+// by restoring the database from a backup. If 0, nonces are not checked.
+func (c Config) EthNonceGapCheckInterval() time.Duration {
+	return c.viper.GetDuration(EnvVarName("EthNonceGapCheckInterval"))
+}
+
+// EthNonceGapAutoRepair enables repairing the nonce gaps found by the
+// periodic check. Otherwise gaps are only logged, and can be repaired through
+// the API.
+func (c Config) EthNonceGapAutoRepair() bool {
+	return c.viper.GetBool(EnvVarName("EthNonceGapAutoRepair"))
+}
+
+// EthSimulateTransactions enables running each ethtx task's transaction as an
+// eth_call before it is sent, so that a transaction which would revert fails
+// the task instead of being mined. Tasks can override this with their
+// simulate parameter.
+func (c Config) EthSimulateTransactions() bool {
+	return c.viper.GetBool(EnvVarName("EthSimulateTransactions"))
+}
+
+// EthPrivateChainMode is for private chains, such as Quorum or dev chains,
+// which do not price gas. Transactions are sent with a gas price of 0 and
+// their gas is never bumped, the gas updater is disabled, and a single block
+// confirmation is enough both for incoming requests and outgoing
+// transactions.
+func (c Config) EthPrivateChainMode() bool {
+	return c.viper.GetBool(EnvVarName("EthPrivateChainMode"))
+}
+
 // EthMaxGasPriceWei is the maximum amount in Wei that a transaction will be
 // bumped to before abandoning it and marking it as errored.
 func (c Config) EthMaxGasPriceWei() *big.Int {
@@ -323,6 +585,9 @@ func (c Config) EthGasLimitDefault() uint64 {
 
 // EthGasPriceDefault is the starting gas price for every transaction
 func (c Config) EthGasPriceDefault() *big.Int {
+	if c.EthPrivateChainMode() {
+		return big.NewInt(0)
+	}
 	if c.runtimeStore != nil {
 		var value big.Int
 		if err := c.runtimeStore.GetConfigValue("EthGasPriceDefault", &value); err != nil && errors.Cause(err) != ErrorNotFound {
@@ -342,6 +607,29 @@ func (c Config) SetEthGasPriceDefault(value *big.Int) error {
 	return c.runtimeStore.SetConfigValue("EthGasPriceDefault", value)
 }
 
+// EthGasTipCapDefault is the priority fee offered to miners on top of the
+// base fee of EIP-1559 chains when GAS_UPDATER_TIP_CAP_ENABLED is set. The
+// gas updater then keeps it up to date, and prices transactions by it.
+func (c Config) EthGasTipCapDefault() *big.Int {
+	if c.runtimeStore != nil {
+		var value big.Int
+		if err := c.runtimeStore.GetConfigValue("EthGasTipCapDefault", &value); err != nil && errors.Cause(err) != ErrorNotFound {
+			logger.Warnw("Error while trying to fetch EthGasTipCapDefault.", "error", err)
+		} else if err == nil {
+			return &value
+		}
+	}
+	return c.getWithFallback("EthGasTipCapDefault", parseBigInt).(*big.Int)
+}
+
+// SetEthGasTipCapDefault saves a runtime value for the default tip cap
+func (c Config) SetEthGasTipCapDefault(value *big.Int) error {
+	if c.runtimeStore == nil {
+		return errors.New("No runtime store installed")
+	}
+	return c.runtimeStore.SetConfigValue("EthGasTipCapDefault", value)
+}
+
 // EthFinalityDepth is the number of blocks after which an ethereum transaction is considered "final"
 // BlocksConsideredFinal determines how deeply we look back to ensure that transactions are confirmed onto the longest chain
 // There is not a large performance penalty to setting this relatively high (on the order of hundreds)
@@ -384,6 +672,22 @@ func (c Config) EthereumDisabled() bool {
 	return c.viper.GetBool(EnvVarName("EthereumDisabled"))
 }
 
+// EVMChains returns the chains served by the node in addition to the primary
+// chain given by ETH_CHAIN_ID and ETH_URL. EVM_CHAINS is a JSON array, for
+// example [{"chainId": "100", "ethUrl": "wss://xdai.example.com"}].
+// Secondary chains only run jobs which are not triggered by chain events,
+// and send their transactions with the legacy tx manager, as the head
+// tracker, log broadcaster and bulletproof tx manager only serve the primary
+// chain.
+func (c Config) EVMChains() []EVMChain {
+	chains, err := parseEVMChains(c.viper.GetString(EnvVarName("EVMChains")))
+	if err != nil {
+		logger.Errorw("Invalid value provided for EVMChains, ignoring", "error", err)
+		return nil
+	}
+	return chains
+}
+
 // FlagsContractAddress represents the Flags contract address
 func (c Config) FlagsContractAddress() string {
 	return c.viper.GetString(EnvVarName("FlagsContractAddress"))
@@ -416,9 +720,46 @@ func (c Config) GasUpdaterTransactionPercentile() uint16 {
 // GasUpdaterEnabled turns on the automatic gas updater if set to true
 // It is disabled by default
 func (c Config) GasUpdaterEnabled() bool {
+	if c.EthPrivateChainMode() {
+		return false
+	}
 	return c.viper.GetBool(EnvVarName("GasUpdaterEnabled"))
 }
 
+// GasUpdaterTipCapEnabled makes the gas updater price transactions by the
+// priority fees paid in recent blocks rather than their gas prices, for
+// chains with EIP-1559. ETH_GAS_TIP_CAP_DEFAULT is set to the
+// GAS_UPDATER_TRANSACTION_PERCENTILE of the tips, and ETH_GAS_PRICE_DEFAULT
+// to it on top of the highest base fee the next block can have. Blocks from
+// before EIP-1559 are skipped.
+func (c Config) GasUpdaterTipCapEnabled() bool {
+	return c.viper.GetBool(EnvVarName("GasUpdaterTipCapEnabled"))
+}
+
+// HTTPCacheBackend is where the responses of HTTP tasks which set a cacheTTL
+// are kept, either "memory" or "database"
+func (c Config) HTTPCacheBackend() string {
+	return c.viper.GetString(EnvVarName("HTTPCacheBackend"))
+}
+
+// HTTPRateLimit is the number of requests per second HTTP tasks may send to
+// each host, beyond which requests wait their turn. Zero disables the limit.
+func (c Config) HTTPRateLimit() float64 {
+	return c.viper.GetFloat64(EnvVarName("HTTPRateLimit"))
+}
+
+// HTTPMaxResponseSize is the largest response HTTP tasks may raise their
+// maxResponseSize to, above DEFAULT_HTTP_LIMIT
+func (c Config) HTTPMaxResponseSize() int64 {
+	return c.viper.GetInt64(EnvVarName("HTTPMaxResponseSize"))
+}
+
+// HTTPRateLimitBurst is the number of requests HTTP tasks may send to a host
+// at once, before HTTP_RATE_LIMIT spaces them out
+func (c Config) HTTPRateLimitBurst() uint {
+	return c.viper.GetUint(EnvVarName("HTTPRateLimitBurst"))
+}
+
 // InsecureFastScrypt causes all key stores to encrypt using "fast" scrypt params instead
 // This is insecure and only useful for local testing. DO NOT SET THIS IN PRODUCTION
 func (c Config) InsecureFastScrypt() bool {
@@ -447,6 +788,44 @@ func (c Config) JobPipelineReaperThreshold() time.Duration {
 	return c.viper.GetDuration(EnvVarName("JobPipelineReaperThreshold"))
 }
 
+// JobRunMaxDataSize is the maximum number of bytes of task output a single
+// run may hold. A task whose output would take the run over this budget is
+// errored rather than its output being stored. Zero means unlimited.
+func (c Config) JobRunMaxDataSize() int64 {
+	return c.viper.GetInt64(EnvVarName("JobRunMaxDataSize"))
+}
+
+// JobRunMaxExecutionTime is the maximum total time the tasks of a single run
+// may spend being performed, not counting time spent waiting for confirmations,
+// bridges or retries. Zero means unlimited.
+func (c Config) JobRunMaxExecutionTime() time.Duration {
+	return c.viper.GetDuration(EnvVarName("JobRunMaxExecutionTime"))
+}
+
+// JobRunReaperInterval is how often the finished runs kept longer than their
+// job's retention allows are deleted or archived. Zero disables the reaper.
+func (c Config) JobRunReaperInterval() time.Duration {
+	return c.viper.GetDuration(EnvVarName("JobRunReaperInterval"))
+}
+
+// JobRunRetentionArchive makes the reaper archive runs, hiding them while
+// keeping their rows, instead of deleting them.
+func (c Config) JobRunRetentionArchive() bool {
+	return c.viper.GetBool(EnvVarName("JobRunRetentionArchive"))
+}
+
+// JobRunRetentionCount is how many finished runs of each job are kept, unless
+// the job sets its own limit. Zero means unlimited.
+func (c Config) JobRunRetentionCount() uint32 {
+	return c.viper.GetUint32(EnvVarName("JobRunRetentionCount"))
+}
+
+// JobRunRetentionPeriod is how long after finishing a run is kept, unless its
+// job sets its own limit. Zero means forever.
+func (c Config) JobRunRetentionPeriod() time.Duration {
+	return c.viper.GetDuration(EnvVarName("JobRunRetentionPeriod"))
+}
+
 // JSONConsole enables the JSON console.
 func (c Config) JSONConsole() bool {
 	return c.viper.GetBool(EnvVarName("JSONConsole"))
@@ -553,6 +932,9 @@ func (c Config) LogSQLMigrations() bool {
 // confirmations that need to be recorded since a job run started before a task
 // can proceed.
 func (c Config) MinIncomingConfirmations() uint32 {
+	if c.EthPrivateChainMode() {
+		return 1
+	}
 	return c.viper.GetUint32(EnvVarName("MinIncomingConfirmations"))
 }
 
@@ -560,6 +942,9 @@ func (c Config) MinIncomingConfirmations() uint32 {
 // confirmations that need to be recorded on an outgoing ethtx task before the run can move onto the next task.
 // This can be overridden on a per-task basis by setting the `MinRequiredOutgoingConfirmations` parameter.
 func (c Config) MinRequiredOutgoingConfirmations() uint64 {
+	if c.EthPrivateChainMode() {
+		return 1
+	}
 	return c.viper.GetUint64(EnvVarName("MinRequiredOutgoingConfirmations"))
 }
 
@@ -619,6 +1004,33 @@ func (c Config) RootDir() string {
 	return c.getWithFallback("RootDir", parseHomeDir).(string)
 }
 
+// RunQueueMaxPending is the maximum number of runs which may wait for a free
+// worker before further runs are rejected. Zero means unbounded.
+func (c Config) RunQueueMaxPending() uint {
+	return c.viper.GetUint(EnvVarName("RunQueueMaxPending"))
+}
+
+// RunQueuePriorityAging is how long a run may wait for a worker before it is
+// promoted to the next priority class, so that low priority runs are not
+// starved by a steady stream of higher priority ones.
+func (c Config) RunQueuePriorityAging() time.Duration {
+	return c.viper.GetDuration(EnvVarName("RunQueuePriorityAging"))
+}
+
+// RunQueueMaxWorkers is the maximum number of runs executed concurrently
+// across all jobs. Zero means unlimited.
+func (c Config) RunQueueMaxWorkers() uint {
+	return c.viper.GetUint(EnvVarName("RunQueueMaxWorkers"))
+}
+
+// RunQueueMaxWorkersPerJob is the maximum number of runs of a single job
+// executed concurrently. Zero means unlimited. Runs paused waiting for a
+// bridge or confirmations do not count against it; a job's maxConcurrentRuns
+// limits those as well.
+func (c Config) RunQueueMaxWorkersPerJob() uint {
+	return c.viper.GetUint(EnvVarName("RunQueueMaxWorkersPerJob"))
+}
+
 // SecureCookies allows toggling of the secure cookies HTTP flag
 func (c Config) SecureCookies() bool {
 	return c.viper.GetBool(EnvVarName("SecureCookies"))
@@ -659,11 +1071,39 @@ func (c Config) TxAttemptLimit() uint16 {
 	return c.getWithFallback("TxAttemptLimit", parseUint16).(uint16)
 }
 
+// TracingOTLPEndpoint is the OTLP/HTTP traces endpoint of the OpenTelemetry
+// collector that spans are exported to, for example
+// http://localhost:4318/v1/traces. Tracing is disabled if it is not set.
+func (c Config) TracingOTLPEndpoint() *url.URL {
+	rval := c.getWithFallback("TracingOTLPEndpoint", parseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		logger.Panicf("invariant: TracingOTLPEndpoint returned as type %T", rval)
+		return nil
+	}
+}
+
+// TracingSampleRatio is the fraction of job runs which are traced, between 0
+// and 1.
+func (c Config) TracingSampleRatio() float64 {
+	return c.viper.GetFloat64(EnvVarName("TracingSampleRatio"))
+}
+
 // TLSRedirect forces TLS redirect for unencrypted connections
 func (c Config) TLSRedirect() bool {
 	return c.viper.GetBool(EnvVarName("TLSRedirect"))
 }
 
+// ClientCertificatesDir is where the client certificates of HTTP tasks and
+// bridges which connect with mutual TLS are kept
+func (c Config) ClientCertificatesDir() string {
+	return filepath.Join(c.RootDir(), "client_certs")
+}
+
 // KeysDir returns the path of the keys directory (used for keystore files).
 func (c Config) KeysDir() string {
 	return filepath.Join(c.RootDir(), "tempkeys")
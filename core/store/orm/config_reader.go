@@ -15,6 +15,21 @@ import (
 
 // ConfigReader represents just the read side of the config
 type ConfigReader interface {
+	AlertCheckInterval() time.Duration
+	AlertEmailFrom() string
+	AlertEmailSMTPAddress() string
+	AlertEmailSMTPPassword() string
+	AlertEmailSMTPUsername() string
+	AlertEmailTo() []string
+	AlertMinEthBalanceWei() *big.Int
+	AlertPagerDutyRoutingKey() string
+	AlertRunErrorRate() float64
+	AlertRunErrorRateMinRuns() uint
+	AlertRunErrorRateWindow() time.Duration
+	AlertSlackWebhookURL() *url.URL
+	AlertStaleHeadThreshold() time.Duration
+	AlertStuckTxThreshold() time.Duration
+	AlertWebhookURL() *url.URL
 	AllowOrigins() string
 	BlockBackfillDepth() uint64
 	BridgeResponseURL() *url.URL
@@ -36,22 +51,47 @@ type ConfigReader interface {
 	EnableExperimentalAdapters() bool
 	EnableBulletproofTxManager() bool
 	EthBalanceMonitorBlockDelay() uint16
+	EthBalanceTopUpAmountWei() *big.Int
+	EthBalanceTopUpFundingAddress() common.Address
 	EthGasBumpPercent() uint16
+	EthGasBumpReplacementPercent() uint16
 	EthGasBumpThreshold() uint64
 	EthGasBumpTxDepth() uint16
 	EthGasBumpWei() *big.Int
 	EthGasLimitDefault() uint64
 	EthGasPriceDefault() *big.Int
+	EthPrivateChainMode() bool
+	EthGasTipCapDefault() *big.Int
 	EthMaxGasPriceWei() *big.Int
 	EthFinalityDepth() uint
 	EthHeadTrackerHistoryDepth() uint
 	EthHeadTrackerMaxBufferSize() uint
+	EthKeySelection() string
+	EthForwarderAddress() string
+	EthSimulateTransactions() bool
+	EthRemoteSignerURL() string
+	EthReceiptFetchBatchSize() uint32
+	EthNonceGapCheckInterval() time.Duration
+	EthNonceGapAutoRepair() bool
 	SetEthGasPriceDefault(value *big.Int) error
+	SetEthGasTipCapDefault(value *big.Int) error
 	EthereumURL() string
 	EthereumSecondaryURL() string
+	EVMChains() []EVMChain
 	GasUpdaterBlockDelay() uint16
 	GasUpdaterBlockHistorySize() uint16
 	GasUpdaterTransactionPercentile() uint16
+	GasUpdaterTipCapEnabled() bool
+	HTTPCacheBackend() string
+	HTTPMaxResponseSize() int64
+	HTTPRateLimit() float64
+	HTTPRateLimitBurst() uint
+	JobRunMaxDataSize() int64
+	JobRunMaxExecutionTime() time.Duration
+	JobRunReaperInterval() time.Duration
+	JobRunRetentionArchive() bool
+	JobRunRetentionCount() uint32
+	JobRunRetentionPeriod() time.Duration
 	JSONConsole() bool
 	LinkContractAddress() string
 	ExplorerURL() *url.URL
@@ -70,6 +110,10 @@ type ConfigReader interface {
 	Port() uint16
 	ReaperExpiration() models.Duration
 	RootDir() string
+	RunQueueMaxPending() uint
+	RunQueuePriorityAging() time.Duration
+	RunQueueMaxWorkers() uint
+	RunQueueMaxWorkersPerJob() uint
 	SecureCookies() bool
 	SessionTimeout() models.Duration
 	TLSCertPath() string
@@ -77,8 +121,11 @@ type ConfigReader interface {
 	TLSKeyPath() string
 	TLSPort() uint16
 	TLSRedirect() bool
+	TracingOTLPEndpoint() *url.URL
+	TracingSampleRatio() float64
 	TxAttemptLimit() uint16
 	KeysDir() string
+	ClientCertificatesDir() string
 	tlsDir() string
 	KeyFile() string
 	CertFile() string
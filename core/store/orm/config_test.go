@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
@@ -164,3 +165,126 @@ func TestStore_urlParser(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_EVMChains(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+	config.Set("ETH_CHAIN_ID", 1)
+	assert.Empty(t, config.EVMChains())
+	require.NoError(t, config.Validate())
+
+	config.Set("EVM_CHAINS", `[{"chainId": "100", "ethUrl": "wss://xdai.example.com", "ethGasPriceDefault": "1000000000"}, {"chainId": 137, "ethUrl": "wss://matic.example.com", "minOutgoingConfirmations": 50}]`)
+	require.NoError(t, config.Validate())
+	chains := config.EVMChains()
+	require.Len(t, chains, 2)
+	assert.Equal(t, big.NewInt(100), chains[0].ChainID.ToInt())
+	assert.Equal(t, "wss://xdai.example.com", chains[0].EthereumURL)
+	assert.Equal(t, big.NewInt(1000000000), chains[0].EthGasPriceDefault.ToInt())
+	assert.Equal(t, big.NewInt(137), chains[1].ChainID.ToInt())
+	assert.Equal(t, uint64(50), chains[1].MinRequiredOutgoingConfirmations)
+
+	config.Set("EVM_CHAINS", `[{"chainId": "1", "ethUrl": "wss://mainnet.example.com"}]`)
+	assert.Error(t, config.Validate())
+	config.Set("EVM_CHAINS", `[{"chainId": "100"}]`)
+	assert.Error(t, config.Validate())
+	config.Set("EVM_CHAINS", `{"chainId": "100"}`)
+	assert.Error(t, config.Validate())
+	assert.Empty(t, config.EVMChains())
+
+	config.Set("EVM_CHAINS", `[{"chainId": "100", "ethUrl": "wss://xdai.example.com", "keys": ["0x9FBDa871d559710256a2502A2517b794B482Db40"]}]`)
+	require.NoError(t, config.Validate())
+	assert.Equal(t, []common.Address{common.HexToAddress("0x9FBDa871d559710256a2502A2517b794B482Db40")}, config.EVMChains()[0].Keys)
+	config.Set("EVM_CHAINS", `[{"chainId": "100", "ethUrl": "wss://xdai.example.com", "keys": ["0x9FBDa871d559710256a2502A2517b794B482Db40"]}, {"chainId": 137, "ethUrl": "wss://matic.example.com", "keys": ["0x9FBDa871d559710256a2502A2517b794B482Db40"]}]`)
+	assert.Error(t, config.Validate())
+}
+
+func TestConfig_EthBalanceTopUp(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+	assert.Equal(t, common.Address{}, config.EthBalanceTopUpFundingAddress())
+
+	funding := "0x9FBDa871d559710256a2502A2517b794B482Db40"
+	config.Set("ETH_BALANCE_TOP_UP_FUNDING_ADDRESS", funding)
+	assert.Equal(t, common.HexToAddress(funding), config.EthBalanceTopUpFundingAddress())
+	assert.Error(t, config.Validate())
+
+	config.Set("ALERT_MIN_ETH_BALANCE_WEI", "100000000000000000")
+	config.Set("ETH_BALANCE_TOP_UP_AMOUNT_WEI", "500000000000000000")
+	require.NoError(t, config.Validate())
+	assert.Equal(t, big.NewInt(500000000000000000), config.EthBalanceTopUpAmountWei())
+
+	config.Set("ENABLE_BULLETPROOF_TX_MANAGER", false)
+	assert.Error(t, config.Validate())
+}
+
+func TestConfig_EthPrivateChainMode(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+	config.Set("ETH_GAS_PRICE_DEFAULT", 30000000000)
+	config.Set("MIN_INCOMING_CONFIRMATIONS", 3)
+	config.Set("MIN_OUTGOING_CONFIRMATIONS", 12)
+	assert.False(t, config.EthPrivateChainMode())
+	assert.True(t, config.GasUpdaterEnabled())
+
+	config.Set("ETH_PRIVATE_CHAIN_MODE", true)
+	assert.Equal(t, big.NewInt(0), config.EthGasPriceDefault())
+	assert.False(t, config.GasUpdaterEnabled())
+	assert.Equal(t, uint32(1), config.MinIncomingConfirmations())
+	assert.Equal(t, uint64(1), config.MinRequiredOutgoingConfirmations())
+
+	public := false
+	chainConfig := config.ForChain(EVMChain{
+		ChainID:          utils.NewBigI(100),
+		EthereumURL:      "wss://xdai.example.com",
+		PrivateChainMode: &public,
+	})
+	assert.False(t, chainConfig.EthPrivateChainMode())
+	assert.Equal(t, big.NewInt(30000000000), chainConfig.EthGasPriceDefault())
+	assert.Equal(t, uint64(12), chainConfig.MinRequiredOutgoingConfirmations())
+}
+
+func TestConfig_ForChain(t *testing.T) {
+	t.Parallel()
+	config := NewConfig()
+	config.Set("ETH_CHAIN_ID", 1)
+	config.Set("ETH_URL", "wss://mainnet.example.com")
+	config.Set("ETH_SECONDARY_URL", "https://mainnet-backup.example.com")
+	config.Set("ETH_GAS_PRICE_DEFAULT", 30000000000)
+	config.Set("ETH_GAS_LIMIT_DEFAULT", 600000)
+	config.Set("MIN_OUTGOING_CONFIRMATIONS", 12)
+	config.Set("ETH_FINALITY_DEPTH", 42)
+
+	chainKey := common.HexToAddress("0x9FBDa871d559710256a2502A2517b794B482Db40")
+	chainConfig := config.ForChain(EVMChain{
+		ChainID:                          utils.NewBigI(100),
+		EthereumURL:                      "wss://xdai.example.com",
+		EthGasLimitDefault:               700000,
+		MinRequiredOutgoingConfirmations: 3,
+		Keys:                             []common.Address{chainKey},
+	})
+
+	assert.Equal(t, big.NewInt(100), chainConfig.ChainID())
+	assert.Equal(t, "wss://xdai.example.com", chainConfig.EthereumURL())
+	assert.Equal(t, "", chainConfig.EthereumSecondaryURL())
+	assert.Equal(t, big.NewInt(30000000000), chainConfig.EthGasPriceDefault())
+	assert.Equal(t, uint64(700000), chainConfig.EthGasLimitDefault())
+	assert.Equal(t, uint64(3), chainConfig.MinRequiredOutgoingConfirmations())
+	assert.Equal(t, uint(42), chainConfig.EthFinalityDepth())
+	assert.Equal(t, config.EnableBulletproofTxManager(), chainConfig.EnableBulletproofTxManager())
+	require.NotNil(t, chainConfig.EVMChain())
+	assert.True(t, chainConfig.ServesChain(utils.NewBigI(100)))
+	assert.False(t, chainConfig.ServesChain(nil))
+	assert.True(t, chainConfig.SendsFrom(chainKey))
+	assert.False(t, chainConfig.SendsFrom(common.Address{}))
+
+	assert.Equal(t, big.NewInt(1), config.ChainID())
+	assert.Equal(t, "wss://mainnet.example.com", config.EthereumURL())
+	assert.Equal(t, uint64(600000), config.EthGasLimitDefault())
+	assert.Nil(t, config.EVMChain())
+	assert.True(t, config.ServesChain(nil))
+	assert.True(t, config.ServesChain(utils.NewBigI(1)))
+	assert.False(t, config.ServesChain(utils.NewBigI(100)))
+	assert.True(t, config.SendsFrom(chainKey))
+	config.Set("EVM_CHAINS", `[{"chainId": "100", "ethUrl": "wss://xdai.example.com", "keys": ["0x9FBDa871d559710256a2502A2517b794B482Db40"]}]`)
+	assert.False(t, config.SendsFrom(chainKey))
+}
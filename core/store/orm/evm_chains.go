@@ -0,0 +1,133 @@
+package orm
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/spf13/viper"
+)
+
+// EVMChain configures an additional EVM chain served by the node. Gas and
+// confirmation settings which are left unset are inherited from the primary
+// chain. PrivateChainMode switches ETH_PRIVATE_CHAIN_MODE on or off for the
+// chain alone. Keys lists the addresses of the node's keys which send the
+// chain's transactions; a key can serve only one chain, and keys not listed
+// by any chain serve the primary chain.
+type EVMChain struct {
+	ChainID                          *utils.Big       `json:"chainId"`
+	EthereumURL                      string           `json:"ethUrl"`
+	EthereumSecondaryURL             string           `json:"ethSecondaryUrl,omitempty"`
+	EthGasLimitDefault               uint64           `json:"ethGasLimitDefault,omitempty"`
+	EthGasPriceDefault               *utils.Big       `json:"ethGasPriceDefault,omitempty"`
+	MinRequiredOutgoingConfirmations uint64           `json:"minOutgoingConfirmations,omitempty"`
+	PrivateChainMode                 *bool            `json:"privateChainMode,omitempty"`
+	Keys                             []common.Address `json:"keys,omitempty"`
+}
+
+func parseEVMChains(str string) ([]EVMChain, error) {
+	if str == "" {
+		return nil, nil
+	}
+	var chains []EVMChain
+	if err := json.Unmarshal([]byte(str), &chains); err != nil {
+		return nil, err
+	}
+	return chains, nil
+}
+
+func validateEVMChains(primaryChainID *big.Int, chains []EVMChain) error {
+	seen := map[string]bool{primaryChainID.String(): true}
+	keys := map[common.Address]bool{}
+	for i, chain := range chains {
+		if chain.ChainID == nil {
+			return errors.Errorf("chain %d is missing a chainId", i)
+		}
+		if chain.EthereumURL == "" {
+			return errors.Errorf("chain %s is missing an ethUrl", chain.ChainID)
+		}
+		if seen[chain.ChainID.String()] {
+			return errors.Errorf("chain %s is configured more than once", chain.ChainID)
+		}
+		seen[chain.ChainID.String()] = true
+		for _, key := range chain.Keys {
+			if keys[key] {
+				return errors.Errorf("key %s is configured for more than one chain", key.Hex())
+			}
+			keys[key] = true
+		}
+	}
+	return nil
+}
+
+// ForChain returns a copy of the config in which the Ethereum connection,
+// gas and confirmation settings are those of the given chain. The copy does
+// not read runtime values from the database, which only apply to the primary
+// chain.
+func (c *Config) ForChain(chain EVMChain) *Config {
+	v := viper.New()
+	for _, key := range c.viper.AllKeys() {
+		v.Set(key, c.viper.Get(key))
+	}
+	v.Set(EnvVarName("ChainID"), chain.ChainID.String())
+	v.Set(EnvVarName("EthereumURL"), chain.EthereumURL)
+	v.Set(EnvVarName("EthereumSecondaryURL"), chain.EthereumSecondaryURL)
+	if chain.EthGasPriceDefault != nil {
+		v.Set(EnvVarName("EthGasPriceDefault"), chain.EthGasPriceDefault.String())
+	}
+	if chain.EthGasLimitDefault != 0 {
+		v.Set(EnvVarName("EthGasLimitDefault"), chain.EthGasLimitDefault)
+	}
+	if chain.MinRequiredOutgoingConfirmations != 0 {
+		v.Set(EnvVarName("MinRequiredOutgoingConfirmations"), chain.MinRequiredOutgoingConfirmations)
+	}
+	if chain.PrivateChainMode != nil {
+		v.Set(EnvVarName("EthPrivateChainMode"), *chain.PrivateChainMode)
+	}
+
+	return &Config{
+		viper:           v,
+		SecretGenerator: c.SecretGenerator,
+		Dialect:         c.Dialect,
+		AdvisoryLockID:  c.AdvisoryLockID,
+		evmChain:        &chain,
+	}
+}
+
+// EVMChain returns the secondary chain the config was made for with
+// ForChain, or nil if it is the config of the primary chain.
+func (c Config) EVMChain() *EVMChain {
+	return c.evmChain
+}
+
+// ServesChain returns true if jobs and runs bound to the given chain, which
+// is nil for the primary chain, are served through this config.
+func (c Config) ServesChain(chainID *utils.Big) bool {
+	if c.evmChain == nil {
+		return chainID == nil || chainID.ToInt().Cmp(c.ChainID()) == 0
+	}
+	return chainID != nil && chainID.ToInt().Cmp(c.ChainID()) == 0
+}
+
+// SendsFrom returns true if the key with the given address sends the
+// transactions of the config's chain.
+func (c Config) SendsFrom(address common.Address) bool {
+	if c.evmChain != nil {
+		for _, key := range c.evmChain.Keys {
+			if key == address {
+				return true
+			}
+		}
+		return false
+	}
+	for _, chain := range c.EVMChains() {
+		for _, key := range chain.Keys {
+			if key == address {
+				return false
+			}
+		}
+	}
+	return true
+}
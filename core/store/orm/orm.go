@@ -7,6 +7,7 @@ import (
 	"encoding"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 	"sync"
@@ -50,6 +51,9 @@ type ORM struct {
 	advisoryLockTimeout models.Duration
 	closeOnce           sync.Once
 	shutdownSignal      gracefulpanic.Signal
+	// chainID is the secondary chain whose keys and heads are read and
+	// written, or nil for the primary chain
+	chainID *utils.Big
 }
 
 // NewORM initializes the orm with the configured uri
@@ -130,9 +134,58 @@ func (orm *ORM) Unscoped() *ORM {
 	return &ORM{
 		DB:              orm.DB.Unscoped(),
 		lockingStrategy: orm.lockingStrategy,
+		chainID:         orm.chainID,
 	}
 }
 
+// ForChain returns a new instance of this ORM whose send keys and heads are
+// those of the given secondary chain.
+func (orm *ORM) ForChain(chainID *big.Int) *ORM {
+	return &ORM{
+		DB:                  orm.DB,
+		lockingStrategy:     orm.lockingStrategy,
+		advisoryLockTimeout: orm.advisoryLockTimeout,
+		shutdownSignal:      orm.shutdownSignal,
+		chainID:             utils.NewBig(chainID),
+	}
+}
+
+// ChainCondition returns an SQL condition on the evm_chain_id column of the
+// given table, matching the keys or heads of the ORM's chain.
+func (orm *ORM) ChainCondition(table string) string {
+	if orm.chainID == nil {
+		return table + ".evm_chain_id IS NULL"
+	}
+	return fmt.Sprintf("%s.evm_chain_id = %s", table, orm.chainID)
+}
+
+// FromChainKeysCondition returns an SQL condition on the from_address column
+// of the given table of transactions, matching those sent from the keys of
+// the ORM's chain.
+func (orm *ORM) FromChainKeysCondition(table string) string {
+	return fmt.Sprintf("%s.from_address IN (SELECT address FROM keys WHERE %s)", table, orm.ChainCondition("keys"))
+}
+
+// AssignKeyChains records which chain each key sends transactions on: the
+// secondary chain listing it, or the primary chain if none does.
+func (orm *ORM) AssignKeyChains(chains []EVMChain) error {
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		if err := dbtx.Exec(`UPDATE keys SET evm_chain_id = NULL`).Error; err != nil {
+			return err
+		}
+		for _, chain := range chains {
+			if len(chain.Keys) == 0 {
+				continue
+			}
+			err := dbtx.Exec(`UPDATE keys SET evm_chain_id = ? WHERE address IN (?)`, chain.ChainID, chain.Keys).Error
+			if err != nil {
+				return errors.Wrapf(err, "failed to assign keys to chain %s", chain.ChainID)
+			}
+		}
+		return nil
+	})
+}
+
 // FindBridge looks up a Bridge by its Name.
 func (orm *ORM) FindBridge(name models.TaskType) (models.BridgeType, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -195,10 +248,10 @@ func (orm *ORM) FindInitiator(ID int64) (models.Initiator, error) {
 func (orm *ORM) preloadJobs() *gorm.DB {
 	return orm.DB.
 		Preload("Initiators", func(db *gorm.DB) *gorm.DB {
-			return db.Unscoped().Order(`"id" asc`)
+			return db.Unscoped().Where("superseded_at IS NULL").Order(`"id" asc`)
 		}).
 		Preload("Tasks", func(db *gorm.DB) *gorm.DB {
-			return db.Unscoped().Order("id asc")
+			return db.Unscoped().Where("superseded_at IS NULL").Order("id asc")
 		})
 }
 
@@ -222,6 +275,17 @@ func (orm *ORM) preloadJobRuns() *gorm.DB {
 		Preload("Result")
 }
 
+// FindJobRunByIdempotencyKey looks up the JobRun whose run request was made
+// with the given idempotency key.
+func (orm *ORM) FindJobRunByIdempotencyKey(key string) (models.JobRun, error) {
+	orm.MustEnsureAdvisoryLock()
+	var jr models.JobRun
+	err := orm.preloadJobRuns().
+		Joins("INNER JOIN run_requests ON run_requests.id = job_runs.run_request_id").
+		First(&jr, "run_requests.idempotency_key = ?", key).Error
+	return jr, err
+}
+
 // FindJobRun looks up a JobRun by its ID.
 func (orm *ORM) FindJobRun(id *models.ID) (models.JobRun, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -230,28 +294,61 @@ func (orm *ORM) FindJobRun(id *models.ID) (models.JobRun, error) {
 	return jr, err
 }
 
-// AllSyncEvents returns all sync events
+// AllSyncEvents returns all sync events, oldest first. The callback may delete
+// the event it is passed, so events are paged by ID rather than by offset.
 func (orm *ORM) AllSyncEvents(cb func(models.SyncEvent) error) error {
 	orm.MustEnsureAdvisoryLock()
-	return Batch(BatchSize, func(offset, limit uint) (uint, error) {
+	var lastID int64
+	for {
 		var events []models.SyncEvent
 		err := orm.DB.
-			Limit(limit).
-			Offset(offset).
-			Order("id, created_at asc").
+			Where("id > ?", lastID).
+			Limit(BatchSize).
+			Order("id asc").
 			Find(&events).Error
 		if err != nil {
-			return 0, err
+			return err
 		}
 
 		for _, event := range events {
-			err = cb(event)
-			if err != nil {
-				return 0, err
+			if err = cb(event); err != nil {
+				return err
 			}
+			lastID = event.ID
+		}
+
+		if uint(len(events)) < BatchSize {
+			return nil
 		}
+	}
+}
 
-		return uint(len(events)), err
+// CreateSyncEvent queues an event to be pushed to the explorer.
+func (orm *ORM) CreateSyncEvent(event *models.SyncEvent) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.DB.Create(event).Error
+}
+
+// CreateTelemetrySyncEvents queues telemetry logs to be pushed to the explorer
+// with a single insert. Unless limit is zero, only the latest limit telemetry
+// events are kept, so that an unreachable explorer cannot grow the queue
+// without bound.
+func (orm *ORM) CreateTelemetrySyncEvents(bodies []string, limit uint) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		err := dbtx.Exec(`
+			INSERT INTO sync_events (created_at, updated_at, body, telemetry)
+			SELECT now(), now(), body, true FROM unnest(?::text[]) AS body`, pq.Array(bodies)).Error
+		if err != nil {
+			return errors.Wrap(err, "error creating telemetry sync events")
+		} else if limit == 0 {
+			return nil
+		}
+		err = dbtx.Exec(`
+			DELETE FROM sync_events WHERE telemetry AND id < (
+				SELECT id FROM sync_events WHERE telemetry ORDER BY id DESC OFFSET ? LIMIT 1
+			)`, limit-1).Error
+		return errors.Wrap(err, "error discarding old telemetry sync events")
 	})
 }
 
@@ -383,7 +480,7 @@ func (orm *ORM) CreateExternalInitiator(externalInitiator *models.ExternalInitia
 // DeleteExternalInitiator removes an external initiator
 func (orm *ORM) DeleteExternalInitiator(name string) error {
 	orm.MustEnsureAdvisoryLock()
-	err := orm.DB.Delete(&models.ExternalInitiator{Name: name}).Error
+	err := orm.DB.Where("name = ?", name).Delete(&models.ExternalInitiator{}).Error
 	return err
 }
 
@@ -415,13 +512,13 @@ func (orm *ORM) FindServiceAgreement(id string) (models.ServiceAgreement, error)
 	return sa, orm.DB.Set("gorm:auto_preload", true).First(&sa, "id = ?", id).Error
 }
 
-// Jobs fetches all jobs.
+// Jobs fetches all jobs, other than those which are paused.
 func (orm *ORM) Jobs(cb func(*models.JobSpec) bool, initrTypes ...string) error {
 	orm.MustEnsureAdvisoryLock()
 	return Batch(BatchSize, func(offset, limit uint) (uint, error) {
 		scope := orm.DB.Limit(limit).Offset(offset)
 		if len(initrTypes) > 0 {
-			scope = scope.Where("initiators.type IN (?)", initrTypes)
+			scope = scope.Where("initiators.type IN (?) AND initiators.superseded_at IS NULL", initrTypes)
 			if dbutil.IsPostgres(orm.DB) {
 				scope = scope.Joins("JOIN initiators ON job_specs.id = initiators.job_spec_id::uuid")
 			} else {
@@ -445,7 +542,7 @@ func (orm *ORM) Jobs(cb func(*models.JobSpec) bool, initrTypes ...string) error
 		}
 		for _, j := range jobs {
 			temp := j
-			if temp.DeletedAt.Valid {
+			if temp.DeletedAt.Valid || temp.Paused() {
 				continue
 			}
 			if !cb(&temp) {
@@ -487,6 +584,71 @@ func (orm *ORM) JobRunsCountFor(jobSpecID *models.ID) (int, error) {
 	return count, err
 }
 
+// UnfinishedJobRunsCount returns the number of runs which are in progress or
+// pending, including those waiting on the concurrency limits of their jobs.
+func (orm *ORM) UnfinishedJobRunsCount() (int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := orm.DB.
+		Model(&models.JobRun{}).
+		Where("status NOT IN (?)", []models.RunStatus{
+			models.RunStatusCompleted,
+			models.RunStatusErrored,
+			models.RunStatusCancelled,
+		}).
+		Count(&count).Error
+	return count, err
+}
+
+// ErroredJobRunsWithNotifications returns the runs which errored after since
+// and no later than until, oldest first, of the jobs with failure
+// notifications.
+func (orm *ORM) ErroredJobRunsWithNotifications(since, until time.Time) ([]models.JobRun, error) {
+	orm.MustEnsureAdvisoryLock()
+	var runs []models.JobRun
+	err := orm.Unscoped().preloadJobRuns().
+		Where("job_runs.status = ? AND job_runs.finished_at > ? AND job_runs.finished_at <= ?", models.RunStatusErrored, since, until).
+		Where("job_runs.job_spec_id IN (SELECT id FROM job_specs WHERE failure_notifications IS NOT NULL)").
+		Order("job_runs.finished_at asc").
+		Find(&runs).Error
+	return runs, err
+}
+
+// ConsecutiveErroredJobRunsCount returns the number of runs of the job which
+// errored after its last completed run, counting those finished by until.
+func (orm *ORM) ConsecutiveErroredJobRunsCount(jobSpecID *models.ID, until time.Time) (int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := orm.DB.
+		Model(&models.JobRun{}).
+		Unscoped().
+		Where("job_spec_id = ? AND status = ? AND finished_at <= ?", jobSpecID, models.RunStatusErrored, until).
+		Where(`finished_at > COALESCE((
+			SELECT MAX(finished_at) FROM job_runs
+			WHERE job_spec_id = ? AND status = ? AND finished_at <= ?
+		), '-infinity')`, jobSpecID, models.RunStatusCompleted, until).
+		Count(&count).Error
+	return count, err
+}
+
+// JobRunsFinishedSince returns the number of runs which have completed or
+// errored since the given time, and how many of those errored.
+func (orm *ORM) JobRunsFinishedSince(since time.Time) (finished int, errored int, err error) {
+	orm.MustEnsureAdvisoryLock()
+	var counts struct {
+		Finished int
+		Errored  int
+	}
+	err = orm.DB.Raw(`
+		SELECT count(*) AS finished, count(*) FILTER (WHERE status = ?) AS errored
+		FROM job_runs
+		WHERE finished_at >= ? AND status IN (?) AND deleted_at IS NULL`,
+		models.RunStatusErrored, since,
+		[]string{string(models.RunStatusCompleted), string(models.RunStatusErrored)},
+	).Scan(&counts).Error
+	return counts.Finished, counts.Errored, err
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (orm *ORM) Sessions(offset, limit int) ([]models.Session, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -531,13 +693,166 @@ func (orm *ORM) CreateJob(job *models.JobSpec) error {
 	})
 }
 
+// CreateJobBundle creates the bridges and external initiators of an imported
+// job bundle along with its job, in one transaction, so that none of them
+// are created unless all are.
+func (orm *ORM) CreateJobBundle(job *models.JobSpec, bridges []models.BridgeType, externalInitiators []models.ExternalInitiator) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		for i := range bridges {
+			if err := dbtx.Create(&bridges[i]).Error; err != nil {
+				return errors.Wrapf(err, "creating bridge %s", bridges[i].Name)
+			}
+		}
+		for i := range externalInitiators {
+			if err := dbtx.Create(&externalInitiators[i]).Error; err != nil {
+				return errors.Wrapf(err, "creating external initiator %s", externalInitiators[i].Name)
+			}
+		}
+		return orm.createJob(dbtx, job)
+	})
+}
+
 func (orm *ORM) createJob(tx *gorm.DB, job *models.JobSpec) error {
 	orm.MustEnsureAdvisoryLock()
 	for i := range job.Initiators {
 		job.Initiators[i].JobSpecID = job.ID
 	}
 
-	return tx.Create(job).Error
+	if err := tx.Create(job).Error; err != nil {
+		return err
+	}
+	return saveJobSpecVersion(tx, *job)
+}
+
+// UpdateJob saves the job as the next version of the existing job with its
+// ID, replacing its initiators and tasks. Those replaced are marked as
+// superseded rather than deleted, as the runs of earlier versions refer to
+// them.
+func (orm *ORM) UpdateJob(job *models.JobSpec) error {
+	orm.MustEnsureAdvisoryLock()
+	current, err := orm.FindJob(job.ID)
+	if err != nil {
+		return err
+	}
+
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		// Jobs created before versions were kept have no snapshot of their
+		// first version yet
+		if err := saveJobSpecVersion(dbtx, current); err != nil {
+			return err
+		}
+
+		job.Version = current.Version + 1
+		job.CreatedAt = current.CreatedAt
+		job.PausedAt = current.PausedAt
+		for i := range job.Initiators {
+			job.Initiators[i].ID = 0
+			job.Initiators[i].JobSpecID = job.ID
+		}
+		for i := range job.Tasks {
+			job.Tasks[i].ID = 0
+			job.Tasks[i].JobSpecID = job.ID
+		}
+
+		err := multierr.Combine(
+			dbtx.Exec("UPDATE initiators SET deleted_at = NOW(), superseded_at = NOW() WHERE job_spec_id = ? AND superseded_at IS NULL", job.ID).Error,
+			dbtx.Exec("UPDATE task_specs SET deleted_at = NOW(), superseded_at = NOW() WHERE job_spec_id = ? AND superseded_at IS NULL", job.ID).Error,
+		)
+		if err != nil {
+			return err
+		}
+		if err := dbtx.Save(job).Error; err != nil {
+			return err
+		}
+		return saveJobSpecVersion(dbtx, *job)
+	})
+}
+
+// UpdateFluxMonitorInitiators saves the feeds and deviation thresholds of the
+// fluxmonitor initiators of a job in place, as a new version of the job.
+// Unlike UpdateJob, the initiators keep their IDs.
+func (orm *ORM) UpdateFluxMonitorInitiators(job *models.JobSpec) error {
+	orm.MustEnsureAdvisoryLock()
+	current, err := orm.FindJob(job.ID)
+	if err != nil {
+		return err
+	}
+
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		if err := saveJobSpecVersion(dbtx, current); err != nil {
+			return err
+		}
+
+		for _, initr := range job.InitiatorsFor(models.InitiatorFluxMonitor) {
+			err := dbtx.Exec(`
+				UPDATE initiators SET feeds = ?, threshold = ?, absolute_threshold = ?, updated_at = NOW()
+				WHERE id = ? AND job_spec_id = ?
+			`, initr.Feeds, initr.Threshold, initr.AbsoluteThreshold, initr.ID, job.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		job.Version = current.Version + 1
+		if err := dbtx.Exec("UPDATE job_specs SET version = ? WHERE id = ?", job.Version, job.ID).Error; err != nil {
+			return err
+		}
+		return saveJobSpecVersion(dbtx, *job)
+	})
+}
+
+// saveJobSpecVersion keeps a snapshot of the job at its current version,
+// unless one has already been kept.
+func saveJobSpecVersion(tx *gorm.DB, job models.JobSpec) error {
+	version, err := models.NewJobSpecVersion(job)
+	if err != nil {
+		return err
+	}
+	return tx.Exec(`
+		INSERT INTO job_spec_versions (job_spec_id, version, spec, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (job_spec_id, version) DO NOTHING
+	`, version.JobSpecID, version.Version, version.Spec, version.CreatedAt).Error
+}
+
+// JobSpecVersions returns the versions kept of a job, oldest first.
+func (orm *ORM) JobSpecVersions(jobID *models.ID) ([]models.JobSpecVersion, error) {
+	orm.MustEnsureAdvisoryLock()
+	var versions []models.JobSpecVersion
+	err := orm.DB.
+		Where("job_spec_id = ?", jobID).
+		Order("version asc").
+		Find(&versions).Error
+	return versions, err
+}
+
+// FindJobSpecVersion returns one of the versions kept of a job.
+func (orm *ORM) FindJobSpecVersion(jobID *models.ID, version int32) (models.JobSpecVersion, error) {
+	orm.MustEnsureAdvisoryLock()
+	var v models.JobSpecVersion
+	err := orm.DB.
+		Where("job_spec_id = ? AND version = ?", jobID, version).
+		First(&v).Error
+	return v, err
+}
+
+// PauseJob marks the job as paused, if it is not already.
+func (orm *ORM) PauseJob(ID *models.ID) error {
+	orm.MustEnsureAdvisoryLock()
+	if _, err := orm.FindJob(ID); err != nil {
+		return err
+	}
+	return orm.DB.Exec("UPDATE job_specs SET paused_at = NOW() WHERE id = ? AND paused_at IS NULL", ID).Error
+}
+
+// ResumeJob clears the job's paused mark.
+func (orm *ORM) ResumeJob(ID *models.ID) error {
+	orm.MustEnsureAdvisoryLock()
+	if _, err := orm.FindJob(ID); err != nil {
+		return err
+	}
+	return orm.DB.Exec("UPDATE job_specs SET paused_at = NULL WHERE id = ?", ID).Error
 }
 
 // ArchiveJob soft deletes the job, job_runs and its initiator.
@@ -586,6 +901,79 @@ func (orm *ORM) UnscopedJobRunsWithStatus(cb func(*models.JobRun), statuses ...m
 		return errors.Wrap(err, "finding job ids")
 	}
 
+	return orm.unscopedJobRunsByIDs(runIDs, cb)
+}
+
+// UnscopedJobRunsPastDeadline passes all unfinished JobRuns whose deadline
+// is before the given time to a callback, one by one, including those that
+// were soft deleted.
+func (orm *ORM) UnscopedJobRunsPastDeadline(cb func(*models.JobRun), now time.Time) error {
+	orm.MustEnsureAdvisoryLock()
+	var runIDs []string
+	err := orm.DB.Unscoped().
+		Table("job_runs").
+		Where("deadline <= ?", now).
+		Where("status NOT IN (?)", []models.RunStatus{
+			models.RunStatusCompleted,
+			models.RunStatusErrored,
+			models.RunStatusCancelled,
+		}).
+		Order("created_at asc").
+		Pluck("ID", &runIDs).Error
+	if err != nil {
+		return errors.Wrap(err, "finding job ids")
+	}
+
+	return orm.unscopedJobRunsByIDs(runIDs, cb)
+}
+
+// DequeueJobRuns moves the runs waiting in the pending_concurrency status back
+// in progress, oldest first, as far as the limits of their jobs on unfinished
+// runs now allow, and returns them. Given a job spec ID, only the runs of
+// that job are dequeued.
+func (orm *ORM) DequeueJobRuns(jobSpecID *models.ID) ([]models.JobRun, error) {
+	orm.MustEnsureAdvisoryLock()
+	unfinished := []models.RunStatus{
+		models.RunStatusInProgress,
+		models.RunStatusPendingIncomingConfirmations,
+		models.RunStatusPendingOutgoingConfirmations,
+		models.RunStatusPendingConnection,
+		models.RunStatusPendingBridge,
+		models.RunStatusPendingSleep,
+	}
+	args := []interface{}{unfinished, models.RunStatusPendingConcurrency}
+	filter := ""
+	if jobSpecID != nil {
+		filter = "AND job_runs.job_spec_id = ?"
+		args = append(args, jobSpecID)
+	}
+	args = append(args, models.RunStatusInProgress)
+
+	query := fmt.Sprintf(`
+		WITH active AS (
+			SELECT job_spec_id, COUNT(*) AS count
+			FROM job_runs
+			WHERE status IN (?)
+			GROUP BY job_spec_id
+		), queued AS (
+			SELECT job_runs.id, job_specs.max_concurrent_runs AS max, COALESCE(active.count, 0) AS active,
+				ROW_NUMBER() OVER (PARTITION BY job_runs.job_spec_id ORDER BY job_runs.created_at, job_runs.id) AS position
+			FROM job_runs
+			JOIN job_specs ON job_specs.id = job_runs.job_spec_id
+			LEFT JOIN active ON active.job_spec_id = job_runs.job_spec_id
+			WHERE job_runs.status = ? %s
+		)
+		UPDATE job_runs SET status = ?
+		FROM queued
+		WHERE job_runs.id = queued.id AND (queued.max = 0 OR queued.position <= queued.max - queued.active)
+		RETURNING job_runs.id, job_runs.job_spec_id, job_runs.priority, job_runs.status`, filter)
+
+	var runs []models.JobRun
+	err := orm.DB.Raw(query, args...).Scan(&runs).Error
+	return runs, errors.Wrap(err, "error dequeueing JobRuns")
+}
+
+func (orm *ORM) unscopedJobRunsByIDs(runIDs []string, cb func(*models.JobRun)) error {
 	return Batch(BatchSize, func(offset, limit uint) (uint, error) {
 		batchIDs := runIDs[offset:utils.MinUint(limit, uint(len(runIDs)))]
 		var runs []models.JobRun
@@ -616,9 +1004,12 @@ func (orm *ORM) AnyJobWithType(taskTypeName string) (bool, error) {
 	return found, ignoreRecordNotFound(rval)
 }
 
-// IdempotentInsertEthTaskRunTx creates both eth_task_run_transaction and eth_tx in one hit
+// IdempotentInsertEthTaskRunTx creates both eth_task_run_transaction and eth_tx in one hit.
+// A nil gasPrice means the transaction is sent at ETH_GAS_PRICE_DEFAULT, and a nil
+// maxGasPrice means it may be bumped up to ETH_MAX_GAS_PRICE_WEI. Unstarted
+// transactions with a higher priority are broadcast first.
 // It can be called multiple times without error as long as the outcome would have resulted in the same database state
-func (orm *ORM) IdempotentInsertEthTaskRunTx(taskRunID models.ID, fromAddress common.Address, toAddress common.Address, encodedPayload []byte, gasLimit uint64) error {
+func (orm *ORM) IdempotentInsertEthTaskRunTx(taskRunID models.ID, fromAddress common.Address, toAddress common.Address, encodedPayload []byte, gasLimit uint64, gasPrice, maxGasPrice *big.Int, priority int32) error {
 	etx := models.EthTx{
 		FromAddress:    fromAddress,
 		ToAddress:      toAddress,
@@ -626,6 +1017,13 @@ func (orm *ORM) IdempotentInsertEthTaskRunTx(taskRunID models.ID, fromAddress co
 		Value:          assets.NewEthValue(0),
 		GasLimit:       gasLimit,
 		State:          models.EthTxUnstarted,
+		Priority:       priority,
+	}
+	if gasPrice != nil {
+		etx.GasPrice = utils.NewBig(gasPrice)
+	}
+	if maxGasPrice != nil {
+		etx.MaxGasPrice = utils.NewBig(maxGasPrice)
 	}
 	ethTaskRunTransaction := models.EthTaskRunTx{
 		TaskRunID: taskRunID.UUID(),
@@ -695,6 +1093,29 @@ func (orm *ORM) EthTransactionsWithAttempts(offset, limit int) ([]models.EthTx,
 	return txs, count, err
 }
 
+// CountEthTxesUnconfirmedSince returns the number of eth transactions which
+// were first broadcast before the given time and have not yet been confirmed.
+func (orm *ORM) CountEthTxesUnconfirmedSince(broadcastBefore time.Time) (int, error) {
+	var count int
+	err := orm.DB.
+		Model(&models.EthTx{}).
+		Where("state = ? AND broadcast_at < ?", models.EthTxUnconfirmed, broadcastBefore).
+		Count(&count).Error
+	return count, err
+}
+
+// CountPendingEthTxesBetween returns the number of eth_txes from one address
+// to another which have not yet been confirmed or errored.
+func (orm *ORM) CountPendingEthTxesBetween(from, to common.Address) (int, error) {
+	var count int
+	err := orm.DB.
+		Model(&models.EthTx{}).
+		Where("from_address = ? AND to_address = ? AND state IN (?)", from, to,
+			[]models.EthTxState{models.EthTxUnstarted, models.EthTxInProgress, models.EthTxUnconfirmed}).
+		Count(&count).Error
+	return count, err
+}
+
 // FindEthTaskRunTxByTaskRunID finds the EthTaskRunTx with its EthTxes and EthTxAttempts preloaded
 func (orm *ORM) FindEthTaskRunTxByTaskRunID(taskRunID uuid.UUID) (*models.EthTaskRunTx, error) {
 	etrt := &models.EthTaskRunTx{}
@@ -705,6 +1126,14 @@ func (orm *ORM) FindEthTaskRunTxByTaskRunID(taskRunID uuid.UUID) (*models.EthTas
 	return etrt, err
 }
 
+// FindEthTxByIdempotencyKey finds the EthTx created by a request made with the
+// given idempotency key.
+func (orm *ORM) FindEthTxByIdempotencyKey(key string) (models.EthTx, error) {
+	etx := models.EthTx{}
+	err := orm.DB.First(&etx, "idempotency_key = ?", key).Error
+	return etx, err
+}
+
 // FindEthTxWithAttempts finds the EthTx with its attempts and receipts preloaded
 func (orm *ORM) FindEthTxWithAttempts(etxID int64) (models.EthTx, error) {
 	etx := models.EthTx{}
@@ -712,6 +1141,64 @@ func (orm *ORM) FindEthTxWithAttempts(etxID int64) (models.EthTx, error) {
 	return etx, err
 }
 
+// AllEthTxesWithAttempts calls cb with every eth_tx, oldest first, along with
+// its attempts and their receipts, and the ID of the job run whose ethtx task
+// created it if there is one. The eth_txes are loaded in batches, so that the
+// whole table is never held in memory.
+func (orm *ORM) AllEthTxesWithAttempts(cb func(etx models.EthTx, jobRunID *models.ID) error) error {
+	lastID := int64(0)
+	for {
+		var txs []models.EthTx
+		err := orm.DB.
+			Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
+				return db.Order("created_at asc")
+			}).
+			Preload("EthTxAttempts.EthReceipts").
+			Where("id > ?", lastID).
+			Order("id asc").
+			Limit(BatchSize).
+			Find(&txs).Error
+		if err != nil {
+			return errors.Wrap(err, "error fetching eth_tx batch")
+		}
+		if len(txs) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(txs))
+		for i, etx := range txs {
+			ids[i] = etx.ID
+		}
+		var links []struct {
+			EthTxID  int64
+			JobRunID *models.ID
+		}
+		err = orm.DB.Raw(`
+			SELECT eth_task_run_txes.eth_tx_id, task_runs.job_run_id
+			FROM eth_task_run_txes
+			JOIN task_runs ON task_runs.id = eth_task_run_txes.task_run_id
+			WHERE eth_task_run_txes.eth_tx_id IN (?)`, ids).
+			Scan(&links).Error
+		if err != nil {
+			return errors.Wrap(err, "error fetching job runs of eth_tx batch")
+		}
+		jobRunIDs := make(map[int64]*models.ID, len(links))
+		for _, link := range links {
+			jobRunIDs[link.EthTxID] = link.JobRunID
+		}
+
+		for _, etx := range txs {
+			if err := cb(etx, jobRunIDs[etx.ID]); err != nil {
+				return err
+			}
+		}
+		if uint(len(txs)) < BatchSize {
+			return nil
+		}
+		lastID = txs[len(txs)-1].ID
+	}
+}
+
 // EthTxAttempts returns the last tx attempts sorted by created_at descending.
 func (orm *ORM) EthTxAttempts(offset, limit int) ([]models.EthTxAttempt, int, error) {
 	count, err := orm.CountOf(&models.EthTxAttempt{})
@@ -902,6 +1389,12 @@ func (orm *ORM) MarkRan(i models.Initiator, ran bool) error {
 	})
 }
 
+// MarkRanAt records the time a recurring runat initiator last ran at.
+func (orm *ORM) MarkRanAt(i models.Initiator, lastRanAt time.Time) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.DB.Model(&i).UpdateColumn("last_ran_at", lastRanAt).Error
+}
+
 // FindUser will return the one API user, or an error.
 func (orm *ORM) FindUser() (models.User, error) {
 	orm.MustEnsureAdvisoryLock()
@@ -1171,6 +1664,45 @@ func (orm *ORM) JobRunsSortedFor(id *models.ID, order SortType, offset int, limi
 	return runs, count, err
 }
 
+// DeadLetterJobRuns returns the runs in the dead-letter queue, most recently
+// failed first.
+func (orm *ORM) DeadLetterJobRuns(offset int, limit int) ([]models.JobRun, int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := orm.DB.Model(&models.JobRun{}).Where("dead_lettered_at IS NOT NULL").Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var runs []models.JobRun
+	err = orm.preloadJobRuns().
+		Where("dead_lettered_at IS NOT NULL").
+		Order("dead_lettered_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&runs).Error
+	return runs, count, err
+}
+
+// DiscardDeadLetterJobRuns removes the given runs from the dead-letter queue,
+// deleting them along with their related records as BulkDeleteRuns does. Runs
+// which are not dead lettered are left untouched.
+func (orm *ORM) DiscardDeadLetterJobRuns(runIDs []*models.ID) error {
+	orm.MustEnsureAdvisoryLock()
+	return orm.convenientTransaction(func(dbtx *gorm.DB) error {
+		err := dbtx.Exec(`
+			WITH deleted_job_runs AS (
+				DELETE FROM job_runs WHERE id IN (?) AND dead_lettered_at IS NOT NULL RETURNING result_id, run_request_id
+			),
+			deleted_run_results AS (
+				DELETE FROM run_results WHERE id IN (SELECT result_id FROM deleted_job_runs)
+			)
+			DELETE FROM run_requests WHERE id IN (SELECT run_request_id FROM deleted_job_runs)`,
+			runIDs).Error
+		return errors.Wrap(err, "error discarding dead lettered JobRuns")
+	})
+}
+
 // BridgeTypes returns bridge types ordered by name filtered limited by the
 // passed params.
 func (orm *ORM) BridgeTypes(offset int, limit int) ([]models.BridgeType, int, error) {
@@ -1215,6 +1747,13 @@ func (orm *ORM) UpdateBridgeType(bt *models.BridgeType, btr *models.BridgeTypeRe
 	bt.URL = btr.URL
 	bt.Confirmations = btr.Confirmations
 	bt.MinimumContractPayment = btr.MinimumContractPayment
+	bt.ClientCertificate = btr.ClientCertificate
+	bt.Proxy = btr.Proxy
+	bt.ResponseSchema = btr.ResponseSchema
+	bt.Timeout = btr.Timeout
+	bt.Retries = btr.Retries
+	bt.CircuitBreakerThreshold = btr.CircuitBreakerThreshold
+	bt.CircuitBreakerCooldown = btr.CircuitBreakerCooldown
 	return orm.DB.Save(bt).Error
 }
 
@@ -1234,6 +1773,7 @@ func (orm *ORM) CreateInitiator(initr *models.Initiator) error {
 // IdempotentInsertHead inserts a head only if the hash is new. Will do nothing if hash exists already.
 // No advisory lock required because this is thread safe.
 func (orm *ORM) IdempotentInsertHead(h models.Head) error {
+	h.EVMChainID = orm.chainID
 	err := orm.DB.Set("gorm:insert_option", "ON CONFLICT (hash) DO NOTHING").Create(&h).Error
 	if err != nil && err.Error() == "sql: no rows in result set" {
 		return nil
@@ -1245,10 +1785,11 @@ func (orm *ORM) IdempotentInsertHead(h models.Head) error {
 func (orm *ORM) TrimOldHeads(n uint) (err error) {
 	return orm.DB.Exec(`
 	DELETE FROM heads
-	WHERE number < (
+	WHERE `+orm.ChainCondition("heads")+` AND number < (
 		SELECT min(number) FROM (
 			SELECT number
 			FROM heads
+			WHERE `+orm.ChainCondition("heads")+`
 			ORDER BY number DESC
 			LIMIT ?
 		) numbers
@@ -1260,10 +1801,11 @@ func (orm *ORM) TrimOldHeads(n uint) (err error) {
 func (orm *ORM) Chain(hash common.Hash, lookback uint) (models.Head, error) {
 	rows, err := orm.DB.Raw(`
 	WITH RECURSIVE chain AS (
-		SELECT * FROM heads WHERE hash = ?
+		SELECT * FROM heads WHERE hash = ? AND `+orm.ChainCondition("heads")+`
 	UNION
 		SELECT h.* FROM heads h
 		JOIN chain ON chain.parent_hash = h.hash
+		WHERE `+orm.ChainCondition("h")+`
 	) SELECT id, hash, number, parent_hash, timestamp, created_at FROM chain LIMIT ?
 	`, hash, lookback).Rows()
 	if err != nil {
@@ -1293,7 +1835,7 @@ func (orm *ORM) Chain(hash common.Hash, lookback uint) (models.Head, error) {
 // HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 func (orm *ORM) HeadByHash(hash common.Hash) (*models.Head, error) {
 	head := &models.Head{}
-	err := orm.DB.Where("hash = ?", hash).First(head).Error
+	err := orm.DB.Where("hash = ?", hash).Where(orm.ChainCondition("heads")).First(head).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
@@ -1304,7 +1846,7 @@ func (orm *ORM) HeadByHash(hash common.Hash) (*models.Head, error) {
 // due to re-org) it returns the most recently seen head entry.
 func (orm *ORM) LastHead() (*models.Head, error) {
 	number := &models.Head{}
-	err := orm.DB.Order("number DESC, created_at DESC, id DESC").First(number).Error
+	err := orm.DB.Where(orm.ChainCondition("heads")).Order("number DESC, created_at DESC, id DESC").First(number).Error
 	if err == gorm.ErrRecordNotFound {
 		return nil, nil
 	}
@@ -1355,6 +1897,65 @@ func (orm *ORM) BulkDeleteRuns(bulkQuery *models.BulkDeleteRunRequest) error {
 	})
 }
 
+// JobRunRetentions returns the ID and run retention of every job which has not
+// been archived.
+func (orm *ORM) JobRunRetentions() ([]models.JobSpec, error) {
+	orm.MustEnsureAdvisoryLock()
+	var jobs []models.JobSpec
+	return jobs, orm.DB.Select("id, run_retention").Find(&jobs).Error
+}
+
+// PruneJobRuns deletes the finished runs of a job which finished before
+// finishedBefore, if it is set, or are not among the keep most recently
+// finished, if keep is nonzero. Runs in the dead-letter queue are left for
+// the operator. With archive set the runs are archived rather than deleted.
+// It returns the number of runs pruned.
+func (orm *ORM) PruneJobRuns(jobSpecID *models.ID, finishedBefore time.Time, keep uint32, archive bool) (int64, error) {
+	orm.MustEnsureAdvisoryLock()
+	if finishedBefore.IsZero() && keep == 0 {
+		return 0, nil
+	}
+
+	pruned := `
+		WITH pruned AS (
+			SELECT id FROM (
+				SELECT id, finished_at, ROW_NUMBER() OVER (ORDER BY finished_at DESC, created_at DESC) AS position
+				FROM job_runs
+				WHERE job_spec_id = ? AND status IN (?) AND dead_lettered_at IS NULL AND deleted_at IS NULL
+			) finished
+			WHERE (? AND finished_at < ?) OR (? > 0 AND position > ?)
+		)`
+	var query string
+	if archive {
+		query = pruned + `,
+		archived_job_runs AS (
+			UPDATE job_runs SET deleted_at = NOW() WHERE id IN (SELECT id FROM pruned) RETURNING id
+		)
+		SELECT COUNT(*) FROM archived_job_runs`
+	} else {
+		query = pruned + `,
+		deleted_job_runs AS (
+			DELETE FROM job_runs WHERE id IN (SELECT id FROM pruned) RETURNING result_id, run_request_id
+		),
+		deleted_run_results AS (
+			DELETE FROM run_results WHERE id IN (SELECT result_id FROM deleted_job_runs)
+		),
+		deleted_run_requests AS (
+			DELETE FROM run_requests WHERE id IN (SELECT run_request_id FROM deleted_job_runs)
+		)
+		SELECT COUNT(*) FROM deleted_job_runs`
+	}
+
+	finished := models.RunStatusCollection{models.RunStatusCompleted, models.RunStatusErrored, models.RunStatusCancelled}
+	var count int64
+	err := orm.DB.Raw(query,
+		jobSpecID, finished.ToStrings(),
+		!finishedBefore.IsZero(), finishedBefore,
+		keep, keep,
+	).Row().Scan(&count)
+	return count, errors.Wrap(err, "error pruning JobRuns")
+}
+
 // AllKeys returns all of the keys recorded in the database including the funding key.
 // This method is deprecated! You should use SendKeys() to retrieve all but the funding keys.
 func (orm *ORM) AllKeys() ([]models.Key, error) {
@@ -1362,10 +1963,10 @@ func (orm *ORM) AllKeys() ([]models.Key, error) {
 	return keys, orm.DB.Order("created_at ASC, address ASC").Find(&keys).Error
 }
 
-// SendKeys will return only the keys that are not is_funding=true.
+// SendKeys will return only the keys that are not is_funding=true, of the ORM's chain.
 func (orm *ORM) SendKeys() ([]models.Key, error) {
 	var keys []models.Key
-	err := orm.DB.Where("is_funding != TRUE").Order("created_at ASC, address ASC").Find(&keys).Error
+	err := orm.DB.Where("is_funding != TRUE").Where(orm.ChainCondition("keys")).Order("created_at ASC, address ASC").Find(&keys).Error
 	return keys, err
 }
 
@@ -1442,7 +2043,7 @@ func (orm *ORM) FindEncryptedSecretVRFKeys(where ...vrfkey.EncryptedVRFKey) (
 func (orm *ORM) GetRoundRobinAddress(addresses ...common.Address) (address common.Address, err error) {
 	err = orm.Transaction(func(tx *gorm.DB) error {
 		q := tx.Set("gorm:query_option", "FOR UPDATE").Order("last_used ASC NULLS FIRST, id ASC")
-		q = q.Where("is_funding = FALSE")
+		q = q.Where("is_funding = FALSE").Where(orm.ChainCondition("keys"))
 		if len(addresses) > 0 {
 			q = q.Where("address in (?)", addresses)
 		}
@@ -1464,6 +2065,34 @@ func (orm *ORM) GetRoundRobinAddress(addresses ...common.Address) (address commo
 	return address, nil
 }
 
+// GetLeastPendingAddress returns the address of the ethereum key with the
+// fewest transactions waiting to be confirmed, picking the least recently
+// used key in case of a tie.
+func (orm *ORM) GetLeastPendingAddress(addresses ...common.Address) (address common.Address, err error) {
+	err = orm.Transaction(func(tx *gorm.DB) error {
+		q := tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("is_funding = FALSE").
+			Where(orm.ChainCondition("keys")).
+			Order(`(
+				SELECT count(*) FROM eth_txes
+				WHERE eth_txes.from_address = keys.address AND eth_txes.state IN ('unstarted', 'in_progress', 'unconfirmed')
+			) ASC, last_used ASC NULLS FIRST, id ASC`)
+		if len(addresses) > 0 {
+			q = q.Where("address in (?)", addresses)
+		}
+		keys := make([]models.Key, 0)
+		if err := q.Find(&keys).Error; err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return errors.New("no keys available")
+		}
+		address = keys[0].Address.Address()
+		return tx.Model(&keys[0]).Update("last_used", time.Now()).Error
+	})
+	return address, err
+}
+
 // HasConsumedLog reports whether the given consumer had already consumed the given log
 func (orm *ORM) HasConsumedLog(blockHash common.Hash, logIndex uint, jobID *models.ID) (bool, error) {
 	query := "SELECT exists (" +
@@ -1564,8 +2193,47 @@ func (orm *ORM) UpdateFluxMonitorRoundStats(aggregator common.Address, roundID u
     `, aggregator, roundID, jobRunID).Error
 }
 
+// CreateFluxMonitorSimulatedSubmission records the answer a simulated
+// fluxmonitor initiator would have submitted. Only the first answer for each
+// round is kept, as the initiator could only have submitted once.
+func (orm *ORM) CreateFluxMonitorSimulatedSubmission(submission *models.FluxMonitorSimulatedSubmission) error {
+	orm.MustEnsureAdvisoryLock()
+	if submission.CreatedAt.IsZero() {
+		submission.CreatedAt = time.Now()
+	}
+	return orm.DB.Exec(`
+		INSERT INTO flux_monitor_simulated_submissions (
+			job_spec_id, initiator_id, aggregator, round_id, answer, latest_answer, rejected_feeds, created_at
+		) VALUES (
+			?, ?, ?, ?, ?, ?, ?, ?
+		) ON CONFLICT (initiator_id, round_id) DO NOTHING
+	`, submission.JobSpecID, submission.InitiatorID, submission.Aggregator, submission.RoundID,
+		submission.Answer, submission.LatestAnswer, submission.RejectedFeeds, submission.CreatedAt).Error
+}
+
+// FluxMonitorSimulatedSubmissions returns the answers the simulated
+// fluxmonitor initiators of a job would have submitted, latest first.
+func (orm *ORM) FluxMonitorSimulatedSubmissions(jobID *models.ID, offset, limit int) ([]models.FluxMonitorSimulatedSubmission, int, error) {
+	orm.MustEnsureAdvisoryLock()
+	var count int
+	err := orm.DB.Model(&models.FluxMonitorSimulatedSubmission{}).Where("job_spec_id = ?", jobID).Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var submissions []models.FluxMonitorSimulatedSubmission
+	err = orm.DB.
+		Where("job_spec_id = ?", jobID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&submissions).Error
+	return submissions, count, err
+}
+
 // ClobberDiskKeyStoreWithDBKeys writes all keys stored in the orm to
-// the keys folder on disk, deleting anything there prior.
+// the keys folder on disk, deleting anything there prior. Keys held by a
+// remote signer are not written.
 func (orm *ORM) ClobberDiskKeyStoreWithDBKeys(keysDir string) error {
 	if err := os.RemoveAll(keysDir); err != nil {
 		return err
@@ -1582,6 +2250,9 @@ func (orm *ORM) ClobberDiskKeyStoreWithDBKeys(keysDir string) error {
 
 	var merr error
 	for _, k := range keys {
+		if k.IsRemote() {
+			continue
+		}
 		merr = multierr.Append(
 			k.WriteToDisk(filepath.Join(keysDir, keyFileName(k.Address, k.CreatedAt))),
 			merr)
@@ -54,6 +54,101 @@ func TestORM_CreateJob(t *testing.T) {
 	assert.Equal(t, j2.ID, j2.Initiators[0].JobSpecID)
 }
 
+func TestORM_UpdateJob(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+	run := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&run))
+
+	update := cltest.NewJobWithSchedule("* * * * *")
+	update.ID = job.ID
+	update.Name = job.Name
+	update.Tasks = []models.TaskSpec{{Type: adapters.TaskTypeNoOp}, {Type: adapters.TaskTypeNoOp}}
+	require.NoError(t, store.UpdateJob(&update))
+
+	updated, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), updated.Version)
+	require.Len(t, updated.Initiators, 1)
+	assert.Equal(t, models.InitiatorCron, updated.Initiators[0].Type)
+	assert.Len(t, updated.Tasks, 2)
+
+	runs, err := store.JobRunsFor(job.ID)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, models.InitiatorWeb, runs[0].Initiator.Type)
+
+	versions, err := store.JobSpecVersions(job.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	first, err := versions[0].JobSpecRequest()
+	require.NoError(t, err)
+	require.Len(t, first.Initiators, 1)
+	assert.Equal(t, models.InitiatorWeb, first.Initiators[0].Type)
+	assert.Len(t, first.Tasks, 1)
+	assert.Equal(t, int32(2), versions[1].Version)
+}
+
+func TestORM_UpdateFluxMonitorInitiators(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	job.Initiators[0].Feeds = cltest.JSONFromString(t, `["https://lambda.staging.devnet.tools/bnc/call"]`)
+	job.Initiators[0].Threshold = 0.25
+	job.Initiators[0].AbsoluteThreshold = 0.5
+	require.NoError(t, store.UpdateFluxMonitorInitiators(&job))
+	assert.Equal(t, int32(2), job.Version)
+
+	updated, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), updated.Version)
+	require.Len(t, updated.Initiators, 1)
+	assert.Equal(t, job.Initiators[0].ID, updated.Initiators[0].ID)
+	assert.Equal(t, float32(0.25), updated.Initiators[0].Threshold)
+	assert.Equal(t, float32(0.5), updated.Initiators[0].AbsoluteThreshold)
+	assert.JSONEq(t, `["https://lambda.staging.devnet.tools/bnc/call"]`, updated.Initiators[0].Feeds.String())
+
+	versions, err := store.JobSpecVersions(job.ID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 2)
+}
+
+func TestORM_PauseJob(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithSchedule("* * * * *")
+	require.NoError(t, store.CreateJob(&job))
+
+	require.NoError(t, store.PauseJob(job.ID))
+	paused, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.True(t, paused.Paused())
+
+	count := 0
+	require.NoError(t, store.Jobs(func(*models.JobSpec) bool {
+		count++
+		return true
+	}, models.InitiatorCron))
+	assert.Equal(t, 0, count)
+
+	require.NoError(t, store.ResumeJob(job.ID))
+	resumed, err := store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.False(t, resumed.Paused())
+
+	assert.Equal(t, orm.ErrorNotFound, store.PauseJob(models.NewID()))
+}
+
 func TestORM_Unscoped(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -315,6 +410,54 @@ func TestORM_LinkEarnedFor(t *testing.T) {
 	assert.Equal(t, assets.NewLink(10), totalEarned)
 }
 
+func TestORM_PruneJobRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	now := time.Now()
+	var runs []models.JobRun
+	for i := 4; i > 0; i-- {
+		run := cltest.NewJobRun(job)
+		run.TaskRuns[0].Status = models.RunStatusCompleted
+		run.SetStatus(models.RunStatusCompleted)
+		run.FinishedAt = null.TimeFrom(now.Add(-time.Duration(i) * time.Hour))
+		require.NoError(t, store.CreateJobRun(&run))
+		runs = append(runs, run)
+	}
+	inProgress := cltest.NewJobRun(job)
+	require.NoError(t, store.CreateJobRun(&inProgress))
+
+	count, err := store.PruneJobRuns(job.ID, time.Time{}, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = store.PruneJobRuns(job.ID, time.Time{}, 2, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	_, err = store.FindJobRun(runs[0].ID)
+	assert.Error(t, err)
+	archived, err := store.Unscoped().FindJobRun(runs[0].ID)
+	require.NoError(t, err)
+	assert.True(t, archived.DeletedAt.Valid)
+
+	count, err = store.PruneJobRuns(job.ID, now.Add(-90*time.Minute), 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	_, err = store.Unscoped().FindJobRun(runs[2].ID)
+	assert.Equal(t, orm.ErrorNotFound, err)
+	_, err = store.FindJobRun(runs[3].ID)
+	assert.NoError(t, err)
+	_, err = store.FindJobRun(inProgress.ID)
+	assert.NoError(t, err)
+}
+
 func TestORM_JobRunsSortedFor(t *testing.T) {
 	t.Parallel()
 
@@ -345,6 +488,41 @@ func TestORM_JobRunsSortedFor(t *testing.T) {
 	assert.Equal(t, []*models.ID{jr2.ID, jr1.ID}, actual)
 }
 
+func TestORM_DeadLetterJobRuns(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	older := cltest.NewJobRun(job)
+	older.SetError(fmt.Errorf("older"))
+	older.DeadLetter(time.Now().Add(-time.Hour))
+	require.NoError(t, store.CreateJobRun(&older))
+	newer := cltest.NewJobRun(job)
+	newer.SetError(fmt.Errorf("newer"))
+	newer.DeadLetter(time.Now())
+	require.NoError(t, store.CreateJobRun(&newer))
+	errored := cltest.CreateJobRunWithStatus(t, store, job, models.RunStatusErrored)
+
+	runs, count, err := store.DeadLetterJobRuns(0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	assert.Equal(t, []*models.ID{newer.ID, older.ID}, []*models.ID{runs[0].ID, runs[1].ID})
+
+	require.NoError(t, store.DiscardDeadLetterJobRuns([]*models.ID{older.ID, errored.ID}))
+
+	_, count, err = store.DeadLetterJobRuns(0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	_, err = store.FindJobRun(older.ID)
+	assert.True(t, gorm.IsRecordNotFoundError(err))
+	_, err = store.FindJobRun(errored.ID)
+	assert.NoError(t, err)
+}
+
 func TestORM_UnscopedJobRunsWithStatus_Happy(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -533,6 +711,38 @@ func TestORM_JobRunsCountFor(t *testing.T) {
 	assert.Equal(t, 1, count)
 }
 
+func TestORM_JobRunsFinishedSince(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, store.CreateJob(&job))
+
+	now := time.Now()
+	for _, r := range []struct {
+		status     models.RunStatus
+		finishedAt time.Time
+	}{
+		{models.RunStatusCompleted, now},
+		{models.RunStatusErrored, now},
+		{models.RunStatusErrored, now.Add(-2 * time.Hour)},
+		{models.RunStatusInProgress, time.Time{}},
+	} {
+		run := cltest.NewJobRun(job)
+		run.Status = r.status
+		if !r.finishedAt.IsZero() {
+			run.FinishedAt = null.TimeFrom(r.finishedAt)
+		}
+		require.NoError(t, store.CreateJobRun(&run))
+	}
+
+	finished, errored, err := store.JobRunsFinishedSince(now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, finished)
+	assert.Equal(t, 1, errored)
+}
+
 func TestORM_CreateTx(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -734,7 +944,7 @@ func TestORM_PendingBridgeType_alreadyCompleted(t *testing.T) {
 	pusher := new(mocks.StatsPusher)
 	pusher.On("PushNow").Return(nil)
 
-	executor := services.NewRunExecutor(store, pusher)
+	executor := services.NewRunExecutor(store, pusher, &services.NullChainSet{})
 	require.NoError(t, executor.Execute(run.ID))
 
 	cltest.WaitForJobRunStatus(t, store, run, models.RunStatusCompleted)
@@ -1023,6 +1233,45 @@ func TestORM_AllSyncEvents(t *testing.T) {
 	assert.Greater(t, events[1].ID, events[0].ID)
 }
 
+func TestORM_AllSyncEvents_DeletingInCallback(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	eventCount := orm.BatchSize*2 + 1
+	for i := 0; i < eventCount; i++ {
+		require.NoError(t, store.CreateSyncEvent(&models.SyncEvent{Body: "{}"}))
+	}
+
+	visited := 0
+	err := store.AllSyncEvents(func(event models.SyncEvent) error {
+		visited++
+		return store.RawDB(func(db *gorm.DB) error {
+			return db.Delete(event).Error
+		})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, eventCount, visited)
+	cltest.WaitForSyncEventCount(t, store.ORM, 0)
+}
+
+func TestORM_CreateTelemetrySyncEvents(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	require.NoError(t, store.CreateSyncEvent(&models.SyncEvent{Body: "run"}))
+	require.NoError(t, store.CreateTelemetrySyncEvents([]string{"a", "b"}, 3))
+	require.NoError(t, store.CreateTelemetrySyncEvents([]string{"c", "d"}, 3))
+
+	var bodies []string
+	err := store.AllSyncEvents(func(event models.SyncEvent) error {
+		bodies = append(bodies, event.Body)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"run", "b", "c", "d"}, bodies)
+}
+
 func TestBulkDeleteRuns(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -1291,6 +1540,38 @@ func TestORM_SendKeys(t *testing.T) {
 	require.Len(t, keys, 1)
 }
 
+func TestORM_SendKeys_ForChain(t *testing.T) {
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	sendingAddress := cltest.DefaultKeyAddressEIP55
+	require.NoError(t, store.CreateKeyIfNotExists(models.Key{Address: sendingAddress, JSON: cltest.JSONFromString(t, "{}")}))
+	chainORM := store.ORM.ForChain(big.NewInt(100))
+
+	require.NoError(t, store.AssignKeyChains([]orm.EVMChain{{ChainID: utils.NewBigI(100), Keys: []common.Address{sendingAddress.Address()}}}))
+	keys, err := store.SendKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 0)
+	_, err = store.GetRoundRobinAddress()
+	require.Error(t, err)
+	keys, err = chainORM.SendKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, sendingAddress, keys[0].Address)
+	address, err := chainORM.GetRoundRobinAddress()
+	require.NoError(t, err)
+	assert.Equal(t, sendingAddress.Address(), address)
+
+	// Keys no longer listed by a chain serve the primary chain again
+	require.NoError(t, store.AssignKeyChains(nil))
+	keys, err = store.SendKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	keys, err = chainORM.SendKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 0)
+}
+
 func TestORM_SyncDbKeyStoreToDisk(t *testing.T) {
 	store, cleanup := cltest.NewStore(t)
 	defer cleanup()
@@ -1780,6 +2061,39 @@ func TestORM_Heads_IdempotentInsertHead(t *testing.T) {
 	assert.Equal(t, head.Hash, foundHead.Hash)
 }
 
+func TestORM_Heads_ForChain(t *testing.T) {
+	t.Parallel()
+
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+	chainORM := store.ORM.ForChain(big.NewInt(100))
+
+	primaryHead := *cltest.Head(5)
+	require.NoError(t, store.IdempotentInsertHead(primaryHead))
+	chainHead := *cltest.Head(1000)
+	require.NoError(t, chainORM.IdempotentInsertHead(chainHead))
+
+	foundHead, err := store.LastHead()
+	require.NoError(t, err)
+	assert.Equal(t, primaryHead.Hash, foundHead.Hash)
+	foundHead, err = chainORM.LastHead()
+	require.NoError(t, err)
+	assert.Equal(t, chainHead.Hash, foundHead.Hash)
+	foundHead, err = store.HeadByHash(chainHead.Hash)
+	require.NoError(t, err)
+	assert.Nil(t, foundHead)
+
+	// Trimming the heads of one chain leaves those of the other
+	require.NoError(t, chainORM.IdempotentInsertHead(*cltest.Head(1001)))
+	require.NoError(t, chainORM.TrimOldHeads(1))
+	foundHead, err = store.LastHead()
+	require.NoError(t, err)
+	assert.Equal(t, primaryHead.Hash, foundHead.Hash)
+	foundHead, err = chainORM.HeadByHash(chainHead.Hash)
+	require.NoError(t, err)
+	assert.Nil(t, foundHead)
+}
+
 func TestORM_EthTaskRunTx(t *testing.T) {
 	t.Parallel()
 
@@ -1801,7 +2115,7 @@ func TestORM_EthTaskRunTx(t *testing.T) {
 		encodedPayload := []byte{0, 1, 2}
 		gasLimit := uint64(42)
 
-		err := store.IdempotentInsertEthTaskRunTx(sharedTaskRunID, fromAddress, toAddress, encodedPayload, gasLimit)
+		err := store.IdempotentInsertEthTaskRunTx(sharedTaskRunID, fromAddress, toAddress, encodedPayload, gasLimit, nil, nil, 0)
 		require.NoError(t, err)
 
 		etrt, err := store.FindEthTaskRunTxByTaskRunID(sharedTaskRunID.UUID())
@@ -1817,7 +2131,7 @@ func TestORM_EthTaskRunTx(t *testing.T) {
 		assert.Equal(t, models.EthTxUnstarted, etrt.EthTx.State)
 
 		// Do it again to test idempotence
-		err = store.IdempotentInsertEthTaskRunTx(sharedTaskRunID, fromAddress, toAddress, encodedPayload, gasLimit)
+		err = store.IdempotentInsertEthTaskRunTx(sharedTaskRunID, fromAddress, toAddress, encodedPayload, gasLimit, nil, nil, 0)
 		require.NoError(t, err)
 
 		// Ensure it didn't leave a stray EthTx hanging around
@@ -1834,7 +2148,7 @@ func TestORM_EthTaskRunTx(t *testing.T) {
 		encodedPayload := []byte{3, 2, 1}
 		gasLimit := uint64(24)
 
-		err := store.IdempotentInsertEthTaskRunTx(sharedTaskRunID, fromAddress, toAddress, encodedPayload, gasLimit)
+		err := store.IdempotentInsertEthTaskRunTx(sharedTaskRunID, fromAddress, toAddress, encodedPayload, gasLimit, nil, nil, 0)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "transaction already exists for task run ID")
 	})
@@ -1846,13 +2160,13 @@ func TestORM_EthTaskRunTx(t *testing.T) {
 		firstGasLimit := uint64(42)
 
 		// First insert
-		err := store.IdempotentInsertEthTaskRunTx(taskRunID, fromAddress, toAddress, encodedPayload, firstGasLimit)
+		err := store.IdempotentInsertEthTaskRunTx(taskRunID, fromAddress, toAddress, encodedPayload, firstGasLimit, nil, nil, 0)
 		require.NoError(t, err)
 
 		secondGasLimit := uint64(99)
 
 		// Second insert
-		err = store.IdempotentInsertEthTaskRunTx(taskRunID, fromAddress, toAddress, encodedPayload, secondGasLimit)
+		err = store.IdempotentInsertEthTaskRunTx(taskRunID, fromAddress, toAddress, encodedPayload, secondGasLimit, nil, nil, 0)
 		require.NoError(t, err)
 
 		etrt, err := store.FindEthTaskRunTxByTaskRunID(taskRunID.UUID())
@@ -1861,6 +2175,22 @@ func TestORM_EthTaskRunTx(t *testing.T) {
 		// But the second insert did not change the gas limit
 		assert.Equal(t, firstGasLimit, etrt.EthTx.GasLimit)
 	})
+
+	t.Run("saves the gas price override", func(t *testing.T) {
+		taskRunID := cltest.MustInsertTaskRun(t, store)
+		toAddress := cltest.NewAddress()
+		encodedPayload := []byte{0, 1, 2}
+		gasPrice := big.NewInt(42000000000)
+
+		err := store.IdempotentInsertEthTaskRunTx(taskRunID, fromAddress, toAddress, encodedPayload, 42, gasPrice, nil, 0)
+		require.NoError(t, err)
+
+		etrt, err := store.FindEthTaskRunTxByTaskRunID(taskRunID.UUID())
+		require.NoError(t, err)
+
+		require.NotNil(t, etrt.EthTx.GasPrice)
+		assert.Equal(t, gasPrice, etrt.EthTx.GasPrice.ToInt())
+	})
 }
 
 func TestORM_FindJobWithErrorsPreloadsJobSpecErrors(t *testing.T) {
@@ -2154,6 +2484,45 @@ func TestORM_GetRoundRobinAddress(t *testing.T) {
 	})
 }
 
+func TestORM_GetLeastPendingAddress(t *testing.T) {
+	t.Parallel()
+	store, cleanup := cltest.NewStore(t)
+	defer cleanup()
+
+	k0Address := common.HexToAddress(cltest.DefaultKey)
+	k1 := models.Key{Address: models.EIP55Address(cltest.NewAddress().Hex()), JSON: cltest.JSONFromString(t, `{"key": 1}`)}
+	require.NoError(t, store.CreateKeyIfNotExists(k1))
+
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 0, k0Address)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 1, k0Address)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 0, k1.Address.Address())
+
+	t.Run("picks the key with the fewest pending transactions", func(t *testing.T) {
+		address, err := store.GetLeastPendingAddress()
+		require.NoError(t, err)
+		assert.Equal(t, k1.Address.Hex(), address.Hex())
+
+		address, err = store.GetLeastPendingAddress()
+		require.NoError(t, err)
+		assert.Equal(t, k1.Address.Hex(), address.Hex())
+	})
+
+	t.Run("only considers the given addresses", func(t *testing.T) {
+		address, err := store.GetLeastPendingAddress(k0Address)
+		require.NoError(t, err)
+		assert.Equal(t, k0Address, address)
+	})
+
+	t.Run("ignores confirmed transactions", func(t *testing.T) {
+		cltest.MustInsertConfirmedEthTxWithAttempt(t, store, 2, 1, k1.Address.Address())
+		cltest.MustInsertConfirmedEthTxWithAttempt(t, store, 3, 1, k1.Address.Address())
+
+		address, err := store.GetLeastPendingAddress()
+		require.NoError(t, err)
+		assert.Equal(t, k1.Address.Hex(), address.Hex())
+	})
+}
+
 func TestORM_MarkLogConsumed(t *testing.T) {
 	t.Parallel()
 	store, cleanup := cltest.NewStore(t)
@@ -16,6 +16,21 @@ import (
 
 // ConfigSchema records the schema of configuration at the type level
 type ConfigSchema struct {
+	AlertCheckInterval                        time.Duration   `env:"ALERT_CHECK_INTERVAL" default:"1m"`
+	AlertEmailFrom                            string          `env:"ALERT_EMAIL_FROM"`
+	AlertEmailSMTPAddress                     string          `env:"ALERT_EMAIL_SMTP_ADDRESS"`
+	AlertEmailSMTPPassword                    string          `env:"ALERT_EMAIL_SMTP_PASSWORD"`
+	AlertEmailSMTPUsername                    string          `env:"ALERT_EMAIL_SMTP_USERNAME"`
+	AlertEmailTo                              string          `env:"ALERT_EMAIL_TO"`
+	AlertMinEthBalanceWei                     big.Int         `env:"ALERT_MIN_ETH_BALANCE_WEI" default:"0"`
+	AlertPagerDutyRoutingKey                  string          `env:"ALERT_PAGERDUTY_ROUTING_KEY"`
+	AlertRunErrorRate                         float64         `env:"ALERT_RUN_ERROR_RATE" default:"0"`
+	AlertRunErrorRateMinRuns                  uint            `env:"ALERT_RUN_ERROR_RATE_MIN_RUNS" default:"10"`
+	AlertRunErrorRateWindow                   time.Duration   `env:"ALERT_RUN_ERROR_RATE_WINDOW" default:"1h"`
+	AlertSlackWebhookURL                      *url.URL        `env:"ALERT_SLACK_WEBHOOK_URL"`
+	AlertStaleHeadThreshold                   time.Duration   `env:"ALERT_STALE_HEAD_THRESHOLD" default:"5m"`
+	AlertStuckTxThreshold                     time.Duration   `env:"ALERT_STUCK_TX_THRESHOLD" default:"30m"`
+	AlertWebhookURL                           *url.URL        `env:"ALERT_WEBHOOK_URL"`
 	AllowOrigins                              string          `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
 	BalanceMonitorEnabled                     bool            `env:"BALANCE_MONITOR_ENABLED" default:"true"`
 	BlockBackfillDepth                        string          `env:"BLOCK_BACKFILL_DEPTH" default:"10"`
@@ -41,28 +56,52 @@ type ConfigSchema struct {
 	EthGasBumpThreshold                       uint64          `env:"ETH_GAS_BUMP_THRESHOLD" default:"3" `
 	EthGasBumpWei                             big.Int         `env:"ETH_GAS_BUMP_WEI" default:"5000000000"`
 	EthGasBumpPercent                         uint16          `env:"ETH_GAS_BUMP_PERCENT" default:"20"`
+	EthGasBumpReplacementPercent              uint16          `env:"ETH_GAS_BUMP_REPLACEMENT_PERCENT" default:"0"`
 	EthGasBumpTxDepth                         uint16          `env:"ETH_GAS_BUMP_TX_DEPTH" default:"10"`
 	EthGasLimitDefault                        uint64          `env:"ETH_GAS_LIMIT_DEFAULT" default:"500000"`
 	EthGasPriceDefault                        big.Int         `env:"ETH_GAS_PRICE_DEFAULT" default:"20000000000"`
+	EthGasTipCapDefault                       big.Int         `env:"ETH_GAS_TIP_CAP_DEFAULT" default:"1000000000"`
 	EthMaxGasPriceWei                         uint64          `env:"ETH_MAX_GAS_PRICE_WEI" default:"1500000000000"`
+	EthPrivateChainMode                       bool            `env:"ETH_PRIVATE_CHAIN_MODE" default:"false"`
 	EthFinalityDepth                          uint            `env:"ETH_FINALITY_DEPTH" default:"50"`
 	EthHeadTrackerHistoryDepth                uint            `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH" default:"100"`
 	EthHeadTrackerMaxBufferSize               uint            `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE" default:"3"`
+	EthKeySelection                           string          `env:"ETH_KEY_SELECTION" default:"round_robin"`
+	EthForwarderAddress                       string          `env:"ETH_FORWARDER_ADDRESS"`
+	EthSimulateTransactions                   bool            `env:"ETH_SIMULATE_TRANSACTIONS" default:"false"`
+	EthRemoteSignerURL                        string          `env:"ETH_REMOTE_SIGNER_URL"`
+	EthReceiptFetchBatchSize                  uint32          `env:"ETH_RECEIPT_FETCH_BATCH_SIZE" default:"0"`
+	EthNonceGapCheckInterval                  time.Duration   `env:"ETH_NONCE_GAP_CHECK_INTERVAL" default:"0s"`
+	EthNonceGapAutoRepair                     bool            `env:"ETH_NONCE_GAP_AUTO_REPAIR" default:"false"`
 	EthBalanceMonitorBlockDelay               uint16          `env:"ETH_BALANCE_MONITOR_BLOCK_DELAY" default:"1"`
+	EthBalanceTopUpAmountWei                  big.Int         `env:"ETH_BALANCE_TOP_UP_AMOUNT_WEI" default:"0"`
+	EthBalanceTopUpFundingAddress             common.Address  `env:"ETH_BALANCE_TOP_UP_FUNDING_ADDRESS"`
 	EthereumURL                               string          `env:"ETH_URL" default:"ws://localhost:8546"`
 	EthereumSecondaryURL                      string          `env:"ETH_SECONDARY_URL" default:""`
 	EthereumDisabled                          bool            `env:"ETH_DISABLED" default:"false"`
+	EVMChains                                 string          `env:"EVM_CHAINS"`
 	FlagsContractAddress                      string          `env:"FLAGS_CONTRACT_ADDRESS"`
 	GasUpdaterBlockDelay                      uint16          `env:"GAS_UPDATER_BLOCK_DELAY" default:"3"`
 	GasUpdaterBlockHistorySize                uint16          `env:"GAS_UPDATER_BLOCK_HISTORY_SIZE" default:"24"`
 	GasUpdaterTransactionPercentile           uint16          `env:"GAS_UPDATER_TRANSACTION_PERCENTILE" default:"60"`
 	GasUpdaterEnabled                         bool            `env:"GAS_UPDATER_ENABLED" default:"true"`
+	GasUpdaterTipCapEnabled                   bool            `env:"GAS_UPDATER_TIP_CAP_ENABLED" default:"false"`
+	HTTPCacheBackend                          string          `env:"HTTP_CACHE_BACKEND" default:"memory"`
+	HTTPMaxResponseSize                       int64           `env:"HTTP_MAX_RESPONSE_SIZE" default:"10485760"`
+	HTTPRateLimit                             float64         `env:"HTTP_RATE_LIMIT" default:"0"`
+	HTTPRateLimitBurst                        uint            `env:"HTTP_RATE_LIMIT_BURST" default:"1"`
 	InsecureFastScrypt                        bool            `env:"INSECURE_FAST_SCRYPT" default:"false"`
 	JobPipelineDBPollInterval                 time.Duration   `env:"JOB_PIPELINE_DB_POLL_INTERVAL" default:"10s"`
 	JobPipelineMaxTaskDuration                time.Duration   `env:"JOB_PIPELINE_MAX_TASK_DURATION" default:"10m"`
 	JobPipelineParallelism                    uint8           `env:"JOB_PIPELINE_PARALLELISM" default:"4"`
 	JobPipelineReaperInterval                 time.Duration   `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                time.Duration   `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"7d"`
+	JobRunMaxDataSize                         int64           `env:"JOB_RUN_MAX_DATA_SIZE" default:"0"`
+	JobRunMaxExecutionTime                    time.Duration   `env:"JOB_RUN_MAX_EXECUTION_TIME" default:"0s"`
+	JobRunReaperInterval                      time.Duration   `env:"JOB_RUN_REAPER_INTERVAL" default:"1h"`
+	JobRunRetentionArchive                    bool            `env:"JOB_RUN_RETENTION_ARCHIVE" default:"false"`
+	JobRunRetentionCount                      uint32          `env:"JOB_RUN_RETENTION_COUNT" default:"0"`
+	JobRunRetentionPeriod                     time.Duration   `env:"JOB_RUN_RETENTION_PERIOD" default:"0s"`
 	JSONConsole                               bool            `env:"JSON_CONSOLE" default:"false"`
 	LinkContractAddress                       string          `env:"LINK_CONTRACT_ADDRESS" default:"0x514910771AF9Ca656af840dff83E8264EcF986CA"`
 	ExplorerURL                               *url.URL        `env:"EXPLORER_URL"`
@@ -95,6 +134,10 @@ type ConfigSchema struct {
 	ReaperExpiration                          models.Duration `env:"REAPER_EXPIRATION" default:"240h"`
 	ReplayFromBlock                           int64           `env:"REPLAY_FROM_BLOCK" default:"-1"`
 	RootDir                                   string          `env:"ROOT" default:"~/.chainlink"`
+	RunQueueMaxPending                        uint            `env:"RUN_QUEUE_MAX_PENDING" default:"0"`
+	RunQueuePriorityAging                     time.Duration   `env:"RUN_QUEUE_PRIORITY_AGING" default:"1m"`
+	RunQueueMaxWorkers                        uint            `env:"RUN_QUEUE_MAX_WORKERS" default:"0"`
+	RunQueueMaxWorkersPerJob                  uint            `env:"RUN_QUEUE_MAX_WORKERS_PER_JOB" default:"0"`
 	SecureCookies                             bool            `env:"SECURE_COOKIES" default:"true"`
 	SessionTimeout                            models.Duration `env:"SESSION_TIMEOUT" default:"15m"`
 	TLSCertPath                               string          `env:"TLS_CERT_PATH" `
@@ -102,6 +145,8 @@ type ConfigSchema struct {
 	TLSKeyPath                                string          `env:"TLS_KEY_PATH" `
 	TLSPort                                   uint16          `env:"CHAINLINK_TLS_PORT" default:"6689"`
 	TLSRedirect                               bool            `env:"CHAINLINK_TLS_REDIRECT" default:"false"`
+	TracingOTLPEndpoint                       *url.URL        `env:"TRACING_OTLP_ENDPOINT"`
+	TracingSampleRatio                        float64         `env:"TRACING_SAMPLE_RATIO" default:"1"`
 	TxAttemptLimit                            uint16          `env:"CHAINLINK_TX_ATTEMPT_LIMIT" default:"10"`
 }
 
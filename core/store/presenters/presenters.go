@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitor"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/store"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -138,6 +139,13 @@ type ConfigPrinter struct {
 
 // EnvPrinter contains the supported environment variables
 type EnvPrinter struct {
+	AlertCheckInterval                    time.Duration   `json:"alertCheckInterval"`
+	AlertMinEthBalanceWei                 *big.Int        `json:"alertMinEthBalanceWei"`
+	AlertRunErrorRate                     float64         `json:"alertRunErrorRate"`
+	AlertRunErrorRateMinRuns              uint            `json:"alertRunErrorRateMinRuns"`
+	AlertRunErrorRateWindow               time.Duration   `json:"alertRunErrorRateWindow"`
+	AlertStaleHeadThreshold               time.Duration   `json:"alertStaleHeadThreshold"`
+	AlertStuckTxThreshold                 time.Duration   `json:"alertStuckTxThreshold"`
 	AllowOrigins                          string          `json:"allowOrigins"`
 	BalanceMonitorEnabled                 bool            `json:"balanceMonitorEnabled"`
 	BlockBackfillDepth                    uint64          `json:"blockBackfillDepth"`
@@ -152,18 +160,31 @@ type EnvPrinter struct {
 	EnableBulletproofTxManager            bool            `json:"enableBulletproofTxManager"`
 	EnableExperimentalAdapters            bool            `json:"enableExperimentalAdapters"`
 	EthBalanceMonitorBlockDelay           uint16          `json:"ethBalanceMonitorBlockDelay"`
+	EthBalanceTopUpAmountWei              *big.Int        `json:"ethBalanceTopUpAmountWei"`
+	EthBalanceTopUpFundingAddress         common.Address  `json:"ethBalanceTopUpFundingAddress"`
 	EthereumDisabled                      bool            `json:"ethereumDisabled"`
 	EthFinalityDepth                      uint            `json:"ethFinalityDepth"`
+	EthGasBumpReplacementPercent          uint16          `json:"ethGasBumpReplacementPercent"`
 	EthGasBumpThreshold                   uint64          `json:"ethGasBumpThreshold"`
 	EthGasBumpTxDepth                     uint16          `json:"ethGasBumpTxDepth"`
 	EthGasBumpWei                         *big.Int        `json:"ethGasBumpWei"`
 	EthGasLimitDefault                    uint64          `json:"ethGasLimitDefault"`
 	EthGasPriceDefault                    *big.Int        `json:"ethGasPriceDefault"`
+	EthGasTipCapDefault                   *big.Int        `json:"ethGasTipCapDefault"`
 	EthHeadTrackerHistoryDepth            uint            `json:"ethHeadTrackerHistoryDepth"`
 	EthHeadTrackerMaxBufferSize           uint            `json:"ethHeadTrackerMaxBufferSize"`
+	EthKeySelection                       string          `json:"ethKeySelection"`
+	EthForwarderAddress                   string          `json:"ethForwarderAddress"`
+	EthSimulateTransactions               bool            `json:"ethSimulateTransactions"`
+	EthRemoteSignerURL                    string          `json:"ethRemoteSignerURL"`
+	EthReceiptFetchBatchSize              uint32          `json:"ethReceiptFetchBatchSize"`
+	EthNonceGapCheckInterval              time.Duration   `json:"ethNonceGapCheckInterval"`
+	EthNonceGapAutoRepair                 bool            `json:"ethNonceGapAutoRepair"`
 	EthMaxGasPriceWei                     *big.Int        `json:"ethMaxGasPriceWei"`
+	EthPrivateChainMode                   bool            `json:"ethPrivateChainMode"`
 	EthereumURL                           string          `json:"ethUrl"`
 	EthereumSecondaryURL                  string          `json:"ethSecondaryURL"`
+	EVMChains                             []orm.EVMChain  `json:"evmChains"`
 	ExplorerURL                           string          `json:"explorerUrl"`
 	FeatureExternalInitiators             bool            `json:"featureExternalInitiators"`
 	FeatureFluxMonitor                    bool            `json:"featureFluxMonitor"`
@@ -172,13 +193,24 @@ type EnvPrinter struct {
 	GasUpdaterBlockDelay                  uint16          `json:"gasUpdaterBlockDelay"`
 	GasUpdaterBlockHistorySize            uint16          `json:"gasUpdaterBlockHistorySize"`
 	GasUpdaterEnabled                     bool            `json:"gasUpdaterEnabled"`
+	GasUpdaterTipCapEnabled               bool            `json:"gasUpdaterTipCapEnabled"`
 	GasUpdaterTransactionPercentile       uint16          `json:"gasUpdaterTransactionPercentile"`
+	HTTPCacheBackend                      string          `json:"httpCacheBackend"`
+	HTTPMaxResponseSize                   int64           `json:"httpMaxResponseSize"`
+	HTTPRateLimit                         float64         `json:"httpRateLimit"`
+	HTTPRateLimitBurst                    uint            `json:"httpRateLimitBurst"`
 	InsecureFastScrypt                    bool            `json:"insecureFastScrypt"`
 	JobPipelineDBPollInterval             time.Duration   `json:"jobPipelineDBPollInterval"`
 	JobPipelineMaxTaskDuration            time.Duration   `json:"jobPipelineMaxTaskDuration"`
 	JobPipelineParallelism                uint8           `json:"jobPipelineParallelism"`
 	JobPipelineReaperInterval             time.Duration   `json:"jobPipelineReaperInterval"`
 	JobPipelineReaperThreshold            time.Duration   `json:"jobPipelineReaperThreshold"`
+	JobRunMaxDataSize                     int64           `json:"jobRunMaxDataSize"`
+	JobRunMaxExecutionTime                time.Duration   `json:"jobRunMaxExecutionTime"`
+	JobRunReaperInterval                  time.Duration   `json:"jobRunReaperInterval"`
+	JobRunRetentionArchive                bool            `json:"jobRunRetentionArchive"`
+	JobRunRetentionCount                  uint32          `json:"jobRunRetentionCount"`
+	JobRunRetentionPeriod                 time.Duration   `json:"jobRunRetentionPeriod"`
 	JSONConsole                           bool            `json:"jsonConsole"`
 	LinkContractAddress                   string          `json:"linkContractAddress"`
 	LogLevel                              orm.LogLevel    `json:"logLevel"`
@@ -206,11 +238,17 @@ type EnvPrinter struct {
 	ReaperExpiration                      models.Duration `json:"reaperExpiration"`
 	ReplayFromBlock                       int64           `json:"replayFromBlock"`
 	RootDir                               string          `json:"root"`
+	RunQueueMaxPending                    uint            `json:"runQueueMaxPending"`
+	RunQueuePriorityAging                 time.Duration   `json:"runQueuePriorityAging"`
+	RunQueueMaxWorkers                    uint            `json:"runQueueMaxWorkers"`
+	RunQueueMaxWorkersPerJob              uint            `json:"runQueueMaxWorkersPerJob"`
 	SecureCookies                         bool            `json:"secureCookies"`
 	SessionTimeout                        models.Duration `json:"sessionTimeout"`
 	TLSHost                               string          `json:"chainlinkTLSHost"`
 	TLSPort                               uint16          `json:"chainlinkTLSPort"`
 	TLSRedirect                           bool            `json:"chainlinkTLSRedirect"`
+	TracingOTLPEndpoint                   string          `json:"tracingOtlpEndpoint"`
+	TracingSampleRatio                    float64         `json:"tracingSampleRatio"`
 	TxAttemptLimit                        uint16          `json:"txAttemptLimit"`
 }
 
@@ -226,9 +264,20 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 	if config.ExplorerURL() != nil {
 		explorerURL = config.ExplorerURL().String()
 	}
+	tracingEndpoint := ""
+	if config.TracingOTLPEndpoint() != nil {
+		tracingEndpoint = config.TracingOTLPEndpoint().String()
+	}
 	return ConfigPrinter{
 		AccountAddress: account.Address.Hex(),
 		EnvPrinter: EnvPrinter{
+			AlertCheckInterval:                    config.AlertCheckInterval(),
+			AlertMinEthBalanceWei:                 config.AlertMinEthBalanceWei(),
+			AlertRunErrorRate:                     config.AlertRunErrorRate(),
+			AlertRunErrorRateMinRuns:              config.AlertRunErrorRateMinRuns(),
+			AlertRunErrorRateWindow:               config.AlertRunErrorRateWindow(),
+			AlertStaleHeadThreshold:               config.AlertStaleHeadThreshold(),
+			AlertStuckTxThreshold:                 config.AlertStuckTxThreshold(),
 			AllowOrigins:                          config.AllowOrigins(),
 			BalanceMonitorEnabled:                 config.BalanceMonitorEnabled(),
 			BlockBackfillDepth:                    config.BlockBackfillDepth(),
@@ -243,18 +292,31 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			EnableBulletproofTxManager:            config.EnableBulletproofTxManager(),
 			EnableExperimentalAdapters:            config.EnableExperimentalAdapters(),
 			EthBalanceMonitorBlockDelay:           config.EthBalanceMonitorBlockDelay(),
+			EthBalanceTopUpAmountWei:              config.EthBalanceTopUpAmountWei(),
+			EthBalanceTopUpFundingAddress:         config.EthBalanceTopUpFundingAddress(),
 			EthereumDisabled:                      config.EthereumDisabled(),
 			EthFinalityDepth:                      config.EthFinalityDepth(),
+			EthGasBumpReplacementPercent:          config.EthGasBumpReplacementPercent(),
 			EthGasBumpThreshold:                   config.EthGasBumpThreshold(),
 			EthGasBumpTxDepth:                     config.EthGasBumpTxDepth(),
 			EthGasBumpWei:                         config.EthGasBumpWei(),
 			EthGasLimitDefault:                    config.EthGasLimitDefault(),
 			EthGasPriceDefault:                    config.EthGasPriceDefault(),
+			EthGasTipCapDefault:                   config.EthGasTipCapDefault(),
 			EthHeadTrackerHistoryDepth:            config.EthHeadTrackerHistoryDepth(),
 			EthHeadTrackerMaxBufferSize:           config.EthHeadTrackerMaxBufferSize(),
+			EthKeySelection:                       config.EthKeySelection(),
+			EthForwarderAddress:                   config.EthForwarderAddress(),
+			EthSimulateTransactions:               config.EthSimulateTransactions(),
+			EthRemoteSignerURL:                    config.EthRemoteSignerURL(),
+			EthReceiptFetchBatchSize:              config.EthReceiptFetchBatchSize(),
+			EthNonceGapCheckInterval:              config.EthNonceGapCheckInterval(),
+			EthNonceGapAutoRepair:                 config.EthNonceGapAutoRepair(),
 			EthMaxGasPriceWei:                     config.EthMaxGasPriceWei(),
+			EthPrivateChainMode:                   config.EthPrivateChainMode(),
 			EthereumURL:                           config.EthereumURL(),
 			EthereumSecondaryURL:                  config.EthereumSecondaryURL(),
+			EVMChains:                             config.EVMChains(),
 			ExplorerURL:                           explorerURL,
 			FeatureExternalInitiators:             config.FeatureExternalInitiators(),
 			FeatureFluxMonitor:                    config.FeatureFluxMonitor(),
@@ -263,13 +325,24 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			GasUpdaterBlockDelay:                  config.GasUpdaterBlockDelay(),
 			GasUpdaterBlockHistorySize:            config.GasUpdaterBlockHistorySize(),
 			GasUpdaterEnabled:                     config.GasUpdaterEnabled(),
+			GasUpdaterTipCapEnabled:               config.GasUpdaterTipCapEnabled(),
 			GasUpdaterTransactionPercentile:       config.GasUpdaterTransactionPercentile(),
+			HTTPCacheBackend:                      config.HTTPCacheBackend(),
+			HTTPMaxResponseSize:                   config.HTTPMaxResponseSize(),
+			HTTPRateLimit:                         config.HTTPRateLimit(),
+			HTTPRateLimitBurst:                    config.HTTPRateLimitBurst(),
 			InsecureFastScrypt:                    config.InsecureFastScrypt(),
 			JobPipelineDBPollInterval:             config.JobPipelineDBPollInterval(),
 			JobPipelineMaxTaskDuration:            config.JobPipelineMaxTaskDuration(),
 			JobPipelineParallelism:                config.JobPipelineParallelism(),
 			JobPipelineReaperInterval:             config.JobPipelineReaperInterval(),
 			JobPipelineReaperThreshold:            config.JobPipelineReaperThreshold(),
+			JobRunMaxDataSize:                     config.JobRunMaxDataSize(),
+			JobRunMaxExecutionTime:                config.JobRunMaxExecutionTime(),
+			JobRunReaperInterval:                  config.JobRunReaperInterval(),
+			JobRunRetentionArchive:                config.JobRunRetentionArchive(),
+			JobRunRetentionCount:                  config.JobRunRetentionCount(),
+			JobRunRetentionPeriod:                 config.JobRunRetentionPeriod(),
 			JSONConsole:                           config.JSONConsole(),
 			LinkContractAddress:                   config.LinkContractAddress(),
 			LogLevel:                              config.LogLevel(),
@@ -297,11 +370,17 @@ func NewConfigPrinter(store *store.Store) (ConfigPrinter, error) {
 			ReaperExpiration:                      config.ReaperExpiration(),
 			ReplayFromBlock:                       config.ReplayFromBlock(),
 			RootDir:                               config.RootDir(),
+			RunQueueMaxPending:                    config.RunQueueMaxPending(),
+			RunQueuePriorityAging:                 config.RunQueuePriorityAging(),
+			RunQueueMaxWorkers:                    config.RunQueueMaxWorkers(),
+			RunQueueMaxWorkersPerJob:              config.RunQueueMaxWorkersPerJob(),
 			SecureCookies:                         config.SecureCookies(),
 			SessionTimeout:                        config.SessionTimeout(),
 			TLSHost:                               config.TLSHost(),
 			TLSPort:                               config.TLSPort(),
 			TLSRedirect:                           config.TLSRedirect(),
+			TracingOTLPEndpoint:                   tracingEndpoint,
+			TracingSampleRatio:                    config.TracingSampleRatio(),
 			TxAttemptLimit:                        config.TxAttemptLimit(),
 		},
 	}, nil
@@ -380,6 +459,32 @@ func (job JobSpec) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// JobBundleImport is the job created by importing a job bundle, together
+// with the credentials of the bridges and external initiators created for it.
+// Bridges and external initiators which already existed are not listed.
+type JobBundleImport struct {
+	Job                JobSpec                           `json:"job"`
+	Bridges            []models.BridgeTypeAuthentication `json:"bridges"`
+	ExternalInitiators []ExternalInitiatorAuthentication `json:"externalInitiators"`
+}
+
+// GetID returns the ID of the job imported for jsonapi serialization.
+func (jbi JobBundleImport) GetID() string {
+	return jbi.Job.ID.String()
+}
+
+// GetName returns the pluralized "type" of this structure for jsonapi serialization.
+func (jbi JobBundleImport) GetName() string {
+	return "jobBundleImports"
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (jbi *JobBundleImport) SetID(value string) error {
+	id, err := models.NewIDFromString(value)
+	jbi.Job.ID = id
+	return err
+}
+
 // FriendlyCreatedAt returns a human-readable string of the Job's
 // CreatedAt field.
 func (job JobSpec) FriendlyCreatedAt() string {
@@ -443,6 +548,19 @@ func (i Initiator) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return []byte{}, err
 	}
+	if i.MinIncomingInterval != nil {
+		b, err := json.Marshal(p)
+		if err != nil {
+			return []byte{}, err
+		}
+		params, err := models.ParseJSON(b)
+		if err != nil {
+			return []byte{}, err
+		}
+		if p, err = params.Add("minIncomingInterval", i.MinIncomingInterval); err != nil {
+			return []byte{}, err
+		}
+	}
 
 	return json.Marshal(&struct {
 		Type   string      `json:"type"`
@@ -450,54 +568,177 @@ func (i Initiator) MarshalJSON() ([]byte, error) {
 	}{i.Type, p})
 }
 
+// presentFeeds returns the feeds of a fluxmonitor initiator with the values
+// of their headers, such as API keys, redacted.
+func presentFeeds(feeds models.Feeds) models.JSON {
+	parsed, err := models.ParseFeeds(feeds)
+	if err != nil {
+		return feeds
+	}
+	redacted := false
+	for i, feed := range parsed {
+		if len(feed.Headers) == 0 {
+			continue
+		}
+		headers := make(map[string]string, len(feed.Headers))
+		for key := range feed.Headers {
+			headers[key] = "*REDACTED*"
+		}
+		parsed[i].Headers = headers
+		redacted = true
+	}
+	if !redacted {
+		return feeds
+	}
+
+	b, err := json.Marshal(parsed)
+	if err != nil {
+		return feeds
+	}
+	presented, err := models.ParseJSON(b)
+	if err != nil {
+		return feeds
+	}
+	return presented
+}
+
 func initiatorParams(i Initiator) (interface{}, error) {
 	switch i.Type {
-	case models.InitiatorWeb:
+	case models.InitiatorWeb, models.InitiatorWebhook:
 		return struct{}{}, nil
 	case models.InitiatorCron:
 		return struct {
-			Schedule models.Cron `json:"schedule"`
-		}{i.Schedule}, nil
+			Schedule  models.Cron  `json:"schedule"`
+			Schedules models.Crons `json:"schedules,omitempty"`
+		}{i.Schedule, i.Schedules}, nil
 	case models.InitiatorRunAt:
+		var until, lastRanAt *models.AnyTime
+		if i.Until.Valid {
+			until = &i.Until
+		}
+		if i.LastRanAt.Valid {
+			lastRanAt = &i.LastRanAt
+		}
 		return struct {
-			Time models.AnyTime `json:"time"`
-			Ran  bool           `json:"ran"`
-		}{models.NewAnyTime(i.Time.Time), i.Ran}, nil
+			Time      models.AnyTime   `json:"time"`
+			Ran       bool             `json:"ran"`
+			Every     *models.Duration `json:"every,omitempty"`
+			Until     *models.AnyTime  `json:"until,omitempty"`
+			LastRanAt *models.AnyTime  `json:"lastRanAt,omitempty"`
+		}{models.NewAnyTime(i.Time.Time), i.Ran, i.Every, until, lastRanAt}, nil
 	case models.InitiatorEthLog:
-		fallthrough
+		return struct {
+			Address  common.Address   `json:"address"`
+			Topics   models.Topics    `json:"topics,omitempty"`
+			EventABI *models.EventABI `json:"eventAbi,omitempty"`
+		}{i.Address, i.Topics, i.EventABI}, nil
 	case models.InitiatorRunLog:
 		return struct {
-			Address common.Address `json:"address"`
-		}{i.Address}, nil
+			Address   common.Address           `json:"address"`
+			Addresses models.AddressCollection `json:"addresses,omitempty"`
+		}{i.Address, i.Addresses}, nil
 	case models.InitiatorExternal:
 		return struct {
 			Name string `json:"name"`
 		}{i.Name}, nil
 	case models.InitiatorFluxMonitor:
+		var flagsContractAddress *common.Address
+		if i.FlagsContractAddress != utils.ZeroAddress {
+			flagsContractAddress = &i.FlagsContractAddress
+		}
 		return struct {
-			Address           common.Address         `json:"address"`
-			RequestData       models.JSON            `json:"requestData"`
-			Feeds             models.JSON            `json:"feeds"`
-			Threshold         float32                `json:"threshold"`
-			AbsoluteThreshold float32                `json:"absoluteThreshold"`
-			Precision         int32                  `json:"precision"`
-			PollTimer         models.PollTimerConfig `json:"pollTimer,omitempty"`
-			IdleTimer         models.IdleTimerConfig `json:"idleTimer,omitempty"`
-		}{i.Address, i.RequestData, i.Feeds, i.Threshold, i.AbsoluteThreshold,
-			i.Precision, i.PollTimer, i.IdleTimer}, nil
+			Address                  common.Address           `json:"address"`
+			RequestData              models.JSON              `json:"requestData"`
+			Feeds                    models.JSON              `json:"feeds"`
+			Threshold                float32                  `json:"threshold"`
+			AbsoluteThreshold        float32                  `json:"absoluteThreshold"`
+			ReleaseThreshold         float32                  `json:"releaseThreshold,omitempty"`
+			AbsoluteReleaseThreshold float32                  `json:"absoluteReleaseThreshold,omitempty"`
+			Precision                int32                    `json:"precision"`
+			PollTimer                models.PollTimerConfig   `json:"pollTimer,omitempty"`
+			IdleTimer                models.IdleTimerConfig   `json:"idleTimer,omitempty"`
+			Aggregation              models.AggregationConfig `json:"aggregation"`
+			DrumbeatSchedule         models.Cron              `json:"drumbeatSchedule,omitempty"`
+			FlagsContractAddress     *common.Address          `json:"flagsContractAddress,omitempty"`
+			Simulate                 bool                     `json:"simulate,omitempty"`
+			SkipSupermajorityRounds  bool                     `json:"skipSupermajorityRounds,omitempty"`
+		}{i.Address, i.RequestData, presentFeeds(i.Feeds), i.Threshold, i.AbsoluteThreshold,
+			i.ReleaseThreshold, i.AbsoluteReleaseThreshold, i.Precision, i.PollTimer, i.IdleTimer,
+			i.Aggregation.WithDefaults(), i.DrumbeatSchedule, flagsContractAddress, i.Simulate,
+			i.SkipSupermajorityRounds}, nil
 	case models.InitiatorRandomnessLog:
 		return struct{ Address common.Address }{i.Address}, nil
+	case models.InitiatorKafka:
+		var kafka *models.KafkaConfig
+		if i.Kafka != nil {
+			config := i.Kafka.WithoutPassword()
+			kafka = &config
+		}
+		return struct {
+			Kafka *models.KafkaConfig `json:"kafka"`
+		}{kafka}, nil
+	case models.InitiatorMQTT:
+		var mqtt *models.MQTTConfig
+		if i.MQTT != nil {
+			config := i.MQTT.WithoutPassword()
+			mqtt = &config
+		}
+		return struct {
+			MQTT *models.MQTTConfig `json:"mqtt"`
+		}{mqtt}, nil
+	case models.InitiatorBalanceThreshold:
+		var tokenAddress *common.Address
+		if i.TokenAddress != utils.ZeroAddress {
+			tokenAddress = &i.TokenAddress
+		}
+		crossing := strings.ToLower(i.Crossing)
+		if crossing == "" {
+			crossing = models.BalanceCrossingBelow
+		}
+		return struct {
+			Address          common.Address  `json:"address"`
+			TokenAddress     *common.Address `json:"tokenAddress,omitempty"`
+			BalanceThreshold *utils.Big      `json:"balanceThreshold"`
+			Crossing         string          `json:"crossing"`
+		}{i.Address, tokenAddress, i.BalanceThreshold, crossing}, nil
+	case models.InitiatorGasPrice:
+		return struct {
+			GasPriceThreshold *utils.Big `json:"gasPriceThreshold"`
+			Crossing          string     `json:"crossing"`
+		}{i.GasPriceThreshold, i.GasPriceCrossing()}, nil
+	case models.InitiatorBlockInterval:
+		return struct {
+			BlockInterval uint32 `json:"blockInterval"`
+		}{i.BlockInterval}, nil
 	default:
 		return nil, fmt.Errorf("cannot marshal unsupported initiator type '%v'", i.Type)
 	}
 }
 
-// FriendlyRunAt returns a human-readable string for Cron Initiator types.
+// FriendlyRunAt returns a human-readable string for RunAt Initiator types,
+// including the interval and end of recurring ones.
 func (i Initiator) FriendlyRunAt() string {
-	if i.Type == models.InitiatorRunAt {
+	if i.Type != models.InitiatorRunAt {
+		return ""
+	}
+	if !i.Recurring() {
 		return utils.ISO8601UTC(i.Time.Time)
 	}
-	return ""
+	runAt := fmt.Sprintf("%s every %s", utils.ISO8601UTC(i.Time.Time), i.Every)
+	if i.Until.Valid {
+		runAt += fmt.Sprintf(" until %s", utils.ISO8601UTC(i.Until.Time))
+	}
+	return runAt
+}
+
+// FriendlySchedule returns the schedules of a cron initiator, separated by
+// commas.
+func (i Initiator) FriendlySchedule() string {
+	var schedules []string
+	for _, schedule := range i.CronSchedules() {
+		schedules = append(schedules, schedule.String())
+	}
+	return strings.Join(schedules, ", ")
 }
 
 // FriendlyAddress returns the Ethereum address if present, and a blank
@@ -675,20 +916,25 @@ type Tx struct {
 	Value     string          `json:"value,omitempty"`
 }
 
+// EthTxStateGasPriceCapped is the state shown for an unconfirmed transaction
+// which is held at its gas price ceiling, rather than being bumped further.
+const EthTxStateGasPriceCapped = "gas_price_capped"
+
 // EthTx is a jsonapi wrapper for an Ethereum Transaction.
 type EthTx struct {
-	ID       int64           `json:"-"`
-	State    string          `json:"state,omitempty"`
-	Data     hexutil.Bytes   `json:"data,omitempty"`
-	From     *common.Address `json:"from,omitempty"`
-	GasLimit string          `json:"gasLimit,omitempty"`
-	GasPrice string          `json:"gasPrice,omitempty"`
-	Hash     common.Hash     `json:"hash,omitempty"`
-	Hex      string          `json:"rawHex,omitempty"`
-	Nonce    string          `json:"nonce,omitempty"`
-	SentAt   string          `json:"sentAt,omitempty"`
-	To       *common.Address `json:"to,omitempty"`
-	Value    string          `json:"value,omitempty"`
+	ID        int64           `json:"-"`
+	State     string          `json:"state,omitempty"`
+	Data      hexutil.Bytes   `json:"data,omitempty"`
+	From      *common.Address `json:"from,omitempty"`
+	GasLimit  string          `json:"gasLimit,omitempty"`
+	GasPrice  string          `json:"gasPrice,omitempty"`
+	Hash      common.Hash     `json:"hash,omitempty"`
+	Hex       string          `json:"rawHex,omitempty"`
+	Nonce     string          `json:"nonce,omitempty"`
+	SentAt    string          `json:"sentAt,omitempty"`
+	To        *common.Address `json:"to,omitempty"`
+	Value     string          `json:"value,omitempty"`
+	Abandoned bool            `json:"abandoned,omitempty"`
 }
 
 // NewTx builds a transaction presenter.
@@ -736,20 +982,24 @@ func NewEthTxFromAttempt(txa models.EthTxAttempt) EthTx {
 
 func newEthTxWithAttempt(tx models.EthTx, txa models.EthTxAttempt) EthTx {
 	ethTX := EthTx{
-		Data:     hexutil.Bytes(tx.EncodedPayload),
-		From:     &tx.FromAddress,
-		GasLimit: strconv.FormatUint(tx.GasLimit, 10),
-		GasPrice: txa.GasPrice.String(),
-		Hash:     txa.Hash,
-		Hex:      hexutil.Encode(txa.SignedRawTx),
-		ID:       tx.ID,
-		State:    string(tx.State),
-		To:       &tx.ToAddress,
-		Value:    tx.Value.String(),
+		Data:      hexutil.Bytes(tx.EncodedPayload),
+		From:      &tx.FromAddress,
+		GasLimit:  strconv.FormatUint(tx.GasLimit, 10),
+		GasPrice:  txa.GasPrice.String(),
+		Hash:      txa.Hash,
+		Hex:       hexutil.Encode(txa.SignedRawTx),
+		ID:        tx.ID,
+		State:     string(tx.State),
+		To:        &tx.ToAddress,
+		Value:     tx.Value.String(),
+		Abandoned: tx.Abandoned,
 	}
 	if tx.Nonce != nil {
 		ethTX.Nonce = strconv.FormatUint(uint64(*tx.Nonce), 10)
 	}
+	if tx.GasPriceCapped && tx.State == models.EthTxUnconfirmed {
+		ethTX.State = EthTxStateGasPriceCapped
+	}
 	if txa.BroadcastBeforeBlockNum != nil {
 		ethTX.SentAt = strconv.FormatUint(uint64(*txa.BroadcastBeforeBlockNum), 10)
 	}
@@ -773,6 +1023,47 @@ func (t *EthTx) SetID(hex string) error {
 	return nil
 }
 
+// EthTxStateChange is streamed to clients of the transactions websocket when
+// an eth_tx is created or moves to another state. PreviousState is nil for a
+// newly created eth_tx, and Hash is that of its highest priced attempt, if any.
+type EthTxStateChange struct {
+	ID            int64          `json:"id"`
+	State         string         `json:"state"`
+	PreviousState *string        `json:"previousState"`
+	FromAddress   common.Address `json:"fromAddress"`
+	Nonce         *int64         `json:"nonce"`
+	Hash          *common.Hash   `json:"hash"`
+}
+
+// NonceGap compares the next nonce of a key tracked by the node with its
+// pending nonce on chain. FillerTransactions are the empty transactions sent
+// to use up the missing nonces when the gap is repaired.
+type NonceGap struct {
+	Address            common.Address `json:"address"`
+	LocalNonce         int64          `json:"localNonce"`
+	OnChainNonce       int64          `json:"onChainNonce"`
+	MissingNonces      []int64        `json:"missingNonces"`
+	Found              bool           `json:"found"`
+	FillerTransactions []EthTx        `json:"fillerTransactions,omitempty"`
+}
+
+// GetID returns the jsonapi ID.
+func (g NonceGap) GetID() string {
+	return g.Address.Hex()
+}
+
+// GetName returns the collection name for jsonapi.
+func (NonceGap) GetName() string {
+	return "nonce_gaps"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (g *NonceGap) SetID(value string) error {
+	g.Address = common.HexToAddress(value)
+	return nil
+}
+
 // GetID returns the jsonapi ID.
 func (t Tx) GetID() string {
 	return t.Hash.String()
@@ -835,6 +1126,30 @@ func (ei *ExternalInitiatorAuthentication) SetID(name string) error {
 	return nil
 }
 
+// Maintenance shows whether the node is in maintenance mode, and how many
+// runs are yet to finish draining.
+type Maintenance struct {
+	Enabled        bool       `json:"enabled"`
+	Since          *time.Time `json:"since,omitempty"`
+	UnfinishedRuns int        `json:"unfinishedRuns"`
+}
+
+// GetID returns the jsonapi ID, of which there is only the one.
+func (m Maintenance) GetID() string {
+	return "maintenance"
+}
+
+// GetName returns the collection name for jsonapi.
+func (m Maintenance) GetName() string {
+	return "maintenance"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (m *Maintenance) SetID(string) error {
+	return nil
+}
+
 // ExplorerStatus represents the connected server and status of the connection
 type ExplorerStatus struct {
 	Status string `json:"status"`
@@ -850,3 +1165,27 @@ func NewExplorerStatus(statsPusher synchronization.StatsPusher) ExplorerStatus {
 		Url:    url.String(),
 	}
 }
+
+// FluxMonitorState is the state of the checker of each fluxmonitor initiator
+// of a job.
+type FluxMonitorState struct {
+	JobSpecID  string                     `json:"-"`
+	Initiators []fluxmonitor.CheckerState `json:"initiators"`
+}
+
+// GetID returns the jsonapi ID.
+func (s FluxMonitorState) GetID() string {
+	return s.JobSpecID
+}
+
+// GetName returns the collection name for jsonapi.
+func (FluxMonitorState) GetName() string {
+	return "flux_monitor_states"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (s *FluxMonitorState) SetID(value string) error {
+	s.JobSpecID = value
+	return nil
+}
@@ -35,3 +35,23 @@ func TestTx_MarshalJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, want, string(b))
 }
+
+func TestInitiator_MarshalJSON_FluxMonitorFlagsContractAddress(t *testing.T) {
+	t.Parallel()
+
+	initr := Initiator{models.Initiator{Type: models.InitiatorFluxMonitor}}
+	b, err := json.Marshal(initr)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "flagsContractAddress")
+
+	address := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	initr.FlagsContractAddress = address
+	b, err = json.Marshal(initr)
+	assert.NoError(t, err)
+
+	var params struct {
+		FlagsContractAddress common.Address `json:"flagsContractAddress"`
+	}
+	assert.NoError(t, json.Unmarshal(b, &params))
+	assert.Equal(t, address, params.FlagsContractAddress)
+}
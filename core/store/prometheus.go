@@ -15,12 +15,63 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
-var promETHBalance = promauto.NewGaugeVec(
-	prometheus.GaugeOpts{
-		Name: "eth_balance",
-		Help: "Each Ethereum account's balance",
-	},
-	[]string{"account"},
+var (
+	promETHBalance = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "eth_balance",
+			Help: "Each Ethereum account's balance",
+		},
+		[]string{"account"},
+	)
+	promETHBalanceBelowMinimum = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "eth_balance_below_minimum",
+			Help: "Whether each Ethereum account's balance is below ALERT_MIN_ETH_BALANCE_WEI (1) or not (0)",
+		},
+		[]string{"account"},
+	)
+	promLINKBalance = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "link_balance",
+			Help: "Each Ethereum account's LINK balance",
+		},
+		[]string{"account"},
+	)
+	promJobLinkEarned = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "job_link_earned_total",
+			Help: "The LINK earned by each job from completed runs",
+		},
+		[]string{"job_spec_id"},
+	)
+	promJobGasSpent = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "job_gas_spent_eth_total",
+			Help: "The ETH spent on gas by each job's confirmed transactions",
+		},
+		[]string{"job_spec_id"},
+	)
+	promJobPaymentReceived = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "job_payment_received_link_total",
+			Help: "The LINK offered in payment by the requests received for each job",
+		},
+		[]string{"job_spec_id"},
+	)
+	promJobMinimumPayment = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "job_minimum_payment_link",
+			Help: "The minimum LINK payment last required of a request for each job",
+		},
+		[]string{"job_spec_id"},
+	)
+	promJobRunsUnderpaid = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "job_runs_underpaid_total",
+			Help: "The number of requests for each job rejected for paying less than the minimum",
+		},
+		[]string{"job_spec_id"},
+	)
 )
 
 func PromUpdateEthBalance(balance *assets.Eth, from common.Address) {
@@ -34,6 +85,80 @@ func PromUpdateEthBalance(balance *assets.Eth, from common.Address) {
 	promETHBalance.WithLabelValues(from.Hex()).Set(balanceFloat)
 }
 
+// PromUpdateEthBalanceBelowMinimum records whether the ETH balance of an
+// account is below the configured minimum.
+func PromUpdateEthBalanceBelowMinimum(below bool, from common.Address) {
+	var value float64
+	if below {
+		value = 1
+	}
+	promETHBalanceBelowMinimum.WithLabelValues(from.Hex()).Set(value)
+}
+
+// PromUpdateLinkBalance records the LINK balance of an account.
+func PromUpdateLinkBalance(balance *assets.Link, from common.Address) {
+	balanceFloat, err := approximateFloat64((*assets.Eth)(balance))
+	if err != nil {
+		logger.Error(fmt.Errorf("PromUpdateLinkBalance: %v", err))
+		return
+	}
+	promLINKBalance.WithLabelValues(from.Hex()).Set(balanceFloat)
+}
+
+// PromAddLinkEarned adds the payment of a completed run to the LINK earned by
+// its job.
+func PromAddLinkEarned(jobSpecID *models.ID, payment *assets.Link) {
+	if jobSpecID == nil || payment == nil {
+		return
+	}
+	earned, err := approximateFloat64((*assets.Eth)(payment))
+	if err != nil {
+		logger.Error(fmt.Errorf("PromAddLinkEarned: %v", err))
+		return
+	}
+	promJobLinkEarned.WithLabelValues(jobSpecID.String()).Add(earned)
+}
+
+// PromAddGasSpent adds the cost in wei of a confirmed transaction to the gas
+// spent by the job which sent it.
+func PromAddGasSpent(jobSpecID *models.ID, cost *big.Int) {
+	if jobSpecID == nil || cost == nil {
+		return
+	}
+	spent, err := approximateFloat64((*assets.Eth)(cost))
+	if err != nil {
+		logger.Error(fmt.Errorf("PromAddGasSpent: %v", err))
+		return
+	}
+	promJobGasSpent.WithLabelValues(jobSpecID.String()).Add(spent)
+}
+
+// PromObservePayment records the payment offered by a request for a job,
+// alongside the minimum payment the job required of it.
+func PromObservePayment(jobSpecID *models.ID, payment, minimum *assets.Link) {
+	if jobSpecID == nil || payment == nil || minimum == nil {
+		return
+	}
+	paymentFloat, err := approximateFloat64((*assets.Eth)(payment))
+	if err != nil {
+		logger.Error(fmt.Errorf("PromObservePayment: %v", err))
+		return
+	}
+	minimumFloat, err := approximateFloat64((*assets.Eth)(minimum))
+	if err != nil {
+		logger.Error(fmt.Errorf("PromObservePayment: %v", err))
+		return
+	}
+	id := jobSpecID.String()
+	promJobPaymentReceived.WithLabelValues(id).Add(paymentFloat)
+	promJobMinimumPayment.WithLabelValues(id).Set(minimumFloat)
+	if payment.Cmp(minimum) < 0 {
+		promJobRunsUnderpaid.WithLabelValues(id).Inc()
+	}
+}
+
+// approximateFloat64 converts an amount in the smallest unit of ETH or LINK,
+// both of which have 18 decimals, into an approximate number of whole units.
 func approximateFloat64(e *assets.Eth) (float64, error) {
 	ef := new(big.Float).SetInt(e.ToInt())
 	weif := new(big.Float).SetInt(models.WeiPerEth)
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +34,25 @@ func Test_approximateFloat64(t *testing.T) {
 		})
 	}
 }
+
+func TestPromObservePayment(t *testing.T) {
+	jobSpecID := models.NewID()
+	label := jobSpecID.String()
+
+	PromObservePayment(jobSpecID, assets.NewLink(1e18), assets.NewLink(2e18))
+	PromObservePayment(jobSpecID, assets.NewLink(3e18), assets.NewLink(2e18))
+
+	require.Equal(t, 4.0, testutil.ToFloat64(promJobPaymentReceived.WithLabelValues(label)))
+	require.Equal(t, 2.0, testutil.ToFloat64(promJobMinimumPayment.WithLabelValues(label)))
+	require.Equal(t, 1.0, testutil.ToFloat64(promJobRunsUnderpaid.WithLabelValues(label)))
+}
+
+func TestPromAddLinkEarned(t *testing.T) {
+	jobSpecID := models.NewID()
+
+	PromAddLinkEarned(jobSpecID, assets.NewLink(5e17))
+	PromAddLinkEarned(jobSpecID, assets.NewLink(5e17))
+	PromAddLinkEarned(jobSpecID, nil)
+
+	require.Equal(t, 1.0, testutil.ToFloat64(promJobLinkEarned.WithLabelValues(jobSpecID.String())))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -45,7 +46,16 @@ type Store struct {
 	EthClient      eth.Client
 	NotifyNewEthTx NotifyNewEthTx
 	AdvisoryLocker postgres.AdvisoryLocker
-	closeOnce      *sync.Once
+	HTTPCache      HTTPCache
+	// HTTPRateLimiter is shared by the HTTP tasks of all jobs, so that
+	// together they keep to the rate limit of each host
+	HTTPRateLimiter *utils.HTTPRateLimiter
+	// ClientCertificates are presented to servers which require mutual TLS
+	ClientCertificates *utils.ClientCertificates
+	// BridgeCircuitBreakers skip the external adapters of bridges which
+	// keep failing
+	BridgeCircuitBreakers *utils.CircuitBreakers
+	closeOnce             *sync.Once
 }
 
 // NewStore will create a new store
@@ -83,20 +93,34 @@ func newStoreWithKeyStore(
 		logger.Fatal(fmt.Sprintf("Unable to migrate key store to disk: %+v", e))
 	}
 
-	keyStore := keyStoreGenerator()
+	var keyStore KeyStoreInterface = keyStoreGenerator()
+	if url := config.EthRemoteSignerURL(); url != "" {
+		signer, err := NewRemoteTxSigner(url)
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("Unable to connect to remote signer: %+v", err))
+		}
+		keyStore, err = NewRemoteSigningKeyStore(keyStore, signer)
+		if err != nil {
+			logger.Fatal(fmt.Sprintf("Unable to list the accounts of remote signer: %+v", err))
+		}
+	}
 	txManager := NewEthTxManager(ethClient, config, keyStore, orm)
 	scryptParams := utils.GetScryptParams(config)
 
 	store := &Store{
-		Clock:          utils.Clock{},
-		AdvisoryLocker: advisoryLocker,
-		Config:         config,
-		KeyStore:       keyStore,
-		OCRKeyStore:    offchainreporting.NewKeyStore(orm.DB, scryptParams),
-		ORM:            orm,
-		TxManager:      txManager,
-		EthClient:      ethClient,
-		closeOnce:      &sync.Once{},
+		Clock:                 utils.Clock{},
+		AdvisoryLocker:        advisoryLocker,
+		Config:                config,
+		KeyStore:              keyStore,
+		OCRKeyStore:           offchainreporting.NewKeyStore(orm.DB, scryptParams),
+		ORM:                   orm,
+		TxManager:             txManager,
+		EthClient:             ethClient,
+		HTTPCache:             NewHTTPCache(config.HTTPCacheBackend(), orm.DB),
+		HTTPRateLimiter:       utils.NewHTTPRateLimiter(config.HTTPRateLimit(), config.HTTPRateLimitBurst()),
+		ClientCertificates:    utils.NewClientCertificates(config.ClientCertificatesDir()),
+		BridgeCircuitBreakers: utils.NewCircuitBreakers(),
+		closeOnce:             &sync.Once{},
 	}
 	store.VRFKeyStore = NewVRFKeyStore(store)
 	return store
@@ -109,10 +133,45 @@ func (s *Store) Start() error {
 			return err
 		}
 	} else {
-		s.TxManager.Register(s.KeyStore.Accounts())
+		s.TxManager.Register(s.SendingAccounts())
+	}
+
+	if err := s.SyncDiskKeyStoreToDB(); err != nil {
+		return err
+	}
+	if err := s.syncRemoteAccountsToDB(); err != nil {
+		return err
 	}
+	return s.AssignKeyChains(s.Config.EVMChains())
+}
 
-	return s.SyncDiskKeyStoreToDB()
+// SendingAccounts returns the accounts of the key store which send the
+// transactions of the store's chain.
+func (s *Store) SendingAccounts() []accounts.Account {
+	var sending []accounts.Account
+	for _, account := range s.KeyStore.Accounts() {
+		if s.Config.SendsFrom(account.Address) {
+			sending = append(sending, account)
+		}
+	}
+	return sending
+}
+
+// syncRemoteAccountsToDB adds the accounts of the remote signer, if
+// transactions are signed by one, to the keys the node sends from.
+func (s *Store) syncRemoteAccountsToDB() error {
+	if _, ok := s.KeyStore.(*remoteSigningKeyStore); !ok {
+		return nil
+	}
+	var merr error
+	for _, account := range s.KeyStore.Accounts() {
+		key, err := models.NewRemoteKey(account.Address)
+		if err == nil {
+			err = s.CreateKeyIfNotExists(key)
+		}
+		merr = multierr.Append(merr, err)
+	}
+	return merr
 }
 
 func setNonceFromLegacyTxManager(db *gorm.DB) error {
@@ -127,6 +186,9 @@ func setNonceFromLegacyTxManager(db *gorm.DB) error {
 func (s *Store) Close() error {
 	var err error
 	s.closeOnce.Do(func() {
+		if remote, ok := s.KeyStore.(*remoteSigningKeyStore); ok {
+			remote.Close()
+		}
 		err = s.ORM.Close()
 		err = multierr.Append(err, s.AdvisoryLocker.Close())
 	})
@@ -141,6 +203,19 @@ func (s *Store) Unscoped() *Store {
 	return &cpy
 }
 
+// ForChain returns a shallow copy of the store which sends transactions and
+// reads chain state through the given config, client and tx manager of
+// another chain, from the keys and with the heads of that chain. The
+// database, key stores and clock are shared.
+func (s *Store) ForChain(config *orm.Config, ethClient eth.Client, txManager TxManager) *Store {
+	cpy := *s
+	cpy.ORM = s.ORM.ForChain(config.ChainID())
+	cpy.Config = config
+	cpy.EthClient = ethClient
+	cpy.TxManager = txManager
+	return &cpy
+}
+
 // AuthorizedUserWithSession will return the one API user if the Session ID exists
 // and hasn't expired, and update session's LastUsed field.
 func (s *Store) AuthorizedUserWithSession(sessionID string) (models.User, error) {
@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tevino/abool"
@@ -29,6 +30,8 @@ import (
 	"gopkg.in/guregu/null.v3"
 )
 
+var txManagerLogger = logger.Module(logger.ModuleTxManager)
+
 const (
 	// Linear backoff is used so worst-case transaction time increases quadratically with this number
 	nonceReloadLimit int = 3
@@ -41,6 +44,14 @@ var (
 	// ErrPendingConnection is the error returned if TxManager is not connected.
 	ErrPendingConnection = errors.New("Cannot talk to chain, pending connection")
 
+	// ErrGasPriceCapped is the cause of the error returned by BumpGas when the
+	// bumped gas price would exceed ETH_MAX_GAS_PRICE_WEI.
+	ErrGasPriceCapped = errors.New("gas price capped")
+
+	// ErrGasBumpingDisabled is returned by BumpGas in ETH_PRIVATE_CHAIN_MODE,
+	// where gas is not priced.
+	ErrGasBumpingDisabled = errors.New("gas bumping is disabled in ETH_PRIVATE_CHAIN_MODE")
+
 	promNumGasBumps = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "tx_manager_num_gas_bumps",
 		Help: "Number of gas bumps",
@@ -169,24 +180,37 @@ func (txm *EthTxManager) Connect(bn *models.Head) error {
 	for _, attempt := range attempts {
 		ma := txm.getAccount(attempt.Tx.From)
 		if ma == nil {
-			logger.Warnf("Trying to rebroadcast tx %v, could not find account %v", attempt.Hash.Hex(), attempt.Tx.From.Hex())
+			txManagerLogger.Warnf("Trying to rebroadcast tx %v, could not find account %v", attempt.Hash.Hex(), attempt.Tx.From.Hex())
 			continue
 		} else if ma.Nonce() > attempt.Tx.Nonce {
 			// Do not rebroadcast txs with nonces that are lower than our current nonce
 			continue
+		} else if !txm.signedForChain(attempt.SignedRawTx) {
+			// Txs for other chains are rebroadcast by the tx manager of that chain
+			continue
 		}
 
-		logger.Infof("Rebroadcasting tx %v", attempt.Hash.Hex())
+		txManagerLogger.Infof("Rebroadcasting tx %v", attempt.Hash.Hex())
 
 		_, err = txm.SendRawTx(attempt.SignedRawTx)
 		if err != nil && !isNonceTooLowError(err) {
-			logger.Warnf("Failed to rebroadcast tx %v: %v", attempt.Hash.Hex(), err)
+			txManagerLogger.Warnf("Failed to rebroadcast tx %v: %v", attempt.Hash.Hex(), err)
 		}
 	}
 
 	return merr
 }
 
+// signedForChain returns false if the raw tx was signed for a chain other
+// than the one this tx manager is connected to.
+func (txm *EthTxManager) signedForChain(signedRawTx []byte) bool {
+	var tx types.Transaction
+	if err := rlp.DecodeBytes(signedRawTx, &tx); err != nil {
+		return true
+	}
+	return tx.ChainId().Cmp(txm.config.ChainID()) == 0
+}
+
 // Disconnect marks this instance as disconnected.
 func (txm *EthTxManager) Disconnect() {
 	if txm.config.EnableBulletproofTxManager() {
@@ -279,7 +303,7 @@ func (txm *EthTxManager) createTx(
 			return nil, errors.Wrap(err, "TxManager#retryInitialTx sendInitialTx")
 		}
 
-		logger.Warnw(
+		txManagerLogger.Warnw(
 			"Tx #0: another tx with this nonce already exists, will retry with network nonce",
 			"nonce", tx.Nonce, "gasPriceWei", gasPriceWei, "gasLimit", gasLimit, "error", err.Error(),
 		)
@@ -287,7 +311,7 @@ func (txm *EthTxManager) createTx(
 		// Linear backoff
 		time.Sleep(time.Duration(nrc+1) * nonceReloadBackoffBaseTime)
 
-		logger.Warnw(
+		txManagerLogger.Warnw(
 			"Tx #0: another tx with this nonce already exists, retrying with network nonce",
 			"nonce", tx.Nonce, "gasPriceWei", gasPriceWei, "gasLimit", gasLimit, "error", err.Error(),
 		)
@@ -351,7 +375,7 @@ func (txm *EthTxManager) sendInitialTx(
 			return errors.Wrap(e, "TxManager#sendInitialTx AddTxAttempt")
 		}
 
-		logger.Debugw("Added Tx attempt #0", "txID", tx.ID, "txAttemptID", txAttempt.ID)
+		txManagerLogger.Debugw("Added Tx attempt #0", "txID", tx.ID, "txAttemptID", txAttempt.ID)
 
 		return nil
 	})
@@ -577,7 +601,7 @@ func (txm *EthTxManager) processAttempt(
 		return receipt, state, txm.handleSafe(tx, attemptIndex)
 
 	case Confirmed:
-		logger.Debugw(
+		txManagerLogger.Debugw(
 			fmt.Sprintf("Tx #%d is %s", attemptIndex, state),
 			"txHash", txAttempt.Hash.String(),
 			"txID", txAttempt.TxID,
@@ -592,7 +616,7 @@ func (txm *EthTxManager) processAttempt(
 	case Unconfirmed:
 		attemptLimit := txm.config.TxAttemptLimit()
 		if attemptIndex >= int(attemptLimit) {
-			logger.Warnw(
+			txManagerLogger.Warnw(
 				fmt.Sprintf("Tx #%d is %s, has met TxAttemptLimit", attemptIndex, state),
 				"txAttemptLimit", attemptLimit,
 				"txHash", txAttempt.Hash.String(),
@@ -603,7 +627,7 @@ func (txm *EthTxManager) processAttempt(
 		}
 
 		if isLatestAttempt(tx, attemptIndex) && txm.hasTxAttemptMetGasBumpThreshold(tx, attemptIndex, blockHeight) {
-			logger.Debugw(
+			txManagerLogger.Debugw(
 				fmt.Sprintf("Tx #%d is %s, bumping gas", attemptIndex, state),
 				"txHash", txAttempt.Hash.String(),
 				"txID", txAttempt.TxID,
@@ -612,7 +636,7 @@ func (txm *EthTxManager) processAttempt(
 			)
 			err = txm.bumpGas(tx, attemptIndex, blockHeight)
 		} else {
-			logger.Debugw(
+			txManagerLogger.Debugw(
 				fmt.Sprintf("Tx #%d is %s", attemptIndex, state),
 				"txHash", txAttempt.Hash.String(),
 				"txID", txAttempt.TxID,
@@ -623,7 +647,7 @@ func (txm *EthTxManager) processAttempt(
 		return receipt, state, err
 
 	default:
-		logger.Debugw(
+		txManagerLogger.Debugw(
 			fmt.Sprintf("Tx #%d is %s, error fetching receipt", attemptIndex, state),
 			"txHash", txAttempt.Hash.String(),
 			"txID", txAttempt.TxID,
@@ -650,6 +674,9 @@ func (txm *EthTxManager) hasTxAttemptMetGasBumpThreshold(
 	attemptIndex int,
 	blockHeight uint64) bool {
 
+	if txm.config.EthPrivateChainMode() {
+		return false
+	}
 	gasBumpThreshold := txm.config.EthGasBumpThreshold()
 	txAttempt := tx.Attempts[attemptIndex]
 
@@ -674,7 +701,7 @@ func (txm *EthTxManager) handleSafe(
 	}
 
 	minimumConfirmations := txm.config.MinRequiredOutgoingConfirmations()
-	logger.Infow(
+	txManagerLogger.Infow(
 		fmt.Sprintf("Tx #%d is safe", attemptIndex),
 		"minimumConfirmations", minimumConfirmations,
 		"txHash", txAttempt.Hash.String(),
@@ -694,6 +721,9 @@ func (txm *EthTxManager) BumpGasByIncrement(originalGasPrice *big.Int) *big.Int
 // - A configured fixed amount of Wei (ETH_GAS_PRICE_WEI) on top of the baseline price.
 // The baseline price is the maximum of the previous gas price attempt and the node's current gas price.
 func BumpGas(config orm.ConfigReader, originalGasPrice *big.Int) (*big.Int, error) {
+	if config.EthPrivateChainMode() {
+		return originalGasPrice, ErrGasBumpingDisabled
+	}
 	baselinePrice := max(originalGasPrice, config.EthGasPriceDefault())
 
 	var priceByPercentage = new(big.Int)
@@ -705,7 +735,7 @@ func BumpGas(config orm.ConfigReader, originalGasPrice *big.Int) (*big.Int, erro
 
 	bumpedGasPrice := max(priceByPercentage, priceByIncrement)
 	if bumpedGasPrice.Cmp(config.EthMaxGasPriceWei()) > 0 {
-		return config.EthMaxGasPriceWei(), errors.Errorf("bumped gas price of %s would exceed configured max gas price of %s (original price was %s)",
+		return config.EthMaxGasPriceWei(), errors.Wrapf(ErrGasPriceCapped, "bumped gas price of %s would exceed configured max gas price of %s (original price was %s)",
 			bumpedGasPrice.String(), config.EthMaxGasPriceWei(), originalGasPrice.String())
 	} else if bumpedGasPrice.Cmp(originalGasPrice) == 0 {
 		// NOTE: This really shouldn't happen since we enforce minimums for
@@ -741,7 +771,7 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 			// until CHAINLINK_TX_ATTEMPT_LIMIT is reached
 			promGasBumpExceedsLimit.Inc()
 			err := fmt.Errorf("bumped gas price of %v would exceed maximum configured limit of %v, set by ETH_MAX_GAS_PRICE_WEI", bumpedGasPrice, txm.config.EthMaxGasPriceWei())
-			logger.Error(err)
+			txManagerLogger.Error(err)
 			return err
 		}
 		bumpedTxAttempt, err := txm.createAttempt(tx, bumpedGasPrice, blockHeight)
@@ -749,7 +779,7 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 			// This is not expected if we have bumped at least geth's required
 			// amount.
 			promGasBumpUnderpricedReplacement.Inc()
-			logger.Warnw(fmt.Sprintf("Gas bump was rejected by ethereum node as underpriced, bumping again. Your value of ETH_GAS_BUMP_PERCENT (%v) may be set too low", txm.config.EthGasBumpPercent()),
+			txManagerLogger.Warnw(fmt.Sprintf("Gas bump was rejected by ethereum node as underpriced, bumping again. Your value of ETH_GAS_BUMP_PERCENT (%v) may be set too low", txm.config.EthGasBumpPercent()),
 				"originalGasPrice", originalGasPrice, "bumpedGasPrice", bumpedGasPrice,
 			)
 			bumpedGasPrice = txm.BumpGasByIncrement(bumpedGasPrice)
@@ -758,11 +788,11 @@ func (txm *EthTxManager) bumpGas(tx *models.Tx, attemptIndex int, blockHeight ui
 		if err != nil {
 			promTxAttemptFailed.Inc()
 			e := errors.Wrapf(err, "bumpGas from Tx #%s", txAttempt.Hash.Hex())
-			logger.Error(e)
+			txManagerLogger.Error(e)
 			return e
 		}
 
-		logger.Infow(
+		txManagerLogger.Infow(
 			fmt.Sprintf("Tx #%d created with bumped gas %v", attemptIndex+1, bumpedGasPrice),
 			"originalTxHash", txAttempt.Hash,
 			"newTxHash", bumpedTxAttempt.Hash)
@@ -806,7 +836,7 @@ func (txm *EthTxManager) createAttempt(
 		return nil, errors.Wrap(err, "createAttempt#AddTxAttempt failed")
 	}
 
-	logger.Debugw(fmt.Sprintf("Added Tx attempt #%d", len(tx.Attempts)+1), "txID", tx.ID, "txAttemptID", txAttempt.ID)
+	txManagerLogger.Debugw(fmt.Sprintf("Added Tx attempt #%d", len(tx.Attempts)+1), "txID", tx.ID, "txAttemptID", txAttempt.ID)
 
 	return txAttempt, nil
 }
@@ -878,7 +908,7 @@ func (a *ManagedAccount) ReloadNonce(txm *EthTxManager) error {
 	if err != nil {
 		return fmt.Errorf("TxManager ReloadNonce: %v", err)
 	}
-	logger.Debugw("Got new network nonce", "nonce", nonce)
+	txManagerLogger.Debugw("Got new network nonce", "nonce", nonce)
 	a.nonce = nonce
 	return nil
 }
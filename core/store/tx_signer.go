@@ -0,0 +1,328 @@
+package store
+
+import (
+	"context"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/txsigner"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// remoteSignerTimeout is how long to wait for the remote signer to sign a
+// transaction before giving up
+const remoteSignerTimeout = 15 * time.Second
+
+// ErrRemoteSigner is returned by the key store operations which need the
+// keys themselves, when the keys are held by a remote signer.
+var ErrRemoteSigner = errors.New("not supported when transactions are signed by a remote signer")
+
+// TxSigner signs transactions on behalf of the node's accounts
+type TxSigner interface {
+	SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// RemoteTxSigner signs transactions with an external signing service, which
+// holds the keys of the node's accounts.
+type RemoteTxSigner interface {
+	TxSigner
+	// Accounts returns the accounts the service signs for.
+	Accounts() ([]accounts.Account, error)
+	// Close disconnects from the signing service.
+	Close()
+}
+
+// NewRemoteTxSigner returns a RemoteTxSigner for the signing service at url.
+// Services with a grpc:// or grpcs:// URL implement the TxSigner gRPC service
+// of the txsigner package. Any other URL is of a service, such as Clef or
+// Web3Signer, implementing the eth_accounts and eth_signTransaction JSON-RPC
+// methods over HTTP(S) or websockets.
+func NewRemoteTxSigner(rawurl string) (RemoteTxSigner, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer URL")
+	}
+	switch u.Scheme {
+	case "grpc", "grpcs":
+		return newGRPCTxSigner(u)
+	default:
+		return newJSONRPCTxSigner(rawurl)
+	}
+}
+
+// jsonRPCTxSigner signs transactions through the eth_signTransaction JSON-RPC
+// method.
+type jsonRPCTxSigner struct {
+	client *rpc.Client
+}
+
+func newJSONRPCTxSigner(url string) (*jsonRPCTxSigner, error) {
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to dial remote signer")
+	}
+	return &jsonRPCTxSigner{client: client}, nil
+}
+
+type signTransactionArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	Data     hexutil.Bytes   `json:"data"`
+	ChainID  *hexutil.Big    `json:"chainId"`
+}
+
+type signTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// Accounts asks the signing service for the accounts it holds.
+func (s *jsonRPCTxSigner) Accounts() ([]accounts.Account, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	var addresses []common.Address
+	if err := s.client.CallContext(ctx, &addresses, "eth_accounts"); err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to list accounts")
+	}
+	return accountsFor(addresses), nil
+}
+
+// SignTx asks the signing service to sign tx with the key of account.
+func (s *jsonRPCTxSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	args := signTransactionArgs{
+		From:     account.Address,
+		To:       tx.To(),
+		Gas:      hexutil.Uint64(tx.Gas()),
+		GasPrice: (*hexutil.Big)(tx.GasPrice()),
+		Value:    (*hexutil.Big)(tx.Value()),
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		Data:     tx.Data(),
+		ChainID:  (*hexutil.Big)(chainID),
+	}
+	var result signTransactionResult
+	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to sign transaction")
+	}
+	return checkRemotelySigned(account, tx, chainID, result.Raw)
+}
+
+// Close disconnects from the signing service.
+func (s *jsonRPCTxSigner) Close() {
+	s.client.Close()
+}
+
+// grpcTxSigner signs transactions through the TxSigner gRPC service.
+type grpcTxSigner struct {
+	conn   *grpc.ClientConn
+	client txsigner.TxSignerClient
+}
+
+func newGRPCTxSigner(u *url.URL) (*grpcTxSigner, error) {
+	var opts []grpc.DialOption
+	if u.Scheme == "grpcs" {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.Dial(u.Host, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to dial remote signer")
+	}
+	return &grpcTxSigner{conn: conn, client: txsigner.NewTxSignerClient(conn)}, nil
+}
+
+// Accounts asks the signing service for the accounts it holds.
+func (s *grpcTxSigner) Accounts() ([]accounts.Account, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	response, err := s.client.Accounts(ctx, &txsigner.AccountsRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to list accounts")
+	}
+	addresses := make([]common.Address, len(response.Addresses))
+	for i, address := range response.Addresses {
+		if len(address) != common.AddressLength {
+			return nil, errors.Errorf("remote signer returned an invalid address %x", address)
+		}
+		addresses[i] = common.BytesToAddress(address)
+	}
+	return accountsFor(addresses), nil
+}
+
+// SignTx asks the signing service to sign tx with the key of account.
+func (s *grpcTxSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	unsigned, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to encode transaction")
+	}
+	response, err := s.client.SignTransaction(ctx, &txsigner.SignTransactionRequest{
+		From:        account.Address.Bytes(),
+		Transaction: unsigned,
+		ChainId:     chainID.Bytes(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to sign transaction")
+	}
+	return checkRemotelySigned(account, tx, chainID, response.SignedTransaction)
+}
+
+// Close disconnects from the signing service.
+func (s *grpcTxSigner) Close() {
+	_ = s.conn.Close()
+}
+
+// checkRemotelySigned decodes the transaction returned by a signing service,
+// checking that it is tx, signed by account for chainID, so that a
+// misbehaving signer cannot make the node send something else.
+func checkRemotelySigned(account accounts.Account, tx *types.Transaction, chainID *big.Int, raw []byte) (*types.Transaction, error) {
+	signed := new(types.Transaction)
+	if err := rlp.DecodeBytes(raw, signed); err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid transaction")
+	}
+	signer := types.NewEIP155Signer(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer returned a different transaction than the one it was asked to sign")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid signature")
+	}
+	if sender != account.Address {
+		return nil, errors.Errorf("remote signer signed transaction with %s instead of %s", sender.Hex(), account.Address.Hex())
+	}
+	return signed, nil
+}
+
+func accountsFor(addresses []common.Address) []accounts.Account {
+	accts := make([]accounts.Account, len(addresses))
+	for i, address := range addresses {
+		accts[i] = accounts.Account{Address: address}
+	}
+	return accts
+}
+
+// remoteSigningKeyStore is a key store whose accounts are those of a remote
+// signer, which signs their transactions. The accounts are listed once, when
+// the key store is created, so accounts added to the signer are only used
+// once the node restarts. The local key store is only used to check the
+// node's password.
+type remoteSigningKeyStore struct {
+	local    KeyStoreInterface
+	signer   RemoteTxSigner
+	accounts []accounts.Account
+}
+
+// NewRemoteSigningKeyStore returns a key store with the accounts of signer,
+// whose transactions are signed by it. Operations which need the keys
+// themselves, such as creating, importing and exporting accounts or signing
+// hashes, return ErrRemoteSigner.
+func NewRemoteSigningKeyStore(local KeyStoreInterface, signer RemoteTxSigner) (KeyStoreInterface, error) {
+	accts, err := signer.Accounts()
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSigningKeyStore{local: local, signer: signer, accounts: accts}, nil
+}
+
+// Accounts returns the accounts of the remote signer.
+func (ks *remoteSigningKeyStore) Accounts() []accounts.Account {
+	return append([]accounts.Account(nil), ks.accounts...)
+}
+
+// GetAccounts returns the accounts of the remote signer.
+func (ks *remoteSigningKeyStore) GetAccounts() []accounts.Account {
+	return ks.Accounts()
+}
+
+// Wallets returns no wallets, as the keys are held by the remote signer.
+func (ks *remoteSigningKeyStore) Wallets() []accounts.Wallet {
+	return nil
+}
+
+// HasAccounts returns true if the remote signer has any accounts.
+func (ks *remoteSigningKeyStore) HasAccounts() bool {
+	return len(ks.accounts) > 0
+}
+
+// GetFirstAccount returns the first account of the remote signer.
+func (ks *remoteSigningKeyStore) GetFirstAccount() (accounts.Account, error) {
+	if len(ks.accounts) == 0 {
+		return accounts.Account{}, errors.New("remote signer has no accounts")
+	}
+	return ks.accounts[0], nil
+}
+
+// GetAccountByAddress returns the account of the remote signer with the
+// given address.
+func (ks *remoteSigningKeyStore) GetAccountByAddress(address common.Address) (accounts.Account, error) {
+	for _, account := range ks.accounts {
+		if account.Address == address {
+			return account, nil
+		}
+	}
+	return accounts.Account{}, errors.Errorf("remote signer has no account %s", address.Hex())
+}
+
+// Unlock checks the password against the local key store, as the remote
+// signer needs none.
+func (ks *remoteSigningKeyStore) Unlock(phrase string) error {
+	return ks.local.Unlock(phrase)
+}
+
+// NewAccount returns ErrRemoteSigner, as accounts are created on the signer.
+func (ks *remoteSigningKeyStore) NewAccount(passphrase string) (accounts.Account, error) {
+	return accounts.Account{}, ErrRemoteSigner
+}
+
+// Import returns ErrRemoteSigner, as keys are imported into the signer.
+func (ks *remoteSigningKeyStore) Import(keyJSON []byte, passphrase, newPassphrase string) (accounts.Account, error) {
+	return accounts.Account{}, ErrRemoteSigner
+}
+
+// Export returns ErrRemoteSigner, as the keys never leave the signer.
+func (ks *remoteSigningKeyStore) Export(a accounts.Account, passphrase, newPassphrase string) ([]byte, error) {
+	return nil, ErrRemoteSigner
+}
+
+// SignHash returns ErrRemoteSigner, as only transactions are signed remotely.
+func (ks *remoteSigningKeyStore) SignHash(hash common.Hash) (models.Signature, error) {
+	return models.Signature{}, ErrRemoteSigner
+}
+
+// SignHashWithAccount returns ErrRemoteSigner, as only transactions are
+// signed remotely.
+func (ks *remoteSigningKeyStore) SignHashWithAccount(account accounts.Account, hash common.Hash) (models.Signature, error) {
+	return models.Signature{}, ErrRemoteSigner
+}
+
+// SignTx signs tx with the remote signer.
+func (ks *remoteSigningKeyStore) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return ks.signer.SignTx(account, tx, chainID)
+}
+
+// Close disconnects from the remote signer.
+func (ks *remoteSigningKeyStore) Close() {
+	ks.signer.Close()
+}
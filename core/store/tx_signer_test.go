@@ -0,0 +1,210 @@
+package store_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store"
+	"github.com/smartcontractkit/chainlink/core/store/txsigner"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type signTransactionArgs struct {
+	To       *common.Address
+	Gas      hexutil.Uint64
+	GasPrice *hexutil.Big
+	Value    *hexutil.Big
+	Nonce    hexutil.Uint64
+	Data     hexutil.Bytes
+	ChainID  *hexutil.Big
+}
+
+// fakeSigner implements eth_signTransaction like Clef does
+type fakeSigner struct {
+	key        *ecdsa.PrivateKey
+	bumpsNonce bool
+}
+
+func (s *fakeSigner) Accounts() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(s.key.PublicKey)}
+}
+
+func (s *fakeSigner) SignTransaction(args signTransactionArgs) (map[string]interface{}, error) {
+	tx := types.NewTransaction(uint64(args.Nonce), *args.To, args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), args.Data)
+	raw, err := s.sign(tx, args.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"raw": hexutil.Bytes(raw)}, nil
+}
+
+func (s *fakeSigner) sign(tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	if s.bumpsNonce {
+		tx = types.NewTransaction(tx.Nonce()+1, *tx.To(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+	}
+	signed, err := types.SignTx(tx, types.NewEIP155Signer(chainID), s.key)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(signed)
+}
+
+// fakeGRPCSigner implements the TxSigner gRPC service
+type fakeGRPCSigner struct {
+	txsigner.UnimplementedTxSignerServer
+	*fakeSigner
+}
+
+func (s *fakeGRPCSigner) Accounts(context.Context, *txsigner.AccountsRequest) (*txsigner.AccountsResponse, error) {
+	return &txsigner.AccountsResponse{Addresses: [][]byte{crypto.PubkeyToAddress(s.key.PublicKey).Bytes()}}, nil
+}
+
+func (s *fakeGRPCSigner) SignTransaction(_ context.Context, request *txsigner.SignTransactionRequest) (*txsigner.SignTransactionResponse, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(request.Transaction, tx); err != nil {
+		return nil, err
+	}
+	raw, err := s.sign(tx, new(big.Int).SetBytes(request.ChainId))
+	if err != nil {
+		return nil, err
+	}
+	return &txsigner.SignTransactionResponse{SignedTransaction: raw}, nil
+}
+
+func newGRPCRemoteSigner(t *testing.T, service *fakeSigner) (store.RemoteTxSigner, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	txsigner.RegisterTxSignerServer(server, &fakeGRPCSigner{fakeSigner: service})
+	go server.Serve(listener)
+
+	signer, err := store.NewRemoteTxSigner("grpc://" + listener.Addr().String())
+	require.NoError(t, err)
+	return signer, func() {
+		signer.Close()
+		server.Stop()
+	}
+}
+
+func newRemoteSigner(t *testing.T, service *fakeSigner) (store.RemoteTxSigner, func()) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", service))
+	httpServer := httptest.NewServer(server)
+
+	signer, err := store.NewRemoteTxSigner(httpServer.URL)
+	require.NoError(t, err)
+	return signer, func() {
+		signer.Close()
+		httpServer.Close()
+		server.Stop()
+	}
+}
+
+func TestRemoteTxSigner_SignTx(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	account := accounts.Account{Address: crypto.PubkeyToAddress(key.PublicKey)}
+	chainID := big.NewInt(3)
+	tx := types.NewTransaction(7, cltest.NewAddress(), big.NewInt(0), 21000, big.NewInt(20000000000), []byte{1, 2, 3})
+
+	signers := []struct {
+		name      string
+		newSigner func(*testing.T, *fakeSigner) (store.RemoteTxSigner, func())
+	}{
+		{"json-rpc", newRemoteSigner},
+		{"grpc", newGRPCRemoteSigner},
+	}
+
+	for _, test := range signers {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Run("returns the accounts of the remote signer", func(t *testing.T) {
+				signer, cleanup := test.newSigner(t, &fakeSigner{key: key})
+				defer cleanup()
+
+				accounts, err := signer.Accounts()
+				require.NoError(t, err)
+				require.Len(t, accounts, 1)
+				assert.Equal(t, account.Address, accounts[0].Address)
+			})
+
+			t.Run("returns the transaction signed by the remote signer", func(t *testing.T) {
+				signer, cleanup := test.newSigner(t, &fakeSigner{key: key})
+				defer cleanup()
+
+				signed, err := signer.SignTx(account, tx, chainID)
+				require.NoError(t, err)
+
+				sender, err := types.Sender(types.NewEIP155Signer(chainID), signed)
+				require.NoError(t, err)
+				assert.Equal(t, account.Address, sender)
+				assert.Equal(t, tx.Nonce(), signed.Nonce())
+				assert.Equal(t, tx.Data(), signed.Data())
+			})
+
+			t.Run("rejects a transaction which differs from the one to sign", func(t *testing.T) {
+				signer, cleanup := test.newSigner(t, &fakeSigner{key: key, bumpsNonce: true})
+				defer cleanup()
+
+				_, err := signer.SignTx(account, tx, chainID)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "different transaction")
+			})
+
+			t.Run("rejects a transaction signed with another key", func(t *testing.T) {
+				otherKey, err := crypto.GenerateKey()
+				require.NoError(t, err)
+				signer, cleanup := test.newSigner(t, &fakeSigner{key: otherKey})
+				defer cleanup()
+
+				_, err = signer.SignTx(account, tx, chainID)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "instead of")
+			})
+		})
+	}
+}
+
+func TestRemoteSigningKeyStore(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	signer, cleanup := newRemoteSigner(t, &fakeSigner{key: key})
+	defer cleanup()
+	keyStore, err := store.NewRemoteSigningKeyStore(store.NewInsecureKeyStore(t.TempDir()), signer)
+	require.NoError(t, err)
+
+	assert.True(t, keyStore.HasAccounts())
+	account, err := keyStore.GetFirstAccount()
+	require.NoError(t, err)
+	assert.Equal(t, address, account.Address)
+	_, err = keyStore.GetAccountByAddress(address)
+	require.NoError(t, err)
+	_, err = keyStore.GetAccountByAddress(cltest.NewAddress())
+	require.Error(t, err)
+
+	_, err = keyStore.NewAccount(cltest.Password)
+	assert.Equal(t, store.ErrRemoteSigner, err)
+	_, err = keyStore.SignHash(common.Hash{})
+	assert.Equal(t, store.ErrRemoteSigner, err)
+}
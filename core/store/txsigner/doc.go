@@ -0,0 +1,5 @@
+// Package txsigner is the gRPC interface of external transaction signing
+// services, which hold the keys of the node's accounts.
+package txsigner
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative txsigner.proto
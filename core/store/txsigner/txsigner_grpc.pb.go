@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package txsigner
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// TxSignerClient is the client API for TxSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TxSignerClient interface {
+	// Accounts returns the addresses of the accounts the service signs for.
+	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	// SignTransaction signs a transaction with the key of one of the accounts.
+	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+}
+
+type txSignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxSignerClient(cc grpc.ClientConnInterface) TxSignerClient {
+	return &txSignerClient{cc}
+}
+
+func (c *txSignerClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := c.cc.Invoke(ctx, "/txsigner.TxSigner/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txSignerClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	err := c.cc.Invoke(ctx, "/txsigner.TxSigner/SignTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxSignerServer is the server API for TxSigner service.
+// All implementations must embed UnimplementedTxSignerServer
+// for forward compatibility
+type TxSignerServer interface {
+	// Accounts returns the addresses of the accounts the service signs for.
+	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
+	// SignTransaction signs a transaction with the key of one of the accounts.
+	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
+	mustEmbedUnimplementedTxSignerServer()
+}
+
+// UnimplementedTxSignerServer must be embedded to have forward compatible implementations.
+type UnimplementedTxSignerServer struct {
+}
+
+func (UnimplementedTxSignerServer) Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (UnimplementedTxSignerServer) SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+}
+func (UnimplementedTxSignerServer) mustEmbedUnimplementedTxSignerServer() {}
+
+// UnsafeTxSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TxSignerServer will
+// result in compilation errors.
+type UnsafeTxSignerServer interface {
+	mustEmbedUnimplementedTxSignerServer()
+}
+
+func RegisterTxSignerServer(s grpc.ServiceRegistrar, srv TxSignerServer) {
+	s.RegisterService(&_TxSigner_serviceDesc, srv)
+}
+
+func _TxSigner_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxSignerServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/txsigner.TxSigner/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxSignerServer).Accounts(ctx, req.(*AccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxSigner_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxSignerServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/txsigner.TxSigner/SignTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxSignerServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TxSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "txsigner.TxSigner",
+	HandlerType: (*TxSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Accounts",
+			Handler:    _TxSigner_Accounts_Handler,
+		},
+		{
+			MethodName: "SignTransaction",
+			Handler:    _TxSigner_SignTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "txsigner.proto",
+}
@@ -54,6 +54,21 @@ func (ks *VRFKeyStore) GenerateProof(k vrfkey.PublicKey, i vrf.PreSeedData) (
 	return privateKey.MarshaledProof(i)
 }
 
+// HasKey returns true if k is unlocked in memory or stored in the db, so that
+// it will be able to generate proofs once the node has been unlocked.
+func (ks *VRFKeyStore) HasKey(k vrfkey.PublicKey) (bool, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if _, found := ks.keys[k]; found {
+		return true, nil
+	}
+	keys, err := ks.get(k)
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
 // Unlock tries to unlock each vrf key in the db, using the given pass phrase,
 // and returns any keys it manages to unlock, and any errors which result.
 func (ks *VRFKeyStore) Unlock(phrase string) (keysUnlocked []vrfkey.PublicKey,
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CircuitBreakers keep track of the consecutive failures of named services,
+// such as external adapters, so that one which keeps failing is skipped for a
+// while rather than every request to it waiting on it to fail again. Once the
+// cooldown of an open circuit has passed a request is let through, which
+// closes the circuit if it succeeds, or opens it again if it fails.
+type CircuitBreakers struct {
+	circuits map[string]*circuit
+	mutex    sync.Mutex
+}
+
+type circuit struct {
+	failures  uint32
+	openUntil time.Time
+}
+
+// NewCircuitBreakers returns CircuitBreakers with every circuit closed.
+func NewCircuitBreakers() *CircuitBreakers {
+	return &CircuitBreakers{circuits: make(map[string]*circuit)}
+}
+
+// Allow returns an error if the circuit of name is open at now.
+func (b *CircuitBreakers) Allow(name string, now time.Time) error {
+	if b == nil {
+		return nil
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	c, ok := b.circuits[name]
+	if !ok || !now.Before(c.openUntil) {
+		return nil
+	}
+	return fmt.Errorf("circuit open after %d consecutive failures, until %s", c.failures, ISO8601UTC(c.openUntil))
+}
+
+// Record records the outcome of a request to name, opening its circuit for
+// cooldown once threshold requests in a row have failed. A threshold of 0
+// never opens the circuit.
+func (b *CircuitBreakers) Record(name string, failed bool, threshold uint32, cooldown time.Duration, now time.Time) {
+	if b == nil {
+		return
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !failed {
+		delete(b.circuits, name)
+		return
+	}
+	c, ok := b.circuits[name]
+	if !ok {
+		c = &circuit{}
+		b.circuits[name] = c
+	}
+	c.failures++
+	if threshold > 0 && c.failures >= threshold {
+		c.openUntil = now.Add(cooldown)
+	}
+}
@@ -0,0 +1,58 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)
+	cooldown := time.Minute
+
+	t.Run("opens after the threshold of consecutive failures", func(t *testing.T) {
+		breakers := utils.NewCircuitBreakers()
+		breakers.Record("a", true, 2, cooldown, now)
+		assert.NoError(t, breakers.Allow("a", now))
+		breakers.Record("a", true, 2, cooldown, now)
+		assert.EqualError(t, breakers.Allow("a", now), "circuit open after 2 consecutive failures, until 2020-12-01T00:01:00Z")
+		assert.NoError(t, breakers.Allow("b", now))
+	})
+
+	t.Run("successes reset the failures", func(t *testing.T) {
+		breakers := utils.NewCircuitBreakers()
+		breakers.Record("a", true, 2, cooldown, now)
+		breakers.Record("a", false, 2, cooldown, now)
+		breakers.Record("a", true, 2, cooldown, now)
+		assert.NoError(t, breakers.Allow("a", now))
+	})
+
+	t.Run("lets a request through after the cooldown", func(t *testing.T) {
+		breakers := utils.NewCircuitBreakers()
+		breakers.Record("a", true, 1, cooldown, now)
+		assert.Error(t, breakers.Allow("a", now.Add(cooldown/2)))
+
+		later := now.Add(cooldown)
+		assert.NoError(t, breakers.Allow("a", later))
+		breakers.Record("a", true, 1, cooldown, later)
+		assert.Error(t, breakers.Allow("a", later))
+
+		later = later.Add(cooldown)
+		assert.NoError(t, breakers.Allow("a", later))
+		breakers.Record("a", false, 1, cooldown, later)
+		assert.NoError(t, breakers.Allow("a", later))
+	})
+
+	t.Run("never opens with a threshold of zero", func(t *testing.T) {
+		breakers := utils.NewCircuitBreakers()
+		for i := 0; i < 10; i++ {
+			breakers.Record("a", true, 0, cooldown, now)
+		}
+		assert.NoError(t, breakers.Allow("a", now))
+	})
+}
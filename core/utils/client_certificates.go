@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+)
+
+var clientCertificateNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// ClientCertificates holds the certificates the node presents to servers
+// which require mutual TLS. Each is kept in a directory as a pair of PEM
+// files, <name>.crt and <name>.key, and referred to by name. The files are
+// read again whenever they change, so that certificates can be rotated
+// without restarting the node.
+type ClientCertificates struct {
+	dir    string
+	loaded map[string]loadedClientCertificate
+	mutex  sync.Mutex
+}
+
+type loadedClientCertificate struct {
+	certificate *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+// NewClientCertificates returns the ClientCertificates kept in dir.
+func NewClientCertificates(dir string) *ClientCertificates {
+	return &ClientCertificates{
+		dir:    dir,
+		loaded: make(map[string]loadedClientCertificate),
+	}
+}
+
+// TLSConfig returns the TLS configuration of a client presenting the named
+// certificate. It errors if the certificate does not exist.
+func (cc *ClientCertificates) TLSConfig(name string) (*tls.Config, error) {
+	if _, err := cc.Get(name); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return cc.Get(name)
+		},
+	}, nil
+}
+
+// Get returns the named certificate, reading it from its files if they
+// have changed since it was last read.
+func (cc *ClientCertificates) Get(name string) (*tls.Certificate, error) {
+	if !clientCertificateNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("invalid client certificate name %q", name)
+	}
+	certFile := filepath.Join(cc.dir, name+".crt")
+	keyFile := filepath.Join(cc.dir, name+".key")
+	certInfo, err := os.Stat(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("client certificate %s not found: %v", name, err)
+	}
+	keyInfo, err := os.Stat(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("key of client certificate %s not found: %v", name, err)
+	}
+
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	loaded, ok := cc.loaded[name]
+	if ok && loaded.certModTime.Equal(certInfo.ModTime()) && loaded.keyModTime.Equal(keyInfo.ModTime()) {
+		return loaded.certificate, nil
+	}
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load client certificate %s: %v", name, err)
+	}
+	cc.loaded[name] = loadedClientCertificate{
+		certificate: &certificate,
+		certModTime: certInfo.ModTime(),
+		keyModTime:  keyInfo.ModTime(),
+	}
+	return &certificate, nil
+}
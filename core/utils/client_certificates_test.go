@@ -0,0 +1,130 @@
+package utils_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeClientCertificate writes a self signed certificate for commonName to
+// dir as name.crt and name.key
+func writeClientCertificate(t *testing.T, dir, name, commonName string, modTime time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func commonName(t *testing.T, certificate *tls.Certificate) string {
+	parsed, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.NoError(t, err)
+	return parsed.Subject.CommonName
+}
+
+func TestClientCertificates_Get(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "client_certs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certificates := utils.NewClientCertificates(dir)
+
+	t.Run("loads the named certificate", func(t *testing.T) {
+		writeClientCertificate(t, dir, "provider", "first", time.Now().Add(-time.Minute))
+		certificate, err := certificates.Get("provider")
+		require.NoError(t, err)
+		assert.Equal(t, "first", commonName(t, certificate))
+	})
+
+	t.Run("loads a rotated certificate", func(t *testing.T) {
+		writeClientCertificate(t, dir, "provider", "second", time.Now())
+		certificate, err := certificates.Get("provider")
+		require.NoError(t, err)
+		assert.Equal(t, "second", commonName(t, certificate))
+	})
+
+	t.Run("errors for a missing certificate", func(t *testing.T) {
+		_, err := certificates.Get("missing")
+		require.Error(t, err)
+		_, err = certificates.TLSConfig("missing")
+		require.Error(t, err)
+	})
+
+	t.Run("errors for names outside the directory", func(t *testing.T) {
+		_, err := certificates.Get("../provider")
+		require.Error(t, err)
+	})
+}
+
+func TestClientCertificates_TLSConfig(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "client_certs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	writeClientCertificate(t, dir, "node", "chainlink-node", time.Now())
+
+	var presented string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	config, err := utils.NewClientCertificates(dir).TLSConfig("node")
+	require.NoError(t, err)
+	config.RootCAs = x509.NewCertPool()
+	config.RootCAs.AddCert(server.Certificate())
+
+	request, err := http.NewRequest("GET", server.URL, nil)
+	require.NoError(t, err)
+	httpRequest := utils.HTTPRequest{
+		Request: request,
+		Config: utils.HTTPRequestConfig{
+			Timeout:                        time.Second,
+			MaxAttempts:                    1,
+			SizeLimit:                      1024,
+			AllowUnrestrictedNetworkAccess: true,
+			TLSConfig:                      config,
+		},
+	}
+	_, statusCode, err := httpRequest.SendRequest(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "chainlink-node", presented)
+}
@@ -2,10 +2,12 @@ package utils
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/jpillora/backoff"
@@ -23,11 +25,67 @@ type HTTPRequestConfig struct {
 	MaxAttempts                    uint
 	SizeLimit                      int64
 	AllowUnrestrictedNetworkAccess bool
+	// Backoff is the delay before the first retry, which doubles on each
+	// retry after it
+	Backoff time.Duration
+	// RetryStatusCodes are the response status codes which are retried, or
+	// any 5xx if empty
+	RetryStatusCodes []int
+	// RateLimiter, if set, holds back each attempt until the rate limit of
+	// the request's host allows it
+	RateLimiter *HTTPRateLimiter
+	// TLSConfig, if set, configures the TLS connection, such as with a
+	// client certificate for servers which require mutual TLS
+	TLSConfig *tls.Config
+	// Proxy, if set, is the HTTP or SOCKS5 proxy the request is sent through
+	Proxy *url.URL
+	// ResponsePath, if set, is the path of the JSON value which is returned
+	// in place of a successful response, decoded from the body as it is read
+	// rather than after the whole body has been buffered
+	ResponsePath []string
+}
+
+// ParseProxyURL parses the URL of an HTTP, HTTPS or SOCKS5 proxy, returning
+// nil if it is empty.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %q: %v", raw, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy %q: scheme must be http, https or socks5", raw)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy %q: missing host", raw)
+	}
+	return u, nil
+}
+
+// retriesStatusCode returns true if a response with statusCode is retried
+func (c HTTPRequestConfig) retriesStatusCode(statusCode int) bool {
+	if len(c.RetryStatusCodes) == 0 {
+		return 500 <= statusCode && statusCode < 600
+	}
+	for _, code := range c.RetryStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
 }
 
 func (h *HTTPRequest) SendRequest(ctx context.Context) (responseBody []byte, statusCode int, err error) {
 	tr := &http.Transport{
 		DisableCompression: true,
+		TLSClientConfig:    h.Config.TLSConfig,
+	}
+	if h.Config.Proxy != nil {
+		tr.Proxy = http.ProxyURL(h.Config.Proxy)
 	}
 	if !h.Config.AllowUnrestrictedNetworkAccess {
 		tr.DialContext = restrictedDialContext
@@ -39,7 +97,8 @@ func (h *HTTPRequest) SendRequest(ctx context.Context) (responseBody []byte, sta
 
 // withRetry executes the http request in a retry. Timeout is controlled with a context
 // Retry occurs if the request timeout, or there is any kind of connection or transport-layer error
-// Retry also occurs on remote server 5xx errors
+// Retry also occurs on remote server 5xx errors, or on the status codes
+// configured instead
 func withRetry(
 	ctx context.Context,
 	client *http.Client,
@@ -51,7 +110,13 @@ func withRetry(
 		Max:    20 * time.Minute, // We stop retrying on the number of attempts!
 		Jitter: true,
 	}
+	if config.Backoff > 0 {
+		bb.Min = config.Backoff
+	}
 	for {
+		if err = config.RateLimiter.Wait(ctx, originalRequest.URL.Host); err != nil {
+			return responseBody, statusCode, err
+		}
 		responseBody, statusCode, err = makeHTTPCall(ctx, client, originalRequest, config)
 		if err == nil {
 			return responseBody, statusCode, nil
@@ -85,6 +150,14 @@ func makeHTTPCall(
 	ctx, cancel := context.WithTimeout(ctx, config.Timeout)
 	defer cancel()
 	requestWithTimeout := originalRequest.Clone(ctx)
+	if originalRequest.GetBody != nil {
+		// The body of the original request was read by the previous attempt
+		body, e := originalRequest.GetBody()
+		if e != nil {
+			return nil, 0, e
+		}
+		requestWithTimeout.Body = body
+	}
 
 	start := time.Now()
 
@@ -99,7 +172,12 @@ func makeHTTPCall(
 	logger.Debugw(fmt.Sprintf("http adapter got %v in %s", statusCode, elapsed), "statusCode", statusCode, "timeElapsedSeconds", elapsed)
 
 	source := NewMaxBytesReader(r.Body, config.SizeLimit)
-	bytes, e := ioutil.ReadAll(source)
+	var bytes []byte
+	if len(config.ResponsePath) > 0 && statusCode < 400 {
+		bytes, e = ExtractJSONPath(source, config.ResponsePath)
+	} else {
+		bytes, e = ioutil.ReadAll(source)
+	}
 	if e != nil {
 		logger.Errorf("http adapter error reading body: %v", e.Error())
 		return nil, statusCode, e
@@ -110,7 +188,7 @@ func makeHTTPCall(
 	responseBody = bytes
 
 	// Retry on 5xx since this might give a different result
-	if 500 <= r.StatusCode && r.StatusCode < 600 {
+	if config.retriesStatusCode(r.StatusCode) {
 		return responseBody, statusCode, &RemoteServerError{responseBody, statusCode}
 	}
 
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// HTTPRateLimiter limits the rate of requests to each host, so that many jobs
+// polling the same provider don't get the node banned by it. Each host has a
+// bucket of burst tokens which refills at rps tokens per second. Requests over
+// the limit wait for their turn, in the order they arrived.
+type HTTPRateLimiter struct {
+	rps     float64
+	burst   float64
+	buckets map[string]*hostBucket
+	mutex   sync.Mutex
+}
+
+type hostBucket struct {
+	// tokens is negative when requests are waiting for tokens yet to refill
+	tokens  float64
+	updated time.Time
+}
+
+// NewHTTPRateLimiter returns an HTTPRateLimiter allowing rps requests per
+// second to each host, in bursts of up to burst requests. It does not limit
+// requests if rps is 0.
+func NewHTTPRateLimiter(rps float64, burst uint) *HTTPRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &HTTPRateLimiter{
+		rps:     rps,
+		burst:   float64(burst),
+		buckets: make(map[string]*hostBucket),
+	}
+}
+
+// Wait blocks until a request to host may be sent, or ctx is done.
+func (l *HTTPRateLimiter) Wait(ctx context.Context, host string) error {
+	if l == nil || l.rps <= 0 {
+		return nil
+	}
+	delay := l.reserve(host, time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token from the host's bucket, returning how long to wait
+// for it to refill if the bucket is empty.
+func (l *HTTPRateLimiter) reserve(host string, now time.Time) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	bucket, ok := l.buckets[host]
+	if !ok {
+		bucket = &hostBucket{tokens: l.burst, updated: now}
+		l.buckets[host] = bucket
+	}
+	bucket.tokens += now.Sub(bucket.updated).Seconds() * l.rps
+	if bucket.tokens > l.burst {
+		bucket.tokens = l.burst
+	}
+	bucket.updated = now
+
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / l.rps * float64(time.Second))
+}
@@ -0,0 +1,63 @@
+package utils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPRateLimiter_Wait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("allows a burst and then spaces out requests", func(t *testing.T) {
+		limiter := utils.NewHTTPRateLimiter(10, 2)
+
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			require.NoError(t, limiter.Wait(context.Background(), "example.com"))
+		}
+		assert.Less(t, int64(time.Since(start)), int64(50*time.Millisecond))
+
+		require.NoError(t, limiter.Wait(context.Background(), "example.com"))
+		require.NoError(t, limiter.Wait(context.Background(), "example.com"))
+		assert.GreaterOrEqual(t, int64(time.Since(start)), int64(150*time.Millisecond))
+	})
+
+	t.Run("limits each host separately", func(t *testing.T) {
+		limiter := utils.NewHTTPRateLimiter(1, 1)
+
+		start := time.Now()
+		require.NoError(t, limiter.Wait(context.Background(), "a.example.com"))
+		require.NoError(t, limiter.Wait(context.Background(), "b.example.com"))
+		assert.Less(t, int64(time.Since(start)), int64(50*time.Millisecond))
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		limiter := utils.NewHTTPRateLimiter(0.1, 1)
+		require.NoError(t, limiter.Wait(context.Background(), "example.com"))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx, "example.com"))
+	})
+
+	t.Run("does not limit without a rate", func(t *testing.T) {
+		limiter := utils.NewHTTPRateLimiter(0, 1)
+
+		start := time.Now()
+		for i := 0; i < 100; i++ {
+			require.NoError(t, limiter.Wait(context.Background(), "example.com"))
+		}
+		assert.Less(t, int64(time.Since(start)), int64(50*time.Millisecond))
+	})
+
+	t.Run("does not limit when unset", func(t *testing.T) {
+		var limiter *utils.HTTPRateLimiter
+		require.NoError(t, limiter.Wait(context.Background(), "example.com"))
+	})
+}
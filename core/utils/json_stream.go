@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ExtractJSONPath decodes the JSON document read from r as a stream, and
+// returns the raw JSON of the value at path, keeping none of the rest of the
+// document in memory. Path elements index into objects by key and into arrays
+// by position. As with the JSONParse adapter, the value is null if only the
+// last key of the path is missing, and an error if any other is.
+func ExtractJSONPath(r io.Reader, path []string) ([]byte, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	for i, key := range path {
+		found, err := seekJSONKey(decoder, key)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			if i == len(path)-1 {
+				return []byte("null"), nil
+			}
+			return nil, fmt.Errorf("No value could be found for the key '%s'", key)
+		}
+	}
+	var value json.RawMessage
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// seekJSONKey advances the decoder to the value under key in the object or
+// array which comes next, skipping over the values before it.
+func seekJSONKey(decoder *json.Decoder, key string) (bool, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return false, err
+	}
+	switch token {
+	case json.Delim('{'):
+		for decoder.More() {
+			name, err := decoder.Token()
+			if err != nil {
+				return false, err
+			}
+			if name == key {
+				return true, nil
+			}
+			if err := skipJSONValue(decoder); err != nil {
+				return false, err
+			}
+		}
+	case json.Delim('['):
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 {
+			return false, nil
+		}
+		for i := 0; decoder.More(); i++ {
+			if i == index {
+				return true, nil
+			}
+			if err := skipJSONValue(decoder); err != nil {
+				return false, err
+			}
+		}
+	default:
+		return false, nil
+	}
+	return false, nil
+}
+
+// skipJSONValue reads past the next value, token by token, so that large
+// arrays and objects are not held in memory.
+func skipJSONValue(decoder *json.Decoder) error {
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return errors.New("unexpected end of JSON")
+		} else if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractJSONPath(t *testing.T) {
+	t.Parallel()
+
+	document := `{
+		"skipped": {"nested": [1, {"a": "b"}, [[]]], "text": "}]"},
+		"data": {"prices": [1.5, {"last": 600.25}], "name": "ETH", "big": 12345678901234567890},
+		"after": [1, 2, 3]
+	}`
+
+	tests := []struct {
+		name    string
+		path    []string
+		want    string
+		wantErr string
+	}{
+		{"number", []string{"data", "prices", "1", "last"}, "600.25", ""},
+		{"string", []string{"data", "name"}, `"ETH"`, ""},
+		{"big number", []string{"data", "big"}, "12345678901234567890", ""},
+		{"array", []string{"data", "prices"}, `[1.5,{"last":600.25}]`, ""},
+		{"missing last key", []string{"data", "volume"}, "null", ""},
+		{"index out of range", []string{"data", "prices", "2"}, "null", ""},
+		{"missing key", []string{"meta", "volume"}, "", "No value could be found for the key 'meta'"},
+		{"key of a scalar", []string{"data", "name", "first"}, "null", ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := utils.ExtractJSONPath(strings.NewReader(document), test.path)
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, string(value))
+		})
+	}
+}
+
+func TestExtractJSONPath_InvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	_, err := utils.ExtractJSONPath(strings.NewReader(`{"data": {"skipped": [1, 2`), []string{"data", "price"})
+	assert.Error(t, err)
+
+	_, err = utils.ExtractJSONPath(strings.NewReader(`<html></html>`), []string{"data"})
+	assert.Error(t, err)
+}
@@ -814,8 +814,9 @@ func (t *PausableTicker) Destroy() {
 }
 
 type ResettableTimer struct {
-	timer *time.Timer
-	mu    *sync.RWMutex
+	timer    *time.Timer
+	deadline time.Time
+	mu       *sync.RWMutex
 }
 
 func NewResettableTimer() ResettableTimer {
@@ -849,6 +850,18 @@ func (t *ResettableTimer) Reset(duration time.Duration) {
 		t.timer.Stop()
 	}
 	t.timer = time.NewTimer(duration)
+	t.deadline = time.Now().Add(duration)
+}
+
+// Deadline returns when the timer fires, or fired, since it was last reset,
+// and false if it is stopped.
+func (t *ResettableTimer) Deadline() (time.Time, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.timer == nil {
+		return time.Time{}, false
+	}
+	return t.deadline, true
 }
 
 func EVMBytesToUint64(buf []byte) uint64 {
@@ -18,6 +18,9 @@ const (
 	// MediaType is the response header for JSONAPI documents.
 	MediaType = "application/vnd.api+json"
 
+	// MediaTypeTOML is the request header for job specs written in TOML.
+	MediaTypeTOML = "application/toml"
+
 	// KeyNextLink is the name of the key that contains the HREF for the next
 	// document in a paginated response.
 	KeyNextLink = "next"
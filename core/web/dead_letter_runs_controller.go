@@ -0,0 +1,76 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// DeadLetterRunsController manages the runs which failed permanently and were
+// moved into the dead-letter queue.
+type DeadLetterRunsController struct {
+	App chainlink.Application
+}
+
+// Index returns the paginated runs in the dead-letter queue, most recently
+// failed first.
+// Example:
+//  "<application>/dead_letter_runs?size=10&page=2"
+func (dlrc *DeadLetterRunsController) Index(c *gin.Context, size, page, offset int) {
+	runs, count, err := dlrc.App.GetStore().DeadLetterJobRuns(offset, size)
+	paginatedResponse(c, "JobRuns", size, page, presentJobRuns(runs), count, err)
+}
+
+// Retry resumes each of the selected runs from the task it failed on. If any
+// of them cannot be retried, none are.
+// Example:
+//  "<application>/dead_letter_runs/retries"
+func (dlrc *DeadLetterRunsController) Retry(c *gin.Context) {
+	request := &models.DeadLetterRunRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := models.ValidateDeadLetterRunRequest(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	runs, err := dlrc.App.RetryDeadLetters(request.RunIDs)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presentJobRuns(runs), "job runs")
+}
+
+// Discard deletes the selected runs from the dead-letter queue.
+// Example:
+//  "<application>/dead_letter_runs"
+func (dlrc *DeadLetterRunsController) Discard(c *gin.Context) {
+	request := &models.DeadLetterRunRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := models.ValidateDeadLetterRunRequest(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := dlrc.App.GetStore().DiscardDeadLetterJobRuns(request.RunIDs); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "nil", http.StatusNoContent)
+}
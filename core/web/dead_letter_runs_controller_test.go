@@ -0,0 +1,113 @@
+package web_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeadLetterRunsController_IndexAndRetry(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+	run := cltest.NewJobRun(job)
+	run.TaskRuns[0].SetError(errors.New("failed"))
+	run.SetError(errors.New("failed"))
+	run.DeadLetter(time.Now())
+	require.NoError(t, app.Store.CreateJobRun(&run))
+	cltest.CreateJobRunWithStatus(t, app.Store, job, models.RunStatusErrored)
+
+	resp, cleanup := client.Get("/v2/dead_letter_runs?size=10")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	count, err := cltest.ParseJSONAPIResponseMetaCount(cltest.ParseResponseBody(t, resp))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	t.Run("no runs selected", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/dead_letter_runs/retries", bytes.NewBufferString(`{"runIds":[]}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("valid run", func(t *testing.T) {
+		body := fmt.Sprintf(`{"runIds":["%s"]}`, run.ID)
+		resp, cleanup := client.Post("/v2/dead_letter_runs/retries", bytes.NewBufferString(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var runs []models.JobRun
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &runs))
+		require.Len(t, runs, 1)
+		assert.False(t, runs[0].DeadLettered())
+
+		cltest.WaitForJobRunToComplete(t, app.Store, run)
+	})
+
+	t.Run("run not dead lettered", func(t *testing.T) {
+		body := fmt.Sprintf(`{"runIds":["%s"]}`, run.ID)
+		resp, cleanup := client.Post("/v2/dead_letter_runs/retries", bytes.NewBufferString(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("batch with a run that cannot be retried", func(t *testing.T) {
+		otherRun := cltest.NewJobRun(job)
+		otherRun.TaskRuns[0].SetError(errors.New("failed"))
+		otherRun.SetError(errors.New("failed"))
+		otherRun.DeadLetter(time.Now())
+		require.NoError(t, app.Store.CreateJobRun(&otherRun))
+
+		body := fmt.Sprintf(`{"runIds":["%s","%s"]}`, otherRun.ID, run.ID)
+		resp, cleanup := client.Post("/v2/dead_letter_runs/retries", bytes.NewBufferString(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		found, err := app.Store.Unscoped().FindJobRun(otherRun.ID)
+		require.NoError(t, err)
+		assert.True(t, found.DeadLettered())
+
+		body = fmt.Sprintf(`{"runIds":["%s","%s"]}`, otherRun.ID, models.NewID())
+		resp, cleanup = client.Post("/v2/dead_letter_runs/retries", bytes.NewBufferString(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+		found, err = app.Store.Unscoped().FindJobRun(otherRun.ID)
+		require.NoError(t, err)
+		assert.True(t, found.DeadLettered())
+	})
+
+	t.Run("run of paused job", func(t *testing.T) {
+		pausedRun := cltest.NewJobRun(job)
+		pausedRun.TaskRuns[0].SetError(errors.New("failed"))
+		pausedRun.SetError(errors.New("failed"))
+		pausedRun.DeadLetter(time.Now())
+		require.NoError(t, app.Store.CreateJobRun(&pausedRun))
+		require.NoError(t, app.Store.PauseJob(job.ID))
+
+		body := fmt.Sprintf(`{"runIds":["%s"]}`, pausedRun.ID)
+		resp, cleanup := client.Post("/v2/dead_letter_runs/retries", bytes.NewBufferString(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		found, err := app.Store.Unscoped().FindJobRun(pausedRun.ID)
+		require.NoError(t, err)
+		assert.True(t, found.DeadLettered())
+	})
+}
@@ -0,0 +1,113 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// FluxMonitorController shows and updates the jobs run by the flux monitor.
+type FluxMonitorController struct {
+	App chainlink.Application
+}
+
+// State returns what the fluxmonitor initiators of a job last saw of their
+// feeds and aggregators, along with the state of their timers.
+// Example:
+//  "<application>/flux_monitor/:SpecID/state"
+func (fmc *FluxMonitorController) State(c *gin.Context) {
+	id, err := models.NewIDFromString(c.Param("SpecID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	states, ok := fmc.App.GetFluxMonitor().State(*id)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job is not running on the flux monitor"))
+		return
+	}
+	jsonAPIResponse(c, presenters.FluxMonitorState{JobSpecID: id.String(), Initiators: states}, "flux_monitor_states")
+}
+
+// Update changes the feeds or deviation thresholds of the fluxmonitor
+// initiators of a job, without restarting them, so that they carry on from
+// the rounds they are on. The job is saved as a new version.
+// Example:
+//  "<application>/flux_monitor/:SpecID"
+func (fmc *FluxMonitorController) Update(c *gin.Context) {
+	id, err := models.NewIDFromString(c.Param("SpecID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var request models.UpdateFluxMonitorRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	store := fmc.App.GetStore()
+	job, err := store.FindJob(id)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(job.InitiatorsFor(models.InitiatorFluxMonitor)) == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job has no fluxmonitor initiators"))
+		return
+	}
+
+	for i, initr := range job.Initiators {
+		if initr.Type != models.InitiatorFluxMonitor {
+			continue
+		}
+		if request.Feeds != nil {
+			initr.Feeds = *request.Feeds
+		}
+		if request.Threshold != nil {
+			initr.Threshold = *request.Threshold
+		}
+		if request.AbsoluteThreshold != nil {
+			initr.AbsoluteThreshold = *request.AbsoluteThreshold
+		}
+		if err := services.ValidateInitiator(initr, job, store); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		job.Initiators[i] = initr
+	}
+
+	if err := fmc.App.UpdateFluxMonitorJob(job); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.JobSpec{JobSpec: job}, "job")
+}
+
+// SimulatedSubmissions returns the paginated answers which the simulated
+// fluxmonitor initiators of a job would have submitted, latest first.
+// Example:
+//  "<application>/flux_monitor/:SpecID/simulated_submissions?size=10&page=2"
+func (fmc *FluxMonitorController) SimulatedSubmissions(c *gin.Context, size, page, offset int) {
+	id, err := models.NewIDFromString(c.Param("SpecID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	submissions, count, err := fmc.App.GetStore().FluxMonitorSimulatedSubmissions(id, offset, size)
+	paginatedResponse(c, "FluxMonitorSimulatedSubmissions", size, page, submissions, count, err)
+}
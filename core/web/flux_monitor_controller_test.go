@@ -0,0 +1,103 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFluxMonitorController_State_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+	app.EthMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	resp, cleanup := client.Get("/v2/flux_monitor/" + job.ID.String() + "/state")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Get("/v2/flux_monitor/notanid/state")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestFluxMonitorController_Update_NotFluxMonitor(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+	app.EthMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	body := bytes.NewBufferString(`{"threshold": 0.5}`)
+	resp, cleanup := client.Patch("/v2/flux_monitor/"+job.ID.String(), body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	body = bytes.NewBufferString(`{"threshold": 0.5}`)
+	resp, cleanup = client.Patch("/v2/flux_monitor/"+models.NewID().String(), body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestFluxMonitorController_SimulatedSubmissions(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+	app.EthMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	job := cltest.NewJobWithFluxMonitorInitiator()
+	job.Initiators[0].Simulate = true
+	require.NoError(t, app.Store.CreateJob(&job))
+	initr := job.Initiators[0]
+	for roundID := uint32(1); roundID <= 2; roundID++ {
+		require.NoError(t, app.Store.CreateFluxMonitorSimulatedSubmission(&models.FluxMonitorSimulatedSubmission{
+			JobSpecID:   job.ID,
+			InitiatorID: initr.ID,
+			Aggregator:  initr.Address,
+			RoundID:     roundID,
+			Answer:      decimal.NewFromInt(100),
+		}))
+	}
+
+	resp, cleanup := client.Get("/v2/flux_monitor/" + job.ID.String() + "/simulated_submissions?size=1")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	body := cltest.ParseResponseBody(t, resp)
+	count, err := cltest.ParseJSONAPIResponseMetaCount(body)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	var submissions []models.FluxMonitorSimulatedSubmission
+	require.NoError(t, web.ParseJSONAPIResponse(body, &submissions))
+	require.Len(t, submissions, 1)
+	assert.Equal(t, uint32(2), submissions[0].RoundID)
+	assert.Equal(t, "100", submissions[0].Answer.String())
+}
@@ -11,6 +11,20 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 )
 
+// IdempotencyKeyHeader is the header with which clients make a retried request
+// return the record created by the first request, rather than creating another.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKey returns the request's Idempotency-Key header, or nil if it was
+// not set.
+func idempotencyKey(c *gin.Context) *string {
+	key := c.GetHeader(IdempotencyKeyHeader)
+	if key == "" {
+		return nil
+	}
+	return &key
+}
+
 // StatusCodeForError returns an http status code for an error type.
 func StatusCodeForError(err interface{}) int {
 	switch err.(type) {
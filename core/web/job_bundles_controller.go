@@ -0,0 +1,142 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// JobBundlesController exports jobs, together with the bridges and external
+// initiators they refer to, and imports them into another node.
+type JobBundlesController struct {
+	App chainlink.Application
+}
+
+// Show exports a JobSpec as a job bundle, in the form it is imported in.
+// Example:
+//  "<application>/specs/:SpecID/export"
+func (jbc *JobBundlesController) Show(c *gin.Context) {
+	jsc := JobSpecsController{App: jbc.App}
+	job, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+
+	bundle, err := services.ExportJobBundle(job, jbc.App.GetStore())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, bundle)
+}
+
+// Create imports a job bundle, creating the bridges and external initiators
+// which do not exist yet along with the job, in one transaction. Those which
+// do exist are used as they are. The external initiator of the job is
+// notified once it has been saved, and the import undone if that fails.
+// Example:
+//  "<application>/job_bundles"
+func (jbc *JobBundlesController) Create(c *gin.Context) {
+	var bundle models.JobBundle
+	if err := c.ShouldBindJSON(&bundle); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	store := jbc.App.GetStore()
+	var imported presenters.JobBundleImport
+	var bridges []models.BridgeType
+	for i, btr := range bundle.Bridges {
+		if _, err := store.FindBridge(btr.Name); err == nil {
+			continue
+		} else if errors.Cause(err) != orm.ErrorNotFound {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if err := services.ValidateBridgeType(&bundle.Bridges[i], store); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		bta, bt, err := models.NewBridgeType(&bundle.Bridges[i])
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		bridges = append(bridges, *bt)
+		imported.Bridges = append(imported.Bridges, *bta)
+	}
+	var externalInitiators []models.ExternalInitiator
+	for i, eir := range bundle.ExternalInitiators {
+		if _, err := store.FindExternalInitiatorByName(eir.Name); err == nil {
+			continue
+		} else if errors.Cause(err) != orm.ErrorNotFound {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if !store.Config.Dev() && !store.Config.FeatureExternalInitiators() {
+			jsonAPIError(c, http.StatusMethodNotAllowed, errors.New("The External Initiator feature is disabled by configuration"))
+			return
+		}
+		if err := services.ValidateExternalInitiator(&bundle.ExternalInitiators[i], store); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		eia := auth.NewToken()
+		ei, err := models.NewExternalInitiator(eia, &bundle.ExternalInitiators[i])
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		externalInitiators = append(externalInitiators, *ei)
+		imported.ExternalInitiators = append(imported.ExternalInitiators, *presenters.NewExternalInitiatorAuthentication(*ei, *eia))
+	}
+
+	jsc := JobSpecsController{App: jbc.App}
+	js := models.NewJobFromRequest(bundle.Job)
+	if httpStatus, err := jsc.checkJobSpec(js); err != nil {
+		jsonAPIError(c, httpStatus, err)
+		return
+	}
+	if err := jbc.App.AddJobBundle(js, bridges, externalInitiators); err != nil {
+		jobSaveError(c, js, err)
+		return
+	}
+	if err := NotifyExternalInitiator(js, store); err != nil {
+		jbc.removeImported(js, imported)
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	imported.Job = presenters.JobSpec{JobSpec: js}
+	jsonAPIResponseWithStatus(c, imported, "job bundle import", http.StatusCreated)
+}
+
+// removeImported archives an imported job whose external initiator could not
+// be notified, and deletes the bridges and external initiators created for
+// it.
+func (jbc *JobBundlesController) removeImported(js models.JobSpec, imported presenters.JobBundleImport) {
+	if err := jbc.App.ArchiveJob(js.ID); err != nil {
+		logger.Errorw(fmt.Sprintf("error archiving imported job %s", js.ID), "error", err)
+	}
+	store := jbc.App.GetStore()
+	for _, bta := range imported.Bridges {
+		if err := store.DeleteBridgeType(&models.BridgeType{Name: bta.Name}); err != nil {
+			logger.Errorw(fmt.Sprintf("error removing imported bridge %s", bta.Name), "error", err)
+		}
+	}
+	for _, eia := range imported.ExternalInitiators {
+		if err := store.DeleteExternalInitiator(eia.Name); err != nil {
+			logger.Errorw(fmt.Sprintf("error removing imported external initiator %s", eia.Name), "error", err)
+		}
+	}
+}
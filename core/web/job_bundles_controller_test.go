@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobBundlesController_ExportAndImport(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	store := app.Store
+
+	_, bt := cltest.NewBridgeType(t, "bundledbridge", "https://bridge.example.com/api")
+	require.NoError(t, store.CreateBridgeType(bt))
+	job := cltest.NewJobWithWebInitiator()
+	job.Name = "exported"
+	job.Tasks = []models.TaskSpec{{Type: bt.Name}, {Type: bt.Name}, {Type: models.MustNewTaskType("noop")}}
+	require.NoError(t, app.AddJob(job))
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/specs/" + job.ID.String() + "/export")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var bundle models.JobBundle
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&bundle))
+	assert.Equal(t, "exported", bundle.Job.Name)
+	require.Len(t, bundle.Job.Tasks, 3)
+	require.Len(t, bundle.Bridges, 1)
+	assert.Equal(t, bt.Name, bundle.Bridges[0].Name)
+	assert.Equal(t, bt.URL, bundle.Bridges[0].URL)
+	assert.Len(t, bundle.ExternalInitiators, 0)
+
+	require.NoError(t, store.DeleteBridgeType(bt))
+	bundle.Job.Name = "imported"
+	body, err := json.Marshal(bundle)
+	require.NoError(t, err)
+
+	resp, cleanup = client.Post("/v2/job_bundles", bytes.NewBuffer(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var imported presenters.JobBundleImport
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &imported))
+	require.Len(t, imported.Bridges, 1)
+	assert.Equal(t, bt.Name, imported.Bridges[0].Name)
+	assert.NotEmpty(t, imported.Bridges[0].IncomingToken)
+
+	importedJob, err := store.FindJob(imported.Job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "imported", importedJob.Name)
+	assert.Len(t, importedJob.Tasks, 3)
+	_, err = store.FindBridge(bt.Name)
+	assert.NoError(t, err)
+}
+
+func TestJobBundlesController_Import_InvalidJob(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Post("/v2/job_bundles", bytes.NewBufferString(`{
+		"job": {"initiators": [{"type": "web"}], "tasks": []},
+		"bridges": [{"name": "unusedbridge", "url": "https://bridge.example.com/api"}]
+	}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	_, err := app.Store.FindBridge(models.MustNewTaskType("unusedbridge"))
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
@@ -48,10 +49,11 @@ func (jrc *JobRunsController) Index(c *gin.Context, size, page, offset int) {
 		runs, count, err = store.JobRunsSortedFor(runID, order, offset, size)
 	}
 
-	paginatedResponse(c, "JobRuns", size, page, runs, count, err)
+	paginatedResponse(c, "JobRuns", size, page, presentJobRuns(runs), count, err)
 }
 
-// Create starts a new Run for the requested JobSpec.
+// Create starts a new Run for the requested JobSpec. With an Idempotency-Key
+// header, retrying the request returns the run created by the first request.
 // Example:
 //  "<application>/specs/:SpecID/runs"
 func (jrc *JobRunsController) Create(c *gin.Context) {
@@ -70,6 +72,10 @@ func (jrc *JobRunsController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	if j.Paused() {
+		jsonAPIError(c, http.StatusConflict, errors.New("Job is paused"))
+		return
+	}
 
 	initiator, err := getAuthenticatedInitiator(c, j)
 	if err != nil {
@@ -77,23 +83,57 @@ func (jrc *JobRunsController) Create(c *gin.Context) {
 		return
 	}
 
+	key := idempotencyKey(c)
+	if key != nil {
+		jr, err := jrc.App.GetStore().FindJobRunByIdempotencyKey(*key)
+		if err == nil {
+			if jr.JobSpecID.String() != j.ID.String() {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s was already used for a run of another job", IdempotencyKeyHeader))
+				return
+			}
+			jsonAPIResponse(c, presenters.JobRun{JobRun: jr}, "job run")
+			return
+		} else if errors.Cause(err) != orm.ErrorNotFound {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
 	data, err := getRunData(c)
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 
-	jr, err := jrc.App.Create(j.ID, initiator, nil, &models.RunRequest{RequestParams: data})
+	jr, err := jrc.App.Create(j.ID, initiator, nil, &models.RunRequest{RequestParams: data, IdempotencyKey: key})
+	if err != nil {
+		jsonAPIRunCreateError(c, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
+}
+
+// jsonAPIRunCreateError responds with the status for the error returned by
+// creating a run.
+func jsonAPIRunCreateError(c *gin.Context, err error) {
 	if errors.Cause(err) == orm.ErrorNotFound {
 		jsonAPIError(c, http.StatusNotFound, errors.New("Job not found"))
 		return
 	}
-	if err != nil {
-		jsonAPIError(c, http.StatusInternalServerError, err)
+	if _, ok := errors.Cause(err).(services.RunInputError); ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
-
-	jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
+	if errors.Cause(err) == services.ErrMaintenance {
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	}
+	if _, ok := errors.Cause(err).(services.RunRateLimitedError); ok {
+		jsonAPIError(c, http.StatusTooManyRequests, err)
+		return
+	}
+	jsonAPIError(c, http.StatusInternalServerError, err)
 }
 
 // getInitiator returns the Job Spec's initiator for the given web context.
@@ -205,7 +245,7 @@ func (jrc *JobRunsController) Update(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, jr, "job run")
+	jsonAPIResponse(c, presenters.JobRun{JobRun: jr}, "job run")
 }
 
 // Cancel stops a Run from continuing.
@@ -230,3 +270,37 @@ func (jrc *JobRunsController) Cancel(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
 }
+
+// Retry resumes an errored JobRun from the task it failed on, keeping the
+// results of the tasks before it.
+// Example:
+//  "<application>/runs/:RunID/retry"
+func (jrc *JobRunsController) Retry(c *gin.Context) {
+	id, err := models.NewIDFromString(c.Param("RunID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jr, err := jrc.App.RetryRun(id)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Job run not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
+}
+
+// presentJobRuns returns the runs as presented by the API, with the
+// credentials of their initiators left out.
+func presentJobRuns(runs []models.JobRun) []presenters.JobRun {
+	prs := make([]presenters.JobRun, len(runs))
+	for i, jr := range runs {
+		prs[i] = presenters.JobRun{JobRun: jr}
+	}
+	return prs
+}
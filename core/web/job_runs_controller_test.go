@@ -137,6 +137,39 @@ func TestJobRunsController_Create_Success(t *testing.T) {
 	assert.Equal(t, "100", value)
 }
 
+func TestJobRunsController_Create_IdempotencyKey(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	app.Start()
+	defer cleanup()
+	client := app.NewHTTPClient()
+
+	j := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&j))
+	headers := map[string]string{web.IdempotencyKeyHeader: "4d2c51a0-run"}
+
+	var runIDs []string
+	for i := 0; i < 2; i++ {
+		resp, cleanup := client.Post("/v2/specs/"+j.ID.String()+"/runs", bytes.NewBufferString(`{"result":"100"}`), headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var jr models.JobRun
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &jr))
+		runIDs = append(runIDs, jr.ID.String())
+	}
+	assert.Equal(t, runIDs[0], runIDs[1])
+
+	count, err := app.Store.CountOf(&models.JobRun{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	other := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&other))
+	resp, cleanup := client.Post("/v2/specs/"+other.ID.String()+"/runs", bytes.NewBufferString(`{"result":"100"}`), headers)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
 func TestJobRunsController_Create_Wrong_ExternalInitiator(t *testing.T) {
 	t.Parallel()
 	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
@@ -555,3 +588,42 @@ func TestJobRunsController_Cancel(t *testing.T) {
 		assert.Equal(t, models.RunStatusCancelled, r.GetStatus())
 	})
 }
+
+func TestJobRunsController_Retry(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	t.Run("missing run", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/runs/29023583-0D39-4844-9696-451102590936/retry", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	job := cltest.NewJobWithWebInitiator()
+	job.Tasks = []models.TaskSpec{{Type: "noop"}, {Type: "noop"}}
+	require.NoError(t, app.Store.CreateJob(&job))
+	run := cltest.NewJobRun(job)
+	run.TaskRuns[0].ApplyOutput(models.NewRunOutputCompleteWithResult("first"))
+	run.TaskRuns[1].SetError(fmt.Errorf("bridge unavailable"))
+	run.SetError(fmt.Errorf("bridge unavailable"))
+	require.NoError(t, app.Store.CreateJobRun(&run))
+
+	t.Run("errored run", func(t *testing.T) {
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/runs/%s/retry", run.ID), nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		run = cltest.WaitForJobRunToComplete(t, app.Store, run)
+		assert.Equal(t, "first", run.TaskRuns[0].Result.Data.Get("result").String())
+	})
+
+	t.Run("completed run", func(t *testing.T) {
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/runs/%s/retry", run.ID), nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
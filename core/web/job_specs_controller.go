@@ -2,7 +2,9 @@ package web
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lib/pq"
@@ -36,20 +38,46 @@ func (jsc *JobSpecsController) requireImplemented(js models.JobSpec) error {
 // reflects the type of failure to be reported back to the client.
 func (jsc *JobSpecsController) getAndCheckJobSpec(
 	c *gin.Context) (js models.JobSpec, httpStatus int, err error) {
-	var jsr models.JobSpecRequest
-	if err := c.ShouldBindJSON(&jsr); err != nil {
+	jsr, httpStatus, err := getJobSpecRequest(c)
+	if err != nil {
+		return models.JobSpec{}, httpStatus, err
+	}
+	js = models.NewJobFromRequest(jsr)
+	if httpStatus, err := jsc.checkJobSpec(js); err != nil {
+		return models.JobSpec{}, httpStatus, err
+	}
+	return js, 0, nil
+}
+
+// getJobSpecRequest reads the job spec request from c, written in JSON or,
+// with a Content-Type of application/toml, in TOML.
+func getJobSpecRequest(c *gin.Context) (jsr models.JobSpecRequest, httpStatus int, err error) {
+	if c.ContentType() == MediaTypeTOML {
+		body, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			return jsr, http.StatusBadRequest, err
+		}
+		if jsr, err = models.NewJobSpecRequestFromTOML(body); err != nil {
+			return jsr, http.StatusBadRequest, err
+		}
+	} else if err := c.ShouldBindJSON(&jsr); err != nil {
 		// TODO(alx): Better parsing and more specific error messages
 		// https://www.pivotaltracker.com/story/show/171164115
-		return models.JobSpec{}, http.StatusBadRequest, err
+		return jsr, http.StatusBadRequest, err
 	}
-	js = models.NewJobFromRequest(jsr)
+	return jsr, 0, nil
+}
+
+// checkJobSpec validates the job spec, returning the status to respond with
+// if it is not valid.
+func (jsc *JobSpecsController) checkJobSpec(js models.JobSpec) (int, error) {
 	if err := jsc.requireImplemented(js); err != nil {
-		return models.JobSpec{}, http.StatusNotImplemented, err
+		return http.StatusNotImplemented, err
 	}
 	if err := services.ValidateJob(js, jsc.App.GetStore()); err != nil {
-		return models.JobSpec{}, http.StatusBadRequest, err
+		return http.StatusBadRequest, err
 	}
-	return js, 0, nil
+	return 0, nil
 }
 
 func showJobPresenter(jsc *JobSpecsController, job models.JobSpec) presenters.JobSpec {
@@ -92,24 +120,189 @@ func (jsc *JobSpecsController) Create(c *gin.Context) {
 		return
 	}
 	if err := jsc.App.AddJob(js); err != nil {
-		switch err := err.(type) {
-		case *pq.Error:
-			var apiErr error
-			if err.Constraint == "job_specs_name_key" {
-				apiErr = fmt.Errorf("name '%s' already taken", js.Name)
-			} else {
-				apiErr = err
-			}
-			jsonAPIError(c, http.StatusConflict, apiErr)
-			return
-		default:
+		jobSaveError(c, js, err)
+		return
+	}
+
+	// TODO: https://www.pivotaltracker.com/story/show/171169052
+	jsonAPIResponse(c, presenters.JobSpec{JobSpec: js}, "job")
+}
+
+// jobSaveError responds with the error saving the job spec.
+func jobSaveError(c *gin.Context, js models.JobSpec, err error) {
+	switch err := err.(type) {
+	case *pq.Error:
+		var apiErr error
+		if err.Constraint == "job_specs_name_key" {
+			apiErr = fmt.Errorf("name '%s' already taken", js.Name)
+		} else {
+			apiErr = err
+		}
+		jsonAPIError(c, http.StatusConflict, apiErr)
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
+
+// Update replaces the initiators and tasks of a JobSpec with those of a new
+// version of it, keeping its ID, runs and external initiator registration. A
+// spec without a name keeps the job's name.
+// Example:
+//  "<application>/specs/:SpecID"
+func (jsc *JobSpecsController) Update(c *gin.Context) {
+	current, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+	jsr, httpStatus, err := getJobSpecRequest(c)
+	if err != nil {
+		jsonAPIError(c, httpStatus, err)
+		return
+	}
+	jsc.update(c, current, jsr)
+}
+
+// Versions lists the versions kept of a JobSpec, oldest first.
+// Example:
+//  "<application>/specs/:SpecID/versions"
+func (jsc *JobSpecsController) Versions(c *gin.Context) {
+	job, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+	versions, err := jsc.App.GetStore().JobSpecVersions(job.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for i, version := range versions {
+		if versions[i], err = version.WithoutCredentials(); err != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err)
 			return
 		}
 	}
+	jsonAPIResponse(c, versions, "specVersions")
+}
 
-	// TODO: https://www.pivotaltracker.com/story/show/171169052
-	jsonAPIResponse(c, presenters.JobSpec{JobSpec: js}, "job")
+// Rollback restores an earlier version of a JobSpec, saving it as a new
+// version.
+// Example:
+//  "<application>/specs/:SpecID/versions/:Version/rollback"
+func (jsc *JobSpecsController) Rollback(c *gin.Context) {
+	current, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+	version, err := strconv.ParseInt(c.Param("Version"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	v, err := jsc.App.GetStore().FindJobSpecVersion(current.ID, int32(version))
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, fmt.Errorf("version %d of the JobSpec not found", version))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsr, err := v.JobSpecRequest()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsc.update(c, current, jsr)
+}
+
+// Pause stops the initiators of a JobSpec from starting new runs, keeping the
+// job and its runs, until it is resumed.
+// Example:
+//  "<application>/specs/:SpecID/pause"
+func (jsc *JobSpecsController) Pause(c *gin.Context) {
+	job, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+	if err := jsc.App.PauseJob(job.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsc.showJob(c, job.ID)
+}
+
+// Resume restarts the initiators of a paused JobSpec.
+// Example:
+//  "<application>/specs/:SpecID/resume"
+func (jsc *JobSpecsController) Resume(c *gin.Context) {
+	job, ok := jsc.findJob(c)
+	if !ok {
+		return
+	}
+	if err := jsc.App.ResumeJob(job.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsc.showJob(c, job.ID)
+}
+
+// showJob responds with the JobSpec as it is saved.
+func (jsc *JobSpecsController) showJob(c *gin.Context, id *models.ID) {
+	job, err := jsc.App.GetStore().FindJob(id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.JobSpec{JobSpec: job}, "job")
+}
+
+// findJob looks up the JobSpec named by the SpecID param, responding with an
+// error if there is none.
+func (jsc *JobSpecsController) findJob(c *gin.Context) (models.JobSpec, bool) {
+	id, err := models.NewIDFromString(c.Param("SpecID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return models.JobSpec{}, false
+	}
+
+	j, err := jsc.App.GetStore().FindJob(id)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+		return models.JobSpec{}, false
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return models.JobSpec{}, false
+	}
+	return j, true
+}
+
+// update saves the job spec requested as the next version of the current job.
+func (jsc *JobSpecsController) update(c *gin.Context, current models.JobSpec, jsr models.JobSpecRequest) {
+	if jsr.Name == "" {
+		jsr.Name = current.Name
+	}
+	js := models.NewJobFromRequest(jsr)
+	js.ID = current.ID
+	if httpStatus, err := jsc.checkJobSpec(js); err != nil {
+		jsonAPIError(c, httpStatus, err)
+		return
+	}
+
+	// External initiators already notified of the job keep their reference
+	// to it, as its ID is unchanged
+	if len(current.InitiatorsFor(models.InitiatorExternal)) == 0 {
+		if err := NotifyExternalInitiator(js, jsc.App.GetStore()); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	if err := jsc.App.UpdateJob(js); err != nil {
+		jobSaveError(c, js, err)
+		return
+	}
+	jsc.showJob(c, js.ID)
 }
 
 // Show returns the details of a JobSpec.
@@ -206,6 +206,46 @@ func TestJobSpecsController_Create_HappyPath(t *testing.T) {
 	assert.Equal(t, httpGet.GetURL(), "https://bitstamp.net/api/ticker/")
 }
 
+func TestJobSpecsController_Create_TOML(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	body := cltest.MustReadFile(t, "testdata/hello_world_job.toml")
+	resp, cleanup := client.Post("/v2/specs", bytes.NewBuffer(body), map[string]string{"Content-Type": web.MediaTypeTOML})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var j models.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &j))
+	assert.Equal(t, "hello-world-toml", j.Name)
+
+	j, err := app.GetStore().ORM.FindJob(j.ID)
+	require.NoError(t, err)
+	require.Len(t, j.Initiators, 1)
+	assert.Equal(t, models.InitiatorWeb, j.Initiators[0].Type)
+	require.Len(t, j.Tasks, 4)
+
+	adapter1, err := adapters.For(j.Tasks[0], app.Store.Config, app.Store.ORM)
+	require.NoError(t, err)
+	httpGet := adapter1.BaseAdapter.(*adapters.HTTPGet)
+	assert.Equal(t, "https://bitstamp.net/api/ticker/", httpGet.GetURL())
+	assert.Equal(t, []string{"value"}, httpGet.Headers["Key1"])
+
+	adapter4, err := adapters.For(j.Tasks[3], app.Store.Config, app.Store.ORM)
+	require.NoError(t, err)
+	signTx := adapter4.BaseAdapter.(*adapters.EthTx)
+	assert.Equal(t, "0x609ff1bd", signTx.FunctionSelector.String())
+
+	resp, cleanup = client.Post("/v2/specs", bytes.NewBufferString("[[tasks]\ntype ="), map[string]string{"Content-Type": web.MediaTypeTOML})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
+
 func TestJobSpecsController_Create_CustomName(t *testing.T) {
 	t.Parallel()
 
@@ -709,3 +749,122 @@ func TestJobSpecsController_Destroy_MultipleJobs(t *testing.T) {
 	assert.Error(t, utils.JustError(app.Store.FindJob(job2.ID)))
 	assert.Equal(t, 0, len(app.ChainlinkApplication.JobSubscriber.Jobs()))
 }
+
+func TestJobSpecsController_Update(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithLogInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	body := `{"initiators": [{"type": "web"}], "tasks": [{"type": "NoOp"}, {"type": "NoOp"}]}`
+	resp, cleanup := client.Put("/v2/specs/"+job.ID.String(), bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var respJob presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &respJob))
+	assert.Equal(t, job.ID, respJob.ID)
+	assert.Equal(t, job.Name, respJob.Name)
+	assert.Equal(t, int32(2), respJob.Version)
+	require.Len(t, respJob.Initiators, 1)
+	assert.Equal(t, models.InitiatorWeb, respJob.Initiators[0].Type)
+	assert.Len(t, respJob.Tasks, 2)
+	assert.Equal(t, 0, len(app.ChainlinkApplication.JobSubscriber.Jobs()))
+}
+
+func TestJobSpecsController_Update_Errors(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	resp, cleanup := client.Put("/v2/specs/"+models.NewID().String(), bytes.NewBufferString(`{"initiators": [{"type": "web"}], "tasks": [{"type": "NoOp"}]}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Put("/v2/specs/"+job.ID.String(), bytes.NewBufferString(`{"initiators": [{"type": "web"}], "tasks": []}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	unchanged, err := app.Store.FindJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), unchanged.Version)
+}
+
+func TestJobSpecsController_VersionsAndRollback(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.Store.CreateJob(&job))
+
+	body := `{"initiators": [{"type": "web"}], "tasks": [{"type": "NoOp"}, {"type": "NoOp"}]}`
+	resp, cleanup := client.Put("/v2/specs/"+job.ID.String(), bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/specs/" + job.ID.String() + "/versions")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var versions []models.JobSpecVersion
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &versions))
+	require.Len(t, versions, 2)
+	assert.Equal(t, int32(1), versions[0].Version)
+	assert.Equal(t, int32(2), versions[1].Version)
+
+	resp, cleanup = client.Post("/v2/specs/"+job.ID.String()+"/versions/1/rollback", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var respJob presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &respJob))
+	assert.Equal(t, int32(3), respJob.Version)
+	assert.Len(t, respJob.Tasks, 1)
+
+	resp, cleanup = client.Post("/v2/specs/"+job.ID.String()+"/versions/7/rollback", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestJobSpecsController_PauseAndResume(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(job))
+
+	resp, cleanup := client.Post("/v2/specs/"+job.ID.String()+"/pause", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var respJob presenters.JobSpec
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &respJob))
+	assert.True(t, respJob.Paused())
+
+	resp, cleanup = client.Post("/v2/specs/"+job.ID.String()+"/runs", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	resp, cleanup = client.Post("/v2/specs/"+job.ID.String()+"/resume", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	respJob = presenters.JobSpec{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &respJob))
+	assert.False(t, respJob.Paused())
+
+	resp, cleanup = client.Post("/v2/specs/"+job.ID.String()+"/runs", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+}
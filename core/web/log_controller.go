@@ -0,0 +1,102 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap/zapcore"
+)
+
+// LogController manages the levels of the node's loggers
+type LogController struct {
+	App chainlink.Application
+}
+
+// LogLevels is the level of the node's logger, as set by LOG_LEVEL, and the
+// level each module logger is currently logging at.
+type LogLevels struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+// GetID returns the jsonapi ID.
+func (LogLevels) GetID() string {
+	return "log"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*LogLevels) SetID(string) error {
+	return nil
+}
+
+func currentLogLevels() *LogLevels {
+	levels := &LogLevels{
+		Level:   logger.DefaultLevel().String(),
+		Modules: make(map[string]string),
+	}
+	for module, level := range logger.ModuleLevels() {
+		levels.Modules[module] = level.String()
+	}
+	return levels
+}
+
+// Show returns the current log levels
+// Example:
+//  "<application>/log"
+func (lc *LogController) Show(c *gin.Context) {
+	jsonAPIResponse(c, currentLogLevels(), "log")
+}
+
+type logPatchRequest struct {
+	Modules map[string]string `json:"modules"`
+}
+
+// Patch sets the level of one or more module loggers. An empty level resets
+// the module to log at the node's LOG_LEVEL.
+// Example:
+//  "<application>/log"
+func (lc *LogController) Patch(c *gin.Context) {
+	request := &logPatchRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	levels := make(map[string]*zapcore.Level, len(request.Modules))
+	for module, name := range request.Modules {
+		if !logger.IsModule(module) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("unknown log module %q, expected one of %v", module, logger.Modules))
+			return
+		}
+		if name == "" {
+			levels[module] = nil
+			continue
+		}
+		var level zapcore.Level
+		if err := level.UnmarshalText([]byte(name)); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid level for log module %s: %v", module, err))
+			return
+		}
+		levels[module] = &level
+	}
+
+	for module, level := range levels {
+		var err error
+		if level == nil {
+			err = logger.ResetModuleLevel(module)
+		} else {
+			err = logger.SetModuleLevel(module, *level)
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	jsonAPIResponse(c, currentLogLevels(), "log")
+}
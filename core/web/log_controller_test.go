@@ -0,0 +1,54 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/web"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogController_ShowAndPatch(t *testing.T) {
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+	defer logger.ResetModuleLevel(logger.ModuleHeadTracker)
+
+	resp, cleanup := client.Get("/v2/log")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	levels := web.LogLevels{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &levels))
+	assert.Equal(t, levels.Level, levels.Modules[logger.ModuleHeadTracker])
+	assert.Len(t, levels.Modules, len(logger.Modules))
+
+	body := bytes.NewBufferString(`{"modules": {"headtracker": "error"}}`)
+	resp, cleanup = client.Patch("/v2/log", body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &levels))
+	assert.Equal(t, "error", levels.Modules[logger.ModuleHeadTracker])
+
+	body = bytes.NewBufferString(`{"modules": {"headtracker": ""}}`)
+	resp, cleanup = client.Patch("/v2/log", body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &levels))
+	assert.Equal(t, levels.Level, levels.Modules[logger.ModuleHeadTracker])
+
+	body = bytes.NewBufferString(`{"modules": {"nonexistent": "debug"}}`)
+	resp, cleanup = client.Patch("/v2/log", body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	body = bytes.NewBufferString(`{"modules": {"web": "loud"}}`)
+	resp, cleanup = client.Patch("/v2/log", body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,55 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaintenanceController puts the node in and out of maintenance mode, in
+// which initiators do not start new runs while those already started drain.
+type MaintenanceController struct {
+	App chainlink.Application
+}
+
+// Show returns whether the node is in maintenance mode, with the number of
+// runs still to finish.
+// Example:
+//  "<application>/maintenance"
+func (mc *MaintenanceController) Show(c *gin.Context) {
+	mc.showMaintenance(c)
+}
+
+// Create puts the node in maintenance mode.
+// Example:
+//  "<application>/maintenance"
+func (mc *MaintenanceController) Create(c *gin.Context) {
+	mc.App.StartMaintenance()
+	mc.showMaintenance(c)
+}
+
+// Destroy takes the node out of maintenance mode.
+// Example:
+//  "<application>/maintenance"
+func (mc *MaintenanceController) Destroy(c *gin.Context) {
+	mc.App.StopMaintenance()
+	mc.showMaintenance(c)
+}
+
+func (mc *MaintenanceController) showMaintenance(c *gin.Context) {
+	count, err := mc.App.GetStore().UnfinishedJobRunsCount()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	maintenance := presenters.Maintenance{UnfinishedRuns: count}
+	if since, ok := mc.App.MaintenanceSince(); ok {
+		maintenance.Enabled = true
+		maintenance.Since = &since
+	}
+	jsonAPIResponse(c, maintenance, "maintenance")
+}
@@ -0,0 +1,55 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceController_StartAndStop(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+	job := cltest.NewJobWithWebInitiator()
+	require.NoError(t, app.AddJob(job))
+
+	resp, cleanup := client.Post("/v2/maintenance", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var maintenance presenters.Maintenance
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &maintenance))
+	assert.True(t, maintenance.Enabled)
+	assert.NotNil(t, maintenance.Since)
+
+	resp, cleanup = client.Post("/v2/specs/"+job.ID.String()+"/runs", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusServiceUnavailable)
+
+	resp, cleanup = client.Get("/v2/maintenance")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	maintenance = presenters.Maintenance{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &maintenance))
+	assert.True(t, maintenance.Enabled)
+	assert.Equal(t, 0, maintenance.UnfinishedRuns)
+
+	resp, cleanup = client.Delete("/v2/maintenance")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	maintenance = presenters.Maintenance{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &maintenance))
+	assert.False(t, maintenance.Enabled)
+	assert.Nil(t, maintenance.Since)
+
+	resp, cleanup = client.Post("/v2/specs/"+job.ID.String()+"/runs", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+}
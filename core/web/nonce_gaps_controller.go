@@ -0,0 +1,108 @@
+package web
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// NonceGapsController compares the nonces of the node's keys with the eth
+// node, and repairs them when they have drifted apart.
+type NonceGapsController struct {
+	App chainlink.Application
+}
+
+// Index returns the nonce gap of each sending key.
+// Example:
+//  "<application>/nonce_gaps"
+func (ngc *NonceGapsController) Index(c *gin.Context) {
+	store := ngc.App.GetStore()
+	if !store.Config.EnableBulletproofTxManager() {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("nonce gaps require ENABLE_BULLETPROOF_TX_MANAGER"))
+		return
+	}
+
+	keys, err := store.SendKeys()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	gaps := make([]presenters.NonceGap, len(keys))
+	for i, key := range keys {
+		gap, err := bulletprooftxmanager.CheckNonceGap(store, key.Address.Address())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		gaps[i] = newNonceGapPresenter(gap, nil)
+	}
+	jsonAPIResponse(c, gaps, "nonce_gaps")
+}
+
+// Repair moves the local nonce of each sending key up to its on-chain nonce
+// if it is behind, or fills the missing nonces with empty transactions if it
+// is ahead. The keys to repair can be narrowed down to one address.
+// Example:
+//  "<application>/nonce_gaps/repair"
+func (ngc *NonceGapsController) Repair(c *gin.Context) {
+	store := ngc.App.GetStore()
+	if !store.Config.EnableBulletproofTxManager() {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("nonce gaps require ENABLE_BULLETPROOF_TX_MANAGER"))
+		return
+	}
+
+	request := models.RepairNonceGapsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	keys, err := store.SendKeys()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var gaps []presenters.NonceGap
+	for _, key := range keys {
+		if request.Address != nil && key.Address.Address() != *request.Address {
+			continue
+		}
+		gap, attempts, err := bulletprooftxmanager.RepairNonceGap(store, key)
+		if errors.Cause(err) == bulletprooftxmanager.ErrNonceGapInProgressEthTx {
+			jsonAPIError(c, http.StatusConflict, errors.Wrapf(err, "cannot repair nonces of %s", key.Address.Hex()))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		gaps = append(gaps, newNonceGapPresenter(gap, attempts))
+	}
+	if request.Address != nil && len(gaps) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("no sending key with address %s", request.Address.Hex()))
+		return
+	}
+	jsonAPIResponse(c, gaps, "nonce_gaps")
+}
+
+func newNonceGapPresenter(gap bulletprooftxmanager.NonceGap, fillers []models.EthTxAttempt) presenters.NonceGap {
+	p := presenters.NonceGap{
+		Address:       gap.Address,
+		LocalNonce:    gap.LocalNonce,
+		OnChainNonce:  gap.OnChainNonce,
+		MissingNonces: gap.MissingNonces,
+		Found:         gap.Found(),
+	}
+	for _, attempt := range fillers {
+		p.FillerTransactions = append(p.FillerTransactions, presenters.NewEthTxFromAttempt(attempt))
+	}
+	return p
+}
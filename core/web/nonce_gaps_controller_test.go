@@ -0,0 +1,65 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceGapsController_IndexAndRepair(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+
+	require.NoError(t, store.DB.Exec(`UPDATE keys SET next_nonce = 3 WHERE address = ?`, from).Error)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 1, from)
+
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	resp, cleanup := client.Get("/v2/nonce_gaps")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var gaps []presenters.NonceGap
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &gaps))
+	require.Len(t, gaps, 1)
+	assert.Equal(t, from, gaps[0].Address)
+	assert.True(t, gaps[0].Found)
+	assert.Equal(t, int64(3), gaps[0].LocalNonce)
+	assert.Equal(t, int64(0), gaps[0].OnChainNonce)
+	assert.Equal(t, []int64{0, 2}, gaps[0].MissingNonces)
+
+	ethMock.Register("eth_getTransactionCount", "0x0")
+	resp, cleanup = client.Post("/v2/nonce_gaps/repair", bytes.NewBufferString(`{"address": "`+from.Hex()+`"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	gaps = nil
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &gaps))
+	require.Len(t, gaps, 1)
+	assert.Len(t, gaps[0].FillerTransactions, 2)
+
+	var count int
+	require.NoError(t, store.DB.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND nonce IN (0, 2)`, from).Row().Scan(&count))
+	assert.Equal(t, 2, count)
+
+	resp, cleanup = client.Post("/v2/nonce_gaps/repair", bytes.NewBufferString(`{"address": "`+cltest.NewAddress().Hex()+`"}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
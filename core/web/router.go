@@ -34,6 +34,8 @@ import (
 	"github.com/unrolled/secure"
 )
 
+var webLogger = logger.Module(logger.ModuleWeb)
+
 var prometheus *ginprom.Prometheus
 
 func init() {
@@ -45,7 +47,7 @@ func init() {
 }
 
 func printRoutes(httpMethod, absolutePath, handlerName string, nuHandlers int) {
-	logger.Debugf("%-6s %-25s --> %s (%d handlers)", httpMethod, absolutePath, handlerName, nuHandlers)
+	webLogger.Debugf("%-6s %-25s --> %s (%d handlers)", httpMethod, absolutePath, handlerName, nuHandlers)
 }
 
 const (
@@ -79,7 +81,7 @@ func Router(app chainlink.Application) *gin.Engine {
 	config := store.Config
 	secret, err := config.SessionSecret()
 	if err != nil {
-		logger.Panic(err)
+		webLogger.Panic(err)
 	}
 	sessionStore := sessions.NewCookieStore(secret)
 	sessionStore.Options(config.SessionOptions())
@@ -201,6 +203,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	sa := ServiceAgreementsController{app}
 	unauthedv2.POST("/service_agreements", sa.Create)
 
+	wh := WebhooksController{app}
+	unauthedv2.POST("/jobs/:SpecID/webhook", wh.Create)
+
 	j := JobSpecsController{app}
 	jsec := JobSpecErrorsController{app}
 
@@ -219,14 +224,34 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/specs", j.Create)
 		authv2.GET("/specs", paginatedRequest(j.Index))
 		authv2.GET("/specs/:SpecID", j.Show)
+		authv2.PUT("/specs/:SpecID", j.Update)
+		authv2.GET("/specs/:SpecID/versions", j.Versions)
+		authv2.POST("/specs/:SpecID/versions/:Version/rollback", j.Rollback)
+		authv2.POST("/specs/:SpecID/pause", j.Pause)
+		authv2.POST("/specs/:SpecID/resume", j.Resume)
 		authv2.DELETE("/specs/:SpecID", j.Destroy)
 
+		jb := JobBundlesController{app}
+		authv2.GET("/specs/:SpecID/export", jb.Show)
+		authv2.POST("/job_bundles", jb.Create)
+
+		mc := MaintenanceController{app}
+		authv2.GET("/maintenance", mc.Show)
+		authv2.POST("/maintenance", mc.Create)
+		authv2.DELETE("/maintenance", mc.Destroy)
+
 		authv2.GET("/runs", paginatedRequest(jr.Index))
 		authv2.GET("/runs/:RunID", jr.Show)
 		authv2.PUT("/runs/:RunID/cancellation", jr.Cancel)
+		authv2.POST("/runs/:RunID/retry", jr.Retry)
 
 		authv2.DELETE("/job_spec_errors/:jobSpecErrorID", jsec.Destroy)
 
+		fmc := FluxMonitorController{app}
+		authv2.PATCH("/flux_monitor/:SpecID", fmc.Update)
+		authv2.GET("/flux_monitor/:SpecID/state", fmc.State)
+		authv2.GET("/flux_monitor/:SpecID/simulated_submissions", paginatedRequest(fmc.SimulatedSubmissions))
+
 		authv2.GET("/service_agreements/:SAID", sa.Show)
 
 		bt := BridgeTypesController{app}
@@ -248,16 +273,31 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/config", cc.Show)
 		authv2.PATCH("/config", cc.Patch)
 
+		lc := LogController{app}
+		authv2.GET("/log", lc.Show)
+		authv2.PATCH("/log", lc.Patch)
+
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.DELETE("/transactions/:TxHash", txs.Abandon)
+		authv2.POST("/transactions/rebroadcast", txs.Rebroadcast)
+
+		ngc := NonceGapsController{app}
+		authv2.GET("/nonce_gaps", ngc.Index)
+		authv2.POST("/nonce_gaps/repair", ngc.Repair)
 
 		bdc := BulkDeletesController{app}
 		authv2.DELETE("/bulk_delete_runs", bdc.Delete)
 
+		dlrc := DeadLetterRunsController{app}
+		authv2.GET("/dead_letter_runs", paginatedRequest(dlrc.Index))
+		authv2.POST("/dead_letter_runs/retries", dlrc.Retry)
+		authv2.DELETE("/dead_letter_runs", dlrc.Discard)
+
 		ocrkc := OffChainReportingKeysController{app}
 		authv2.GET("/off_chain_reporting_keys", ocrkc.Index)
 		authv2.POST("/off_chain_reporting_keys", ocrkc.Create)
@@ -323,12 +363,12 @@ func guiAssetRoutes(box packr.Box, engine *gin.Engine) {
 			if err == os.ErrNotExist {
 				c.AbortWithStatus(http.StatusNotFound)
 			} else {
-				logger.Errorf("failed to open static file '%s': %+v", path, err)
+				webLogger.Errorf("failed to open static file '%s': %+v", path, err)
 				c.AbortWithStatus(http.StatusInternalServerError)
 			}
 			return
 		}
-		defer logger.ErrorIfCalling(file.Close, "failed when close file")
+		defer webLogger.ErrorIfCalling(file.Close, "failed when close file")
 
 		http.ServeContent(c.Writer, c.Request, path, time.Time{}, file)
 	})
@@ -339,7 +379,7 @@ func loggerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		buf, err := ioutil.ReadAll(c.Request.Body)
 		if err != nil {
-			logger.Error("Web request log error: ", err.Error())
+			webLogger.Error("Web request log error: ", err.Error())
 			// Implicitly relies on limits.RequestSizeLimiter
 			// overriding of c.Request.Body to abort gin's Context
 			// inside ioutil.ReadAll.
@@ -357,7 +397,7 @@ func loggerFunc() gin.HandlerFunc {
 		c.Next()
 		end := time.Now()
 
-		logger.Infow(fmt.Sprintf("%s %s", c.Request.Method, c.Request.URL.Path),
+		webLogger.Infow(fmt.Sprintf("%s %s", c.Request.Method, c.Request.URL.Path),
 			"method", c.Request.Method,
 			"status", c.Writer.Status(),
 			"path", c.Request.URL.Path,
@@ -392,7 +432,7 @@ func readBody(reader io.Reader) string {
 	buf := new(bytes.Buffer)
 	_, err := buf.ReadFrom(reader)
 	if err != nil {
-		logger.Warn("unable to read from body for sanitization: ", err)
+		webLogger.Warn("unable to read from body for sanitization: ", err)
 		return "*FAILED TO READ BODY*"
 	}
 
@@ -402,7 +442,7 @@ func readBody(reader io.Reader) string {
 
 	s, err := readSanitizedJSON(buf)
 	if err != nil {
-		logger.Warn("unable to sanitize json for logging: ", err)
+		webLogger.Warn("unable to sanitize json for logging: ", err)
 		return "*FAILED TO READ BODY*"
 	}
 	return s
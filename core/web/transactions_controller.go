@@ -1,17 +1,42 @@
 package web
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/orm"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 )
 
+const (
+	// transactionsStreamWriteWait is how long a message to a client of the
+	// transactions websocket may take to be written
+	transactionsStreamWriteWait = 10 * time.Second
+	// transactionsStreamPongWait is how long to wait for a client to answer a
+	// ping before dropping it
+	transactionsStreamPongWait = 60 * time.Second
+	// transactionsStreamPingPeriod is how often clients are pinged, which
+	// must be less than transactionsStreamPongWait
+	transactionsStreamPingPeriod = 30 * time.Second
+)
+
 // TransactionsController displays Ethereum transactions requests.
 type TransactionsController struct {
 	App chainlink.Application
@@ -32,6 +57,17 @@ func (tc *TransactionsController) Index(c *gin.Context, size, page, offset int)
 // Example:
 //  "<application>/transactions/:TxHash"
 func (tc *TransactionsController) Show(c *gin.Context) {
+	// The router cannot have static /transactions/ws or /transactions/export
+	// routes next to /transactions/:TxHash, so they are dispatched from here
+	switch c.Param("TxHash") {
+	case "ws":
+		tc.Stream(c)
+		return
+	case "export":
+		tc.Export(c)
+		return
+	}
+
 	hash := common.HexToHash(c.Param("TxHash"))
 
 	ethTxAttempt, err := tc.App.GetStore().FindEthTxAttempt(hash)
@@ -46,3 +82,313 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Abandon replaces an unconfirmed transaction with an empty self-transfer at
+// the same nonce, at a higher gas price. The transaction is identified either
+// by the hash of one of its attempts or by its ID.
+// Example:
+//  "<application>/transactions/:TxHash"
+func (tc *TransactionsController) Abandon(c *gin.Context) {
+	store := tc.App.GetStore()
+
+	var etxID int64
+	param := c.Param("TxHash")
+	if strings.HasPrefix(param, "0x") {
+		ethTxAttempt, err := store.FindEthTxAttempt(common.HexToHash(param))
+		if errors.Cause(err) == orm.ErrorNotFound {
+			jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		etxID = ethTxAttempt.EthTxID
+	} else {
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		etxID = id
+	}
+
+	attempt, err := bulletprooftxmanager.AbandonEthTx(store, etxID)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if errors.Cause(err) == bulletprooftxmanager.ErrEthTxNotAbandonable {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	etx, err := store.FindEthTxWithAttempts(etxID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	attempt.EthTx = etx
+	jsonAPIResponse(c, presenters.NewEthTxFromAttempt(attempt), "transaction")
+}
+
+// Rebroadcast re-signs the unconfirmed transactions of the node's keys at the
+// requested gas price, and sends them again.
+// Example:
+//  "<application>/transactions/rebroadcast"
+func (tc *TransactionsController) Rebroadcast(c *gin.Context) {
+	store := tc.App.GetStore()
+	if !store.Config.EnableBulletproofTxManager() {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("rebroadcasting requires ENABLE_BULLETPROOF_TX_MANAGER"))
+		return
+	}
+
+	request := models.RebroadcastRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.GasPrice == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("gasPrice is required"))
+		return
+	}
+
+	attempts, err := bulletprooftxmanager.RebroadcastUnconfirmed(store, request.Address, request.BeginningNonce, request.GasPrice.ToInt(), request.GasLimit)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ptxs := make([]presenters.EthTx, len(attempts))
+	for i, attempt := range attempts {
+		ptxs[i] = presenters.NewEthTxFromAttempt(attempt)
+	}
+	jsonAPIResponse(c, ptxs, "transactions")
+}
+
+// ethTxExportHeader names the columns of the transaction history export.
+// Each row is one attempt, and each eth_tx without attempts has a row of its
+// own with the attempt columns left empty.
+var ethTxExportHeader = []string{
+	"eth_tx_id",
+	"job_run_id",
+	"from_address",
+	"to_address",
+	"nonce",
+	"value_wei",
+	"gas_limit",
+	"state",
+	"error",
+	"created_at",
+	"broadcast_at",
+	"attempt_id",
+	"attempt_hash",
+	"attempt_gas_price_wei",
+	"attempt_state",
+	"broadcast_before_block_num",
+	"receipt_block_number",
+	"receipt_block_hash",
+	"gas_used",
+	"fee_wei",
+}
+
+// Export streams the whole transaction history of the node, so that gas costs
+// can be accounted for outside of it. Only the csv format is supported.
+// Example:
+//  "<application>/transactions/export?format=csv"
+func (tc *TransactionsController) Export(c *gin.Context) {
+	if format := c.DefaultQuery("format", "csv"); format != "csv" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("unsupported export format %q", format))
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", `attachment; filename="transactions.csv"`)
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	err := w.Write(ethTxExportHeader)
+	if err == nil {
+		err = tc.App.GetStore().AllEthTxesWithAttempts(func(etx models.EthTx, jobRunID *models.ID) error {
+			for _, row := range ethTxExportRows(etx, jobRunID) {
+				if err := w.Write(row); err != nil {
+					return err
+				}
+			}
+			w.Flush()
+			return w.Error()
+		})
+	}
+	if err == nil {
+		w.Flush()
+		err = w.Error()
+	}
+	if err != nil {
+		// The status has already been sent, so the client can only tell
+		// from the truncated body
+		webLogger.Errorw("TransactionsController: error exporting transactions", "err", err)
+		_ = c.Error(err)
+	}
+}
+
+func ethTxExportRows(etx models.EthTx, jobRunID *models.ID) [][]string {
+	tx := []string{
+		fmt.Sprint(etx.ID),
+		"",
+		etx.FromAddress.Hex(),
+		etx.ToAddress.Hex(),
+		"",
+		etx.Value.ToInt().String(),
+		fmt.Sprint(etx.GasLimit),
+		string(etx.State),
+		"",
+		etx.CreatedAt.UTC().Format(time.RFC3339),
+		"",
+	}
+	if jobRunID != nil {
+		tx[1] = jobRunID.String()
+	}
+	if etx.Nonce != nil {
+		tx[4] = fmt.Sprint(*etx.Nonce)
+	}
+	if etx.Error != nil {
+		tx[8] = *etx.Error
+	}
+	if etx.BroadcastAt != nil {
+		tx[10] = etx.BroadcastAt.UTC().Format(time.RFC3339)
+	}
+
+	if len(etx.EthTxAttempts) == 0 {
+		return [][]string{append(tx, make([]string, len(ethTxExportHeader)-len(tx))...)}
+	}
+	rows := make([][]string, len(etx.EthTxAttempts))
+	for i, attempt := range etx.EthTxAttempts {
+		row := append(append([]string{}, tx...),
+			fmt.Sprint(attempt.ID),
+			attempt.Hash.Hex(),
+			attempt.GasPrice.String(),
+			string(attempt.State),
+			"", "", "", "", "",
+		)
+		if attempt.BroadcastBeforeBlockNum != nil {
+			row[15] = fmt.Sprint(*attempt.BroadcastBeforeBlockNum)
+		}
+		if receipt := latestEthReceipt(attempt.EthReceipts); receipt != nil {
+			row[16] = fmt.Sprint(receipt.BlockNumber)
+			row[17] = receipt.BlockHash.Hex()
+			if gasUsed, ok := receiptGasUsed(*receipt); ok {
+				row[18] = fmt.Sprint(gasUsed)
+				row[19] = new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), attempt.GasPrice.ToInt()).String()
+			}
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// latestEthReceipt returns the receipt from the highest block, since an
+// attempt which was re-orged out and mined again has more than one
+func latestEthReceipt(receipts []models.EthReceipt) *models.EthReceipt {
+	var latest *models.EthReceipt
+	for i := range receipts {
+		if latest == nil || receipts[i].BlockNumber > latest.BlockNumber {
+			latest = &receipts[i]
+		}
+	}
+	return latest
+}
+
+func receiptGasUsed(receipt models.EthReceipt) (uint64, bool) {
+	var r struct {
+		GasUsed *hexutil.Uint64 `json:"gasUsed"`
+	}
+	if err := json.Unmarshal(receipt.Receipt, &r); err != nil || r.GasUsed == nil {
+		return 0, false
+	}
+	return uint64(*r.GasUsed), true
+}
+
+// Stream upgrades the connection to a websocket, over which a JSON message is
+// sent whenever an eth_tx is created or changes state, from unstarted through
+// in_progress and unconfirmed to confirmed or fatal_error.
+// Example:
+//  "<application>/transactions/ws"
+func (tc *TransactionsController) Stream(c *gin.Context) {
+	sub, err := tc.App.GetEventBroadcaster().Subscribe(postgres.ChannelEthTxStateChanged, "")
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	defer sub.Close()
+
+	upgrader := websocket.Upgrader{CheckOrigin: tc.checkOrigin}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already replied to the client
+		webLogger.Debugw("TransactionsController: websocket upgrade failed", "err", err)
+		return
+	}
+	defer webLogger.ErrorIfCalling(conn.Close)
+
+	// Clients are not expected to send anything, but reading is needed to
+	// handle pongs and to notice when the client goes away
+	chClosed := make(chan struct{})
+	webLogger.ErrorIf(conn.SetReadDeadline(time.Now().Add(transactionsStreamPongWait)))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(transactionsStreamPongWait))
+	})
+	go func() {
+		defer close(chClosed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(transactionsStreamPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-chClosed:
+			return
+		case event := <-sub.Events():
+			var change presenters.EthTxStateChange
+			if err := json.Unmarshal([]byte(event.Payload), &change); err != nil {
+				webLogger.Errorw("TransactionsController: could not parse eth_tx state change", "payload", event.Payload, "err", err)
+				continue
+			}
+			webLogger.ErrorIf(conn.SetWriteDeadline(time.Now().Add(transactionsStreamWriteWait)))
+			if err := conn.WriteJSON(change); err != nil {
+				return
+			}
+		case <-ticker.C:
+			webLogger.ErrorIf(conn.SetWriteDeadline(time.Now().Add(transactionsStreamWriteWait)))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// checkOrigin allows websocket connections from the node's own origin and
+// from those allowed by ALLOW_ORIGINS, so that other sites cannot use an
+// operator's session cookie to open the stream.
+func (tc *TransactionsController) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	allowOrigins := tc.App.GetStore().Config.AllowOrigins()
+	if allowOrigins == "*" {
+		return true
+	}
+	for _, allowed := range strings.Split(allowOrigins, ",") {
+		if allowed == origin {
+			return true
+		}
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
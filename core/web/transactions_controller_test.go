@@ -1,17 +1,23 @@
 package web_test
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
 	"math/big"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
 
+	"github.com/gorilla/websocket"
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -140,3 +146,220 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	defer cleanup()
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Abandon_Success(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 1, from)
+	require.Len(t, tx.EthTxAttempts, 1)
+
+	resp, cleanup := client.Delete("/v2/transactions/" + tx.EthTxAttempts[0].Hash.Hex())
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTx{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.True(t, ptx.Abandoned)
+	assert.Equal(t, from, *ptx.To)
+
+	tx, err := store.FindEthTxWithAttempts(tx.ID)
+	require.NoError(t, err)
+	assert.True(t, tx.Abandoned)
+	assert.Equal(t, models.EthTxUnconfirmed, tx.State)
+	assert.Equal(t, from, tx.ToAddress)
+	assert.Empty(t, tx.EncodedPayload)
+	assert.Len(t, tx.EthTxAttempts, 2)
+}
+
+func TestTransactionsController_Abandon_Confirmed(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		cltest.EthMockRegisterChainID,
+		cltest.EthMockRegisterGetBalance,
+	)
+	defer cleanup()
+
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+	tx := cltest.MustInsertConfirmedEthTxWithAttempt(t, store, 1, 1, from)
+
+	resp, cleanup := client.Delete(fmt.Sprintf("/v2/transactions/%d", tx.ID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+}
+
+func TestTransactionsController_Rebroadcast(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t, cltest.LenientEthMock)
+	defer cleanup()
+
+	ethMock := app.EthMock
+	ethMock.Context("app.Start()", func(ethMock *cltest.EthMock) {
+		ethMock.Register("eth_chainId", app.Store.Config.ChainID())
+	})
+
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+
+	skipped := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 1, from)
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastAttempt(t, store, 2, from)
+
+	body := `{"beginningNonce": 2, "gasPrice": "42000000000"}`
+	resp, cleanup := client.Post("/v2/transactions/rebroadcast", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var ptxs []presenters.EthTx
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptxs))
+	require.Len(t, ptxs, 1)
+	assert.Equal(t, "42000000000", ptxs[0].GasPrice)
+
+	tx, err := store.FindEthTxWithAttempts(tx.ID)
+	require.NoError(t, err)
+	assert.Len(t, tx.EthTxAttempts, 2)
+
+	skipped, err = store.FindEthTxWithAttempts(skipped.ID)
+	require.NoError(t, err)
+	assert.Len(t, skipped.EthTxAttempts, 1)
+}
+
+func TestTransactionsController_Export(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		cltest.EthMockRegisterChainID,
+		cltest.EthMockRegisterGetBalance,
+	)
+	defer cleanup()
+
+	require.NoError(t, app.Start())
+	store := app.GetStore()
+	client := app.NewHTTPClient()
+	from := cltest.GetAccountAddress(t, store)
+
+	etx := cltest.MustInsertConfirmedEthTxWithAttempt(t, store, 0, 1, from)
+	attempt := etx.EthTxAttempts[0]
+	receipt := models.EthReceipt{
+		TxHash:      attempt.Hash,
+		BlockHash:   cltest.NewHash(),
+		BlockNumber: 1,
+		Receipt:     []byte(`{"gasUsed":"0x5208"}`),
+	}
+	require.NoError(t, store.DB.Create(&receipt).Error)
+
+	taskRunID := cltest.MustInsertTaskRun(t, store)
+	require.NoError(t, store.DB.Exec(`INSERT INTO eth_task_run_txes (task_run_id, eth_tx_id) VALUES (?, ?)`, &taskRunID, etx.ID).Error)
+	var jobRunID models.ID
+	require.NoError(t, store.DB.Raw(`SELECT job_run_id FROM task_runs WHERE id = ?`, &taskRunID).Row().Scan(&jobRunID))
+
+	resp, cleanup := client.Get("/v2/transactions/export?format=csv")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	header, row := records[0], records[1]
+	column := func(name string) string {
+		for i, h := range header {
+			if h == name {
+				return row[i]
+			}
+		}
+		t.Fatalf("no column %s", name)
+		return ""
+	}
+	assert.Equal(t, fmt.Sprint(etx.ID), column("eth_tx_id"))
+	assert.Equal(t, jobRunID.String(), column("job_run_id"))
+	assert.Equal(t, from.Hex(), column("from_address"))
+	assert.Equal(t, "0", column("nonce"))
+	assert.Equal(t, "confirmed", column("state"))
+	assert.Equal(t, attempt.Hash.Hex(), column("attempt_hash"))
+	assert.Equal(t, "1", column("attempt_gas_price_wei"))
+	assert.Equal(t, receipt.BlockHash.Hex(), column("receipt_block_hash"))
+	assert.Equal(t, "21000", column("gas_used"))
+	assert.Equal(t, "21000", column("fee_wei"))
+
+	resp, cleanup = client.Get("/v2/transactions/export?format=xml")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestTransactionsController_Stream(t *testing.T) {
+	t.Parallel()
+
+	app, cleanup := cltest.NewApplicationWithKey(t,
+		cltest.EthMockRegisterChainID,
+		cltest.EthMockRegisterGetBalance,
+	)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	url := "ws" + strings.TrimPrefix(app.Server.URL, "http") + "/v2/transactions/ws"
+
+	t.Run("rejects unauthenticated clients", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("streams eth_tx state changes", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Cookie", cltest.MustGenerateSessionCookie(app.MustSeedNewSession()).String())
+		conn, _, err := websocket.DefaultDialer.Dial(url, header)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		// Notifications from the eth_txes trigger are only sent when the
+		// database transaction commits, which never happens in tests, so
+		// notify on its behalf
+		from := cltest.GetAccountAddress(t, app.Store)
+		hash := cltest.NewHash()
+		payload := fmt.Sprintf(`{"id": 42, "state": "unconfirmed", "previousState": "in_progress", "fromAddress": "%s", "nonce": 7, "hash": "%s"}`,
+			strings.ToLower(from.Hex()), hash.Hex())
+		require.NoError(t, app.EventBroadcaster.Notify(postgres.ChannelEthTxStateChanged, payload))
+
+		var change presenters.EthTxStateChange
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		require.NoError(t, conn.ReadJSON(&change))
+
+		assert.Equal(t, int64(42), change.ID)
+		assert.Equal(t, string(models.EthTxUnconfirmed), change.State)
+		require.NotNil(t, change.PreviousState)
+		assert.Equal(t, string(models.EthTxInProgress), *change.PreviousState)
+		assert.Equal(t, from, change.FromAddress)
+		require.NotNil(t, change.Nonce)
+		assert.Equal(t, int64(7), *change.Nonce)
+		require.NotNil(t, change.Hash)
+		assert.Equal(t, hash, *change.Hash)
+	})
+
+	t.Run("rejects cross-origin clients", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Cookie", cltest.MustGenerateSessionCookie(app.MustSeedNewSession()).String())
+		header.Set("Origin", "https://evil.example.com")
+		_, resp, err := websocket.DefaultDialer.Dial(url, header)
+		require.Equal(t, websocket.ErrBadHandshake, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
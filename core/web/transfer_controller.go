@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/presenters"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 )
 
 // TransfersController can send LINK tokens to another address
@@ -17,7 +18,9 @@ type TransfersController struct {
 	App chainlink.Application
 }
 
-// Create sends ETH from the Chainlink's account to a specified address.
+// Create sends ETH from the Chainlink's account to a specified address. With
+// an Idempotency-Key header, retrying the request returns the transfer created
+// by the first request.
 //
 // Example: "<application>/withdrawals"
 func (tc *TransfersController) Create(c *gin.Context) {
@@ -28,9 +31,14 @@ func (tc *TransfersController) Create(c *gin.Context) {
 	}
 
 	store := tc.App.GetStore()
+	key := idempotencyKey(c)
 
 	if store.Config.EnableBulletproofTxManager() {
-		etx, err := bulletprooftxmanager.SendEther(store, tr.FromAddress, tr.DestinationAddress, tr.Amount)
+		etx, err := bulletprooftxmanager.SendEther(store, tr.FromAddress, tr.DestinationAddress, tr.Amount, key)
+		if errors.Cause(err) == bulletprooftxmanager.ErrIdempotencyKeyReused {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
 		if err != nil {
 			jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("transaction failed: %v", err))
 			return
@@ -38,6 +46,10 @@ func (tc *TransfersController) Create(c *gin.Context) {
 
 		jsonAPIResponse(c, etx, "eth_tx")
 	} else {
+		if key != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("%s is only supported with the bulletproof tx manager", IdempotencyKeyHeader))
+			return
+		}
 		tx, err := store.TxManager.CreateTxWithEth(tr.FromAddress, tr.DestinationAddress, &tr.Amount)
 		if err != nil {
 			jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("transaction failed: %v", err))
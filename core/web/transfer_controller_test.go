@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -98,3 +99,47 @@ func TestTransfersController_JSONBindingError(t *testing.T) {
 
 	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
 }
+
+func TestTransfersController_Create_IdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	config, _ := cltest.NewConfig(t)
+	app, cleanup := cltest.NewApplicationWithConfigAndKey(t, config,
+		cltest.EthMockRegisterChainID,
+		cltest.EthMockRegisterGetBalance,
+	)
+	defer cleanup()
+
+	client := app.NewHTTPClient()
+	require.NoError(t, app.StartAndConnect())
+
+	sendKeys, err := app.GetStore().SendKeys()
+	require.NoError(t, err)
+	from := common.HexToAddress(string(sendKeys[0].Address))
+
+	request := models.SendEtherRequest{
+		DestinationAddress: common.HexToAddress("0xFA01FA015C8A5332987319823728982379128371"),
+		FromAddress:        from,
+		Amount:             *assets.NewEth(100),
+	}
+	body, err := json.Marshal(&request)
+	require.NoError(t, err)
+	headers := map[string]string{web.IdempotencyKeyHeader: "9f1c2b7e-transfer"}
+
+	for i := 0; i < 2; i++ {
+		resp, cleanup := client.Post("/v2/transfers", bytes.NewBuffer(body), headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+	}
+
+	count, err := app.GetStore().CountOf(models.EthTx{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	request.Amount = *assets.NewEth(200)
+	body, err = json.Marshal(&request)
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/transfers", bytes.NewBuffer(body), headers)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,101 @@
+package web
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/orm"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	// WebhookSignatureHeader is the header in which the sender of a webhook
+	// request gives the signature of its timestamp and body, as made by
+	// models.WebhookSignature.
+	WebhookSignatureHeader = "X-Chainlink-Signature"
+	// WebhookTimestampHeader is the header in which the sender of a webhook
+	// request gives the unix time in seconds at which it was signed.
+	WebhookTimestampHeader = "X-Chainlink-Timestamp"
+)
+
+// WebhooksController starts runs of jobs with webhook initiators for
+// requests signed with the initiator's secret, without any other
+// authentication.
+type WebhooksController struct {
+	App chainlink.Application
+}
+
+// Create starts a run of the job, with the request body as its params, if the
+// request is signed by one of its webhook initiators.
+// Example:
+//  "<application>/jobs/:SpecID/webhook"
+func (wc *WebhooksController) Create(c *gin.Context) {
+	id, err := models.NewIDFromString(c.Param("SpecID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	j, err := wc.App.GetStore().FindJob(id)
+	if errors.Cause(err) == orm.ErrorNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	timestamp, err := strconv.ParseInt(c.GetHeader(WebhookTimestampHeader), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("Missing or invalid webhook timestamp"))
+		return
+	}
+	initiator := webhookInitiator(j, timestamp, body, c.GetHeader(WebhookSignatureHeader), wc.App.GetStore().Clock.Now())
+	if initiator == nil {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("Invalid webhook signature"))
+		return
+	}
+	if j.Paused() {
+		jsonAPIError(c, http.StatusConflict, errors.New("Job is paused"))
+		return
+	}
+
+	data, err := models.ParseJSON(body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jr, err := wc.App.Create(j.ID, initiator, nil, &models.RunRequest{RequestParams: data})
+	if err != nil {
+		jsonAPIRunCreateError(c, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.JobRun{JobRun: *jr}, "job run")
+}
+
+// webhookInitiator returns the webhook initiator of the job whose secret the
+// timestamp and body were signed with, or nil if there is none or the
+// timestamp is too old.
+func webhookInitiator(j models.JobSpec, timestamp int64, body []byte, signature string, now time.Time) *models.Initiator {
+	for _, initiator := range j.InitiatorsFor(models.InitiatorWebhook) {
+		if initiator.VerifyWebhookSignature(timestamp, body, signature, now) {
+			return &initiator
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/store/presenters"
+	"github.com/smartcontractkit/chainlink/core/web"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksController_Create(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	const secret = "0123456789abcdef"
+	job := cltest.NewJobWithWebInitiator()
+	job.Initiators[0].Type = models.InitiatorWebhook
+	job.Initiators[0].Secret = secret
+	require.NoError(t, app.AddJob(job))
+
+	body := []byte(`{"symbol": "ETH"}`)
+	now := time.Now().Unix()
+	stale := time.Now().Add(-models.WebhookTimestampTolerance - time.Minute).Unix()
+	post := func(body []byte, timestamp int64, signature string) *http.Response {
+		req, err := http.NewRequest("POST", app.Server.URL+"/v2/jobs/"+job.ID.String()+"/webhook", bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if timestamp != 0 {
+			req.Header.Set(web.WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+		}
+		if signature != "" {
+			req.Header.Set(web.WebhookSignatureHeader, signature)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	tests := []struct {
+		name      string
+		timestamp int64
+		signature string
+		wantCode  int
+	}{
+		{"unsigned", now, "", http.StatusUnauthorized},
+		{"no timestamp", 0, models.WebhookSignature(secret, now, body), http.StatusUnauthorized},
+		{"wrong timestamp", now + 1, models.WebhookSignature(secret, now, body), http.StatusUnauthorized},
+		{"stale timestamp", stale, models.WebhookSignature(secret, stale, body), http.StatusUnauthorized},
+		{"wrong secret", now, models.WebhookSignature("fedcba9876543210", now, body), http.StatusUnauthorized},
+		{"signed", now, models.WebhookSignature(secret, now, body), http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := post(body, test.timestamp, test.signature)
+			defer resp.Body.Close()
+			cltest.AssertServerResponse(t, resp, test.wantCode)
+		})
+	}
+
+	resp := post(body, now, models.WebhookSignature(secret, now, body))
+	defer resp.Body.Close()
+	var run presenters.JobRun
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &run))
+	run = presenters.JobRun{JobRun: cltest.WaitForJobRunToComplete(t, app.Store, run.JobRun)}
+	assert.Equal(t, "ETH", run.RunRequest.RequestParams.Get("symbol").String())
+	assert.Equal(t, models.InitiatorWebhook, run.Initiator.Type)
+
+	resp = post(body, now, models.WebhookSignature(secret, now, []byte(`{"symbol": "BTC"}`)))
+	defer resp.Body.Close()
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+}
+
+func TestWebhooksController_Create_NotFound(t *testing.T) {
+	t.Parallel()
+	app, cleanup := cltest.NewApplication(t, cltest.LenientEthMock)
+	defer cleanup()
+	require.NoError(t, app.Start())
+
+	resp, err := http.Post(app.Server.URL+"/v2/jobs/"+models.NewID().String()+"/webhook", "application/json", nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -7,6 +7,7 @@ export enum RunStatus {
   PENDING_CONNECTION = 'pending_connection',
   PENDING_BRIDGE = 'pending_bridge',
   PENDING_SLEEP = 'pending_sleep',
+  PENDING_CONCURRENCY = 'pending_concurrency',
   ERRORED = 'errored',
   COMPLETED = 'completed',
 }